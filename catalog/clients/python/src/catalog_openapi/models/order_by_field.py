@@ -31,6 +31,7 @@ class OrderByField(str, Enum):
     ID = 'ID'
     NAME = 'NAME'
     ACCURACY = 'ACCURACY'
+    RELEVANCE = 'RELEVANCE'
 
     @classmethod
     def from_json(cls, json_str: str) -> Self:
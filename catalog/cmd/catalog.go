@@ -45,6 +45,19 @@ const (
 
 	envLeaderLockDuration = "CATALOG_LEADER_LOCK_DURATION"
 	envLeaderHeartbeat    = "CATALOG_LEADER_HEARTBEAT"
+
+	// envResponseCacheTTL sets how long responses are cached. Set it to 0
+	// to disable the cache; ETags are still served. Standby pods only see
+	// changes once their cached responses expire, so keep it short.
+	envResponseCacheTTL     = "CATALOG_RESPONSE_CACHE_TTL"
+	defaultResponseCacheTTL = 10 * time.Second
+
+	// envWriteToken holds the bearer token required by the endpoints
+	// that modify catalog models. They are disabled when it is unset.
+	envWriteToken = "CATALOG_WRITE_TOKEN"
+
+	previewPath = "/api/model_catalog/v1alpha1/sources/preview"
+	comparePath = "/api/model_catalog/v1alpha1/models:compare"
 )
 
 // parseDurationEnv parses a duration from an environment variable,
@@ -75,6 +88,16 @@ func getLeaderElectionConfig() (lockDuration, heartbeat time.Duration) {
 	return lockDuration, heartbeat
 }
 
+// requiresWriteToken reports whether r needs the write token. Previewing a
+// source and comparing models use POST but don't change anything, so they
+// stay open.
+func requiresWriteToken(r *http.Request) bool {
+	if r.Method == http.MethodPost && (r.URL.Path == previewPath || r.URL.Path == comparePath) {
+		return false
+	}
+	return middleware.IsWriteRequest(r)
+}
+
 var CatalogCmd = &cobra.Command{
 	Use:   "catalog",
 	Short: "Catalog API server",
@@ -145,6 +168,9 @@ func runCatalogServer(cmd *cobra.Command, args []string) error {
 		getRepo[models.PropertyOptionsRepository](repoSet),
 		getRepo[mcpcatalogmodels.MCPServerRepository](repoSet),
 		getRepo[mcpcatalogmodels.MCPServerToolRepository](repoSet),
+		getRepo[models.CatalogSourceSyncRepository](repoSet),
+		getRepo[models.CatalogSourceRefreshRepository](repoSet),
+		getRepo[models.CatalogNamedQueryRepository](repoSet),
 	)
 
 	loader := catalog.NewLoader(services, catalogCfg.ConfigPath)
@@ -155,6 +181,15 @@ func runCatalogServer(cmd *cobra.Command, args []string) error {
 	}
 	loader.RegisterEventHandler(perfLoader.Load)
 
+	// Loads invalidate the cached responses. Event handlers only run on the
+	// leader, so the cache's TTL bounds how stale standby pods can get.
+	responseCache := openapi.NewResponseCache(parseDurationEnv(envResponseCacheTTL, defaultResponseCacheTTL))
+	loader.RegisterEventHandler(func(ctx context.Context, record catalog.ModelProviderRecord) error {
+		responseCache.SourceChanged(record.SourceID())
+		return nil
+	})
+	loader.RegisterReloadHandler(responseCache.AllChanged)
+
 	elector.OnBecomeLeader(func(leaderCtx context.Context) {
 		poRefresher := models.NewPropertyOptionsRefresher(leaderCtx, services.PropertyOptionsRepository, time.Second)
 		loader.RegisterEventHandler(func(ctx context.Context, record catalog.ModelProviderRecord) error {
@@ -186,19 +221,24 @@ func runCatalogServer(cmd *cobra.Command, args []string) error {
 		mcpSources,
 		loader.Labels(),
 		services.CatalogSourceRepository,
+		services.CatalogSourceSyncRepository,
+		services.CatalogSourceRefreshRepository,
 	)
-	ctrl := openapi.NewModelCatalogServiceAPIController(svc)
+	ctrl := openapi.NewCachingRouter(openapi.NewModelCatalogServiceAPIController(svc), responseCache)
 
 	// Create MCP provider and service, wiring named query resolution from loaded sources.
-	mcpProvider := catalog.NewDBMCPCatalog(services, mcpSources, func(name string) (map[string]catalog.FieldFilter, bool) {
-		return mcpSources.GetNamedQuery(name)
-	})
+	mcpProvider := catalog.NewDBMCPCatalog(services, mcpSources)
 	mcpSvc := openapi.NewMCPCatalogServiceAPIService(mcpProvider, mcpSources)
 	mcpCtrl := openapi.NewMCPCatalogServiceAPIController(mcpSvc)
 
+	router := middleware.BearerTokenMiddleware(os.Getenv(envWriteToken), requiresWriteToken, openapi.NewRouter(ctrl, mcpCtrl))
+	if os.Getenv(envWriteToken) == "" {
+		glog.Infof("%s is not set, endpoints that modify the catalog are disabled", envWriteToken)
+	}
+
 	server := &http.Server{
 		Addr:    catalogCfg.ListenAddress,
-		Handler: middleware.ValidationMiddleware(openapi.NewRouter(ctrl, mcpCtrl)),
+		Handler: middleware.ValidationMiddleware(router),
 	}
 
 	g, gctx := errgroup.WithContext(ctx)
@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
@@ -106,3 +108,25 @@ func TestGetLeaderElectionConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestRequiresWriteToken(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		want   bool
+	}{
+		{method: http.MethodGet, path: "/api/model_catalog/v1alpha1/models", want: false},
+		{method: http.MethodPost, path: previewPath, want: false},
+		{method: http.MethodPost, path: comparePath, want: false},
+		{method: http.MethodPost, path: "/api/model_catalog/v1alpha1/sources/curated/models", want: true},
+		{method: http.MethodPatch, path: "/api/model_catalog/v1alpha1/sources/curated/models/m", want: true},
+		{method: http.MethodDelete, path: "/api/model_catalog/v1alpha1/sources/curated/models/m", want: true},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, tt.path, nil)
+		if got := requiresWriteToken(req); got != tt.want {
+			t.Errorf("requiresWriteToken(%s %s) = %v, want %v", tt.method, tt.path, got, tt.want)
+		}
+	}
+}
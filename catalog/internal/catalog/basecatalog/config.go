@@ -3,8 +3,10 @@ package basecatalog
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/golang/glog"
+	"github.com/kubeflow/hub/internal/licensepolicy"
 	"k8s.io/apimachinery/pkg/util/yaml"
 )
 
@@ -61,6 +63,18 @@ type SourceConfig struct {
 	// See NamedQuery for the supported YAML formats.
 	NamedQueries map[string]NamedQuery `yaml:"namedQueries,omitempty" json:"namedQueries,omitempty"`
 
+	// HardwareCosts contains the hourly cost of one unit of each hardware
+	// type, keyed by the hardware_type of performance metrics. It's used to
+	// rank deployment sizing recommendations.
+	HardwareCosts map[string]float64 `yaml:"hardwareCosts,omitempty" json:"hardwareCosts,omitempty"`
+
+	// LicensePolicy classifies model licenses as allowed, denied or in need
+	// of review. The outcome is recorded on every catalog model.
+	LicensePolicy *licensepolicy.Policy `yaml:"licensePolicy,omitempty" json:"licensePolicy,omitempty"`
+
+	// Scanning configures the scanners that inspect models as sources load.
+	Scanning *ScanConfig `yaml:"scanning,omitempty" json:"scanning,omitempty"`
+
 	// DEPRECATED: Use ModelCatalogs instead
 	// This field is maintained for backwards compatibility
 	Catalogs []ModelSource `yaml:"catalogs,omitempty" json:"catalogs,omitempty"`
@@ -140,5 +154,81 @@ func (c *SourceConfig) Validate() error {
 		return fmt.Errorf("invalid named queries: %w", err)
 	}
 
+	for hardwareType, cost := range c.HardwareCosts {
+		if hardwareType == "" {
+			return fmt.Errorf("hardware cost missing hardware type")
+		}
+		if cost < 0 {
+			return fmt.Errorf("negative cost for hardware type %s: %v", hardwareType, cost)
+		}
+	}
+
+	if err := c.LicensePolicy.Validate(); err != nil {
+		return fmt.Errorf("invalid license policy: %w", err)
+	}
+
+	if err := c.Scanning.Validate(); err != nil {
+		return fmt.Errorf("invalid scanning configuration: %w", err)
+	}
+
+	return nil
+}
+
+// ScanConfig configures the scanners that inspect models as sources load.
+//
+// Example:
+//
+//	scanning:
+//	  scanners: [serialization, oci]
+//	  rescanInterval: 24h
+//	  cosignPublicKey: cosign.pub
+type ScanConfig struct {
+	// Scanners lists the scanners to run, by name. Only the serialization
+	// scanner runs when it's empty.
+	Scanners []string `yaml:"scanners,omitempty" json:"scanners,omitempty"`
+
+	// RescanInterval is how long the results of a scan are reused for a
+	// model whose files and artifacts haven't changed, as a duration such
+	// as "12h". It defaults to DefaultRescanInterval.
+	RescanInterval string `yaml:"rescanInterval,omitempty" json:"rescanInterval,omitempty"`
+
+	// CosignPublicKey is the path of a PEM encoded public key used to
+	// verify the cosign signatures of OCI artifacts. Relative paths are
+	// resolved against the directory of the configuration file.
+	CosignPublicKey string `yaml:"cosignPublicKey,omitempty" json:"cosignPublicKey,omitempty"`
+}
+
+// DefaultRescanInterval is used when ScanConfig.RescanInterval is unset.
+const DefaultRescanInterval = 24 * time.Hour
+
+// Validate checks the scanning configuration for errors.
+func (c *ScanConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+	for _, name := range c.Scanners {
+		if name == "" {
+			return fmt.Errorf("scanner missing name")
+		}
+	}
+	if _, err := c.GetRescanInterval(); err != nil {
+		return err
+	}
 	return nil
 }
+
+// GetRescanInterval returns the parsed rescan interval, or
+// DefaultRescanInterval if it's unset.
+func (c *ScanConfig) GetRescanInterval() (time.Duration, error) {
+	if c == nil || c.RescanInterval == "" {
+		return DefaultRescanInterval, nil
+	}
+	interval, err := time.ParseDuration(c.RescanInterval)
+	if err != nil {
+		return 0, fmt.Errorf("invalid rescan interval %q: %w", c.RescanInterval, err)
+	}
+	if interval <= 0 {
+		return 0, fmt.Errorf("rescan interval must be positive: %s", c.RescanInterval)
+	}
+	return interval, nil
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	apimodels "github.com/kubeflow/hub/catalog/pkg/openapi"
+	"github.com/kubeflow/hub/internal/licensepolicy"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -194,6 +195,65 @@ func TestSourceConfig_Validate(t *testing.T) {
 			expectErr: true,
 			errMsg:    `id "shared" used in both model_catalogs and mcp_catalogs`,
 		},
+		{
+			name: "valid hardware costs",
+			config: &SourceConfig{
+				HardwareCosts: map[string]float64{"H100": 4.5, "cpu": 0},
+			},
+			expectErr: false,
+		},
+		{
+			name: "negative hardware cost",
+			config: &SourceConfig{
+				HardwareCosts: map[string]float64{"H100": -1},
+			},
+			expectErr: true,
+			errMsg:    "negative cost for hardware type H100",
+		},
+		{
+			name: "hardware cost without a hardware type",
+			config: &SourceConfig{
+				HardwareCosts: map[string]float64{"": 1},
+			},
+			expectErr: true,
+			errMsg:    "hardware cost missing hardware type",
+		},
+		{
+			name: "valid license policy",
+			config: &SourceConfig{
+				LicensePolicy: &licensepolicy.Policy{
+					Allow: []string{"apache-2.0"},
+					Classifications: map[string]licensepolicy.Classification{
+						"non-commercial": {Licenses: []string{"cc-by-nc-4.0"}, Status: licensepolicy.StatusDenied},
+					},
+					Default: licensepolicy.StatusReview,
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "license policy with an unsupported status",
+			config: &SourceConfig{
+				LicensePolicy: &licensepolicy.Policy{Default: "blocked"},
+			},
+			expectErr: true,
+			errMsg:    "invalid license policy",
+		},
+		{
+			name: "valid scanning configuration",
+			config: &SourceConfig{
+				Scanning: &ScanConfig{Scanners: []string{"serialization", "oci"}, RescanInterval: "12h"},
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid rescan interval",
+			config: &SourceConfig{
+				Scanning: &ScanConfig{RescanInterval: "daily"},
+			},
+			expectErr: true,
+			errMsg:    "invalid rescan interval",
+		},
 	}
 
 	for _, tt := range tests {
@@ -65,6 +65,24 @@ func AnySlice[T any](s []T) []any {
 	return as
 }
 
+// SetOptionCounts sets the counts of a string option from the number of
+// entities with each value, as returned by facets.CountContextPropertyValues.
+// Each of the option's values gets a count, which is 0 if it's missing from
+// counts.
+func SetOptionCounts(option *apimodels.FilterOption, counts map[string]int32) {
+	if option.Type != "string" {
+		return
+	}
+
+	optionCounts := make(map[string]int32, len(option.Values))
+	for _, value := range option.Values {
+		if s, ok := value.(string); ok {
+			optionCounts[s] = counts[s]
+		}
+	}
+	option.Counts = &optionCounts
+}
+
 // ConvertNamedQueries converts internal named queries to the API representation,
 // resolving "min"/"max" sentinel values against the provided filter options.
 // Returns nil if queries is empty.
@@ -0,0 +1,27 @@
+package basecatalog
+
+import (
+	"testing"
+
+	apimodels "github.com/kubeflow/hub/catalog/pkg/openapi"
+	"github.com/kubeflow/hub/internal/platform/apiutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetOptionCounts(t *testing.T) {
+	option := apimodels.FilterOption{
+		Type:   "string",
+		Values: []any{"apache-2.0", "llama3.1", "mit"},
+	}
+	SetOptionCounts(&option, map[string]int32{"apache-2.0": 42, "mit": 7, "gpl-3.0": 1})
+
+	assert.Equal(t, map[string]int32{"apache-2.0": 42, "llama3.1": 0, "mit": 7}, option.GetCounts())
+
+	numberOption := apimodels.FilterOption{
+		Type:  "number",
+		Range: &apimodels.FilterOptionRange{Min: apiutils.Of(1.0), Max: apiutils.Of(2.0)},
+	}
+	SetOptionCounts(&numberOption, map[string]int32{"1": 1})
+
+	assert.False(t, numberOption.HasCounts())
+}
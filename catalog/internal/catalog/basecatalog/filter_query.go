@@ -59,6 +59,20 @@ func FieldFiltersToFilterQuery(filters map[string]FieldFilter) (string, error) {
 	return strings.Join(parts, " AND "), nil
 }
 
+// MergeFilterQueries combines two filterQuery strings with AND.
+// Empty strings are ignored. If both are non-empty, each is wrapped in parentheses
+// to preserve operator precedence.
+func MergeFilterQueries(a, b string) string {
+	switch {
+	case a == "":
+		return b
+	case b == "":
+		return a
+	default:
+		return fmt.Sprintf("(%s) AND (%s)", a, b)
+	}
+}
+
 // formatValue formats a single filter value for inclusion in a filterQuery string.
 func formatValue(v any) string {
 	switch val := v.(type) {
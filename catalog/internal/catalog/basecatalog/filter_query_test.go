@@ -152,3 +152,25 @@ func TestFieldFiltersToFilterQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeFilterQueries(t *testing.T) {
+	t.Run("both empty returns empty string", func(t *testing.T) {
+		result := MergeFilterQueries("", "")
+		assert.Equal(t, "", result)
+	})
+
+	t.Run("only first non-empty returns first", func(t *testing.T) {
+		result := MergeFilterQueries("a = 1", "")
+		assert.Equal(t, "a = 1", result)
+	})
+
+	t.Run("only second non-empty returns second", func(t *testing.T) {
+		result := MergeFilterQueries("", "b = 2")
+		assert.Equal(t, "b = 2", result)
+	})
+
+	t.Run("both non-empty wraps each in parens and joins with AND", func(t *testing.T) {
+		result := MergeFilterQueries("a = 1", "b = 2")
+		assert.Equal(t, "(a = 1) AND (b = 2)", result)
+	})
+}
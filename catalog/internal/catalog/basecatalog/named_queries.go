@@ -0,0 +1,339 @@
+package basecatalog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	dbmodels "github.com/kubeflow/hub/catalog/internal/db/models"
+	apimodels "github.com/kubeflow/hub/catalog/pkg/openapi"
+	"github.com/kubeflow/hub/internal/platform/apiutils"
+	"github.com/kubeflow/hub/internal/platform/db/filter"
+	"github.com/kubeflow/hub/pkg/api"
+)
+
+// NamedQueryManager lists and changes the named queries of one asset type.
+// The providers returned by NewDBCatalog and NewDBMCPCatalog implement it.
+type NamedQueryManager interface {
+	// ListNamedQueries returns every named query, ordered by name.
+	ListNamedQueries(ctx context.Context) (*apimodels.CatalogNamedQueryList, error)
+
+	// GetNamedQuery returns a named query, or an api.ErrNotFound error.
+	GetNamedQuery(ctx context.Context, name string) (*apimodels.CatalogNamedQuery, error)
+
+	// CreateNamedQuery saves a new named query. It returns an
+	// api.ErrBadRequest error if its filters don't make a valid filter
+	// query, and an api.ErrConflict error if a query with the same name
+	// exists.
+	CreateNamedQuery(ctx context.Context, query apimodels.CatalogNamedQuery) (*apimodels.CatalogNamedQuery, error)
+
+	// UpdateNamedQuery replaces the filters of a saved named query. It
+	// returns an api.ErrConflict error for queries from config files.
+	UpdateNamedQuery(ctx context.Context, name string, update apimodels.CatalogNamedQueryUpdate) (*apimodels.CatalogNamedQuery, error)
+
+	// DeleteNamedQuery removes a saved named query. It returns an
+	// api.ErrConflict error for queries from config files.
+	DeleteNamedQuery(ctx context.Context, name string) error
+
+	// ResolveFilterQuery combines filterQuery with the filters of the
+	// named query called namedQuery, if it's set. It returns an
+	// api.ErrBadRequest error if there's no such query.
+	ResolveFilterQuery(filterQuery string, namedQuery string) (string, error)
+}
+
+// NamedQueryStore manages the named queries of one asset type. It combines
+// the queries defined in source config files with the ones saved through the
+// API. When both have a query with the same name, the one in the config file
+// is used, and it can't be changed through the API.
+type NamedQueryStore struct {
+	assetType string
+	fromFiles func() map[string]map[string]FieldFilter
+	repo      dbmodels.CatalogNamedQueryRepository
+}
+
+var _ NamedQueryManager = (*NamedQueryStore)(nil)
+
+// NewNamedQueryStore creates a NamedQueryStore for assetType. fromFiles
+// returns the queries from config files; it may be nil, as may repo if
+// queries can't be saved.
+func NewNamedQueryStore(assetType string, fromFiles func() map[string]map[string]FieldFilter, repo dbmodels.CatalogNamedQueryRepository) *NamedQueryStore {
+	return &NamedQueryStore{
+		assetType: assetType,
+		fromFiles: fromFiles,
+		repo:      repo,
+	}
+}
+
+// All returns the filters of every named query, keyed by query name.
+func (s *NamedQueryStore) All() (map[string]map[string]FieldFilter, error) {
+	saved, err := s.saved()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]map[string]FieldFilter, len(saved))
+	for _, query := range saved {
+		filters, err := decodeFilters(query)
+		if err != nil {
+			return nil, err
+		}
+		result[query.Name] = filters
+	}
+	for name, filters := range s.files() {
+		result[name] = filters
+	}
+	return result, nil
+}
+
+// Resolve returns the filters of a named query, and false if there's no
+// query with that name.
+func (s *NamedQueryStore) Resolve(name string) (map[string]FieldFilter, bool, error) {
+	if filters, ok := s.files()[name]; ok {
+		return filters, true, nil
+	}
+	if s.repo == nil {
+		return nil, false, nil
+	}
+
+	query, err := s.repo.Get(s.assetType, name)
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	filters, err := decodeFilters(query)
+	if err != nil {
+		return nil, false, err
+	}
+	return filters, true, nil
+}
+
+// ResolveFilterQuery combines filterQuery with the filters of the named
+// query called namedQuery, if it's set.
+func (s *NamedQueryStore) ResolveFilterQuery(filterQuery string, namedQuery string) (string, error) {
+	if namedQuery == "" {
+		return filterQuery, nil
+	}
+
+	filters, found, err := s.Resolve(namedQuery)
+	if err != nil {
+		return "", err
+	}
+	if !found {
+		return "", fmt.Errorf("unknown named query %q: %w", namedQuery, api.ErrBadRequest)
+	}
+	return ApplyNamedQuery(filterQuery, namedQuery, filters)
+}
+
+// ApplyNamedQuery combines filterQuery with the filters of the named query
+// called name using AND.
+func ApplyNamedQuery(filterQuery string, name string, filters map[string]FieldFilter) (string, error) {
+	resolved, err := FieldFiltersToFilterQuery(filters)
+	if err != nil {
+		return "", fmt.Errorf("named query %q has invalid filter: %w", name, err)
+	}
+	return MergeFilterQueries(filterQuery, resolved), nil
+}
+
+// ListNamedQueries returns every named query, ordered by name.
+func (s *NamedQueryStore) ListNamedQueries(ctx context.Context) (*apimodels.CatalogNamedQueryList, error) {
+	saved, err := s.saved()
+	if err != nil {
+		return nil, err
+	}
+	files := s.files()
+
+	items := make([]apimodels.CatalogNamedQuery, 0, len(files)+len(saved))
+	for name, filters := range files {
+		items = append(items, fileQueryToAPI(name, filters))
+	}
+	for _, query := range saved {
+		if _, ok := files[query.Name]; ok {
+			continue
+		}
+		item, err := savedQueryToAPI(query)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, *item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Name < items[j].Name
+	})
+
+	return &apimodels.CatalogNamedQueryList{
+		Items: items,
+		Size:  int32(len(items)),
+	}, nil
+}
+
+// GetNamedQuery returns a named query.
+func (s *NamedQueryStore) GetNamedQuery(ctx context.Context, name string) (*apimodels.CatalogNamedQuery, error) {
+	if filters, ok := s.files()[name]; ok {
+		query := fileQueryToAPI(name, filters)
+		return &query, nil
+	}
+	if s.repo == nil {
+		return nil, fmt.Errorf("named query %q not found: %w", name, api.ErrNotFound)
+	}
+
+	query, err := s.repo.Get(s.assetType, name)
+	if err != nil {
+		return nil, err
+	}
+	return savedQueryToAPI(query)
+}
+
+// CreateNamedQuery saves a new named query.
+func (s *NamedQueryStore) CreateNamedQuery(ctx context.Context, query apimodels.CatalogNamedQuery) (*apimodels.CatalogNamedQuery, error) {
+	if err := s.checkWritable(query.Name); err != nil {
+		return nil, err
+	}
+
+	filters, err := s.encodeFilters(query.Name, query.Filters)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.Create(&dbmodels.NamedQuery{
+		Name:      query.Name,
+		AssetType: s.assetType,
+		Filters:   filters,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return savedQueryToAPI(created)
+}
+
+// UpdateNamedQuery replaces the filters of a saved named query.
+func (s *NamedQueryStore) UpdateNamedQuery(ctx context.Context, name string, update apimodels.CatalogNamedQueryUpdate) (*apimodels.CatalogNamedQuery, error) {
+	if err := s.checkWritable(name); err != nil {
+		return nil, err
+	}
+
+	filters, err := s.encodeFilters(name, update.Filters)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.Update(&dbmodels.NamedQuery{
+		Name:      name,
+		AssetType: s.assetType,
+		Filters:   filters,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return savedQueryToAPI(updated)
+}
+
+// DeleteNamedQuery removes a saved named query.
+func (s *NamedQueryStore) DeleteNamedQuery(ctx context.Context, name string) error {
+	if err := s.checkWritable(name); err != nil {
+		return err
+	}
+	return s.repo.Delete(s.assetType, name)
+}
+
+func (s *NamedQueryStore) files() map[string]map[string]FieldFilter {
+	if s.fromFiles == nil {
+		return nil
+	}
+	return s.fromFiles()
+}
+
+func (s *NamedQueryStore) saved() ([]*dbmodels.NamedQuery, error) {
+	if s.repo == nil {
+		return nil, nil
+	}
+	return s.repo.List(s.assetType)
+}
+
+// checkWritable returns an error if the query called name can't be saved
+// through the API.
+func (s *NamedQueryStore) checkWritable(name string) error {
+	if s.repo == nil {
+		return fmt.Errorf("saving named queries is not supported: %w", api.ErrBadRequest)
+	}
+	if name == "" {
+		return fmt.Errorf("named query name cannot be empty: %w", api.ErrBadRequest)
+	}
+	if _, ok := s.files()[name]; ok {
+		return fmt.Errorf("named query %q is defined in a source config file: %w", name, api.ErrConflict)
+	}
+	return nil
+}
+
+// encodeFilters validates the filters of a query the same way as the ones
+// in config files, checks that they make a filter query that parses, and
+// returns their JSON encoding.
+func (s *NamedQueryStore) encodeFilters(name string, apiFilters map[string]apimodels.FieldFilter) (string, error) {
+	filters := make(map[string]FieldFilter, len(apiFilters))
+	for field, f := range apiFilters {
+		filters[field] = FieldFilter{Operator: f.Operator, Value: f.Value}
+	}
+
+	err := ValidateNamedQueries(map[string]NamedQuery{
+		name: {AssetType: s.assetType, Filters: filters},
+	})
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", err, api.ErrBadRequest)
+	}
+
+	filterQuery, err := FieldFiltersToFilterQuery(filters)
+	if err != nil {
+		return "", fmt.Errorf("named query %q has invalid filter: %w: %w", name, err, api.ErrBadRequest)
+	}
+	if _, err := filter.Parse(filterQuery); err != nil {
+		return "", fmt.Errorf("named query %q makes an invalid filter query %q: %w: %w", name, filterQuery, err, api.ErrBadRequest)
+	}
+
+	encoded, err := json.Marshal(filters)
+	if err != nil {
+		return "", fmt.Errorf("error encoding named query filters: %w", err)
+	}
+	return string(encoded), nil
+}
+
+func decodeFilters(query *dbmodels.NamedQuery) (map[string]FieldFilter, error) {
+	var filters map[string]FieldFilter
+	if err := json.Unmarshal([]byte(query.Filters), &filters); err != nil {
+		return nil, fmt.Errorf("error decoding filters of named query %q: %w", query.Name, err)
+	}
+	return filters, nil
+}
+
+func fileQueryToAPI(name string, filters map[string]FieldFilter) apimodels.CatalogNamedQuery {
+	return apimodels.CatalogNamedQuery{
+		Name:       name,
+		Filters:    filtersToAPI(filters),
+		FromConfig: apiutils.Of(true),
+	}
+}
+
+func savedQueryToAPI(query *dbmodels.NamedQuery) (*apimodels.CatalogNamedQuery, error) {
+	filters, err := decodeFilters(query)
+	if err != nil {
+		return nil, err
+	}
+	return &apimodels.CatalogNamedQuery{
+		Name:                     query.Name,
+		Filters:                  filtersToAPI(filters),
+		FromConfig:               apiutils.Of(false),
+		CreateTimeSinceEpoch:     apiutils.Of(strconv.FormatInt(query.CreateTimeSinceEpoch, 10)),
+		LastUpdateTimeSinceEpoch: apiutils.Of(strconv.FormatInt(query.LastUpdateTimeSinceEpoch, 10)),
+	}, nil
+}
+
+func filtersToAPI(filters map[string]FieldFilter) map[string]apimodels.FieldFilter {
+	result := make(map[string]apimodels.FieldFilter, len(filters))
+	for field, f := range filters {
+		result[field] = apimodels.FieldFilter{Operator: f.Operator, Value: f.Value}
+	}
+	return result
+}
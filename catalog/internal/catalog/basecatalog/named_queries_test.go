@@ -0,0 +1,219 @@
+package basecatalog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	dbmodels "github.com/kubeflow/hub/catalog/internal/db/models"
+	apimodels "github.com/kubeflow/hub/catalog/pkg/openapi"
+	"github.com/kubeflow/hub/pkg/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryNamedQueryRepo is an in-memory CatalogNamedQueryRepository.
+type memoryNamedQueryRepo struct {
+	queries map[string]dbmodels.NamedQuery
+}
+
+func (r *memoryNamedQueryRepo) key(assetType string, name string) string {
+	return assetType + ":" + name
+}
+
+func (r *memoryNamedQueryRepo) List(assetType string) ([]*dbmodels.NamedQuery, error) {
+	result := []*dbmodels.NamedQuery{}
+	for _, query := range r.queries {
+		if query.AssetType == assetType {
+			result = append(result, &query)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result, nil
+}
+
+func (r *memoryNamedQueryRepo) Get(assetType string, name string) (*dbmodels.NamedQuery, error) {
+	query, ok := r.queries[r.key(assetType, name)]
+	if !ok {
+		return nil, fmt.Errorf("named query %q not found: %w", name, api.ErrNotFound)
+	}
+	return &query, nil
+}
+
+func (r *memoryNamedQueryRepo) Create(query *dbmodels.NamedQuery) (*dbmodels.NamedQuery, error) {
+	key := r.key(query.AssetType, query.Name)
+	if _, ok := r.queries[key]; ok {
+		return nil, fmt.Errorf("named query %q already exists: %w", query.Name, api.ErrConflict)
+	}
+	created := *query
+	created.CreateTimeSinceEpoch = 1000
+	created.LastUpdateTimeSinceEpoch = 1000
+	r.queries[key] = created
+	return &created, nil
+}
+
+func (r *memoryNamedQueryRepo) Update(query *dbmodels.NamedQuery) (*dbmodels.NamedQuery, error) {
+	existing, err := r.Get(query.AssetType, query.Name)
+	if err != nil {
+		return nil, err
+	}
+	existing.Filters = query.Filters
+	existing.LastUpdateTimeSinceEpoch = 2000
+	r.queries[r.key(query.AssetType, query.Name)] = *existing
+	return existing, nil
+}
+
+func (r *memoryNamedQueryRepo) Delete(assetType string, name string) error {
+	if _, err := r.Get(assetType, name); err != nil {
+		return err
+	}
+	delete(r.queries, r.key(assetType, name))
+	return nil
+}
+
+func newTestNamedQueryStore() (*NamedQueryStore, *memoryNamedQueryRepo) {
+	repo := &memoryNamedQueryRepo{queries: map[string]dbmodels.NamedQuery{}}
+	fromFiles := func() map[string]map[string]FieldFilter {
+		return map[string]map[string]FieldFilter{
+			"apache": {"license": {Operator: "=", Value: "apache-2.0"}},
+		}
+	}
+	return NewNamedQueryStore(AssetTypeModels, fromFiles, repo), repo
+}
+
+func TestNamedQueryStoreCRUD(t *testing.T) {
+	ctx := context.Background()
+	store, _ := newTestNamedQueryStore()
+
+	created, err := store.CreateNamedQuery(ctx, apimodels.CatalogNamedQuery{
+		Name:    "ibm",
+		Filters: map[string]apimodels.FieldFilter{"provider": {Operator: "=", Value: "IBM"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ibm", created.Name)
+	assert.False(t, created.GetFromConfig())
+	assert.Equal(t, "1000", created.GetCreateTimeSinceEpoch())
+
+	got, err := store.GetNamedQuery(ctx, "ibm")
+	require.NoError(t, err)
+	assert.Equal(t, "IBM", got.Filters["provider"].Value)
+
+	updated, err := store.UpdateNamedQuery(ctx, "ibm", apimodels.CatalogNamedQueryUpdate{
+		Filters: map[string]apimodels.FieldFilter{"provider": {Operator: "IN", Value: []any{"IBM", "Red Hat"}}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "IN", updated.Filters["provider"].Operator)
+	assert.Equal(t, "2000", updated.GetLastUpdateTimeSinceEpoch())
+
+	list, err := store.ListNamedQueries(ctx)
+	require.NoError(t, err)
+	require.Len(t, list.Items, 2)
+	assert.Equal(t, "apache", list.Items[0].Name)
+	assert.True(t, list.Items[0].GetFromConfig())
+	assert.Equal(t, "ibm", list.Items[1].Name)
+
+	require.NoError(t, store.DeleteNamedQuery(ctx, "ibm"))
+	_, err = store.GetNamedQuery(ctx, "ibm")
+	assert.ErrorIs(t, err, api.ErrNotFound)
+	assert.ErrorIs(t, store.DeleteNamedQuery(ctx, "ibm"), api.ErrNotFound)
+}
+
+func TestNamedQueryStoreValidation(t *testing.T) {
+	ctx := context.Background()
+	store, _ := newTestNamedQueryStore()
+
+	tests := []struct {
+		name    string
+		query   apimodels.CatalogNamedQuery
+		wantErr error
+	}{
+		{
+			name:    "empty name",
+			query:   apimodels.CatalogNamedQuery{Filters: map[string]apimodels.FieldFilter{"provider": {Operator: "=", Value: "IBM"}}},
+			wantErr: api.ErrBadRequest,
+		},
+		{
+			name:    "no filters",
+			query:   apimodels.CatalogNamedQuery{Name: "empty"},
+			wantErr: api.ErrBadRequest,
+		},
+		{
+			name:    "invalid operator",
+			query:   apimodels.CatalogNamedQuery{Name: "bad", Filters: map[string]apimodels.FieldFilter{"provider": {Operator: "~", Value: "IBM"}}},
+			wantErr: api.ErrBadRequest,
+		},
+		{
+			name:    "IN without a list",
+			query:   apimodels.CatalogNamedQuery{Name: "bad", Filters: map[string]apimodels.FieldFilter{"provider": {Operator: "IN", Value: "IBM"}}},
+			wantErr: api.ErrBadRequest,
+		},
+		{
+			name:    "defined in a config file",
+			query:   apimodels.CatalogNamedQuery{Name: "apache", Filters: map[string]apimodels.FieldFilter{"provider": {Operator: "=", Value: "IBM"}}},
+			wantErr: api.ErrConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := store.CreateNamedQuery(ctx, tt.query)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+
+	t.Run("duplicate", func(t *testing.T) {
+		query := apimodels.CatalogNamedQuery{Name: "dup", Filters: map[string]apimodels.FieldFilter{"provider": {Operator: "=", Value: "IBM"}}}
+		_, err := store.CreateNamedQuery(ctx, query)
+		require.NoError(t, err)
+		_, err = store.CreateNamedQuery(ctx, query)
+		assert.ErrorIs(t, err, api.ErrConflict)
+	})
+
+	t.Run("config queries are read-only", func(t *testing.T) {
+		_, err := store.UpdateNamedQuery(ctx, "apache", apimodels.CatalogNamedQueryUpdate{})
+		assert.ErrorIs(t, err, api.ErrConflict)
+		assert.ErrorIs(t, store.DeleteNamedQuery(ctx, "apache"), api.ErrConflict)
+	})
+
+	t.Run("no repository", func(t *testing.T) {
+		_, err := NewNamedQueryStore(AssetTypeModels, nil, nil).CreateNamedQuery(ctx, apimodels.CatalogNamedQuery{Name: "q"})
+		assert.ErrorIs(t, err, api.ErrBadRequest)
+	})
+}
+
+func TestNamedQueryStoreResolve(t *testing.T) {
+	store, repo := newTestNamedQueryStore()
+
+	// A saved query with the same name as one in a config file is ignored.
+	repo.queries["models:apache"] = dbmodels.NamedQuery{
+		Name:      "apache",
+		AssetType: AssetTypeModels,
+		Filters:   `{"license":{"operator":"=","value":"mit"}}`,
+	}
+	repo.queries["models:ibm"] = dbmodels.NamedQuery{
+		Name:      "ibm",
+		AssetType: AssetTypeModels,
+		Filters:   `{"provider":{"operator":"=","value":"IBM"}}`,
+	}
+
+	all, err := store.All()
+	require.NoError(t, err)
+	assert.Equal(t, "apache-2.0", all["apache"]["license"].Value)
+	assert.Equal(t, "IBM", all["ibm"]["provider"].Value)
+
+	filterQuery, err := store.ResolveFilterQuery("", "apache")
+	require.NoError(t, err)
+	assert.Equal(t, "license = 'apache-2.0'", filterQuery)
+
+	filterQuery, err = store.ResolveFilterQuery("tasks = 'text-generation'", "ibm")
+	require.NoError(t, err)
+	assert.Equal(t, "(tasks = 'text-generation') AND (provider = 'IBM')", filterQuery)
+
+	filterQuery, err = store.ResolveFilterQuery("tasks = 'text-generation'", "")
+	require.NoError(t, err)
+	assert.Equal(t, "tasks = 'text-generation'", filterQuery)
+
+	_, err = store.ResolveFilterQuery("", "missing")
+	assert.ErrorIs(t, err, api.ErrBadRequest)
+}
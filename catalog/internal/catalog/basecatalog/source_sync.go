@@ -0,0 +1,171 @@
+package basecatalog
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/golang/glog"
+	dbmodels "github.com/kubeflow/hub/catalog/internal/db/models"
+	mrmodels "github.com/kubeflow/hub/internal/platform/db/entity"
+)
+
+const (
+	// maxSourceSyncChanges is the number of changed entities stored with
+	// each sync. The counts are always complete.
+	maxSourceSyncChanges = 1000
+
+	// sourceSyncHistoryLimit is the number of syncs kept for each source.
+	sourceSyncHistoryLimit = 100
+)
+
+// SourceSyncRecorder collects what happened while loading a source and saves
+// it to the source's sync history when the load is finished. A recorder
+// with a nil repository does nothing, so callers don't need to check
+// whether sync history is available.
+type SourceSyncRecorder struct {
+	repo dbmodels.CatalogSourceSyncRepository
+	sync dbmodels.SourceSync
+
+	// existing maps the names of the entities that were in the database
+	// before the load started to their digest.
+	existing map[string]string
+}
+
+// NewSourceSyncRecorder starts recording a sync of sourceID. existing maps
+// the entities stored for the source before the sync to their
+// EntityDigest, and is used to tell added entities from updated ones.
+func NewSourceSyncRecorder(repo dbmodels.CatalogSourceSyncRepository, sourceID string, assetType string, existing map[string]string) *SourceSyncRecorder {
+	return &SourceSyncRecorder{
+		repo: repo,
+		sync: dbmodels.SourceSync{
+			SourceID:            sourceID,
+			AssetType:           assetType,
+			StartTimeSinceEpoch: time.Now().UnixMilli(),
+		},
+		existing: existing,
+	}
+}
+
+// Enabled reports whether the recorder saves anything.
+func (r *SourceSyncRecorder) Enabled() bool {
+	return r != nil && r.repo != nil
+}
+
+// Loaded records that an entity was read from the source and saved. It's
+// counted as added if it didn't exist before, or updated if its digest
+// changed.
+func (r *SourceSyncRecorder) Loaded(name string, digest string) {
+	if !r.Enabled() {
+		return
+	}
+
+	previous, ok := r.existing[name]
+	switch {
+	case !ok:
+		r.sync.Added++
+		r.addChange(name, dbmodels.SourceSyncChangeAdded)
+	case previous != digest:
+		r.sync.Updated++
+		r.addChange(name, dbmodels.SourceSyncChangeUpdated)
+	}
+}
+
+// Removed records that an entity was removed because it's no longer in the
+// source.
+func (r *SourceSyncRecorder) Removed(name string) {
+	if !r.Enabled() {
+		return
+	}
+
+	r.sync.Removed++
+	r.addChange(name, dbmodels.SourceSyncChangeRemoved)
+}
+
+func (r *SourceSyncRecorder) addChange(name string, change string) {
+	if len(r.sync.Changes) >= maxSourceSyncChanges {
+		r.sync.ChangesTruncated = true
+		return
+	}
+	r.sync.Changes = append(r.sync.Changes, dbmodels.SourceSyncChange{Name: name, Change: change})
+}
+
+// Finish saves the sync with the final status of the source, then drops
+// the oldest syncs of the source beyond the history limit.
+func (r *SourceSyncRecorder) Finish(status string, errorMsg string) {
+	if !r.Enabled() {
+		return
+	}
+
+	r.sync.Status = status
+	r.sync.Error = errorMsg
+	r.sync.EndTimeSinceEpoch = time.Now().UnixMilli()
+
+	if _, err := r.repo.Save(&r.sync); err != nil {
+		glog.Errorf("failed to save sync history for source %s: %v", r.sync.SourceID, err)
+		return
+	}
+
+	if err := r.repo.Prune(r.sync.SourceID, sourceSyncHistoryLimit); err != nil {
+		glog.Errorf("failed to prune sync history for source %s: %v", r.sync.SourceID, err)
+	}
+}
+
+// EntityDigest returns a string that changes when any of the properties or
+// custom properties of an entity change. Properties named in ignore, such
+// as timestamps that change on every load, are left out.
+func EntityDigest(properties *[]mrmodels.Properties, customProperties *[]mrmodels.Properties, ignore ...string) string {
+	ignored := make(map[string]bool, len(ignore))
+	for _, name := range ignore {
+		ignored[name] = true
+	}
+
+	var entries []string
+	add := func(props *[]mrmodels.Properties, custom bool) {
+		if props == nil {
+			return
+		}
+		for _, p := range *props {
+			if ignored[p.Name] {
+				continue
+			}
+			value, ok := propertyValue(p)
+			if !ok {
+				continue
+			}
+			entries = append(entries, fmt.Sprintf("%t\x00%s\x00%s", custom, p.Name, value))
+		}
+	}
+	add(properties, false)
+	add(customProperties, true)
+
+	sort.Strings(entries)
+
+	h := sha256.New()
+	for _, entry := range entries {
+		h.Write([]byte(entry))
+		h.Write([]byte{'\n'})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// propertyValue formats the value of p, or returns false if it has none.
+func propertyValue(p mrmodels.Properties) (string, bool) {
+	switch {
+	case p.StringValue != nil:
+		return "s" + *p.StringValue, true
+	case p.IntValue != nil:
+		return fmt.Sprintf("i%d", *p.IntValue), true
+	case p.DoubleValue != nil:
+		return fmt.Sprintf("d%v", *p.DoubleValue), true
+	case p.BoolValue != nil:
+		return fmt.Sprintf("b%t", *p.BoolValue), true
+	case p.ByteValue != nil:
+		return "y" + string(*p.ByteValue), true
+	case p.ProtoValue != nil:
+		return "p" + string(*p.ProtoValue), true
+	}
+	return "", false
+}
@@ -0,0 +1,136 @@
+package basecatalog
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	dbmodels "github.com/kubeflow/hub/catalog/internal/db/models"
+	"github.com/kubeflow/hub/internal/platform/apiutils"
+	mrmodels "github.com/kubeflow/hub/internal/platform/db/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSourceSyncRepository struct {
+	saved   []dbmodels.SourceSync
+	pruned  map[string]int
+	saveErr error
+}
+
+func (f *fakeSourceSyncRepository) Save(sync *dbmodels.SourceSync) (*dbmodels.SourceSync, error) {
+	if f.saveErr != nil {
+		return nil, f.saveErr
+	}
+	f.saved = append(f.saved, *sync)
+	return sync, nil
+}
+
+func (f *fakeSourceSyncRepository) List(dbmodels.SourceSyncListOptions) (*mrmodels.ListWrapper[*dbmodels.SourceSync], error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f *fakeSourceSyncRepository) DeleteBySource(string) error {
+	return nil
+}
+
+func (f *fakeSourceSyncRepository) Prune(sourceID string, keep int) error {
+	if f.pruned == nil {
+		f.pruned = map[string]int{}
+	}
+	f.pruned[sourceID] = keep
+	return nil
+}
+
+func TestSourceSyncRecorder(t *testing.T) {
+	repo := &fakeSourceSyncRepository{}
+	recorder := NewSourceSyncRecorder(repo, "src", dbmodels.SourceSyncAssetTypeModels, map[string]string{
+		"same":    "d1",
+		"changed": "d2",
+		"gone":    "d3",
+	})
+	require.True(t, recorder.Enabled())
+
+	recorder.Loaded("same", "d1")
+	recorder.Loaded("changed", "d2-new")
+	recorder.Loaded("new", "d4")
+	recorder.Removed("gone")
+	recorder.Finish(SourceStatusPartiallyAvailable, "one model failed")
+
+	require.Len(t, repo.saved, 1)
+	sync := repo.saved[0]
+	assert.Equal(t, "src", sync.SourceID)
+	assert.Equal(t, dbmodels.SourceSyncAssetTypeModels, sync.AssetType)
+	assert.Equal(t, SourceStatusPartiallyAvailable, sync.Status)
+	assert.Equal(t, "one model failed", sync.Error)
+	assert.Equal(t, int32(1), sync.Added)
+	assert.Equal(t, int32(1), sync.Updated)
+	assert.Equal(t, int32(1), sync.Removed)
+	assert.Equal(t, []dbmodels.SourceSyncChange{
+		{Name: "changed", Change: dbmodels.SourceSyncChangeUpdated},
+		{Name: "new", Change: dbmodels.SourceSyncChangeAdded},
+		{Name: "gone", Change: dbmodels.SourceSyncChangeRemoved},
+	}, sync.Changes)
+	assert.False(t, sync.ChangesTruncated)
+	assert.GreaterOrEqual(t, sync.EndTimeSinceEpoch, sync.StartTimeSinceEpoch)
+	assert.Equal(t, sourceSyncHistoryLimit, repo.pruned["src"])
+}
+
+func TestSourceSyncRecorderTruncatesChanges(t *testing.T) {
+	repo := &fakeSourceSyncRepository{}
+	recorder := NewSourceSyncRecorder(repo, "src", dbmodels.SourceSyncAssetTypeModels, nil)
+
+	for i := 0; i < maxSourceSyncChanges+5; i++ {
+		recorder.Loaded(fmt.Sprintf("model-%d", i), "")
+	}
+	recorder.Finish(SourceStatusAvailable, "")
+
+	require.Len(t, repo.saved, 1)
+	assert.Equal(t, int32(maxSourceSyncChanges+5), repo.saved[0].Added)
+	assert.Len(t, repo.saved[0].Changes, maxSourceSyncChanges)
+	assert.True(t, repo.saved[0].ChangesTruncated)
+}
+
+func TestSourceSyncRecorderDisabled(t *testing.T) {
+	var recorder *SourceSyncRecorder
+	assert.False(t, recorder.Enabled())
+	recorder.Loaded("m", "d")
+	recorder.Removed("m")
+	recorder.Finish(SourceStatusAvailable, "")
+
+	recorder = NewSourceSyncRecorder(nil, "src", dbmodels.SourceSyncAssetTypeModels, nil)
+	assert.False(t, recorder.Enabled())
+	recorder.Finish(SourceStatusAvailable, "")
+}
+
+func TestSourceSyncRecorderSaveError(t *testing.T) {
+	repo := &fakeSourceSyncRepository{saveErr: errors.New("db down")}
+	recorder := NewSourceSyncRecorder(repo, "src", dbmodels.SourceSyncAssetTypeModels, nil)
+	recorder.Finish(SourceStatusAvailable, "")
+
+	assert.Empty(t, repo.pruned, "history isn't pruned when the sync couldn't be saved")
+}
+
+func TestEntityDigest(t *testing.T) {
+	props := []mrmodels.Properties{
+		{Name: "description", StringValue: apiutils.Of("a model")},
+		{Name: "last_synced", StringValue: apiutils.Of("1000")},
+	}
+	custom := []mrmodels.Properties{
+		{Name: "size", IntValue: apiutils.Of(int32(7))},
+	}
+	digest := EntityDigest(&props, &custom, "last_synced")
+
+	reordered := []mrmodels.Properties{props[1], props[0]}
+	reordered[0].StringValue = apiutils.Of("2000")
+	assert.Equal(t, digest, EntityDigest(&reordered, &custom, "last_synced"),
+		"order and ignored properties don't change the digest")
+
+	changed := []mrmodels.Properties{{Name: "description", StringValue: apiutils.Of("another model")}}
+	assert.NotEqual(t, digest, EntityDigest(&changed, &custom, "last_synced"))
+
+	// The same property as a custom property is a different entity.
+	assert.NotEqual(t, EntityDigest(&custom, nil), EntityDigest(nil, &custom))
+
+	assert.Equal(t, EntityDigest(nil, nil), EntityDigest(&[]mrmodels.Properties{}, nil))
+}
@@ -7,8 +7,9 @@ import (
 )
 
 type (
-	ModelSource = basecatalog.ModelSource
-	FieldFilter = basecatalog.FieldFilter
+	ModelSource       = basecatalog.ModelSource
+	FieldFilter       = basecatalog.FieldFilter
+	NamedQueryManager = basecatalog.NamedQueryManager
 )
 
 type (
@@ -16,15 +17,20 @@ type (
 	LabelCollection                = modelcatalog.LabelCollection
 	ModelProviderRecord            = modelcatalog.ModelProviderRecord
 	APIProvider                    = modelcatalog.APIProvider
+	ModelWriter                    = modelcatalog.ModelWriter
 	ListModelsParams               = modelcatalog.ListModelsParams
 	ListArtifactsParams            = modelcatalog.ListArtifactsParams
 	ListPerformanceArtifactsParams = modelcatalog.ListPerformanceArtifactsParams
+	CompareModelsParams            = modelcatalog.CompareModelsParams
+	ModelSizingParams              = modelcatalog.ModelSizingParams
+	FilterOptionsParams            = modelcatalog.FilterOptionsParams
 
 	// MCP catalog types
 	MCPSourceCollection      = mcpcatalog.MCPSourceCollection
 	MCPProvider              = mcpcatalog.MCPCatalogProvider
 	ListMCPServersParams     = mcpcatalog.ListMCPServersParams
 	ListMCPServerToolsParams = mcpcatalog.ListMCPServerToolsParams
+	MCPFilterOptionsParams   = mcpcatalog.FilterOptionsParams
 )
 
 var (
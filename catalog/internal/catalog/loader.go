@@ -10,6 +10,7 @@ import (
 	"github.com/kubeflow/hub/catalog/internal/catalog/basecatalog"
 	"github.com/kubeflow/hub/catalog/internal/catalog/mcpcatalog"
 	"github.com/kubeflow/hub/catalog/internal/catalog/modelcatalog"
+	"github.com/kubeflow/hub/catalog/internal/db/models"
 	"github.com/kubeflow/hub/catalog/internal/db/service"
 )
 
@@ -21,6 +22,12 @@ type Loader struct {
 
 	modelLoader *modelcatalog.ModelLoader
 	mcpLoader   *mcpcatalog.MCPLoader
+
+	// refreshes holds the source refreshes requested through the API. The
+	// leader runs them while it's in leader mode.
+	refreshes models.CatalogSourceRefreshRepository
+
+	reloadHandlers []func()
 }
 
 // NewLoader creates a new unified catalog loader
@@ -30,6 +37,7 @@ func NewLoader(services service.Services, paths []string) *Loader {
 		BaseLoader:  base,
 		modelLoader: modelcatalog.NewModelLoader(services, base),
 		mcpLoader:   mcpcatalog.NewMCPLoaderWithState(services, base),
+		refreshes:   services.CatalogSourceRefreshRepository,
 	}
 }
 
@@ -58,6 +66,20 @@ func (l *Loader) RegisterMCPEventHandler(fn mcpcatalog.MCPLoaderEventHandler) {
 	l.mcpLoader.RegisterEventHandler(fn)
 }
 
+// RegisterReloadHandler adds a function that will be called after the
+// config files are reloaded or a source is refreshed. Unlike event
+// handlers, these are also called by standby pods, and cover sources and
+// models that were removed. This should be called before initialization.
+func (l *Loader) RegisterReloadHandler(fn func()) {
+	l.reloadHandlers = append(l.reloadHandlers, fn)
+}
+
+func (l *Loader) notifyReload() {
+	for _, handler := range l.reloadHandlers {
+		handler()
+	}
+}
+
 // StartReadOnly initializes the loader in read-only mode (standby pod).
 // Parses all config files into in-memory collections and sets up file watchers.
 func (l *Loader) StartReadOnly(ctx context.Context) error {
@@ -125,6 +147,8 @@ func (l *Loader) watchFile(ctx context.Context, path string) {
 				glog.Errorf("unable to perform MCP leader writes on reload: %v", err)
 			}
 		}
+
+		l.notifyReload()
 	}
 }
 
@@ -153,6 +177,10 @@ func (l *Loader) StartLeader(ctx context.Context) error {
 		return fmt.Errorf("mcp leader operations: %w", err)
 	}
 
+	if l.refreshes != nil {
+		go l.runRefreshes(ctx)
+	}
+
 	glog.Info("Leader mode active")
 
 	// Wait for context cancellation
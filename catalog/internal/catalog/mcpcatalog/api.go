@@ -17,6 +17,13 @@ type ListMCPServersParams struct {
 	NextPageToken                        *string
 }
 
+// FilterOptionsParams selects the MCP servers that GetFilterOptions counts
+// the values of filter options for.
+type FilterOptionsParams struct {
+	Query, FilterQuery, NamedQuery string
+	SourceIDs                      []string
+}
+
 type ListMCPServerToolsParams struct {
 	FilterQuery   string
 	PageSize      int32
@@ -47,5 +54,7 @@ type MCPCatalogProvider interface {
 	GetMCPServerTool(ctx context.Context, serverID string, toolName string) (*openapi.MCPTool, error)
 
 	// GetFilterOptions returns filterable fields and named queries for the MCP servers endpoint.
-	GetFilterOptions(ctx context.Context) (*openapi.FilterOptionsList, error)
+	// String options have the number of MCP servers with each value, out of
+	// the MCP servers selected by params.
+	GetFilterOptions(ctx context.Context, params FilterOptionsParams) (*openapi.FilterOptionsList, error)
 }
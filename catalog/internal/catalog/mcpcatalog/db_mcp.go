@@ -15,29 +15,54 @@ import (
 	"github.com/kubeflow/hub/pkg/api"
 )
 
-// NamedQueryResolver resolves a named query name to its field filters.
-// Returns (filters, true) if found, or (nil, false) if unknown.
-type NamedQueryResolver func(name string) (map[string]basecatalog.FieldFilter, bool)
-
 type dbMCPCatalogImpl struct {
 	mcpServerRepo             models.MCPServerRepository
 	mcpServerToolRepo         models.MCPServerToolRepository
-	resolveNamedQuery         NamedQueryResolver
 	propertyOptionsRepository sharedmodels.PropertyOptionsRepository
-	mcpSources                *MCPSourceCollection
+	namedQueries              *basecatalog.NamedQueryStore
 }
 
-func NewDBMCPCatalog(services service.Services, mcpSources *MCPSourceCollection, resolver NamedQueryResolver) MCPCatalogProvider {
+func NewDBMCPCatalog(services service.Services, mcpSources *MCPSourceCollection) MCPCatalogProvider {
+	var fromFiles func() map[string]map[string]basecatalog.FieldFilter
+	if mcpSources != nil {
+		fromFiles = mcpSources.GetNamedQueries
+	}
+
 	return &dbMCPCatalogImpl{
 		mcpServerRepo:             services.MCPServerRepository,
 		mcpServerToolRepo:         services.MCPServerToolRepository,
-		resolveNamedQuery:         resolver,
 		propertyOptionsRepository: services.PropertyOptionsRepository,
-		mcpSources:                mcpSources,
+		namedQueries:              basecatalog.NewNamedQueryStore(basecatalog.AssetTypeMCPServers, fromFiles, services.CatalogNamedQueryRepository),
 	}
 }
 
-func (d *dbMCPCatalogImpl) GetFilterOptions(ctx context.Context) (*openapi.FilterOptionsList, error) {
+var _ basecatalog.NamedQueryManager = (*dbMCPCatalogImpl)(nil)
+
+func (d *dbMCPCatalogImpl) ListNamedQueries(ctx context.Context) (*openapi.CatalogNamedQueryList, error) {
+	return d.namedQueries.ListNamedQueries(ctx)
+}
+
+func (d *dbMCPCatalogImpl) GetNamedQuery(ctx context.Context, name string) (*openapi.CatalogNamedQuery, error) {
+	return d.namedQueries.GetNamedQuery(ctx, name)
+}
+
+func (d *dbMCPCatalogImpl) CreateNamedQuery(ctx context.Context, query openapi.CatalogNamedQuery) (*openapi.CatalogNamedQuery, error) {
+	return d.namedQueries.CreateNamedQuery(ctx, query)
+}
+
+func (d *dbMCPCatalogImpl) UpdateNamedQuery(ctx context.Context, name string, update openapi.CatalogNamedQueryUpdate) (*openapi.CatalogNamedQuery, error) {
+	return d.namedQueries.UpdateNamedQuery(ctx, name, update)
+}
+
+func (d *dbMCPCatalogImpl) DeleteNamedQuery(ctx context.Context, name string) error {
+	return d.namedQueries.DeleteNamedQuery(ctx, name)
+}
+
+func (d *dbMCPCatalogImpl) ResolveFilterQuery(filterQuery string, namedQuery string) (string, error) {
+	return d.namedQueries.ResolveFilterQuery(filterQuery, namedQuery)
+}
+
+func (d *dbMCPCatalogImpl) GetFilterOptions(ctx context.Context, params FilterOptionsParams) (*openapi.FilterOptionsList, error) {
 	mcpServerTypeID := d.mcpServerRepo.GetTypeID()
 
 	contextProperties, err := d.propertyOptionsRepository.List(sharedmodels.ContextPropertyOptionType, mcpServerTypeID)
@@ -45,6 +70,19 @@ func (d *dbMCPCatalogImpl) GetFilterOptions(ctx context.Context) (*openapi.Filte
 		return nil, err
 	}
 
+	filterQuery, err := d.ResolveFilterQuery(params.FilterQuery, params.NamedQuery)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := d.mcpServerRepo.CountPropertyValues(models.MCPServerListOptions{
+		Query:       &params.Query,
+		FilterQuery: &filterQuery,
+		SourceIDs:   &params.SourceIDs,
+	}, contextProperties)
+	if err != nil {
+		return nil, err
+	}
+
 	options := make(map[string]openapi.FilterOption, len(contextProperties))
 
 	for _, prop := range contextProperties {
@@ -55,38 +93,26 @@ func (d *dbMCPCatalogImpl) GetFilterOptions(ctx context.Context) (*openapi.Filte
 
 		option := basecatalog.DbPropToAPIOption(prop)
 		if option != nil {
+			basecatalog.SetOptionCounts(option, counts[prop.FullName("")])
 			options[prop.FullName("")] = *option
 		}
 	}
 
-	var namedQueriesPtr *map[string]map[string]openapi.FieldFilter
-	if d.mcpSources != nil {
-		namedQueriesPtr = basecatalog.ConvertNamedQueries(d.mcpSources.GetNamedQueries(), options)
+	namedQueries, err := d.namedQueries.All()
+	if err != nil {
+		return nil, err
 	}
 
 	return &openapi.FilterOptionsList{
 		Filters:      &options,
-		NamedQueries: namedQueriesPtr,
+		NamedQueries: basecatalog.ConvertNamedQueries(namedQueries, options),
 	}, nil
 }
 
 func (d *dbMCPCatalogImpl) ListMCPServers(ctx context.Context, params ListMCPServersParams) (openapi.MCPServerList, error) {
-	filterQuery := params.FilterQuery
-
-	// Resolve named query server-side and merge with any user-provided filterQuery.
-	if params.NamedQuery != "" {
-		if d.resolveNamedQuery == nil {
-			return openapi.MCPServerList{}, fmt.Errorf("named query %q is not supported: no resolver configured: %w", params.NamedQuery, api.ErrBadRequest)
-		}
-		filters, found := d.resolveNamedQuery(params.NamedQuery)
-		if !found {
-			return openapi.MCPServerList{}, fmt.Errorf("unknown named query %q: %w", params.NamedQuery, api.ErrBadRequest)
-		}
-		resolved, err := basecatalog.FieldFiltersToFilterQuery(filters)
-		if err != nil {
-			return openapi.MCPServerList{}, fmt.Errorf("named query %q has invalid filter: %w", params.NamedQuery, err)
-		}
-		filterQuery = mergeFilterQueries(filterQuery, resolved)
+	filterQuery, err := d.ResolveFilterQuery(params.FilterQuery, params.NamedQuery)
+	if err != nil {
+		return openapi.MCPServerList{}, err
 	}
 
 	// Build MCPServerListOptions from params
@@ -169,20 +195,6 @@ func (d *dbMCPCatalogImpl) ListMCPServers(ctx context.Context, params ListMCPSer
 	}, nil
 }
 
-// mergeFilterQueries combines two filterQuery strings with AND.
-// Empty strings are ignored. If both are non-empty, each is wrapped in parentheses
-// to preserve operator precedence.
-func mergeFilterQueries(a, b string) string {
-	switch {
-	case a == "":
-		return b
-	case b == "":
-		return a
-	default:
-		return fmt.Sprintf("(%s) AND (%s)", a, b)
-	}
-}
-
 func (d *dbMCPCatalogImpl) GetMCPServer(ctx context.Context, serverID string, includeTools bool, toolLimit int32) (*openapi.MCPServer, error) {
 	id, err := apiutils.ValidateIDAsInt32(serverID, "server")
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/kubeflow/hub/catalog/internal/catalog/basecatalog"
 	"github.com/kubeflow/hub/catalog/internal/catalog/mcpcatalog/models"
+	"github.com/kubeflow/hub/catalog/internal/db/facets"
 	sharedmodels "github.com/kubeflow/hub/catalog/internal/db/models"
 	internalmodels "github.com/kubeflow/hub/internal/platform/db/entity"
 	"github.com/kubeflow/hub/pkg/api"
@@ -32,30 +33,6 @@ func (m *mockPropertyOptionsRepo) List(t sharedmodels.PropertyOptionType, _ int3
 	return m.artifactProps, nil
 }
 
-// --- mergeFilterQueries tests ---
-
-func TestMergeFilterQueries(t *testing.T) {
-	t.Run("both empty returns empty string", func(t *testing.T) {
-		result := mergeFilterQueries("", "")
-		assert.Equal(t, "", result)
-	})
-
-	t.Run("only first non-empty returns first", func(t *testing.T) {
-		result := mergeFilterQueries("a = 1", "")
-		assert.Equal(t, "a = 1", result)
-	})
-
-	t.Run("only second non-empty returns second", func(t *testing.T) {
-		result := mergeFilterQueries("", "b = 2")
-		assert.Equal(t, "b = 2", result)
-	})
-
-	t.Run("both non-empty wraps each in parens and joins with AND", func(t *testing.T) {
-		result := mergeFilterQueries("a = 1", "b = 2")
-		assert.Equal(t, "(a = 1) AND (b = 2)", result)
-	})
-}
-
 // --- mock repository ---
 
 // mockMCPServerRepo is a minimal MCPServerRepository for unit testing.
@@ -67,6 +44,10 @@ type mockMCPServerRepo struct {
 	// capturedOptions stores the last MCPServerListOptions passed to List.
 	capturedOptions models.MCPServerListOptions
 	TypeID          int32 // Set to a non-zero value when testing GetFilterOptions scoping
+	// counts is returned by CountPropertyValues, which stores the
+	// MCPServerListOptions it's called with in capturedCountOptions.
+	counts               facets.Counts
+	capturedCountOptions models.MCPServerListOptions
 }
 
 func (m *mockMCPServerRepo) List(opts models.MCPServerListOptions) (*internalmodels.ListWrapper[models.MCPServer], error) {
@@ -95,6 +76,10 @@ func (m *mockMCPServerRepo) GetDistinctSourceIDs() ([]string, error) {
 	return nil, errors.New("not implemented")
 }
 func (m *mockMCPServerRepo) GetTypeID() int32 { return m.TypeID }
+func (m *mockMCPServerRepo) CountPropertyValues(opts models.MCPServerListOptions, _ []sharedmodels.PropertyOption) (facets.Counts, error) {
+	m.capturedCountOptions = opts
+	return m.counts, nil
+}
 
 // mockMCPServerToolRepo is a configurable MCPServerToolRepository for unit testing.
 type mockMCPServerToolRepo struct {
@@ -138,15 +123,17 @@ func (m *mockMCPServerToolRepo) DeleteByID(_ int32) error       { return errors.
 
 // --- helpers ---
 
-func newTestCatalog(repo *mockMCPServerRepo, resolver NamedQueryResolver) *dbMCPCatalogImpl {
-	return newTestCatalogWithToolRepo(repo, &mockMCPServerToolRepo{}, resolver)
+func newTestCatalog(repo *mockMCPServerRepo, namedQueries map[string]map[string]basecatalog.FieldFilter) *dbMCPCatalogImpl {
+	return newTestCatalogWithToolRepo(repo, &mockMCPServerToolRepo{}, namedQueries)
 }
 
-func newTestCatalogWithToolRepo(repo *mockMCPServerRepo, toolRepo *mockMCPServerToolRepo, resolver NamedQueryResolver) *dbMCPCatalogImpl {
+func newTestCatalogWithToolRepo(repo *mockMCPServerRepo, toolRepo *mockMCPServerToolRepo, namedQueries map[string]map[string]basecatalog.FieldFilter) *dbMCPCatalogImpl {
 	return &dbMCPCatalogImpl{
 		mcpServerRepo:     repo,
 		mcpServerToolRepo: toolRepo,
-		resolveNamedQuery: resolver,
+		namedQueries: basecatalog.NewNamedQueryStore(basecatalog.AssetTypeMCPServers, func() map[string]map[string]basecatalog.FieldFilter {
+			return namedQueries
+		}, nil),
 	}
 }
 
@@ -205,15 +192,9 @@ func TestListMCPServers_NoNamedQuery(t *testing.T) {
 
 func TestListMCPServers_NamedQueryResolved(t *testing.T) {
 	repo := &mockMCPServerRepo{listResult: emptyList()}
-	resolver := func(name string) (map[string]basecatalog.FieldFilter, bool) {
-		if name == "verified_only" {
-			return map[string]basecatalog.FieldFilter{
-				"verified": {Operator: "=", Value: true},
-			}, true
-		}
-		return nil, false
-	}
-	cat := newTestCatalog(repo, resolver)
+	cat := newTestCatalog(repo, map[string]map[string]basecatalog.FieldFilter{
+		"verified_only": {"verified": {Operator: "=", Value: true}},
+	})
 
 	_, err := cat.ListMCPServers(context.Background(), ListMCPServersParams{
 		NamedQuery: "verified_only",
@@ -224,15 +205,9 @@ func TestListMCPServers_NamedQueryResolved(t *testing.T) {
 
 func TestListMCPServers_NamedQueryMergedWithFilterQuery(t *testing.T) {
 	repo := &mockMCPServerRepo{listResult: emptyList()}
-	resolver := func(name string) (map[string]basecatalog.FieldFilter, bool) {
-		if name == "active" {
-			return map[string]basecatalog.FieldFilter{
-				"status": {Operator: "=", Value: "active"},
-			}, true
-		}
-		return nil, false
-	}
-	cat := newTestCatalog(repo, resolver)
+	cat := newTestCatalog(repo, map[string]map[string]basecatalog.FieldFilter{
+		"active": {"status": {Operator: "=", Value: "active"}},
+	})
 
 	_, err := cat.ListMCPServers(context.Background(), ListMCPServersParams{
 		NamedQuery:  "active",
@@ -243,23 +218,9 @@ func TestListMCPServers_NamedQueryMergedWithFilterQuery(t *testing.T) {
 	assert.Equal(t, "(provider = 'OpenAI') AND (status = 'active')", *repo.capturedOptions.FilterQuery)
 }
 
-func TestListMCPServers_NoResolverReturnsError(t *testing.T) {
-	repo := &mockMCPServerRepo{listResult: emptyList()}
-	cat := newTestCatalog(repo, nil)
-
-	_, err := cat.ListMCPServers(context.Background(), ListMCPServersParams{
-		NamedQuery: "any_query",
-	})
-	require.Error(t, err)
-	assert.True(t, errors.Is(err, api.ErrBadRequest))
-}
-
 func TestListMCPServers_UnknownNamedQueryReturnsError(t *testing.T) {
 	repo := &mockMCPServerRepo{listResult: emptyList()}
-	resolver := func(_ string) (map[string]basecatalog.FieldFilter, bool) {
-		return nil, false
-	}
-	cat := newTestCatalog(repo, resolver)
+	cat := newTestCatalog(repo, nil)
 
 	_, err := cat.ListMCPServers(context.Background(), ListMCPServersParams{
 		NamedQuery: "nonexistent",
@@ -271,11 +232,15 @@ func TestListMCPServers_UnknownNamedQueryReturnsError(t *testing.T) {
 // --- GetFilterOptions tests ---
 
 func newTestCatalogWithFilterOptions(repo *mockMCPServerRepo, propRepo *mockPropertyOptionsRepo, sources *MCPSourceCollection) *dbMCPCatalogImpl {
+	var fromFiles func() map[string]map[string]basecatalog.FieldFilter
+	if sources != nil {
+		fromFiles = sources.GetNamedQueries
+	}
 	return &dbMCPCatalogImpl{
 		mcpServerRepo:             repo,
 		mcpServerToolRepo:         &mockMCPServerToolRepo{},
 		propertyOptionsRepository: propRepo,
-		mcpSources:                sources,
+		namedQueries:              basecatalog.NewNamedQueryStore(basecatalog.AssetTypeMCPServers, fromFiles, nil),
 	}
 }
 
@@ -284,7 +249,7 @@ func TestGetFilterOptions_EmptyDB(t *testing.T) {
 	propRepo := &mockPropertyOptionsRepo{}
 	cat := newTestCatalogWithFilterOptions(repo, propRepo, nil)
 
-	result, err := cat.GetFilterOptions(context.Background())
+	result, err := cat.GetFilterOptions(context.Background(), FilterOptionsParams{})
 
 	require.NoError(t, err)
 	require.NotNil(t, result)
@@ -305,7 +270,7 @@ func TestGetFilterOptions_FiltersFromDB(t *testing.T) {
 	}
 	cat := newTestCatalogWithFilterOptions(repo, propRepo, nil)
 
-	result, err := cat.GetFilterOptions(context.Background())
+	result, err := cat.GetFilterOptions(context.Background(), FilterOptionsParams{})
 
 	require.NoError(t, err)
 	require.NotNil(t, result)
@@ -329,7 +294,7 @@ func TestGetFilterOptions_NamedQueriesFromSources(t *testing.T) {
 	propRepo := &mockPropertyOptionsRepo{}
 	cat := newTestCatalogWithFilterOptions(repo, propRepo, sources)
 
-	result, err := cat.GetFilterOptions(context.Background())
+	result, err := cat.GetFilterOptions(context.Background(), FilterOptionsParams{})
 
 	require.NoError(t, err)
 	require.NotNil(t, result)
@@ -339,12 +304,49 @@ func TestGetFilterOptions_NamedQueriesFromSources(t *testing.T) {
 	assert.Equal(t, true, nq["verified_only"]["verifiedSource"].Value)
 }
 
+func TestGetFilterOptions_Counts(t *testing.T) {
+	repo := &mockMCPServerRepo{
+		counts: facets.Counts{"provider": {"OpenAI": 3}},
+	}
+	propRepo := &mockPropertyOptionsRepo{
+		contextProps: []sharedmodels.PropertyOption{
+			{Name: "provider", StringValue: []string{"GitHub", "OpenAI"}},
+		},
+	}
+	sources := NewMCPSourceCollection()
+	require.NoError(t, sources.MergeWithNamedQueries("test", nil, map[string]map[string]basecatalog.FieldFilter{
+		"verified_only": {"verifiedSource": {Operator: "=", Value: true}},
+	}))
+	cat := newTestCatalogWithFilterOptions(repo, propRepo, sources)
+
+	result, err := cat.GetFilterOptions(context.Background(), FilterOptionsParams{
+		Query:       "assistant",
+		FilterQuery: "license = 'MIT'",
+		NamedQuery:  "verified_only",
+		SourceIDs:   []string{"src1"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]int32{"GitHub": 0, "OpenAI": 3}, *(*result.Filters)["provider"].Counts)
+
+	opts := repo.capturedCountOptions
+	require.NotNil(t, opts.Query)
+	assert.Equal(t, "assistant", *opts.Query)
+	require.NotNil(t, opts.FilterQuery)
+	assert.Equal(t, "(license = 'MIT') AND (verifiedSource = true)", *opts.FilterQuery)
+	require.NotNil(t, opts.SourceIDs)
+	assert.Equal(t, []string{"src1"}, *opts.SourceIDs)
+
+	_, err = cat.GetFilterOptions(context.Background(), FilterOptionsParams{NamedQuery: "unknown"})
+	assert.ErrorIs(t, err, api.ErrBadRequest)
+}
+
 func TestGetFilterOptions_PropertyOptionsError(t *testing.T) {
 	repo := &mockMCPServerRepo{}
 	propRepo := &mockPropertyOptionsRepo{listErr: errors.New("db error")}
 	cat := newTestCatalogWithFilterOptions(repo, propRepo, nil)
 
-	_, err := cat.GetFilterOptions(context.Background())
+	_, err := cat.GetFilterOptions(context.Background(), FilterOptionsParams{})
 	require.Error(t, err)
 }
 
@@ -12,8 +12,10 @@ import (
 	"github.com/golang/glog"
 	"github.com/kubeflow/hub/catalog/internal/catalog/basecatalog"
 	"github.com/kubeflow/hub/catalog/internal/catalog/mcpcatalog/models"
+	sharedmodels "github.com/kubeflow/hub/catalog/internal/db/models"
 	"github.com/kubeflow/hub/catalog/internal/db/service"
 	mrmodels "github.com/kubeflow/hub/internal/platform/db/entity"
+	"github.com/kubeflow/hub/pkg/api"
 )
 
 // MCPPartiallyAvailableError indicates that a source loaded some MCP servers successfully
@@ -190,70 +192,115 @@ func (ml *MCPLoader) loadAllServers(ctx context.Context, sources map[string]base
 			continue
 		}
 
-		glog.Infof("Loading MCP servers from source: %s (id: %s)", source.Name, source.ID)
-
-		// Get the provider function for this source type
-		providerFunc, ok := GetMCPProvider(source.Type)
-		if !ok {
-			glog.Warningf("Unknown MCP provider type: %s (source: %s)", source.Type, source.Name)
-			basecatalog.SaveSourceStatus(ml.services.CatalogSourceRepository, source.ID, basecatalog.SourceStatusError, fmt.Sprintf("unknown MCP provider type: %s", source.Type))
-			continue
+		// Partially available sources still count as active for cleanup
+		// purposes (some servers are loaded).
+		if status, _ := ml.loadSource(ctx, source); status != basecatalog.SourceStatusError {
+			enabledSourceIDs.Add(source.ID)
 		}
+	}
 
-		// Create the provider
-		provider, err := providerFunc(source)
-		if err != nil {
-			glog.Errorf("Error creating MCP provider for source %s: %v", source.Name, err)
-			basecatalog.SaveSourceStatus(ml.services.CatalogSourceRepository, source.ID, basecatalog.SourceStatusError, err.Error())
-			continue
-		}
+	// Clean up servers from sources that are no longer configured or enabled
+	err := ml.removeServersFromMissingSources(enabledSourceIDs, allSourceIDs, allKnownSourceIDs)
+	if err != nil {
+		return fmt.Errorf("failed to remove servers from missing sources: %w", err)
+	}
 
-		// Build server name filter from source include/exclude config
-		filter, err := NewServerFilterFromSource(&source)
-		if err != nil {
-			glog.Errorf("Error building server filter for source %s: %v", source.Name, err)
-			basecatalog.SaveSourceStatus(ml.services.CatalogSourceRepository, source.ID, basecatalog.SourceStatusError, err.Error())
-			continue
-		}
+	return nil
+}
 
-		// Load servers from this provider
-		err = ml.loadServersFromProvider(ctx, source.ID, provider, filter)
-		if err != nil {
-			if errors.Is(err, ErrMCPPartiallyAvailable) {
-				glog.Warningf("Partial error loading servers from source %s: %v", source.Name, err)
-				if ctx.Err() == nil {
-					basecatalog.SaveSourceStatus(ml.services.CatalogSourceRepository, source.ID, basecatalog.SourceStatusPartiallyAvailable, err.Error())
-				}
-				// Still count as active for cleanup purposes (some servers are loaded)
-				enabledSourceIDs.Add(source.ID)
-			} else {
-				glog.Errorf("Error loading servers from source %s: %v", source.Name, err)
-				basecatalog.SaveSourceStatus(ml.services.CatalogSourceRepository, source.ID, basecatalog.SourceStatusError, err.Error())
-			}
-			continue
-		}
+// RefreshSource loads the servers of a single source again and returns the
+// status the source was left in and, unless the source is available, a
+// message describing the problem. An error means the source couldn't be
+// refreshed at all.
+func (ml *MCPLoader) RefreshSource(ctx context.Context, sourceID string) (string, string, error) {
+	source, ok := ml.Sources.AllSources()[sourceID]
+	if !ok {
+		return "", "", fmt.Errorf("MCP source %s not found: %w", sourceID, api.ErrNotFound)
+	}
+	if !source.IsEnabled() {
+		return "", "", fmt.Errorf("MCP source %s is disabled: %w", sourceID, api.ErrBadRequest)
+	}
+	if !ml.state.ShouldWriteDatabase() {
+		return "", "", errors.New("only the leader can refresh sources")
+	}
 
-		enabledSourceIDs.Add(source.ID)
+	status, errMsg := ml.loadSource(ctx, source)
+	if err := ctx.Err(); err != nil {
+		return "", "", fmt.Errorf("refresh of MCP source %s was interrupted: %w", sourceID, err)
+	}
 
-		// Mark source as available if context is still valid
-		if ctx.Err() == nil {
-			basecatalog.SaveSourceStatus(ml.services.CatalogSourceRepository, source.ID, basecatalog.SourceStatusAvailable, "")
-		}
+	return status, errMsg, nil
+}
+
+// loadSource loads the servers of a single enabled source, saves the status
+// of the source and records the sync. It returns the status and a message
+// describing any problem; when ctx is cancelled partway the status isn't
+// saved.
+func (ml *MCPLoader) loadSource(ctx context.Context, source basecatalog.MCPSource) (string, string) {
+	glog.Infof("Loading MCP servers from source: %s (id: %s)", source.Name, source.ID)
+
+	recorder := ml.newSyncRecorder(source.ID)
+
+	// Get the provider function for this source type
+	providerFunc, ok := GetMCPProvider(source.Type)
+	if !ok {
+		glog.Warningf("Unknown MCP provider type: %s (source: %s)", source.Type, source.Name)
+		errMsg := fmt.Sprintf("unknown MCP provider type: %s", source.Type)
+		basecatalog.SaveSourceStatus(ml.services.CatalogSourceRepository, source.ID, basecatalog.SourceStatusError, errMsg)
+		recorder.Finish(basecatalog.SourceStatusError, errMsg)
+		return basecatalog.SourceStatusError, errMsg
 	}
 
-	// Clean up servers from sources that are no longer configured or enabled
-	err := ml.removeServersFromMissingSources(enabledSourceIDs, allSourceIDs, allKnownSourceIDs)
+	// Create the provider
+	provider, err := providerFunc(source)
 	if err != nil {
-		return fmt.Errorf("failed to remove servers from missing sources: %w", err)
+		glog.Errorf("Error creating MCP provider for source %s: %v", source.Name, err)
+		basecatalog.SaveSourceStatus(ml.services.CatalogSourceRepository, source.ID, basecatalog.SourceStatusError, err.Error())
+		recorder.Finish(basecatalog.SourceStatusError, err.Error())
+		return basecatalog.SourceStatusError, err.Error()
 	}
 
-	return nil
+	// Build server name filter from source include/exclude config
+	filter, err := NewServerFilterFromSource(&source)
+	if err != nil {
+		glog.Errorf("Error building server filter for source %s: %v", source.Name, err)
+		basecatalog.SaveSourceStatus(ml.services.CatalogSourceRepository, source.ID, basecatalog.SourceStatusError, err.Error())
+		recorder.Finish(basecatalog.SourceStatusError, err.Error())
+		return basecatalog.SourceStatusError, err.Error()
+	}
+
+	// Load servers from this provider
+	err = ml.loadServersFromProvider(ctx, source.ID, provider, filter, recorder)
+	if err != nil {
+		if errors.Is(err, ErrMCPPartiallyAvailable) {
+			glog.Warningf("Partial error loading servers from source %s: %v", source.Name, err)
+			if ctx.Err() == nil {
+				basecatalog.SaveSourceStatus(ml.services.CatalogSourceRepository, source.ID, basecatalog.SourceStatusPartiallyAvailable, err.Error())
+				recorder.Finish(basecatalog.SourceStatusPartiallyAvailable, err.Error())
+			}
+			return basecatalog.SourceStatusPartiallyAvailable, err.Error()
+		}
+		glog.Errorf("Error loading servers from source %s: %v", source.Name, err)
+		basecatalog.SaveSourceStatus(ml.services.CatalogSourceRepository, source.ID, basecatalog.SourceStatusError, err.Error())
+		if ctx.Err() == nil {
+			recorder.Finish(basecatalog.SourceStatusError, err.Error())
+		}
+		return basecatalog.SourceStatusError, err.Error()
+	}
+
+	// Mark source as available if context is still valid
+	if ctx.Err() == nil {
+		basecatalog.SaveSourceStatus(ml.services.CatalogSourceRepository, source.ID, basecatalog.SourceStatusAvailable, "")
+		recorder.Finish(basecatalog.SourceStatusAvailable, "")
+	}
+	return basecatalog.SourceStatusAvailable, ""
 }
 
 // loadServersFromProvider loads all servers from a single provider.
 // Returns MCPPartiallyAvailableError if some servers loaded successfully but others failed.
 // Returns a regular error if all servers failed to load.
-func (ml *MCPLoader) loadServersFromProvider(ctx context.Context, sourceID string, provider MCPProvider, filter *ServerFilter) error {
+// Added, updated and removed servers are recorded with recorder.
+func (ml *MCPLoader) loadServersFromProvider(ctx context.Context, sourceID string, provider MCPProvider, filter *ServerFilter, recorder *basecatalog.SourceSyncRecorder) error {
 	recordChan := provider.Servers(ctx)
 
 	validServerNames := mapset.NewSet[string]()
@@ -324,6 +371,7 @@ func (ml *MCPLoader) loadServersFromProvider(ctx context.Context, sourceID strin
 		}
 
 		successCount++
+		recorder.Loaded(serverName, serverDigest(record.Server))
 
 		// Call event handlers
 		for _, handler := range ml.handlers {
@@ -335,9 +383,13 @@ func (ml *MCPLoader) loadServersFromProvider(ctx context.Context, sourceID strin
 
 	// Only clean up orphans if context is still valid
 	if ctx.Err() == nil {
-		if err := ml.removeOrphanedServersFromSource(sourceID, validServerNames); err != nil {
+		removed, err := ml.removeOrphanedServersFromSource(sourceID, validServerNames)
+		if err != nil {
 			glog.Warningf("Failed to remove orphaned servers from source %s: %v", sourceID, err)
 		}
+		for _, name := range removed {
+			recorder.Removed(name)
+		}
 	}
 
 	// Report partial or full failure
@@ -492,6 +544,14 @@ func (ml *MCPLoader) removeServersFromMissingSources(enabledSourceIDs, allSource
 				if delErr := ml.services.CatalogSourceRepository.Delete(dbSourceID); delErr != nil {
 					glog.Errorf("failed to delete status for MCP source %s: %v", dbSourceID, delErr)
 				}
+
+				// The sync history is shared with the other loaders, so only
+				// drop it when no loader knows about the source anymore.
+				if syncRepo := ml.services.CatalogSourceSyncRepository; syncRepo != nil && !allKnownSourceIDs.Contains(dbSourceID) {
+					if delErr := syncRepo.DeleteBySource(dbSourceID); delErr != nil {
+						glog.Errorf("failed to delete sync history for MCP source %s: %v", dbSourceID, delErr)
+					}
+				}
 			}
 		}
 	}
@@ -508,7 +568,8 @@ func (ml *MCPLoader) removeServersFromMissingSources(enabledSourceIDs, allSource
 }
 
 // removeOrphanedServersFromSource removes servers that are no longer in the source
-func (ml *MCPLoader) removeOrphanedServersFromSource(sourceID string, validServerNames mapset.Set[string]) error {
+// and returns the names of the servers it removed.
+func (ml *MCPLoader) removeOrphanedServersFromSource(sourceID string, validServerNames mapset.Set[string]) ([]string, error) {
 	// Get all servers from this source
 	listOptions := models.MCPServerListOptions{
 		SourceIDs: &[]string{sourceID},
@@ -516,13 +577,15 @@ func (ml *MCPLoader) removeOrphanedServersFromSource(sourceID string, validServe
 
 	result, err := ml.services.MCPServerRepository.List(listOptions)
 	if err != nil {
-		return fmt.Errorf("error listing servers from source %s: %w", sourceID, err)
+		return nil, fmt.Errorf("error listing servers from source %s: %w", sourceID, err)
 	}
 
 	if result == nil {
-		return nil
+		return nil, nil
 	}
 
+	var removed []string
+
 	// Delete servers that are no longer in the valid set
 	for _, server := range result.Items {
 		attrs := server.GetAttributes()
@@ -539,10 +602,43 @@ func (ml *MCPLoader) removeOrphanedServersFromSource(sourceID string, validServe
 				err := ml.services.MCPServerRepository.DeleteByID(*server.GetID())
 				if err != nil {
 					glog.Errorf("Error deleting server %s: %v", *attrs.Name, err)
+					continue
 				}
+				removed = append(removed, *attrs.Name)
 			}
 		}
 	}
 
-	return nil
+	return removed, nil
+}
+
+// newSyncRecorder starts recording a sync of a source, using the servers
+// currently stored for it as the baseline.
+func (ml *MCPLoader) newSyncRecorder(sourceID string) *basecatalog.SourceSyncRecorder {
+	repo := ml.services.CatalogSourceSyncRepository
+	if repo == nil {
+		return nil
+	}
+
+	existing := map[string]string{}
+	result, err := ml.services.MCPServerRepository.List(models.MCPServerListOptions{
+		SourceIDs: &[]string{sourceID},
+	})
+	if err != nil {
+		glog.Errorf("Error listing servers from source %s for sync history: %v", sourceID, err)
+	} else if result != nil {
+		for _, server := range result.Items {
+			if attrs := server.GetAttributes(); attrs != nil && attrs.Name != nil {
+				existing[*attrs.Name] = serverDigest(server)
+			}
+		}
+	}
+
+	return basecatalog.NewSourceSyncRecorder(repo, sourceID, sharedmodels.SourceSyncAssetTypeMCPServers, existing)
+}
+
+// serverDigest returns the digest used to tell whether a server changed
+// between loads.
+func serverDigest(server models.MCPServer) string {
+	return basecatalog.EntityDigest(server.GetProperties(), server.GetCustomProperties())
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/kubeflow/hub/catalog/internal/db/service"
 	"github.com/kubeflow/hub/catalog/internal/testhelpers"
 	"github.com/kubeflow/hub/internal/testutils"
+	"github.com/kubeflow/hub/pkg/api"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
@@ -56,6 +57,9 @@ func setupMCPLoaderTest(t *testing.T) (*gorm.DB, service.Services, func()) {
 		service.NewPropertyOptionsRepository(sharedDB),
 		mcpServerRepo,
 		mcpServerToolRepo,
+		nil, // CatalogSourceSyncRepository
+		nil, // CatalogSourceRefreshRepository
+		nil, // CatalogNamedQueryRepository
 	)
 
 	return sharedDB, services, cleanup
@@ -1100,3 +1104,75 @@ func TestMCPLoaderInvalidServerFilterRejected(t *testing.T) {
 	require.Error(t, err, "empty includedServers pattern should be rejected at config load time")
 	assert.Contains(t, err.Error(), "includedServers")
 }
+
+func TestMCPLoaderRefreshSource(t *testing.T) {
+	_, services, cleanup := setupMCPLoaderTest(t)
+	defer cleanup()
+
+	tmpDir := t.TempDir()
+
+	serversFile := filepath.Join(tmpDir, "servers.yaml")
+	err := os.WriteFile(serversFile, []byte(`mcp_servers:
+  - name: "refresh-server-1"
+    description: "First server"
+`), 0644)
+	require.NoError(t, err)
+
+	sourcesFile := filepath.Join(tmpDir, "sources.yaml")
+	err = os.WriteFile(sourcesFile, []byte(`mcp_catalogs:
+  - name: "Refresh Catalog"
+    id: refresh_catalog
+    type: yaml
+    enabled: true
+    properties:
+      yamlCatalogPath: `+serversFile+`
+  - name: "Disabled Catalog"
+    id: disabled_catalog
+    type: yaml
+    enabled: false
+    properties:
+      yamlCatalogPath: `+serversFile+`
+`), 0644)
+	require.NoError(t, err)
+
+	baseLoader := basecatalog.NewBaseLoader([]string{sourcesFile})
+	loader := NewMCPLoaderWithState(services, baseLoader)
+	require.NoError(t, loader.ParseAllConfigs())
+
+	ctx := context.Background()
+
+	_, _, err = loader.RefreshSource(ctx, "refresh_catalog")
+	assert.Error(t, err, "followers can't refresh sources")
+
+	baseLoader.SetLeader(true)
+
+	_, _, err = loader.RefreshSource(ctx, "missing_catalog")
+	assert.ErrorIs(t, err, api.ErrNotFound)
+
+	_, _, err = loader.RefreshSource(ctx, "disabled_catalog")
+	assert.ErrorIs(t, err, api.ErrBadRequest)
+
+	status, errMsg, err := loader.RefreshSource(ctx, "refresh_catalog")
+	require.NoError(t, err)
+	assert.Equal(t, basecatalog.SourceStatusAvailable, status)
+	assert.Empty(t, errMsg)
+
+	_, err = services.MCPServerRepository.GetByNameAndVersion("refresh-server-1", "")
+	require.NoError(t, err)
+
+	// Replace the server and refresh again.
+	err = os.WriteFile(serversFile, []byte(`mcp_servers:
+  - name: "refresh-server-2"
+    description: "Second server"
+`), 0644)
+	require.NoError(t, err)
+
+	status, _, err = loader.RefreshSource(ctx, "refresh_catalog")
+	require.NoError(t, err)
+	assert.Equal(t, basecatalog.SourceStatusAvailable, status)
+
+	_, err = services.MCPServerRepository.GetByNameAndVersion("refresh-server-2", "")
+	require.NoError(t, err)
+	_, err = services.MCPServerRepository.GetByNameAndVersion("refresh-server-1", "")
+	assert.Error(t, err, "servers that are no longer in the source are removed")
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"github.com/kubeflow/hub/catalog/internal/db/facets"
 	"github.com/kubeflow/hub/catalog/internal/db/models"
 	"github.com/kubeflow/hub/internal/platform/db/filter"
 	dbmodels "github.com/kubeflow/hub/internal/platform/db/entity"
@@ -54,4 +55,7 @@ type MCPServerRepository interface {
 	DeleteByID(id int32) error
 	GetDistinctSourceIDs() ([]string, error)
 	GetTypeID() int32
+	// CountPropertyValues counts the MCP servers that match the filters of
+	// listOptions with each value of the string properties in options.
+	CountPropertyValues(listOptions MCPServerListOptions, options []models.PropertyOption) (facets.Counts, error)
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/kubeflow/hub/catalog/internal/catalog/basecatalog"
 	"github.com/kubeflow/hub/catalog/internal/catalog/mcpcatalog/models"
 	apimodels "github.com/kubeflow/hub/catalog/pkg/openapi"
+	"github.com/kubeflow/hub/internal/licensepolicy"
 	mrmodels "github.com/kubeflow/hub/internal/platform/db/entity"
 	"k8s.io/apimachinery/pkg/util/yaml"
 )
@@ -300,7 +301,7 @@ func (ys *yamlMCPServer) ToMCPServerProviderRecord() MCPServerProviderRecord {
 		properties = append(properties, mrmodels.NewStringProperty("logo", *ys.Logo, false))
 	}
 	if ys.License != nil {
-		humanReadableLicense := basecatalog.TransformLicenseToHumanReadable(*ys.License)
+		humanReadableLicense := licensepolicy.TransformLicenseToHumanReadable(*ys.License)
 		properties = append(properties, mrmodels.NewStringProperty("license", humanReadableLicense, false))
 	}
 	if ys.LicenseLink != nil {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/kubeflow/hub/catalog/internal/catalog/basecatalog"
 	apimodels "github.com/kubeflow/hub/catalog/pkg/openapi"
+	"github.com/kubeflow/hub/internal/licensepolicy"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -716,7 +717,7 @@ func TestYamlMCPServerLicenseConsistencyWithModels(t *testing.T) {
 	for _, license := range testLicenses {
 		t.Run(license, func(t *testing.T) {
 			// Get the expected human-readable transformation
-			expectedHumanReadable := basecatalog.TransformLicenseToHumanReadable(license)
+			expectedHumanReadable := licensepolicy.TransformLicenseToHumanReadable(license)
 
 			// Create MCP server with this license
 			yamlServer := &yamlMCPServer{
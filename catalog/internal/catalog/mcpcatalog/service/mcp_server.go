@@ -6,7 +6,9 @@ import (
 	"strings"
 
 	"github.com/kubeflow/hub/catalog/internal/catalog/mcpcatalog/models"
+	"github.com/kubeflow/hub/catalog/internal/db/facets"
 	"github.com/kubeflow/hub/catalog/internal/db/filter"
+	sharedmodels "github.com/kubeflow/hub/catalog/internal/db/models"
 	"github.com/kubeflow/hub/catalog/internal/db/pagination"
 	"github.com/kubeflow/hub/internal/platform/db/dbutil"
 	dbmodels "github.com/kubeflow/hub/internal/platform/db/entity"
@@ -330,6 +332,24 @@ func (r *MCPServerRepositoryImpl) GetDistinctSourceIDs() ([]string, error) {
 	return sourceIDs, nil
 }
 
+// CountPropertyValues counts the MCP servers that match the filters of
+// listOptions with each value of the string properties in options.
+func (r *MCPServerRepositoryImpl) CountPropertyValues(listOptions models.MCPServerListOptions, options []sharedmodels.PropertyOption) (facets.Counts, error) {
+	config := r.GetConfig()
+	contextTable := utils.GetTableName(config.DB, &schema.Context{})
+
+	matching := config.DB.Table(contextTable).
+		Select(contextTable+".id").
+		Where(contextTable+".type_id=?", config.TypeID)
+	matching = applyMCPServerListFilters(matching, &listOptions)
+	matching, err := service.ApplyFilterQuery(matching, &listOptions, config.EntityMappingFuncs)
+	if err != nil {
+		return nil, err
+	}
+
+	return facets.CountContextPropertyValues(config.DB, matching, options)
+}
+
 // applyMCPServerListFilters applies list filters to the query.
 func applyMCPServerListFilters(query *gorm.DB, listOptions *models.MCPServerListOptions) *gorm.DB {
 	contextTable := utils.GetTableName(query.Statement.DB, &schema.Context{})
@@ -15,6 +15,22 @@ type ListModelsParams struct {
 	OrderBy       model.OrderByField
 	SortOrder     model.SortOrder
 	NextPageToken *string
+
+	// GroupByVariant lists each variant group once, with its variants.
+	GroupByVariant bool
+
+	// IncludeDeprecated lists deprecated models too. They're left out by
+	// default.
+	IncludeDeprecated bool
+}
+
+// FilterOptionsParams selects the models that GetFilterOptions counts the
+// values of filter options for.
+type FilterOptionsParams struct {
+	Query             string
+	FilterQuery       string
+	SourceIDs         []string
+	IncludeDeprecated bool
 }
 
 type ListArtifactsParams struct {
@@ -56,7 +72,7 @@ type APIProvider interface {
 	// Models without computable latency appear at the end of results.
 	// If sourceIDs is provided, filter models by source IDs.
 	// If query is provided, filter models by text search.
-	FindModelsWithRecommendedLatency(ctx context.Context, pagination mrmodels.Pagination, paretoParams ParetoFilteringParams, sourceIDs []string, query string) (*model.CatalogModelList, error)
+	FindModelsWithRecommendedLatency(ctx context.Context, pagination mrmodels.Pagination, paretoParams ParetoFilteringParams, sourceIDs []string, query string, includeDeprecated bool) (*model.CatalogModelList, error)
 
 	// GetArtifacts returns all artifacts for a particular model. If no
 	// model is found with that name, it returns nil. If the model is
@@ -69,7 +85,43 @@ type APIProvider interface {
 	// If the model is found but has no performance artifacts, an empty list is returned.
 	GetPerformanceArtifacts(ctx context.Context, modelName string, sourceID string, params ListPerformanceArtifactsParams) (model.CatalogArtifactList, error)
 
+	// CompareModels lines up the attributes, accuracy scores and
+	// performance of two or more models, which can be in different
+	// sources. It returns an api.ErrNotFound error if any of the models
+	// doesn't exist.
+	CompareModels(ctx context.Context, params CompareModelsParams) (*model.CatalogModelComparison, error)
+
+	// GetModelSizing ranks the hardware configurations that a model can be
+	// deployed on to serve a target RPS within latency objectives, based on
+	// its performance artifacts. It returns an api.ErrNotFound error if the
+	// model doesn't exist and an api.ErrBadRequest error if an objective
+	// can't be parsed.
+	GetModelSizing(ctx context.Context, modelName string, sourceID string, params ModelSizingParams) (*model.CatalogModelSizing, error)
+
+	// GetModelVariants returns the models in the same variant group as a
+	// model, including the model itself. It returns an api.ErrNotFound
+	// error if the model doesn't exist.
+	GetModelVariants(ctx context.Context, modelName string, sourceID string) (*model.CatalogModelVariantList, error)
+
 	// GetFilterOptions returns all available filter options for models.
 	// This includes field names, data types, and available values or ranges.
-	GetFilterOptions(ctx context.Context) (*model.FilterOptionsList, error)
+	// String options have the number of models with each value, out of the
+	// models selected by params.
+	GetFilterOptions(ctx context.Context, params FilterOptionsParams) (*model.FilterOptionsList, error)
+}
+
+// ModelWriter modifies models in catalog sources of type "db". The
+// provider returned by NewDBCatalog implements it.
+type ModelWriter interface {
+	// CreateModel adds a model and its artifacts to a source. It returns
+	// an api.ErrConflict error if the source already has a model with
+	// the same name.
+	CreateModel(ctx context.Context, sourceID string, create model.CatalogModelCreate) (*model.CatalogModel, error)
+
+	// UpdateModel changes the fields of a model that are set in update.
+	// If update has artifacts, they replace all of the model's artifacts.
+	UpdateModel(ctx context.Context, sourceID string, modelName string, update model.CatalogModelUpdate) (*model.CatalogModel, error)
+
+	// DeleteModel removes a model and its artifacts from a source.
+	DeleteModel(ctx context.Context, sourceID string, modelName string) error
 }
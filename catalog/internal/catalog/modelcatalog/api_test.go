@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 	"sort"
 	"sync"
 	"testing"
@@ -12,6 +13,7 @@ import (
 	mapset "github.com/deckarep/golang-set/v2"
 	"github.com/kubeflow/hub/catalog/internal/catalog/basecatalog"
 	"github.com/kubeflow/hub/catalog/internal/catalog/modelcatalog/models"
+	"github.com/kubeflow/hub/catalog/internal/db/facets"
 	sharedmodels "github.com/kubeflow/hub/catalog/internal/db/models"
 	"github.com/kubeflow/hub/catalog/internal/db/service"
 	apimodels "github.com/kubeflow/hub/catalog/pkg/openapi"
@@ -48,6 +50,9 @@ func TestLoadCatalogSources(t *testing.T) {
 				&MockPropertyOptionsRepository{},
 				nil, // MCPServerRepository
 				nil, // MCPServerToolRepository
+				nil, // CatalogSourceSyncRepository
+				nil, // CatalogSourceRefreshRepository
+				nil, // CatalogNamedQueryRepository
 			)
 			loader := NewModelLoader(services, basecatalog.NewBaseLoader([]string{tt.args.catalogsPath}))
 			// Parse config and populate Sources/Labels
@@ -112,6 +117,9 @@ func TestLoadCatalogSourcesEnabledDisabled(t *testing.T) {
 				&MockPropertyOptionsRepository{},
 				nil, // MCPServerRepository
 				nil, // MCPServerToolRepository
+				nil, // CatalogSourceSyncRepository
+				nil, // CatalogSourceRefreshRepository
+				nil, // CatalogNamedQueryRepository
 			)
 			loader := NewModelLoader(services, basecatalog.NewBaseLoader([]string{tt.args.catalogsPath}))
 			err := loader.ParseAllConfigs()
@@ -141,6 +149,9 @@ func TestLabelsValidation(t *testing.T) {
 		&MockPropertyOptionsRepository{},
 		nil, // MCPServerRepository
 		nil, // MCPServerToolRepository
+		nil, // CatalogSourceSyncRepository
+		nil, // CatalogSourceRefreshRepository
+		nil, // CatalogNamedQueryRepository
 	)
 
 	tests := []struct {
@@ -274,6 +285,9 @@ func TestCatalogSourceLabelsDefaultToEmptySlice(t *testing.T) {
 				&MockPropertyOptionsRepository{},
 				nil, // MCPServerRepository
 				nil, // MCPServerToolRepository
+				nil, // CatalogSourceSyncRepository
+				nil, // CatalogSourceRefreshRepository
+				nil, // CatalogNamedQueryRepository
 			)
 			loader := NewModelLoader(services, basecatalog.NewBaseLoader([]string{tt.args.catalogsPath}))
 			err := loader.ParseAllConfigs()
@@ -315,6 +329,9 @@ func TestLoadCatalogSourcesWithMockRepositories(t *testing.T) {
 		&MockPropertyOptionsRepository{},
 		nil, // MCPServerRepository
 		nil, // MCPServerToolRepository
+		nil, // CatalogSourceSyncRepository
+		nil, // CatalogSourceRefreshRepository
+		nil, // CatalogNamedQueryRepository
 	)
 
 	// Register a test provider that will create some test data
@@ -456,6 +473,9 @@ func TestLoadCatalogSourcesWithRepositoryErrors(t *testing.T) {
 		&MockPropertyOptionsRepository{},
 		nil, // MCPServerRepository
 		nil, // MCPServerToolRepository
+		nil, // CatalogSourceSyncRepository
+		nil, // CatalogSourceRefreshRepository
+		nil, // CatalogNamedQueryRepository
 	)
 
 	// Register a test provider
@@ -534,6 +554,9 @@ func TestLoadCatalogSourcesWithNilEnabled(t *testing.T) {
 		&MockPropertyOptionsRepository{},
 		nil, // MCPServerRepository
 		nil, // MCPServerToolRepository
+		nil, // CatalogSourceSyncRepository
+		nil, // CatalogSourceRefreshRepository
+		nil, // CatalogNamedQueryRepository
 	)
 
 	// Register a test provider
@@ -700,6 +723,8 @@ type MockCatalogModelRepository struct {
 	SavedModels []models.CatalogModel
 	NextID      int32
 	TypeID      int32 // Set to a non-zero value when testing GetFilterOptions scoping
+
+	RecommendedLatencies map[int32][]models.RecommendedLatency
 }
 
 func (m *MockCatalogModelRepository) GetByID(id int32) (models.CatalogModel, error) {
@@ -754,6 +779,10 @@ func (m *MockCatalogModelRepository) Save(model models.CatalogModel) (models.Cat
 	return savedModel, nil
 }
 
+func (m *MockCatalogModelRepository) Create(model models.CatalogModel) (models.CatalogModel, error) {
+	return m.Save(model)
+}
+
 func (m *MockCatalogModelRepository) DeleteBySource(sourceID string) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -768,6 +797,10 @@ func (m *MockCatalogModelRepository) DeleteByID(id int32) error {
 	return nil
 }
 
+func (m *MockCatalogModelRepository) GetDistinctSourceIDsWithProperty(name, value string) ([]string, error) {
+	return []string{}, nil
+}
+
 func (m *MockCatalogModelRepository) GetDistinctSourceIDs() ([]string, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
@@ -779,6 +812,23 @@ func (m *MockCatalogModelRepository) GetTypeID() int32 {
 	return m.TypeID
 }
 
+func (m *MockCatalogModelRepository) SaveRecommendedLatencies(modelID int32, latencies []models.RecommendedLatency) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.RecommendedLatencies == nil {
+		m.RecommendedLatencies = map[int32][]models.RecommendedLatency{}
+	}
+	m.RecommendedLatencies[modelID] = latencies
+	return nil
+}
+
+func (m *MockCatalogModelRepository) CountPropertyValues(listOptions models.CatalogModelListOptions, options []sharedmodels.PropertyOption) (facets.Counts, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	// Mock implementation - no models have any of the values
+	return facets.Counts{}, nil
+}
+
 // GetSavedModels returns a copy of the saved models slice in a thread-safe manner.
 // This should be used by tests instead of directly accessing SavedModels field.
 func (m *MockCatalogModelRepository) GetSavedModels() []models.CatalogModel {
@@ -868,6 +918,8 @@ type MockCatalogMetricsArtifactRepository struct {
 	mu           sync.RWMutex
 	SavedMetrics []models.CatalogMetricsArtifact
 	NextID       int32
+	// parents holds the parent resource ID of each saved metrics artifact.
+	parents map[int32]int32
 }
 
 func (m *MockCatalogMetricsArtifactRepository) GetByID(id int32) (models.CatalogMetricsArtifact, error) {
@@ -910,6 +962,7 @@ func (m *MockCatalogMetricsArtifactRepository) Save(metricsArtifact models.Catal
 	}
 
 	m.SavedMetrics = append(m.SavedMetrics, savedMetrics)
+	m.setParent(id, parentResourceID)
 	return savedMetrics, nil
 }
 
@@ -933,12 +986,41 @@ func (m *MockCatalogMetricsArtifactRepository) BatchSave(metricsArtifacts []mode
 		}
 
 		m.SavedMetrics = append(m.SavedMetrics, savedMetrics)
+		m.setParent(id, parentResourceID)
 		savedArtifacts[i] = savedMetrics
 	}
 
 	return savedArtifacts, nil
 }
 
+func (m *MockCatalogMetricsArtifactRepository) setParent(id int32, parentResourceID *int32) {
+	if parentResourceID == nil {
+		return
+	}
+	if m.parents == nil {
+		m.parents = map[int32]int32{}
+	}
+	m.parents[id] = *parentResourceID
+}
+
+func (m *MockCatalogMetricsArtifactRepository) ListByParentResourceIDs(metricsType models.MetricsType, parentResourceIDs []int32) (map[int32][]models.CatalogMetricsArtifact, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := map[int32][]models.CatalogMetricsArtifact{}
+	for _, metrics := range m.SavedMetrics {
+		parentID, ok := m.parents[*metrics.GetID()]
+		if !ok || !slices.Contains(parentResourceIDs, parentID) {
+			continue
+		}
+		if metrics.GetAttributes() == nil || metrics.GetAttributes().MetricsType != metricsType {
+			continue
+		}
+		result[parentID] = append(result[parentID], metrics)
+	}
+	return result, nil
+}
+
 // GetSavedMetrics returns a copy of the saved metrics slice in a thread-safe manner.
 func (m *MockCatalogMetricsArtifactRepository) GetSavedMetrics() []models.CatalogMetricsArtifact {
 	m.mu.RLock()
@@ -954,6 +1036,7 @@ func (m *MockCatalogMetricsArtifactRepository) Reset() {
 	defer m.mu.Unlock()
 	m.SavedMetrics = []models.CatalogMetricsArtifact{}
 	m.NextID = 0
+	m.parents = nil
 }
 
 // MockCatalogArtifactRepository mocks the CatalogArtifactRepository interface.
@@ -1134,6 +1217,9 @@ func TestAPIProviderGetPerformanceArtifacts(t *testing.T) {
 		&MockPropertyOptionsRepository{},
 		nil, // MCPServerRepository
 		nil, // MCPServerToolRepository
+		nil, // CatalogSourceSyncRepository
+		nil, // CatalogSourceRefreshRepository
+		nil, // CatalogNamedQueryRepository
 	)
 	provider := NewDBCatalog(services, nil)
 
@@ -1164,6 +1250,9 @@ func TestAPIProviderInterface(t *testing.T) {
 		&MockPropertyOptionsRepository{},
 		nil, // MCPServerRepository
 		nil, // MCPServerToolRepository
+		nil, // CatalogSourceSyncRepository
+		nil, // CatalogSourceRefreshRepository
+		nil, // CatalogNamedQueryRepository
 	)
 	var provider APIProvider = NewDBCatalog(services, nil)
 
@@ -0,0 +1,179 @@
+package modelcatalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kubeflow/hub/catalog/internal/catalog/modelcatalog/models"
+	apimodels "github.com/kubeflow/hub/catalog/pkg/openapi"
+	"github.com/kubeflow/hub/pkg/api"
+)
+
+// maxCompareModels is the most models that can be compared at once.
+const maxCompareModels = 10
+
+type CompareModelsParams struct {
+	Models                []apimodels.CatalogModelReference
+	TargetRPS             int32
+	HardwareType          string
+	RPSProperty           string // configurable "requests_per_second"
+	LatencyProperty       string // configurable "ttft_p90"
+	HardwareCountProperty string // configurable "hardware_count"
+	HardwareTypeProperty  string // configurable "hardware_type"
+}
+
+func (d *dbCatalogImpl) CompareModels(ctx context.Context, params CompareModelsParams) (*apimodels.CatalogModelComparison, error) {
+	if len(params.Models) < 2 || len(params.Models) > maxCompareModels {
+		return nil, fmt.Errorf("between 2 and %d models can be compared, got %d: %w", maxCompareModels, len(params.Models), api.ErrBadRequest)
+	}
+
+	seen := make(map[apimodels.CatalogModelReference]struct{}, len(params.Models))
+	modelIDs := make([]int32, len(params.Models))
+	dbModels := make([]models.CatalogModel, len(params.Models))
+	items := make([]apimodels.CatalogModelComparisonItem, len(params.Models))
+	for i, ref := range params.Models {
+		if ref.SourceId == "" || ref.Name == "" {
+			return nil, fmt.Errorf("sourceId and name are required for every model: %w", api.ErrBadRequest)
+		}
+		if _, ok := seen[ref]; ok {
+			return nil, fmt.Errorf("model %q in source %q is listed more than once: %w", ref.Name, ref.SourceId, api.ErrBadRequest)
+		}
+		seen[ref] = struct{}{}
+
+		dbModel, err := d.getDBModel(ref.Name, ref.SourceId)
+		if err != nil {
+			if errors.Is(err, api.ErrNotFound) {
+				return nil, fmt.Errorf("no model %q found in source %q: %w", ref.Name, ref.SourceId, api.ErrNotFound)
+			}
+			return nil, err
+		}
+		modelIDs[i] = *dbModel.GetID()
+		dbModels[i] = dbModel
+	}
+
+	accuracy, err := d.getAccuracyScores(modelIDs)
+	if err != nil {
+		return nil, err
+	}
+	for i, ref := range params.Models {
+		items[i] = comparisonItem(ref, mapDBModelToAPIModel(dbModels[i]), accuracy[modelIDs[i]])
+	}
+
+	profile, performance, err := d.performanceService.ComparePerformance(modelIDs, ComparePerformanceParams{
+		TargetRPS:             params.TargetRPS,
+		HardwareType:          params.HardwareType,
+		RPSProperty:           params.RPSProperty,
+		LatencyProperty:       params.LatencyProperty,
+		HardwareCountProperty: params.HardwareCountProperty,
+		HardwareTypeProperty:  params.HardwareTypeProperty,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	benchmarks := map[string]struct{}{}
+	for i := range items {
+		items[i].Performance = performance[i]
+		for name := range items[i].Accuracy {
+			benchmarks[name] = struct{}{}
+		}
+	}
+
+	comparison := &apimodels.CatalogModelComparison{
+		Profile:    profile,
+		Benchmarks: make([]string, 0, len(benchmarks)),
+		Items:      items,
+	}
+	for name := range benchmarks {
+		comparison.Benchmarks = append(comparison.Benchmarks, name)
+	}
+	sort.Strings(comparison.Benchmarks)
+
+	return comparison, nil
+}
+
+// getAccuracyScores returns, keyed by model ID, the benchmark scores from
+// all of the models' accuracy-metrics artifacts. When several artifacts of a
+// model score the same benchmark, the highest score is kept.
+func (d *dbCatalogImpl) getAccuracyScores(modelIDs []int32) (map[int32]map[string]float64, error) {
+	artifacts, err := d.catalogMetricsArtifactRepository.ListByParentResourceIDs(models.MetricsTypeAccuracy, modelIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accuracy artifacts: %w", err)
+	}
+
+	scoresByModel := make(map[int32]map[string]float64, len(modelIDs))
+	for _, modelID := range modelIDs {
+		scores := map[string]float64{}
+		for _, artifact := range artifacts[modelID] {
+			for name, score := range accuracyScores(artifact) {
+				if current, ok := scores[name]; !ok || score > current {
+					scores[name] = score
+				}
+			}
+		}
+		scoresByModel[modelID] = scores
+	}
+	return scoresByModel, nil
+}
+
+// accuracyScores returns the scores in the custom properties of an
+// accuracy-metrics artifact. Artifacts from YAML sources hold a single score
+// in their benchmark and score properties, while the artifacts of the
+// performance metrics loader hold one property per benchmark. Other numeric
+// properties aren't benchmark scores.
+func accuracyScores(artifact models.CatalogMetricsArtifact) map[string]float64 {
+	scores := map[string]float64{}
+	props := artifact.GetCustomProperties()
+	if props == nil {
+		return scores
+	}
+
+	var loaded bool
+	if attributes := artifact.GetAttributes(); attributes != nil && attributes.ExternalID != nil {
+		loaded = strings.HasPrefix(*attributes.ExternalID, accuracyArtifactPrefix)
+	}
+
+	var benchmark string
+	var score *float64
+	for _, prop := range *props {
+		switch {
+		case prop.Name == "benchmark" && prop.StringValue != nil:
+			benchmark = *prop.StringValue
+		case prop.Name == "score" && prop.DoubleValue != nil:
+			score = prop.DoubleValue
+		case loaded && prop.Name != overallAccuracyProperty && prop.DoubleValue != nil:
+			scores[prop.Name] = *prop.DoubleValue
+		}
+	}
+
+	if benchmark != "" && score != nil {
+		return map[string]float64{benchmark: *score}
+	}
+	return scores
+}
+
+// comparisonItem lines up the attributes of a model for a comparison.
+func comparisonItem(ref apimodels.CatalogModelReference, model apimodels.CatalogModel, accuracy map[string]float64) apimodels.CatalogModelComparisonItem {
+	item := apimodels.CatalogModelComparisonItem{
+		SourceId: ref.SourceId,
+		Name:     ref.Name,
+		License:  model.License,
+		Tasks:    model.Tasks,
+		Accuracy: accuracy,
+	}
+	if item.Tasks == nil {
+		item.Tasks = []string{}
+	}
+
+	if value, ok := model.CustomProperties["size"]; ok && value.MetadataStringValue != nil {
+		item.Size = &value.MetadataStringValue.StringValue
+	}
+	if value, ok := model.CustomProperties["tensor_type"]; ok && value.MetadataStringValue != nil {
+		item.TensorType = &value.MetadataStringValue.StringValue
+	}
+
+	return item
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -17,30 +18,81 @@ import (
 	apimodels "github.com/kubeflow/hub/catalog/pkg/openapi"
 	"github.com/kubeflow/hub/internal/platform/apiutils"
 	"github.com/kubeflow/hub/internal/converter"
+	"github.com/kubeflow/hub/internal/licensepolicy"
 	mrmodels "github.com/kubeflow/hub/internal/platform/db/entity"
+	"github.com/kubeflow/hub/internal/platform/db/filter"
 	"github.com/kubeflow/hub/pkg/api"
 	"github.com/kubeflow/hub/pkg/openapi"
 )
 
 type dbCatalogImpl struct {
-	catalogModelRepository    models.CatalogModelRepository
-	catalogArtifactRepository sharedmodels.CatalogArtifactRepository
-	propertyOptionsRepository sharedmodels.PropertyOptionsRepository
-	performanceService        *PerformanceArtifactService
-	sources                   *SourceCollection
+	catalogModelRepository           models.CatalogModelRepository
+	catalogArtifactRepository        sharedmodels.CatalogArtifactRepository
+	catalogModelArtifactRepository   models.CatalogModelArtifactRepository
+	catalogMetricsArtifactRepository models.CatalogMetricsArtifactRepository
+	propertyOptionsRepository        sharedmodels.PropertyOptionsRepository
+	performanceService               *PerformanceArtifactService
+	sources                          *SourceCollection
+	namedQueries                     *basecatalog.NamedQueryStore
 }
 
 func NewDBCatalog(services service.Services, sources *SourceCollection) APIProvider {
+	var fromFiles func() map[string]map[string]basecatalog.FieldFilter
+	if sources != nil {
+		fromFiles = sources.GetNamedQueries
+	}
+
 	return &dbCatalogImpl{
-		catalogArtifactRepository: services.CatalogArtifactRepository,
-		catalogModelRepository:    services.CatalogModelRepository,
-		propertyOptionsRepository: services.PropertyOptionsRepository,
-		performanceService:        NewPerformanceArtifactService(services.CatalogArtifactRepository, services.CatalogModelRepository),
-		sources:                   sources,
+		catalogArtifactRepository:        services.CatalogArtifactRepository,
+		catalogModelRepository:           services.CatalogModelRepository,
+		catalogModelArtifactRepository:   services.CatalogModelArtifactRepository,
+		catalogMetricsArtifactRepository: services.CatalogMetricsArtifactRepository,
+		propertyOptionsRepository:        services.PropertyOptionsRepository,
+		performanceService:               NewPerformanceArtifactService(services.CatalogArtifactRepository, services.CatalogModelRepository),
+		sources:                          sources,
+		namedQueries:                     basecatalog.NewNamedQueryStore(basecatalog.AssetTypeModels, fromFiles, services.CatalogNamedQueryRepository),
 	}
 }
 
+var _ basecatalog.NamedQueryManager = (*dbCatalogImpl)(nil)
+
+func (d *dbCatalogImpl) ListNamedQueries(ctx context.Context) (*apimodels.CatalogNamedQueryList, error) {
+	return d.namedQueries.ListNamedQueries(ctx)
+}
+
+func (d *dbCatalogImpl) GetNamedQuery(ctx context.Context, name string) (*apimodels.CatalogNamedQuery, error) {
+	return d.namedQueries.GetNamedQuery(ctx, name)
+}
+
+func (d *dbCatalogImpl) CreateNamedQuery(ctx context.Context, query apimodels.CatalogNamedQuery) (*apimodels.CatalogNamedQuery, error) {
+	return d.namedQueries.CreateNamedQuery(ctx, query)
+}
+
+func (d *dbCatalogImpl) UpdateNamedQuery(ctx context.Context, name string, update apimodels.CatalogNamedQueryUpdate) (*apimodels.CatalogNamedQuery, error) {
+	return d.namedQueries.UpdateNamedQuery(ctx, name, update)
+}
+
+func (d *dbCatalogImpl) DeleteNamedQuery(ctx context.Context, name string) error {
+	return d.namedQueries.DeleteNamedQuery(ctx, name)
+}
+
+func (d *dbCatalogImpl) ResolveFilterQuery(filterQuery string, namedQuery string) (string, error) {
+	return d.namedQueries.ResolveFilterQuery(filterQuery, namedQuery)
+}
+
 func (d *dbCatalogImpl) GetModel(ctx context.Context, modelName string, sourceID string) (*apimodels.CatalogModel, error) {
+	dbModel, err := d.getDBModel(modelName, sourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	model := mapDBModelToAPIModel(dbModel)
+
+	return &model, nil
+}
+
+// getDBModel returns the stored model with a name in a source.
+func (d *dbCatalogImpl) getDBModel(modelName string, sourceID string) (models.CatalogModel, error) {
 	// Resolve by namespaced identifier: sourceId:modelName
 	namespacedName := sourceID + ":" + modelName
 	modelsList, err := d.catalogModelRepository.List(models.CatalogModelListOptions{
@@ -59,9 +111,7 @@ func (d *dbCatalogImpl) GetModel(ctx context.Context, modelName string, sourceID
 		return nil, fmt.Errorf("multiple models found for name=%v: %w", modelName, api.ErrNotFound)
 	}
 
-	model := mapDBModelToAPIModel(modelsList.Items[0])
-
-	return &model, nil
+	return modelsList.Items[0], nil
 }
 
 func (d *dbCatalogImpl) ListModels(ctx context.Context, params ListModelsParams) (apimodels.CatalogModelList, error) {
@@ -74,6 +124,14 @@ func (d *dbCatalogImpl) ListModels(ctx context.Context, params ListModelsParams)
 		orderBy = mrmodels.DefaultOrderBy
 	} else if orderBy == "ACCURACY" {
 		orderBy = "artifacts.overall_average.double_value"
+	} else if orderBy == models.OrderByRelevance {
+		if params.Query == "" {
+			// Without a query there's nothing to rank the models by.
+			orderBy = string(apimodels.ORDERBYFIELD_NAME)
+		} else if sortOrder == "" {
+			// The most relevant models come first by default.
+			sortOrder = string(apimodels.SORTORDER_DESC)
+		}
 	}
 
 	if sortOrder == "" {
@@ -89,7 +147,7 @@ func (d *dbCatalogImpl) ListModels(ctx context.Context, params ListModelsParams)
 
 	sourceIDs := params.SourceIDs
 
-	modelsList, err := d.catalogModelRepository.List(models.CatalogModelListOptions{
+	listOptions := models.CatalogModelListOptions{
 		SourceIDs: &sourceIDs,
 		Query:     queryPtr,
 		Pagination: mrmodels.Pagination{
@@ -99,7 +157,10 @@ func (d *dbCatalogImpl) ListModels(ctx context.Context, params ListModelsParams)
 			SortOrder:     &sortOrder,
 			NextPageToken: nextPageToken,
 		},
-	})
+		GroupByVariant:    params.GroupByVariant,
+		ExcludeDeprecated: !params.IncludeDeprecated,
+	}
+	modelsList, err := d.catalogModelRepository.List(listOptions)
 	if err != nil {
 		return apimodels.CatalogModelList{}, err
 	}
@@ -112,6 +173,12 @@ func (d *dbCatalogImpl) ListModels(ctx context.Context, params ListModelsParams)
 		modelList.Items = append(modelList.Items, mapDBModelToAPIModel(model))
 	}
 
+	if params.GroupByVariant {
+		if err := d.addVariants(modelList.Items, modelsList.Items, listOptions); err != nil {
+			return apimodels.CatalogModelList{}, err
+		}
+	}
+
 	modelList.NextPageToken = modelsList.NextPageToken
 	modelList.PageSize = pageSize
 	modelList.Size = int32(len(modelsList.Items))
@@ -189,13 +256,29 @@ func (d *dbCatalogImpl) GetArtifacts(ctx context.Context, modelName string, sour
 	return *artifactList, nil
 }
 
-func (d *dbCatalogImpl) GetFilterOptions(ctx context.Context) (*apimodels.FilterOptionsList, error) {
+func (d *dbCatalogImpl) GetFilterOptions(ctx context.Context, params FilterOptionsParams) (*apimodels.FilterOptionsList, error) {
 	catalogModelTypeID := d.catalogModelRepository.GetTypeID()
 
 	contextProperties, err := d.propertyOptionsRepository.List(sharedmodels.ContextPropertyOptionType, catalogModelTypeID)
 	if err != nil {
 		return nil, err
 	}
+
+	var queryPtr *string
+	if params.Query != "" {
+		queryPtr = &params.Query
+	}
+	counts, err := d.catalogModelRepository.CountPropertyValues(models.CatalogModelListOptions{
+		SourceIDs: &params.SourceIDs,
+		Query:     queryPtr,
+		Pagination: mrmodels.Pagination{
+			FilterQuery: &params.FilterQuery,
+		},
+		ExcludeDeprecated: !params.IncludeDeprecated,
+	}, contextProperties)
+	if err != nil {
+		return nil, err
+	}
 	// Artifact type filtering uses 0 (all types) intentionally: models have multiple
 	// artifact types (kf.CatalogModelArtifact, kf.CatalogMetricsArtifact) so a single
 	// type ID cannot scope them. MCP servers have no artifact types, so no cross-contamination.
@@ -210,12 +293,13 @@ func (d *dbCatalogImpl) GetFilterOptions(ctx context.Context) (*apimodels.Filter
 	for _, prop := range contextProperties {
 		// Skip internal/technical fields that shouldn't be exposed as filters
 		switch prop.Name {
-		case "source_id", "logo", "license_link":
+		case "source_id", "logo", "license_link", scannedAtProperty, sourceTypeProperty:
 			continue
 		}
 
 		option := basecatalog.DbPropToAPIOption(prop)
 		if option != nil {
+			basecatalog.SetOptionCounts(option, counts[prop.FullName("")])
 			options[prop.FullName("")] = *option
 		}
 	}
@@ -223,7 +307,7 @@ func (d *dbCatalogImpl) GetFilterOptions(ctx context.Context) (*apimodels.Filter
 	for _, prop := range artifactProperties {
 		// Skip internal/technical fields that shouldn't be exposed as filters
 		switch prop.Name {
-		case "metricsType", "model_id":
+		case "metricsType", "model_id", artifactDigestProperty:
 			continue
 		}
 		option := basecatalog.DbPropToAPIOption(prop)
@@ -232,40 +316,26 @@ func (d *dbCatalogImpl) GetFilterOptions(ctx context.Context) (*apimodels.Filter
 		}
 	}
 
-	// Get named queries from sources configuration
-	var namedQueriesPtr *map[string]map[string]apimodels.FieldFilter
-	if d.sources != nil {
-		namedQueriesPtr = basecatalog.ConvertNamedQueries(d.sources.GetNamedQueries(), options)
+	// Get named queries from sources configuration and the ones saved
+	// through the API
+	namedQueries, err := d.namedQueries.All()
+	if err != nil {
+		return nil, err
 	}
 
 	return &apimodels.FilterOptionsList{
 		Filters:      &options,
-		NamedQueries: namedQueriesPtr,
+		NamedQueries: basecatalog.ConvertNamedQueries(namedQueries, options),
 	}, nil
 }
 
 func (d *dbCatalogImpl) GetPerformanceArtifacts(ctx context.Context, modelName string, sourceID string, params ListPerformanceArtifactsParams) (apimodels.CatalogArtifactList, error) {
-	// Resolve by namespaced identifier: sourceId:modelName
-	namespacedName := sourceID + ":" + modelName
 	// Get the model to validate it exists and get its ID
-	modelsList, err := d.catalogModelRepository.List(models.CatalogModelListOptions{
-		Name:      &namespacedName,
-		SourceIDs: &[]string{sourceID},
-	})
+	model, err := d.getDBModel(modelName, sourceID)
 	if err != nil {
 		return apimodels.CatalogArtifactList{}, err
 	}
 
-	if len(modelsList.Items) == 0 {
-		return apimodels.CatalogArtifactList{}, fmt.Errorf("no models found for name=%v: %w", modelName, api.ErrNotFound)
-	}
-
-	if len(modelsList.Items) > 1 {
-		return apimodels.CatalogArtifactList{}, fmt.Errorf("multiple models found for name=%v: %w", modelName, api.ErrNotFound)
-	}
-
-	model := modelsList.Items[0]
-
 	serviceParams := PerformanceArtifactParams{
 		ModelID:               *model.GetID(),
 		TargetRPS:             params.TargetRPS,
@@ -383,6 +453,55 @@ func mapDBModelToAPIModel(m models.CatalogModel) apimodels.CatalogModel {
 						res.Tasks = tasks
 					}
 				}
+			case deprecatedProperty:
+				if prop.BoolValue != nil {
+					res.Deprecated = prop.BoolValue
+				}
+			case deprecationDateProperty:
+				if prop.StringValue != nil {
+					res.DeprecationDate = prop.StringValue
+				}
+			case replacedByProperty:
+				if prop.StringValue != nil {
+					if ref, ok := parseModelReference(*prop.StringValue); ok {
+						res.ReplacedBy = ref
+					}
+				}
+			case licensePolicyProperty:
+				if prop.StringValue != nil {
+					if res.LicensePolicy == nil {
+						res.LicensePolicy = &apimodels.CatalogModelLicensePolicy{}
+					}
+					res.LicensePolicy.Status = *prop.StringValue
+				}
+			case licenseClassificationsProperty:
+				if prop.StringValue != nil {
+					if classifications, err := licensepolicy.DecodeClassifications(*prop.StringValue); err == nil && len(classifications) > 0 {
+						if res.LicensePolicy == nil {
+							res.LicensePolicy = &apimodels.CatalogModelLicensePolicy{}
+						}
+						res.LicensePolicy.Classifications = classifications
+					}
+				}
+			case serializationFormatsProperty:
+				if prop.StringValue != nil {
+					var formats []string
+					if err := json.Unmarshal([]byte(*prop.StringValue), &formats); err == nil {
+						securityScan(&res).SerializationFormats = formats
+					}
+				}
+			case unsafeSerializationProperty:
+				if prop.BoolValue != nil {
+					securityScan(&res).UnsafeSerialization = prop.BoolValue
+				}
+			case signatureStatusProperty:
+				if prop.StringValue != nil {
+					securityScan(&res).SignatureStatus = prop.StringValue
+				}
+			case scannedAtProperty:
+				if prop.StringValue != nil {
+					securityScan(&res).ScannedAt = prop.StringValue
+				}
 			}
 		}
 	}
@@ -405,6 +524,15 @@ func mapDBModelToAPIModel(m models.CatalogModel) apimodels.CatalogModel {
 	return res
 }
 
+// securityScan returns the model's scan results, adding them first if
+// needed.
+func securityScan(m *apimodels.CatalogModel) *apimodels.CatalogModelSecurityScan {
+	if m.SecurityScan == nil {
+		m.SecurityScan = &apimodels.CatalogModelSecurityScan{}
+	}
+	return m.SecurityScan
+}
+
 func mapDBArtifactToAPIArtifact(a sharedmodels.CatalogArtifact) (apimodels.CatalogArtifact, error) {
 	if a.CatalogModelArtifact != nil {
 		modelArtifact, ok := a.CatalogModelArtifact.(models.CatalogModelArtifact)
@@ -588,6 +716,7 @@ func (d *dbCatalogImpl) FindModelsWithRecommendedLatency(
 	paretoParams ParetoFilteringParams,
 	sourceIDs []string,
 	query string,
+	includeDeprecated bool,
 ) (*apimodels.CatalogModelList, error) {
 	// Get all models first (without pagination)
 	var sourceIDsPtr *[]string
@@ -600,6 +729,12 @@ func (d *dbCatalogImpl) FindModelsWithRecommendedLatency(
 		queryPtr = &query
 	}
 
+	if profile, ok := recommendedLatencyProfile(pagination.FilterQuery, paretoParams); ok {
+		return d.findModelsByPrecomputedLatency(pagination, profile, sourceIDsPtr, queryPtr, includeDeprecated)
+	}
+
+	// The latencies for this profile aren't precomputed, so compute them
+	// for every model.
 	allModels, err := d.catalogModelRepository.List(models.CatalogModelListOptions{
 		SourceIDs: sourceIDsPtr,
 		Query:     queryPtr,
@@ -607,6 +742,7 @@ func (d *dbCatalogImpl) FindModelsWithRecommendedLatency(
 			FilterQuery: pagination.FilterQuery,
 			PageSize:    apiutils.Of(int32(0)), // Get all models
 		},
+		ExcludeDeprecated: !includeDeprecated,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch models: %w", err)
@@ -714,3 +850,146 @@ func (d *dbCatalogImpl) FindModelsWithRecommendedLatency(
 		Size:          int32(len(paginatedItems)),
 	}, nil
 }
+
+// findModelsByPrecomputedLatency returns models sorted by the recommended
+// latencies precomputed when they were loaded.
+func (d *dbCatalogImpl) findModelsByPrecomputedLatency(pagination mrmodels.Pagination, profile models.RecommendedLatencyProfile, sourceIDs *[]string, query *string, includeDeprecated bool) (*apimodels.CatalogModelList, error) {
+	pageSize := int32(10) // default
+	if pagination.PageSize != nil {
+		pageSize = *pagination.PageSize
+	}
+
+	modelsList, err := d.catalogModelRepository.List(models.CatalogModelListOptions{
+		SourceIDs: sourceIDs,
+		Query:     query,
+		Pagination: mrmodels.Pagination{
+			FilterQuery:   pagination.FilterQuery,
+			PageSize:      &pageSize,
+			NextPageToken: pagination.NextPageToken,
+		},
+		RecommendedLatency: &profile,
+		ExcludeDeprecated:  !includeDeprecated,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch models: %w", err)
+	}
+
+	items := make([]apimodels.CatalogModel, 0, len(modelsList.Items))
+	for _, model := range modelsList.Items {
+		items = append(items, mapDBModelToAPIModel(model))
+	}
+
+	return &apimodels.CatalogModelList{
+		Items:         items,
+		NextPageToken: modelsList.NextPageToken,
+		PageSize:      pageSize,
+		Size:          int32(len(items)),
+	}, nil
+}
+
+// recommendedLatencyProfile returns the profile of precomputed latencies that
+// matches a recommended latency sort. It returns false when the latencies
+// for the sort aren't precomputed: the target RPS or latency property isn't
+// one of the precomputed ones, the other performance properties aren't the
+// default ones, or the filter has artifact conditions other than a hardware
+// type and a use case.
+func recommendedLatencyProfile(filterQuery *string, params ParetoFilteringParams) (models.RecommendedLatencyProfile, bool) {
+	profile := models.RecommendedLatencyProfile{
+		LatencyProperty: params.LatencyProperty,
+		TargetRPS:       1,
+	}
+	if profile.LatencyProperty == "" {
+		profile.LatencyProperty = "ttft_p90"
+	}
+	if params.TargetRPS != nil {
+		profile.TargetRPS = *params.TargetRPS
+	}
+
+	if !slices.Contains(precomputedTargetRPS, profile.TargetRPS) || !slices.Contains(precomputedLatencyProperties, profile.LatencyProperty) {
+		return profile, false
+	}
+	for property, def := range map[string]string{
+		params.RpsProperty:           "requests_per_second",
+		params.HardwareCountProperty: "hardware_count",
+		params.HardwareTypeProperty:  "hardware_type",
+	} {
+		if property != "" && property != def {
+			return profile, false
+		}
+	}
+
+	if filterQuery == nil {
+		return profile, true
+	}
+	expr, err := filter.Parse(*filterQuery)
+	if err != nil {
+		// Let the per-request computation report the error.
+		return profile, false
+	}
+	return profile, addProfileConditions(expr, &profile)
+}
+
+// addProfileConditions sets the hardware types and use case of a profile
+// from the artifact conditions of a filter. It returns false if the filter
+// has other artifact conditions, or artifact conditions that aren't ANDed
+// with the rest of the filter.
+func addProfileConditions(expr *filter.FilterExpression, profile *models.RecommendedLatencyProfile) bool {
+	if expr == nil {
+		return true
+	}
+
+	if !expr.IsLeaf {
+		if expr.Operator != "AND" {
+			return !hasArtifactConditions(expr)
+		}
+		return addProfileConditions(expr.Left, profile) && addProfileConditions(expr.Right, profile)
+	}
+
+	name, ok := strings.CutPrefix(expr.Property, "artifacts.")
+	if !ok {
+		return true
+	}
+	name = strings.TrimSuffix(name, ".string_value")
+
+	var values []string
+	switch {
+	case expr.Operator == "=":
+		if value, ok := expr.Value.(string); ok && value != "" {
+			values = []string{value}
+		}
+	case strings.EqualFold(expr.Operator, "IN"):
+		list, _ := expr.Value.([]any)
+		for _, item := range list {
+			value, ok := item.(string)
+			if !ok || value == "" {
+				return false
+			}
+			values = append(values, value)
+		}
+	}
+	if len(values) == 0 {
+		return false
+	}
+
+	switch {
+	case name == "hardware_type" && profile.HardwareTypes == nil:
+		profile.HardwareTypes = values
+	case name == useCaseProperty && profile.UseCase == "" && len(values) == 1:
+		profile.UseCase = values[0]
+	default:
+		return false
+	}
+	return true
+}
+
+// hasArtifactConditions returns true if a filter has any artifact
+// conditions.
+func hasArtifactConditions(expr *filter.FilterExpression) bool {
+	if expr == nil {
+		return false
+	}
+	if expr.IsLeaf {
+		return strings.HasPrefix(expr.Property, "artifacts.")
+	}
+	return hasArtifactConditions(expr.Left) || hasArtifactConditions(expr.Right)
+}
@@ -59,6 +59,9 @@ func TestDBCatalog(t *testing.T) {
 		service.NewPropertyOptionsRepository(sharedDB),
 		nil, // MCPServerRepository
 		nil, // MCPServerToolRepository
+		nil, // CatalogSourceSyncRepository
+		nil, // CatalogSourceRefreshRepository
+		nil, // CatalogNamedQueryRepository
 	)
 
 	// Create DB catalog instance
@@ -712,6 +715,8 @@ func TestDBCatalog(t *testing.T) {
 					{Name: "library_name", StringValue: apiutils.Of("pytorch")},
 					{Name: "language", StringValue: apiutils.Of("[\"python\", \"go\"]")},
 					{Name: "tasks", StringValue: apiutils.Of("[\"classification\", \"regression\"]")},
+					{Name: "license_policy", StringValue: apiutils.Of("review")},
+					{Name: "license_classifications", StringValue: apiutils.Of("[\"copyleft\"]")},
 				},
 			}
 
@@ -729,6 +734,11 @@ func TestDBCatalog(t *testing.T) {
 			// Verify JSON arrays are properly parsed
 			assert.Equal(t, []string{"python", "go"}, result.Language)
 			assert.Equal(t, []string{"classification", "regression"}, result.Tasks)
+
+			// Verify the license policy outcome
+			require.NotNil(t, result.LicensePolicy)
+			assert.Equal(t, "review", result.LicensePolicy.Status)
+			assert.Equal(t, []string{"copyleft"}, result.LicensePolicy.Classifications)
 		})
 
 		t.Run("TestMapCatalogArtifactToCatalogArtifact", func(t *testing.T) {
@@ -879,7 +889,7 @@ func TestDBCatalog(t *testing.T) {
 		require.NoError(t, dbCatalog.(*dbCatalogImpl).propertyOptionsRepository.Refresh(sharedmodels.ArtifactPropertyOptionType))
 
 		// Test GetFilterOptions
-		filterOptions, err := dbCatalog.GetFilterOptions(ctx)
+		filterOptions, err := dbCatalog.GetFilterOptions(ctx, FilterOptionsParams{})
 		require.NoError(t, err)
 		require.NotNil(t, filterOptions)
 		require.NotNil(t, filterOptions.Filters)
@@ -976,6 +986,108 @@ func TestDBCatalog(t *testing.T) {
 		}
 		assert.Contains(t, maturityValues, "stable")
 		assert.Contains(t, maturityValues, "experimental")
+
+		// Counts are computed for the models matching the query and filter
+		scoped, err := dbCatalog.GetFilterOptions(ctx, FilterOptionsParams{
+			SourceIDs: []string{"filter-test-source"},
+		})
+		require.NoError(t, err)
+		scopedFilters := *scoped.Filters
+		require.NotNil(t, scopedFilters["license"].Counts)
+		assert.Equal(t, int32(2), (*scopedFilters["license"].Counts)["MIT"])
+		assert.Equal(t, int32(1), (*scopedFilters["license"].Counts)["Apache-2.0"])
+		require.NotNil(t, scopedFilters["language"].Counts)
+		assert.Equal(t, int32(3), (*scopedFilters["language"].Counts)["python"])
+		assert.Equal(t, int32(1), (*scopedFilters["language"].Counts)["rust"])
+
+		filtered, err := dbCatalog.GetFilterOptions(ctx, FilterOptionsParams{
+			SourceIDs:   []string{"filter-test-source"},
+			FilterQuery: "license = 'MIT'",
+		})
+		require.NoError(t, err)
+		filteredFilters := *filtered.Filters
+		assert.Equal(t, int32(1), (*filteredFilters["provider"].Counts)["HuggingFace"])
+		assert.Equal(t, int32(0), (*filteredFilters["provider"].Counts)["OpenAI"])
+		assert.Equal(t, int32(1), (*filteredFilters["provider"].Counts)["PyTorch"])
+
+		searched, err := dbCatalog.GetFilterOptions(ctx, FilterOptionsParams{
+			SourceIDs: []string{"filter-test-source"},
+			Query:     "filter-options-model-2",
+		})
+		require.NoError(t, err)
+		searchedFilters := *searched.Filters
+		assert.Equal(t, int32(1), (*searchedFilters["license"].Counts)["Apache-2.0"])
+		assert.Equal(t, int32(0), (*searchedFilters["license"].Counts)["MIT"])
+	})
+
+	t.Run("TestListModels_RelevanceOrdering", func(t *testing.T) {
+		sourceID := "relevance-test-source"
+		for _, m := range []struct {
+			name       string
+			properties []mr_models.Properties
+		}{
+			{"described-model", []mr_models.Properties{
+				{Name: "description", StringValue: apiutils.Of("A model distilled from granite")},
+			}},
+			{"granite-7b", []mr_models.Properties{
+				{Name: "description", StringValue: apiutils.Of("A general purpose model")},
+			}},
+			{"tagged-model", []mr_models.Properties{
+				{Name: "tasks", StringValue: apiutils.Of(`["granite-chat"]`)},
+			}},
+			{"documented-model", []mr_models.Properties{
+				{Name: "readme", StringValue: apiutils.Of("# Documented\nFine-tuned from Granite.")},
+			}},
+		} {
+			_, err := catalogModelRepo.Save(&models.CatalogModelImpl{
+				TypeID: apiutils.Of(int32(catalogModelTypeID)),
+				Attributes: &models.CatalogModelAttributes{
+					Name:       apiutils.Of(sourceID + ":" + m.name),
+					ExternalID: apiutils.Of("relevance-" + m.name),
+				},
+				Properties: apiutils.Of(append([]mr_models.Properties{
+					{Name: "source_id", StringValue: apiutils.Of(sourceID)},
+				}, m.properties...)),
+			})
+			require.NoError(t, err)
+		}
+
+		params := ListModelsParams{
+			Query:         "granite",
+			SourceIDs:     []string{sourceID},
+			PageSize:      10,
+			OrderBy:       model.OrderByField(models.OrderByRelevance),
+			NextPageToken: apiutils.Of(""),
+		}
+
+		result, err := dbCatalog.ListModels(ctx, params)
+		require.NoError(t, err)
+		require.Len(t, result.Items, 4)
+		assert.Equal(t, "granite-7b", result.Items[0].Name, "name matches rank first")
+		assert.Equal(t, "tagged-model", result.Items[1].Name, "task matches rank above description matches")
+		assert.Equal(t, "described-model", result.Items[2].Name, "description matches rank above readme matches")
+		assert.Equal(t, "documented-model", result.Items[3].Name)
+
+		params.SortOrder = model.SORTORDER_ASC
+		result, err = dbCatalog.ListModels(ctx, params)
+		require.NoError(t, err)
+		require.Len(t, result.Items, 4)
+		assert.Equal(t, "documented-model", result.Items[0].Name)
+		assert.Equal(t, "granite-7b", result.Items[3].Name)
+
+		// LIKE wildcards in the query are matched literally
+		params.Query = "gran%te"
+		result, err = dbCatalog.ListModels(ctx, params)
+		require.NoError(t, err)
+		assert.Empty(t, result.Items)
+
+		// Without a query, relevance falls back to sorting by name
+		params.Query = ""
+		result, err = dbCatalog.ListModels(ctx, params)
+		require.NoError(t, err)
+		require.Len(t, result.Items, 4)
+		assert.Equal(t, "described-model", result.Items[0].Name)
+		assert.Equal(t, "tagged-model", result.Items[3].Name)
 	})
 
 	t.Run("TestGetPerformanceArtifacts_BasicFiltering", func(t *testing.T) {
@@ -1437,6 +1549,9 @@ func TestDBCatalog_GetPerformanceArtifactsWithService(t *testing.T) {
 		service.NewPropertyOptionsRepository(sharedDB),
 		nil, // MCPServerRepository
 		nil, // MCPServerToolRepository
+		nil, // CatalogSourceSyncRepository
+		nil, // CatalogSourceRefreshRepository
+		nil, // CatalogNamedQueryRepository
 	)
 
 	sources := NewSourceCollection()
@@ -1546,7 +1661,7 @@ func TestGetFilterOptionsWithNamedQueries(t *testing.T) {
 	catalog := NewDBCatalog(mockServices, sources)
 
 	// Test GetFilterOptions includes named queries with min/max transformed
-	result, err := catalog.GetFilterOptions(context.Background())
+	result, err := catalog.GetFilterOptions(context.Background(), FilterOptionsParams{})
 	require.NoError(t, err)
 	require.NotNil(t, result.NamedQueries)
 
@@ -1966,6 +2081,9 @@ func TestFindModelsWithRecommendedLatency(t *testing.T) {
 		service.NewPropertyOptionsRepository(sharedDB),
 		nil, // MCPServerRepository
 		nil, // MCPServerToolRepository
+		nil, // CatalogSourceSyncRepository
+		nil, // CatalogSourceRefreshRepository
+		nil, // CatalogNamedQueryRepository
 	)
 
 	// Create DB catalog instance
@@ -2069,6 +2187,7 @@ func TestFindModelsWithRecommendedLatency(t *testing.T) {
 		paretoParams,
 		[]string{"latency-test-source"}, // Filter by this test's source ID
 		"",                              // No query filter
+		false,                           // No deprecated models
 	)
 
 	require.NoError(t, err)
@@ -2088,6 +2207,83 @@ func TestFindModelsWithRecommendedLatency(t *testing.T) {
 	}
 }
 
+func TestRecommendedLatencyProfile(t *testing.T) {
+	tests := []struct {
+		name        string
+		filterQuery *string
+		params      ParetoFilteringParams
+		want        models.RecommendedLatencyProfile
+		wantOK      bool
+	}{
+		{
+			name:   "defaults",
+			want:   models.RecommendedLatencyProfile{LatencyProperty: "ttft_p90", TargetRPS: 1},
+			wantOK: true,
+		},
+		{
+			name:   "precomputed target RPS and latency property",
+			params: ParetoFilteringParams{TargetRPS: apiutils.Of(int32(100)), LatencyProperty: "e2e_p95", HardwareTypeProperty: "hardware_type"},
+			want:   models.RecommendedLatencyProfile{LatencyProperty: "e2e_p95", TargetRPS: 100},
+			wantOK: true,
+		},
+		{
+			name:   "target RPS isn't precomputed",
+			params: ParetoFilteringParams{TargetRPS: apiutils.Of(int32(7))},
+		},
+		{
+			name:   "latency property isn't precomputed",
+			params: ParetoFilteringParams{LatencyProperty: "custom_latency"},
+		},
+		{
+			name:   "custom hardware type property",
+			params: ParetoFilteringParams{HardwareTypeProperty: "accelerator"},
+		},
+		{
+			name:        "model conditions",
+			filterQuery: apiutils.Of(`license = 'apache-2.0' OR provider = 'IBM'`),
+			want:        models.RecommendedLatencyProfile{LatencyProperty: "ttft_p90", TargetRPS: 1},
+			wantOK:      true,
+		},
+		{
+			name:        "hardware type and use case conditions",
+			filterQuery: apiutils.Of(`artifacts.hardware_type.string_value IN ('H100', 'A100') AND artifacts.use_case.string_value = 'chatbot' AND license = 'apache-2.0'`),
+			want: models.RecommendedLatencyProfile{
+				LatencyProperty: "ttft_p90",
+				TargetRPS:       1,
+				UseCase:         "chatbot",
+				HardwareTypes:   []string{"H100", "A100"},
+			},
+			wantOK: true,
+		},
+		{
+			name:        "other artifact conditions",
+			filterQuery: apiutils.Of(`artifacts.hardware_type = 'H100' AND artifacts.ttft_p90.double_value < 50`),
+		},
+		{
+			name:        "artifact condition in an OR",
+			filterQuery: apiutils.Of(`artifacts.hardware_type = 'H100' OR license = 'apache-2.0'`),
+		},
+		{
+			name:        "several use cases",
+			filterQuery: apiutils.Of(`artifacts.use_case IN ('chatbot', 'rag')`),
+		},
+		{
+			name:        "invalid filter",
+			filterQuery: apiutils.Of(`artifacts.hardware_type =`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := recommendedLatencyProfile(tt.filterQuery, tt.params)
+			assert.Equal(t, tt.wantOK, ok)
+			if tt.wantOK {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
 // TestGetFilterOptions_NoMCPServerContamination verifies that the model catalog's
 // GetFilterOptions only returns properties from kf.CatalogModel contexts, not
 // properties from kf.MCPServer contexts. This is a regression test for the bug
@@ -2167,10 +2363,13 @@ func TestGetFilterOptions_NoMCPServerContamination(t *testing.T) {
 		propertyOptionsRepo,
 		mcpServerRepo,
 		mcpServerToolRepo,
+		nil, // CatalogSourceSyncRepository
+		nil, // CatalogSourceRefreshRepository
+		nil, // CatalogNamedQueryRepository
 	)
 	dbCatalog := NewDBCatalog(svcs, nil)
 
-	filterOptions, err := dbCatalog.GetFilterOptions(context.Background())
+	filterOptions, err := dbCatalog.GetFilterOptions(context.Background(), FilterOptionsParams{})
 	require.NoError(t, err)
 	require.NotNil(t, filterOptions)
 	require.NotNil(t, filterOptions.Filters)
@@ -2191,8 +2390,8 @@ func TestGetFilterOptions_NoMCPServerContamination(t *testing.T) {
 	assert.NotContains(t, filters, "source_id", "source_id should be excluded by the model catalog skip list")
 
 	// Reverse direction: verify MCP catalog's GetFilterOptions doesn't leak model properties
-	dbMCPCatalog := mcpcatalog.NewDBMCPCatalog(svcs, nil, nil)
-	mcpFilterOptions, err := dbMCPCatalog.GetFilterOptions(context.Background())
+	dbMCPCatalog := mcpcatalog.NewDBMCPCatalog(svcs, nil)
+	mcpFilterOptions, err := dbMCPCatalog.GetFilterOptions(context.Background(), mcpcatalog.FilterOptionsParams{})
 	require.NoError(t, err)
 	require.NotNil(t, mcpFilterOptions)
 	require.NotNil(t, mcpFilterOptions.Filters)
@@ -2207,3 +2406,479 @@ func TestGetFilterOptions_NoMCPServerContamination(t *testing.T) {
 	// source_id is shared by both types but excluded by both catalogs' skip lists
 	assert.NotContains(t, mcpFilters, "source_id", "source_id should be excluded by the MCP catalog skip list")
 }
+
+func TestDBCatalog_CompareModels(t *testing.T) {
+	sharedDB, cleanup := testutils.SetupPostgresWithMigrations(t, service.DatastoreSpec())
+	defer cleanup()
+
+	catalogModelTypeID := testhelpers.GetCatalogModelTypeIDForDBTest(t, sharedDB)
+	modelArtifactTypeID := testhelpers.GetCatalogModelArtifactTypeIDForDBTest(t, sharedDB)
+	metricsArtifactTypeID := testhelpers.GetCatalogMetricsArtifactTypeIDForDBTest(t, sharedDB)
+	catalogSourceTypeID := testhelpers.GetCatalogSourceTypeIDForDBTest(t, sharedDB)
+
+	catalogModelRepo := modelservice.NewCatalogModelRepository(sharedDB, catalogModelTypeID)
+	catalogArtifactRepo := service.NewCatalogArtifactRepository(sharedDB, map[string]int32{
+		service.CatalogModelArtifactTypeName:   modelArtifactTypeID,
+		service.CatalogMetricsArtifactTypeName: metricsArtifactTypeID,
+	})
+	metricsArtifactRepo := modelservice.NewCatalogMetricsArtifactRepository(sharedDB, metricsArtifactTypeID)
+
+	svcs := service.NewServices(
+		catalogModelRepo,
+		catalogArtifactRepo,
+		modelservice.NewCatalogModelArtifactRepository(sharedDB, modelArtifactTypeID),
+		metricsArtifactRepo,
+		service.NewCatalogSourceRepository(sharedDB, catalogSourceTypeID),
+		service.NewPropertyOptionsRepository(sharedDB),
+		nil, // MCPServerRepository
+		nil, // MCPServerToolRepository
+		nil, // CatalogSourceSyncRepository
+		nil, // CatalogSourceRefreshRepository
+		nil, // CatalogNamedQueryRepository
+	)
+
+	dbCatalog := NewDBCatalog(svcs, nil)
+	ctx := context.Background()
+
+	saveModel := func(sourceID, name string, properties []mr_models.Properties, customProperties []mr_models.Properties) *int32 {
+		properties = append(properties, mr_models.Properties{Name: "source_id", StringValue: apiutils.Of(sourceID)})
+		saved, err := catalogModelRepo.Save(&models.CatalogModelImpl{
+			TypeID: apiutils.Of(int32(catalogModelTypeID)),
+			Attributes: &models.CatalogModelAttributes{
+				Name:       apiutils.Of(sourceID + ":" + name),
+				ExternalID: apiutils.Of(sourceID + "-" + name),
+			},
+			Properties:       &properties,
+			CustomProperties: &customProperties,
+		})
+		require.NoError(t, err)
+		return saved.GetID()
+	}
+	saveMetrics := func(modelID *int32, name string, metricsType models.MetricsType, customProperties []mr_models.Properties) {
+		_, err := metricsArtifactRepo.Save(&models.CatalogMetricsArtifactImpl{
+			TypeID: apiutils.Of(int32(metricsArtifactTypeID)),
+			Attributes: &models.CatalogMetricsArtifactAttributes{
+				Name:        apiutils.Of(name),
+				ExternalID:  apiutils.Of(name),
+				MetricsType: metricsType,
+			},
+			CustomProperties: &customProperties,
+		}, modelID)
+		require.NoError(t, err)
+	}
+
+	granite := saveModel("compare-a", "granite", []mr_models.Properties{
+		{Name: "license", StringValue: apiutils.Of("apache-2.0")},
+		{Name: "tasks", StringValue: apiutils.Of(`["text-generation"]`)},
+	}, []mr_models.Properties{
+		{Name: "size", StringValue: apiutils.Of("8B params")},
+		{Name: "tensor_type", StringValue: apiutils.Of("FP16")},
+	})
+	// One score per artifact, like the YAML sources.
+	saveMetrics(granite, "compare-granite-mmlu", models.MetricsTypeAccuracy, []mr_models.Properties{
+		{Name: "benchmark", StringValue: apiutils.Of("mmlu")},
+		{Name: "score", DoubleValue: apiutils.Of(70.0)},
+	})
+	saveMetrics(granite, "compare-granite-mmlu-2", models.MetricsTypeAccuracy, []mr_models.Properties{
+		{Name: "benchmark", StringValue: apiutils.Of("mmlu")},
+		{Name: "score", DoubleValue: apiutils.Of(75.0)},
+	})
+	saveMetrics(granite, "compare-granite-perf", models.MetricsTypePerformance, []mr_models.Properties{
+		{Name: "hardware_type", StringValue: apiutils.Of("H100")},
+		{Name: "hardware_count", IntValue: apiutils.Of(int32(1))},
+		{Name: "requests_per_second", DoubleValue: apiutils.Of(10.0)},
+		{Name: "ttft_p90", DoubleValue: apiutils.Of(50.0)},
+	})
+
+	llama := saveModel("compare-b", "llama", nil, nil)
+	// One property per benchmark, like the performance metrics loader.
+	saveMetrics(llama, fmt.Sprintf("%s%d", accuracyArtifactPrefix, *llama), models.MetricsTypeAccuracy, []mr_models.Properties{
+		{Name: "mmlu", DoubleValue: apiutils.Of(68.0)},
+		{Name: "gsm8k", DoubleValue: apiutils.Of(55.0)},
+		{Name: overallAccuracyProperty, DoubleValue: apiutils.Of(61.5)},
+	})
+	// Other numeric properties aren't benchmarks.
+	saveMetrics(llama, "compare-llama-eval", models.MetricsTypeAccuracy, []mr_models.Properties{
+		{Name: "num_fewshot", DoubleValue: apiutils.Of(5.0)},
+	})
+	saveMetrics(llama, "compare-llama-perf", models.MetricsTypePerformance, []mr_models.Properties{
+		{Name: "hardware_type", StringValue: apiutils.Of("H100")},
+		{Name: "hardware_count", IntValue: apiutils.Of(int32(2))},
+		{Name: "requests_per_second", DoubleValue: apiutils.Of(40.0)},
+		{Name: "ttft_p90", DoubleValue: apiutils.Of(35.0)},
+	})
+
+	t.Run("lines up models from different sources", func(t *testing.T) {
+		comparison, err := dbCatalog.CompareModels(ctx, CompareModelsParams{
+			Models: []model.CatalogModelReference{
+				{SourceId: "compare-a", Name: "granite"},
+				{SourceId: "compare-b", Name: "llama"},
+			},
+			TargetRPS: 20,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"gsm8k", "mmlu"}, comparison.Benchmarks)
+		require.NotNil(t, comparison.Profile.HardwareType)
+		assert.Equal(t, "H100", *comparison.Profile.HardwareType)
+		assert.Equal(t, int32(20), comparison.Profile.TargetRPS)
+		require.Len(t, comparison.Items, 2)
+
+		first := comparison.Items[0]
+		assert.Equal(t, "compare-a", first.SourceId)
+		assert.Equal(t, "granite", first.Name)
+		assert.Equal(t, "apache-2.0", *first.License)
+		assert.Equal(t, "8B params", *first.Size)
+		assert.Equal(t, "FP16", *first.TensorType)
+		assert.Equal(t, []string{"text-generation"}, first.Tasks)
+		assert.Equal(t, map[string]float64{"mmlu": 75.0}, first.Accuracy, "the best score of a benchmark is kept")
+		require.NotNil(t, first.Performance)
+		assert.Equal(t, int32(2), first.Performance.Replicas)
+		assert.Equal(t, int32(2), first.Performance.TotalHardwareCount)
+
+		second := comparison.Items[1]
+		assert.Nil(t, second.License)
+		assert.Empty(t, second.Tasks)
+		assert.Equal(t, map[string]float64{"mmlu": 68.0, "gsm8k": 55.0}, second.Accuracy)
+		require.NotNil(t, second.Performance)
+		assert.Equal(t, int32(1), second.Performance.Replicas)
+		assert.Equal(t, int32(2), second.Performance.TotalHardwareCount)
+		require.NotNil(t, second.Performance.Latency)
+		assert.Equal(t, 35.0, *second.Performance.Latency)
+	})
+
+	t.Run("missing model", func(t *testing.T) {
+		_, err := dbCatalog.CompareModels(ctx, CompareModelsParams{
+			Models: []model.CatalogModelReference{
+				{SourceId: "compare-a", Name: "granite"},
+				{SourceId: "compare-a", Name: "llama"},
+			},
+		})
+		assert.ErrorIs(t, err, api.ErrNotFound)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		_, err := dbCatalog.CompareModels(ctx, CompareModelsParams{
+			Models: []model.CatalogModelReference{{SourceId: "compare-a", Name: "granite"}},
+		})
+		assert.ErrorIs(t, err, api.ErrBadRequest, "a single model can't be compared")
+
+		_, err = dbCatalog.CompareModels(ctx, CompareModelsParams{
+			Models: []model.CatalogModelReference{
+				{SourceId: "compare-a", Name: "granite"},
+				{SourceId: "compare-a", Name: "granite"},
+			},
+		})
+		assert.ErrorIs(t, err, api.ErrBadRequest, "duplicate models")
+
+		tooMany := make([]model.CatalogModelReference, maxCompareModels+1)
+		for i := range tooMany {
+			tooMany[i] = model.CatalogModelReference{SourceId: "compare-a", Name: fmt.Sprintf("model-%d", i)}
+		}
+		_, err = dbCatalog.CompareModels(ctx, CompareModelsParams{Models: tooMany})
+		assert.ErrorIs(t, err, api.ErrBadRequest)
+	})
+}
+
+func TestDBCatalog_GetModelSizing(t *testing.T) {
+	sharedDB, cleanup := testutils.SetupPostgresWithMigrations(t, service.DatastoreSpec())
+	defer cleanup()
+
+	catalogModelTypeID := testhelpers.GetCatalogModelTypeIDForDBTest(t, sharedDB)
+	modelArtifactTypeID := testhelpers.GetCatalogModelArtifactTypeIDForDBTest(t, sharedDB)
+	metricsArtifactTypeID := testhelpers.GetCatalogMetricsArtifactTypeIDForDBTest(t, sharedDB)
+	catalogSourceTypeID := testhelpers.GetCatalogSourceTypeIDForDBTest(t, sharedDB)
+
+	catalogModelRepo := modelservice.NewCatalogModelRepository(sharedDB, catalogModelTypeID)
+	catalogArtifactRepo := service.NewCatalogArtifactRepository(sharedDB, map[string]int32{
+		service.CatalogModelArtifactTypeName:   modelArtifactTypeID,
+		service.CatalogMetricsArtifactTypeName: metricsArtifactTypeID,
+	})
+	metricsArtifactRepo := modelservice.NewCatalogMetricsArtifactRepository(sharedDB, metricsArtifactTypeID)
+
+	svcs := service.NewServices(
+		catalogModelRepo,
+		catalogArtifactRepo,
+		modelservice.NewCatalogModelArtifactRepository(sharedDB, modelArtifactTypeID),
+		metricsArtifactRepo,
+		service.NewCatalogSourceRepository(sharedDB, catalogSourceTypeID),
+		service.NewPropertyOptionsRepository(sharedDB),
+		nil, // MCPServerRepository
+		nil, // MCPServerToolRepository
+		nil, // CatalogSourceSyncRepository
+		nil, // CatalogSourceRefreshRepository
+		nil, // CatalogNamedQueryRepository
+	)
+
+	sources := NewSourceCollection()
+	sources.MergeHardwareCosts("test", map[string]float64{"H100": 4, "A100": 1.5})
+	dbCatalog := NewDBCatalog(svcs, sources)
+	ctx := context.Background()
+
+	saved, err := catalogModelRepo.Save(&models.CatalogModelImpl{
+		TypeID: apiutils.Of(int32(catalogModelTypeID)),
+		Attributes: &models.CatalogModelAttributes{
+			Name:       apiutils.Of("sizing:granite"),
+			ExternalID: apiutils.Of("sizing-granite"),
+		},
+		Properties: &[]mr_models.Properties{
+			{Name: "source_id", StringValue: apiutils.Of("sizing")},
+		},
+	})
+	require.NoError(t, err)
+
+	for i, benchmark := range []struct {
+		hardwareType string
+		useCase      string
+		rps          float64
+		latency      float64
+	}{
+		{"H100", "chatbot", 10, 100},
+		{"H100", "chatbot", 30, 300},
+		{"A100", "chatbot", 5, 150},
+		{"A100", "chatbot", 10, 400},
+		{"A100", "rag", 10, 200},
+	} {
+		_, err := metricsArtifactRepo.Save(&models.CatalogMetricsArtifactImpl{
+			TypeID: apiutils.Of(int32(metricsArtifactTypeID)),
+			Attributes: &models.CatalogMetricsArtifactAttributes{
+				Name:        apiutils.Of(fmt.Sprintf("sizing-granite-perf-%d", i)),
+				ExternalID:  apiutils.Of(fmt.Sprintf("sizing-granite-perf-%d", i)),
+				MetricsType: models.MetricsTypePerformance,
+			},
+			CustomProperties: &[]mr_models.Properties{
+				{Name: "hardware_type", StringValue: apiutils.Of(benchmark.hardwareType)},
+				{Name: "hardware_count", IntValue: apiutils.Of(int32(1))},
+				{Name: "use_case", StringValue: apiutils.Of(benchmark.useCase)},
+				{Name: "requests_per_second", DoubleValue: apiutils.Of(benchmark.rps)},
+				{Name: "ttft_p90", DoubleValue: apiutils.Of(benchmark.latency)},
+			},
+		}, saved.GetID())
+		require.NoError(t, err)
+	}
+
+	t.Run("ranks configurations by cost", func(t *testing.T) {
+		sizing, err := dbCatalog.GetModelSizing(ctx, "granite", "sizing", ModelSizingParams{
+			TargetRPS:   100,
+			MaxLatency:  []string{"ttft_p90:200"},
+			FilterQuery: "use_case.string_value = 'chatbot'",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(100), sizing.TargetRPS)
+		assert.Equal(t, map[string]float64{"ttft_p90": 200}, sizing.MaxLatency)
+		require.Len(t, sizing.Items, 2)
+
+		// 20 RPS per H100 replica, 4 per hour each.
+		assert.Equal(t, "H100", sizing.Items[0].HardwareType)
+		assert.Equal(t, "chatbot", sizing.Items[0].GetUseCase())
+		assert.Equal(t, int32(5), sizing.Items[0].Replicas)
+		assert.InDelta(t, 20.0, sizing.Items[0].GetCost(), 1e-9)
+
+		// 6 RPS per A100 replica, 1.5 per hour each.
+		assert.Equal(t, "A100", sizing.Items[1].HardwareType)
+		assert.Equal(t, int32(17), sizing.Items[1].Replicas)
+		assert.InDelta(t, 25.5, sizing.Items[1].GetCost(), 1e-9)
+	})
+
+	t.Run("unknown model", func(t *testing.T) {
+		_, err := dbCatalog.GetModelSizing(ctx, "missing", "sizing", ModelSizingParams{})
+		assert.ErrorIs(t, err, api.ErrNotFound)
+	})
+
+	t.Run("invalid objective", func(t *testing.T) {
+		_, err := dbCatalog.GetModelSizing(ctx, "granite", "sizing", ModelSizingParams{MaxLatency: []string{"ttft_p90"}})
+		assert.ErrorIs(t, err, api.ErrBadRequest)
+	})
+}
+
+func TestParseMaxLatency(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []string
+		want    map[string]float64
+		wantErr bool
+	}{
+		{name: "none", values: nil, want: map[string]float64{}},
+		{name: "several", values: []string{"ttft_p90:500", " e2e_p95 : 2000.5 ", ""}, want: map[string]float64{"ttft_p90": 500, "e2e_p95": 2000.5}},
+		{name: "strictest objective per property", values: []string{"ttft_p90:500", "ttft_p90:300", "ttft_p90:400"}, want: map[string]float64{"ttft_p90": 300}},
+		{name: "missing value", values: []string{"ttft_p90"}, wantErr: true},
+		{name: "missing property", values: []string{":500"}, wantErr: true},
+		{name: "not a number", values: []string{"ttft_p90:fast"}, wantErr: true},
+		{name: "not positive", values: []string{"ttft_p90:0"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseMaxLatency(tt.values)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, api.ErrBadRequest)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDBCatalog_ModelVariants(t *testing.T) {
+	sharedDB, cleanup := testutils.SetupPostgresWithMigrations(t, service.DatastoreSpec())
+	defer cleanup()
+
+	catalogModelTypeID := testhelpers.GetCatalogModelTypeIDForDBTest(t, sharedDB)
+	modelArtifactTypeID := testhelpers.GetCatalogModelArtifactTypeIDForDBTest(t, sharedDB)
+	metricsArtifactTypeID := testhelpers.GetCatalogMetricsArtifactTypeIDForDBTest(t, sharedDB)
+	catalogSourceTypeID := testhelpers.GetCatalogSourceTypeIDForDBTest(t, sharedDB)
+
+	catalogModelRepo := modelservice.NewCatalogModelRepository(sharedDB, catalogModelTypeID)
+	catalogArtifactRepo := service.NewCatalogArtifactRepository(sharedDB, map[string]int32{
+		service.CatalogModelArtifactTypeName:   modelArtifactTypeID,
+		service.CatalogMetricsArtifactTypeName: metricsArtifactTypeID,
+	})
+	metricsArtifactRepo := modelservice.NewCatalogMetricsArtifactRepository(sharedDB, metricsArtifactTypeID)
+
+	svcs := service.NewServices(
+		catalogModelRepo,
+		catalogArtifactRepo,
+		modelservice.NewCatalogModelArtifactRepository(sharedDB, modelArtifactTypeID),
+		metricsArtifactRepo,
+		service.NewCatalogSourceRepository(sharedDB, catalogSourceTypeID),
+		service.NewPropertyOptionsRepository(sharedDB),
+		nil, // MCPServerRepository
+		nil, // MCPServerToolRepository
+		nil, // CatalogSourceSyncRepository
+		nil, // CatalogSourceRefreshRepository
+		nil, // CatalogNamedQueryRepository
+	)
+
+	dbCatalog := NewDBCatalog(svcs, nil)
+	ctx := context.Background()
+
+	const sourceID = "variants"
+	saveModel := func(name, groupID, tensorType string, accuracy *float64) {
+		customProperties := []mr_models.Properties{
+			{Name: "size", StringValue: apiutils.Of("8B params")},
+		}
+		if groupID != "" {
+			customProperties = append(customProperties,
+				mr_models.Properties{Name: "variant_group_id", StringValue: apiutils.Of(groupID)},
+				mr_models.Properties{Name: "tensor_type", StringValue: apiutils.Of(tensorType)},
+			)
+		}
+		saved, err := catalogModelRepo.Save(&models.CatalogModelImpl{
+			TypeID: apiutils.Of(int32(catalogModelTypeID)),
+			Attributes: &models.CatalogModelAttributes{
+				Name:       apiutils.Of(sourceID + ":" + name),
+				ExternalID: apiutils.Of(sourceID + "-" + name),
+			},
+			Properties: &[]mr_models.Properties{
+				{Name: "source_id", StringValue: apiutils.Of(sourceID)},
+			},
+			CustomProperties: &customProperties,
+		})
+		require.NoError(t, err)
+
+		if accuracy != nil {
+			_, err = metricsArtifactRepo.Save(&models.CatalogMetricsArtifactImpl{
+				TypeID: apiutils.Of(int32(metricsArtifactTypeID)),
+				Attributes: &models.CatalogMetricsArtifactAttributes{
+					Name:        apiutils.Of(name + "-accuracy"),
+					ExternalID:  apiutils.Of(name + "-accuracy"),
+					MetricsType: models.MetricsTypeAccuracy,
+				},
+				CustomProperties: &[]mr_models.Properties{
+					{Name: "overall_average", DoubleValue: accuracy},
+				},
+			}, saved.GetID())
+			require.NoError(t, err)
+		}
+	}
+
+	saveModel("granite-fp16", "granite", "FP16", apiutils.Of(70.0))
+	saveModel("granite-fp8", "granite", "FP8", apiutils.Of(69.5))
+	saveModel("granite-int4", "granite", "INT4", nil)
+	saveModel("llama", "", "", nil)
+
+	t.Run("list grouped by variant", func(t *testing.T) {
+		list, err := dbCatalog.ListModels(ctx, ListModelsParams{
+			SourceIDs:      []string{sourceID},
+			PageSize:       1,
+			OrderBy:        model.ORDERBYFIELD_NAME,
+			SortOrder:      model.SORTORDER_ASC,
+			GroupByVariant: true,
+		})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, "granite-fp16", list.Items[0].Name)
+		require.Len(t, list.Items[0].Variants, 3)
+		assert.Equal(t, "granite-fp16", list.Items[0].Variants[0].Name)
+		assert.Equal(t, "FP16", list.Items[0].Variants[0].GetTensorType())
+		assert.Equal(t, "8B params", list.Items[0].Variants[0].GetSize())
+		assert.Equal(t, 70.0, list.Items[0].Variants[0].GetAccuracy())
+		assert.Equal(t, "granite-int4", list.Items[0].Variants[2].Name)
+		assert.False(t, list.Items[0].Variants[2].HasAccuracy())
+		require.NotEmpty(t, list.NextPageToken)
+
+		list, err = dbCatalog.ListModels(ctx, ListModelsParams{
+			SourceIDs:      []string{sourceID},
+			PageSize:       1,
+			OrderBy:        model.ORDERBYFIELD_NAME,
+			SortOrder:      model.SORTORDER_ASC,
+			NextPageToken:  apiutils.Of(list.NextPageToken),
+			GroupByVariant: true,
+		})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, "llama", list.Items[0].Name)
+		assert.Empty(t, list.Items[0].Variants, "models without a variant group aren't grouped")
+		assert.Empty(t, list.NextPageToken)
+	})
+
+	t.Run("variants follow the filter", func(t *testing.T) {
+		list, err := dbCatalog.ListModels(ctx, ListModelsParams{
+			SourceIDs:      []string{sourceID},
+			FilterQuery:    "tensor_type IN ('FP8', 'INT4')",
+			PageSize:       10,
+			GroupByVariant: true,
+		})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, "granite-fp8", list.Items[0].Name)
+		require.Len(t, list.Items[0].Variants, 2)
+		assert.Equal(t, "granite-fp8", list.Items[0].Variants[0].Name)
+		assert.Equal(t, "granite-int4", list.Items[0].Variants[1].Name)
+	})
+
+	t.Run("ungrouped list", func(t *testing.T) {
+		list, err := dbCatalog.ListModels(ctx, ListModelsParams{
+			SourceIDs: []string{sourceID},
+			PageSize:  10,
+		})
+		require.NoError(t, err)
+		assert.Len(t, list.Items, 4)
+		for _, item := range list.Items {
+			assert.Empty(t, item.Variants)
+		}
+	})
+
+	t.Run("model variants", func(t *testing.T) {
+		variants, err := dbCatalog.GetModelVariants(ctx, "granite-int4", sourceID)
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), variants.Size)
+		names := make([]string, len(variants.Items))
+		for i, variant := range variants.Items {
+			names[i] = variant.Name
+			assert.Equal(t, sourceID, variant.SourceId)
+		}
+		assert.Equal(t, []string{"granite-fp16", "granite-fp8", "granite-int4"}, names)
+		assert.Equal(t, 69.5, variants.Items[1].GetAccuracy())
+
+		variants, err = dbCatalog.GetModelVariants(ctx, "llama", sourceID)
+		require.NoError(t, err)
+		require.Len(t, variants.Items, 1)
+		assert.Equal(t, "llama", variants.Items[0].Name)
+		assert.False(t, variants.Items[0].HasTensorType())
+
+		_, err = dbCatalog.GetModelVariants(ctx, "missing", sourceID)
+		assert.ErrorIs(t, err, api.ErrNotFound)
+	})
+}
@@ -0,0 +1,323 @@
+package modelcatalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/hub/catalog/internal/catalog/modelcatalog/models"
+	modelservice "github.com/kubeflow/hub/catalog/internal/catalog/modelcatalog/service"
+	sharedmodels "github.com/kubeflow/hub/catalog/internal/db/models"
+	apimodels "github.com/kubeflow/hub/catalog/pkg/openapi"
+	mrmodels "github.com/kubeflow/hub/internal/platform/db/entity"
+	"github.com/kubeflow/hub/internal/platform/db/dbutil"
+	"github.com/kubeflow/hub/pkg/api"
+)
+
+// DBSourceType is the type of catalog sources whose models are managed
+// through the catalog API instead of being read from a provider. The
+// database is the only copy of these models, so the loader leaves them
+// alone when it reloads sources.
+const DBSourceType = "db"
+
+// sourceTypeProperty marks the models written to db sources, so the loader
+// can tell them apart even after their source is removed from the config.
+const sourceTypeProperty = "source_type"
+
+var _ ModelWriter = &dbCatalogImpl{}
+
+// CreateModel adds a new model, with its artifacts, to a db source.
+func (d *dbCatalogImpl) CreateModel(ctx context.Context, sourceID string, create apimodels.CatalogModelCreate) (*apimodels.CatalogModel, error) {
+	if err := d.checkWritableSource(sourceID); err != nil {
+		return nil, err
+	}
+
+	if create.Name == "" {
+		return nil, fmt.Errorf("model name is required: %w", api.ErrBadRequest)
+	}
+	if err := validateDeprecation(create.DeprecationDate, create.ReplacedBy); err != nil {
+		return nil, fmt.Errorf("%w: %w", err, api.ErrBadRequest)
+	}
+
+	ym := yamlModel{
+		CatalogModel: apimodels.CatalogModel{
+			Name:             create.Name,
+			SourceId:         &sourceID,
+			ExternalId:       create.ExternalId,
+			Description:      create.Description,
+			Readme:           create.Readme,
+			Maturity:         create.Maturity,
+			Language:         create.Language,
+			Tasks:            create.Tasks,
+			Provider:         create.Provider,
+			Logo:             create.Logo,
+			License:          create.License,
+			LicenseLink:      create.LicenseLink,
+			LibraryName:      create.LibraryName,
+			Deprecated:       create.Deprecated,
+			DeprecationDate:  create.DeprecationDate,
+			ReplacedBy:       create.ReplacedBy,
+			CustomProperties: create.CustomProperties,
+		},
+	}
+
+	artifacts, err := convertArtifacts(create.Name, create.Artifacts)
+	if err != nil {
+		return nil, err
+	}
+
+	record := ym.ToModelProviderRecord()
+	record.Model.GetAttributes().ExternalID = create.ExternalId
+
+	saved, err := d.saveModel(record.Model, artifacts, true)
+	if dbutil.IsDuplicateKeyError(err) {
+		return nil, fmt.Errorf("model %q already exists in source %q: %w", create.Name, sourceID, api.ErrConflict)
+	}
+	return saved, err
+}
+
+// UpdateModel changes the fields of a model in a db source that are set in
+// update. If update has artifacts, they replace the model's artifacts.
+func (d *dbCatalogImpl) UpdateModel(ctx context.Context, sourceID string, modelName string, update apimodels.CatalogModelUpdate) (*apimodels.CatalogModel, error) {
+	if err := d.checkWritableSource(sourceID); err != nil {
+		return nil, err
+	}
+
+	if err := validateDeprecation(update.DeprecationDate, update.ReplacedBy); err != nil {
+		return nil, fmt.Errorf("%w: %w", err, api.ErrBadRequest)
+	}
+
+	existing, err := d.getStoredModel(sourceID, modelName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the update with the same code used for new models, then
+	// only keep the properties that were actually part of the update.
+	ym := yamlModel{
+		CatalogModel: apimodels.CatalogModel{
+			Name:             modelName,
+			Description:      update.Description,
+			Readme:           update.Readme,
+			Maturity:         update.Maturity,
+			Language:         update.Language,
+			Tasks:            update.Tasks,
+			Provider:         update.Provider,
+			Logo:             update.Logo,
+			License:          update.License,
+			LicenseLink:      update.LicenseLink,
+			LibraryName:      update.LibraryName,
+			Deprecated:       update.Deprecated,
+			DeprecationDate:  update.DeprecationDate,
+			ReplacedBy:       update.ReplacedBy,
+			CustomProperties: update.CustomProperties,
+		},
+	}
+	properties, customProperties := ym.convertModelProperties()
+
+	updated := mergeProperties(existing.GetProperties(), properties, func(name string) bool {
+		switch name {
+		case "language":
+			return update.Language != nil
+		case "tasks":
+			return update.Tasks != nil
+		case deprecatedProperty:
+			return update.Deprecated != nil
+		}
+		return true
+	})
+
+	model := &models.CatalogModelImpl{
+		ID:               existing.GetID(),
+		TypeID:           existing.GetTypeID(),
+		Attributes:       existing.GetAttributes(),
+		Properties:       &updated,
+		CustomProperties: existing.GetCustomProperties(),
+	}
+	if update.CustomProperties != nil {
+		// Always non-nil so that custom properties missing from the
+		// update are removed.
+		if customProperties == nil {
+			customProperties = []mrmodels.Properties{}
+		}
+		model.CustomProperties = &customProperties
+	}
+	if update.ExternalId != nil {
+		model.Attributes.ExternalID = update.ExternalId
+	}
+	model.Attributes.LastUpdateTimeSinceEpoch = apimodels.PtrInt64(time.Now().UnixMilli())
+
+	artifacts, err := convertArtifacts(modelName, update.Artifacts)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.saveModel(model, artifacts, update.Artifacts != nil)
+}
+
+// DeleteModel removes a model and its artifacts from a db source.
+func (d *dbCatalogImpl) DeleteModel(ctx context.Context, sourceID string, modelName string) error {
+	if err := d.checkWritableSource(sourceID); err != nil {
+		return err
+	}
+
+	existing, err := d.getStoredModel(sourceID, modelName)
+	if err != nil {
+		return err
+	}
+
+	err = d.catalogModelRepository.DeleteByID(*existing.GetID())
+	if err != nil {
+		if errors.Is(err, modelservice.ErrCatalogModelNotFound) {
+			return fmt.Errorf("no model found for name=%v: %w", modelName, api.ErrNotFound)
+		}
+		return err
+	}
+
+	d.refreshPropertyOptions()
+	return nil
+}
+
+// checkWritableSource returns an error unless sourceID is an enabled source
+// of type db.
+func (d *dbCatalogImpl) checkWritableSource(sourceID string) error {
+	source, ok := d.sources.AllSources()[sourceID]
+	if !ok {
+		return fmt.Errorf("source %q not found: %w", sourceID, api.ErrNotFound)
+	}
+	if source.Type != DBSourceType {
+		return fmt.Errorf("source %q has type %q, only %q sources can be modified: %w", sourceID, source.Type, DBSourceType, api.ErrBadRequest)
+	}
+	if source.Enabled != nil && !*source.Enabled {
+		return fmt.Errorf("source %q is disabled: %w", sourceID, api.ErrBadRequest)
+	}
+	return nil
+}
+
+func (d *dbCatalogImpl) getStoredModel(sourceID string, modelName string) (models.CatalogModel, error) {
+	model, err := d.catalogModelRepository.GetByName(sourceID + ":" + modelName)
+	if err != nil {
+		if errors.Is(err, modelservice.ErrCatalogModelNotFound) {
+			return nil, fmt.Errorf("no model found for name=%v: %w", modelName, api.ErrNotFound)
+		}
+		return nil, err
+	}
+	return model, nil
+}
+
+// saveModel stores model and, if replaceArtifacts is true, replaces all of
+// its artifacts with the ones provided.
+func (d *dbCatalogImpl) saveModel(model models.CatalogModel, artifacts []sharedmodels.CatalogArtifact, replaceArtifacts bool) (*apimodels.CatalogModel, error) {
+	var saved models.CatalogModel
+
+	setModelProperty(model, mrmodels.NewStringProperty(sourceTypeProperty, DBSourceType, false))
+
+	// The model and its artifacts are written together, or not at all.
+	err := modelservice.InModelWriteTx(d.catalogModelRepository, d.catalogModelArtifactRepository, d.catalogMetricsArtifactRepository, func(tx modelservice.ModelWriteTx) error {
+		var err error
+		if model.GetID() == nil {
+			saved, err = tx.Models.Create(model)
+		} else {
+			saved, err = tx.Models.Save(model)
+		}
+		if err != nil {
+			return err
+		}
+
+		modelID := saved.GetID()
+		if modelID == nil {
+			return errors.New("model has no ID after save")
+		}
+
+		if !replaceArtifacts {
+			return nil
+		}
+
+		if err = tx.DeleteArtifacts(*modelID); err != nil {
+			return fmt.Errorf("unable to remove old artifacts: %w", err)
+		}
+
+		for i, artifact := range artifacts {
+			err = saveArtifact(tx.ModelArtifacts, tx.MetricsArtifacts, modelID, artifact)
+			if err != nil {
+				return fmt.Errorf("artifact %d: %w", i, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if replaceArtifacts {
+		if err = d.performanceService.UpdateRecommendedLatencies(*saved.GetID()); err != nil {
+			glog.Warningf("Failed to update recommended latencies: %v", err)
+		}
+	}
+
+	d.refreshPropertyOptions()
+
+	result := mapDBModelToAPIModel(saved)
+	return &result, nil
+}
+
+// refreshPropertyOptions rebuilds the filter options so they include the
+// values of models written through the API.
+func (d *dbCatalogImpl) refreshPropertyOptions() {
+	if d.propertyOptionsRepository == nil {
+		return
+	}
+
+	for _, t := range []sharedmodels.PropertyOptionType{sharedmodels.ContextPropertyOptionType, sharedmodels.ArtifactPropertyOptionType} {
+		if err := d.propertyOptionsRepository.Refresh(t); err != nil {
+			glog.Warningf("Failed to refresh property options: %v", err)
+		}
+	}
+}
+
+// convertArtifacts converts artifacts from an API request to database format.
+func convertArtifacts(modelName string, artifacts []apimodels.CatalogArtifact) ([]sharedmodels.CatalogArtifact, error) {
+	converted := make([]sharedmodels.CatalogArtifact, 0, len(artifacts))
+	for i := range artifacts {
+		artifact, err := convertArtifact(&artifacts[i])
+		if err != nil {
+			return nil, fmt.Errorf("model %q artifact %d: %w: %w", modelName, i, err, api.ErrBadRequest)
+		}
+		if artifact == nil {
+			return nil, fmt.Errorf("model %q artifact %d: unknown artifact type: %w", modelName, i, api.ErrBadRequest)
+		}
+		converted = append(converted, *artifact)
+	}
+	return converted, nil
+}
+
+// mergeProperties returns existing with every property in updates for which
+// keep returns true added or replaced by name.
+func mergeProperties(existing *[]mrmodels.Properties, updates []mrmodels.Properties, keep func(name string) bool) []mrmodels.Properties {
+	var merged []mrmodels.Properties
+	if existing != nil {
+		merged = append(merged, *existing...)
+	}
+
+	for _, prop := range updates {
+		if !keep(prop.Name) {
+			continue
+		}
+
+		replaced := false
+		for i := range merged {
+			if merged[i].Name == prop.Name {
+				merged[i] = prop
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			merged = append(merged, prop)
+		}
+	}
+
+	return merged
+}
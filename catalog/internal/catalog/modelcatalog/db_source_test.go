@@ -0,0 +1,236 @@
+package modelcatalog
+
+import (
+	"context"
+	"testing"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/kubeflow/hub/catalog/internal/catalog/basecatalog"
+	modelservice "github.com/kubeflow/hub/catalog/internal/catalog/modelcatalog/service"
+	"github.com/kubeflow/hub/catalog/internal/db/service"
+	"github.com/kubeflow/hub/catalog/internal/testhelpers"
+	model "github.com/kubeflow/hub/catalog/pkg/openapi"
+	"github.com/kubeflow/hub/internal/platform/apiutils"
+	mr_models "github.com/kubeflow/hub/internal/platform/db/entity"
+	"github.com/kubeflow/hub/internal/testutils"
+	"github.com/kubeflow/hub/pkg/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDBSourceWrites(t *testing.T) {
+	sharedDB, cleanup := testutils.SetupPostgresWithMigrations(t, service.DatastoreSpec())
+	defer cleanup()
+
+	catalogModelTypeID := testhelpers.GetCatalogModelTypeIDForDBTest(t, sharedDB)
+	modelArtifactTypeID := testhelpers.GetCatalogModelArtifactTypeIDForDBTest(t, sharedDB)
+	metricsArtifactTypeID := testhelpers.GetCatalogMetricsArtifactTypeIDForDBTest(t, sharedDB)
+	catalogSourceTypeID := testhelpers.GetCatalogSourceTypeIDForDBTest(t, sharedDB)
+
+	svcs := service.NewServices(
+		modelservice.NewCatalogModelRepository(sharedDB, catalogModelTypeID),
+		service.NewCatalogArtifactRepository(sharedDB, map[string]int32{
+			service.CatalogModelArtifactTypeName:   modelArtifactTypeID,
+			service.CatalogMetricsArtifactTypeName: metricsArtifactTypeID,
+		}),
+		modelservice.NewCatalogModelArtifactRepository(sharedDB, modelArtifactTypeID),
+		modelservice.NewCatalogMetricsArtifactRepository(sharedDB, metricsArtifactTypeID),
+		service.NewCatalogSourceRepository(sharedDB, catalogSourceTypeID),
+		service.NewPropertyOptionsRepository(sharedDB),
+		nil, // MCPServerRepository
+		nil, // MCPServerToolRepository
+		nil, // CatalogSourceSyncRepository
+		nil, // CatalogSourceRefreshRepository
+		nil, // CatalogNamedQueryRepository
+	)
+
+	sources := NewSourceCollection()
+	require.NoError(t, sources.Merge("", map[string]basecatalog.ModelSource{
+		"picks": {
+			CatalogSource: model.CatalogSource{Id: "picks", Name: "Team Picks", Enabled: apiutils.Of(true)},
+			Type:          DBSourceType,
+		},
+		"disabled-picks": {
+			CatalogSource: model.CatalogSource{Id: "disabled-picks", Name: "Old Picks", Enabled: apiutils.Of(false)},
+			Type:          DBSourceType,
+		},
+		"files": {
+			CatalogSource: model.CatalogSource{Id: "files", Name: "Files", Enabled: apiutils.Of(true)},
+			Type:          "yaml",
+		},
+	}))
+
+	provider := NewDBCatalog(svcs, sources)
+	writer, ok := provider.(ModelWriter)
+	require.True(t, ok, "DB catalog should support writes")
+
+	ctx := context.Background()
+
+	t.Run("Create", func(t *testing.T) {
+		created, err := writer.CreateModel(ctx, "picks", model.CatalogModelCreate{
+			Name:        "org/granite",
+			Description: apiutils.Of("Granite"),
+			License:     apiutils.Of("apache-2.0"),
+			Tasks:       []string{"text-generation"},
+			CustomProperties: map[string]model.MetadataValue{
+				"team": {MetadataStringValue: model.NewMetadataStringValue("nlp", "MetadataStringValue")},
+			},
+			Artifacts: []model.CatalogArtifact{
+				{CatalogModelArtifact: &model.CatalogModelArtifact{ArtifactType: "model-artifact", Uri: "oci://quay.io/org/granite:1.0"}},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "org/granite", created.Name)
+		assert.Equal(t, "picks", created.GetSourceId())
+		assert.Equal(t, "Granite", created.GetDescription())
+
+		got, err := provider.GetModel(ctx, "org/granite", "picks")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"text-generation"}, got.Tasks)
+		assert.Contains(t, got.GetCustomProperties(), "team")
+
+		artifacts, err := provider.GetArtifacts(ctx, "org/granite", "picks", ListArtifactsParams{PageSize: 10})
+		require.NoError(t, err)
+		require.Len(t, artifacts.Items, 1)
+		assert.Equal(t, "oci://quay.io/org/granite:1.0", artifacts.Items[0].CatalogModelArtifact.Uri)
+	})
+
+	t.Run("CreateDuplicate", func(t *testing.T) {
+		_, err := writer.CreateModel(ctx, "picks", model.CatalogModelCreate{Name: "org/granite"})
+		assert.ErrorIs(t, err, api.ErrConflict)
+	})
+
+	t.Run("CreateInvalidArtifact", func(t *testing.T) {
+		_, err := writer.CreateModel(ctx, "picks", model.CatalogModelCreate{
+			Name: "org/broken",
+			Artifacts: []model.CatalogArtifact{
+				{CatalogModelArtifact: &model.CatalogModelArtifact{ArtifactType: "model-artifact", Uri: "not a uri"}},
+			},
+		})
+		assert.ErrorIs(t, err, api.ErrBadRequest)
+	})
+
+	t.Run("CreateInReadOnlySources", func(t *testing.T) {
+		_, err := writer.CreateModel(ctx, "files", model.CatalogModelCreate{Name: "m"})
+		assert.ErrorIs(t, err, api.ErrBadRequest)
+
+		_, err = writer.CreateModel(ctx, "disabled-picks", model.CatalogModelCreate{Name: "m"})
+		assert.ErrorIs(t, err, api.ErrBadRequest)
+
+		_, err = writer.CreateModel(ctx, "missing", model.CatalogModelCreate{Name: "m"})
+		assert.ErrorIs(t, err, api.ErrNotFound)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		updated, err := writer.UpdateModel(ctx, "picks", "org/granite", model.CatalogModelUpdate{
+			Maturity: apiutils.Of("Production"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Production", updated.GetMaturity())
+		assert.Equal(t, "Granite", updated.GetDescription(), "fields missing from the update are kept")
+		assert.Equal(t, []string{"text-generation"}, updated.Tasks)
+		assert.Contains(t, updated.GetCustomProperties(), "team")
+
+		artifacts, err := provider.GetArtifacts(ctx, "org/granite", "picks", ListArtifactsParams{PageSize: 10})
+		require.NoError(t, err)
+		assert.Len(t, artifacts.Items, 1, "artifacts are kept when the update has none")
+	})
+
+	t.Run("UpdateReplacesArtifactsAndCustomProperties", func(t *testing.T) {
+		updated, err := writer.UpdateModel(ctx, "picks", "org/granite", model.CatalogModelUpdate{
+			CustomProperties: map[string]model.MetadataValue{},
+			Artifacts: []model.CatalogArtifact{
+				{CatalogModelArtifact: &model.CatalogModelArtifact{ArtifactType: "model-artifact", Uri: "oci://quay.io/org/granite:2.0"}},
+			},
+		})
+		require.NoError(t, err)
+		assert.NotContains(t, updated.GetCustomProperties(), "team")
+
+		artifacts, err := provider.GetArtifacts(ctx, "org/granite", "picks", ListArtifactsParams{PageSize: 10})
+		require.NoError(t, err)
+		require.Len(t, artifacts.Items, 1)
+		assert.Equal(t, "oci://quay.io/org/granite:2.0", artifacts.Items[0].CatalogModelArtifact.Uri)
+	})
+
+	t.Run("UpdateMissing", func(t *testing.T) {
+		_, err := writer.UpdateModel(ctx, "picks", "org/missing", model.CatalogModelUpdate{})
+		assert.ErrorIs(t, err, api.ErrNotFound)
+	})
+
+	t.Run("ReloadWithoutDBSource", func(t *testing.T) {
+		loader := NewModelLoader(svcs, basecatalog.NewBaseLoader([]string{}))
+		require.NoError(t, loader.Sources.Merge("", map[string]basecatalog.ModelSource{
+			"files": {
+				CatalogSource: model.CatalogSource{Id: "files", Name: "Files", Enabled: apiutils.Of(true)},
+				Type:          "yaml",
+			},
+		}))
+
+		require.NoError(t, loader.removeModelsFromMissingSources(mapset.NewSet[string]()))
+
+		got, err := provider.GetModel(ctx, "org/granite", "picks")
+		require.NoError(t, err, "models of db sources are only stored in the database")
+		assert.Equal(t, "Production", got.GetMaturity())
+
+		artifacts, err := provider.GetArtifacts(ctx, "org/granite", "picks", ListArtifactsParams{PageSize: 10})
+		require.NoError(t, err)
+		assert.Len(t, artifacts.Items, 1)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		require.NoError(t, writer.DeleteModel(ctx, "picks", "org/granite"))
+
+		_, err := provider.GetModel(ctx, "org/granite", "picks")
+		assert.ErrorIs(t, err, api.ErrNotFound)
+
+		err = writer.DeleteModel(ctx, "picks", "org/granite")
+		assert.ErrorIs(t, err, api.ErrNotFound)
+	})
+}
+
+func TestMergeProperties(t *testing.T) {
+	existing := []mr_models.Properties{
+		{Name: "description", StringValue: apiutils.Of("old")},
+		{Name: "language", StringValue: apiutils.Of(`["en"]`)},
+	}
+	updates := []mr_models.Properties{
+		{Name: "description", StringValue: apiutils.Of("new")},
+		{Name: "language", StringValue: apiutils.Of(`[]`)},
+		{Name: "maturity", StringValue: apiutils.Of("Production")},
+	}
+
+	merged := mergeProperties(&existing, updates, func(name string) bool {
+		return name != "language"
+	})
+
+	got := map[string]string{}
+	for _, p := range merged {
+		got[p.Name] = *p.StringValue
+	}
+	assert.Equal(t, map[string]string{
+		"description": "new",
+		"language":    `["en"]`,
+		"maturity":    "Production",
+	}, got)
+	assert.Equal(t, "old", *existing[0].StringValue, "existing properties must not be modified")
+
+	assert.Empty(t, mergeProperties(nil, nil, func(string) bool { return true }))
+}
+
+func TestConvertArtifacts(t *testing.T) {
+	artifacts, err := convertArtifacts("m", []model.CatalogArtifact{
+		{CatalogModelArtifact: &model.CatalogModelArtifact{ArtifactType: "model-artifact", Uri: "oci://quay.io/org/m:1"}},
+		{CatalogMetricsArtifact: &model.CatalogMetricsArtifact{ArtifactType: "metrics-artifact", MetricsType: "accuracy-metrics"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, artifacts, 2)
+	assert.NotNil(t, artifacts[0].CatalogModelArtifact)
+	assert.NotNil(t, artifacts[1].CatalogMetricsArtifact)
+
+	_, err = convertArtifacts("m", []model.CatalogArtifact{{}})
+	assert.ErrorIs(t, err, api.ErrBadRequest)
+
+	artifacts, err = convertArtifacts("m", nil)
+	require.NoError(t, err)
+	assert.Empty(t, artifacts)
+}
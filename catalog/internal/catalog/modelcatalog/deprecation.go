@@ -0,0 +1,62 @@
+package modelcatalog
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	apimodels "github.com/kubeflow/hub/catalog/pkg/openapi"
+	mrmodels "github.com/kubeflow/hub/internal/platform/db/entity"
+)
+
+const (
+	deprecatedProperty      = "deprecated"
+	deprecationDateProperty = "deprecation_date"
+
+	// replacedByProperty holds the model that replaces a deprecated model,
+	// as "<source id>:<model name>".
+	replacedByProperty = "replaced_by"
+)
+
+// validateDeprecation checks the deprecation fields of a model.
+func validateDeprecation(deprecationDate *string, replacedBy *apimodels.CatalogModelReference) error {
+	if deprecationDate != nil {
+		if _, err := time.Parse(time.DateOnly, *deprecationDate); err != nil {
+			return fmt.Errorf("invalid deprecationDate %q, expected YYYY-MM-DD", *deprecationDate)
+		}
+	}
+	if replacedBy != nil {
+		if replacedBy.SourceId == "" || replacedBy.Name == "" {
+			return fmt.Errorf("replacedBy requires both sourceId and name")
+		}
+		if strings.Contains(replacedBy.SourceId, ":") {
+			return fmt.Errorf("invalid replacedBy sourceId %q", replacedBy.SourceId)
+		}
+	}
+	return nil
+}
+
+// deprecationProperties returns the properties recording a model's
+// deprecation. The deprecated property is always set, so that models that
+// stop being deprecated are updated.
+func deprecationProperties(deprecated *bool, deprecationDate *string, replacedBy *apimodels.CatalogModelReference) []mrmodels.Properties {
+	properties := []mrmodels.Properties{
+		mrmodels.NewBoolProperty(deprecatedProperty, deprecated != nil && *deprecated, false),
+	}
+	if deprecationDate != nil {
+		properties = append(properties, mrmodels.NewStringProperty(deprecationDateProperty, *deprecationDate, false))
+	}
+	if replacedBy != nil {
+		properties = append(properties, mrmodels.NewStringProperty(replacedByProperty, replacedBy.SourceId+":"+replacedBy.Name, false))
+	}
+	return properties
+}
+
+// parseModelReference is the inverse of how replacedByProperty is stored.
+func parseModelReference(value string) (*apimodels.CatalogModelReference, bool) {
+	sourceID, name, ok := strings.Cut(value, ":")
+	if !ok || sourceID == "" || name == "" {
+		return nil, false
+	}
+	return apimodels.NewCatalogModelReference(sourceID, name), true
+}
@@ -18,6 +18,7 @@ import (
 	catalogmodels "github.com/kubeflow/hub/catalog/internal/catalog/modelcatalog/models"
 	sharedmodels "github.com/kubeflow/hub/catalog/internal/db/models"
 	apimodels "github.com/kubeflow/hub/catalog/pkg/openapi"
+	"github.com/kubeflow/hub/internal/licensepolicy"
 	models "github.com/kubeflow/hub/internal/platform/db/entity"
 )
 
@@ -141,6 +142,44 @@ type hfCard struct {
 	Data map[string]any `json:"data,omitempty"`
 }
 
+// applyTo copies the model card fields the catalog understands (description,
+// language and license link) into a CatalogModel. The license link is only
+// set if the model doesn't have one yet.
+func (c *hfCard) applyTo(m *apimodels.CatalogModel) {
+	if c == nil || c.Data == nil {
+		return
+	}
+
+	if desc, ok := c.Data["description"].(string); ok && desc != "" {
+		m.Description = &desc
+	}
+
+	if langData, ok := c.Data["language"].([]any); ok && len(langData) > 0 {
+		languages := make([]string, 0, len(langData))
+		for _, lang := range langData {
+			if langStr, ok := lang.(string); ok && langStr != "" {
+				languages = append(languages, langStr)
+			}
+		}
+		if len(languages) > 0 {
+			m.Language = languages
+		}
+	}
+
+	// Check common field names for license link/URL
+	if m.LicenseLink == nil {
+		licenseLinkFields := []string{"license_link", "licenseLink", "license_url", "licenseUrl", "license"}
+		for _, field := range licenseLinkFields {
+			if link, ok := c.Data[field].(string); ok && link != "" {
+				if strings.HasPrefix(link, "http://") || strings.HasPrefix(link, "https://") {
+					m.LicenseLink = &link
+					break
+				}
+			}
+		}
+	}
+}
+
 //go:embed assets/catalog_logo.svg
 var catalogLogoSVG []byte
 
@@ -249,7 +288,7 @@ func (hfm *hfModel) populateFromHFInfo(ctx context.Context, provider *hfModelPro
 				// Extract license (only first one)
 				if hfm.License == nil {
 					if license != "" {
-						license = basecatalog.TransformLicenseToHumanReadable(license)
+						license = licensepolicy.TransformLicenseToHumanReadable(license)
 						hfm.License = &license
 					}
 				}
@@ -279,40 +318,9 @@ func (hfm *hfModel) populateFromHFInfo(ctx context.Context, provider *hfModelPro
 		}
 	}
 
-	// Extract description from cardData if available
-	if hfInfo.CardData != nil && hfInfo.CardData.Data != nil {
-		// Extract description from cardData if available
-		if desc, ok := hfInfo.CardData.Data["description"].(string); ok && desc != "" {
-			hfm.Description = &desc
-		}
-
-		// Extract language from cardData if available
-		if langData, ok := hfInfo.CardData.Data["language"].([]any); ok && len(langData) > 0 {
-			languages := make([]string, 0, len(langData))
-			for _, lang := range langData {
-				if langStr, ok := lang.(string); ok && langStr != "" {
-					languages = append(languages, langStr)
-				}
-			}
-			if len(languages) > 0 {
-				hfm.Language = languages
-			}
-		}
-
-		// Extract license link from cardData if available
-		// Check common field names for license link/URL
-		if hfm.LicenseLink == nil {
-			licenseLinkFields := []string{"license_link", "licenseLink", "license_url", "licenseUrl", "license"}
-			for _, field := range licenseLinkFields {
-				if link, ok := hfInfo.CardData.Data[field].(string); ok && link != "" {
-					if strings.HasPrefix(link, "http://") || strings.HasPrefix(link, "https://") {
-						hfm.LicenseLink = &link
-						break
-					}
-				}
-			}
-		}
-
+	// Extract description, language and license link from cardData if available
+	if hfInfo.CardData != nil {
+		hfInfo.CardData.applyTo(&hfm.CatalogModel)
 	}
 
 	// Set provider from author
@@ -711,9 +719,15 @@ func (p *hfModelProvider) convertHFModelToRecord(ctx context.Context, hfInfo *hf
 		})
 	}
 
+	files := make([]string, 0, len(hfInfo.Siblings))
+	for _, sibling := range hfInfo.Siblings {
+		files = append(files, sibling.RFileName)
+	}
+
 	return ModelProviderRecord{
 		Model:     &model,
 		Artifacts: artifacts,
+		Files:     files,
 	}
 }
 
@@ -733,7 +747,7 @@ func convertHFModelProperties(catalogModel *apimodels.CatalogModel) ([]models.Pr
 		properties = append(properties, models.NewStringProperty("provider", *catalogModel.Provider, false))
 	}
 	if catalogModel.License != nil {
-		humanReadableLicense := basecatalog.TransformLicenseToHumanReadable(*catalogModel.License)
+		humanReadableLicense := licensepolicy.TransformLicenseToHumanReadable(*catalogModel.License)
 		properties = append(properties, models.NewStringProperty("license", humanReadableLicense, false))
 	}
 	if catalogModel.LicenseLink != nil {
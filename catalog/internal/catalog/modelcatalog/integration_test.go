@@ -43,6 +43,9 @@ func setupIntegrationTestProvider(t *testing.T, ctx context.Context, sharedDB *g
 		service.NewPropertyOptionsRepository(sharedDB),
 		nil, // MCPServerRepository
 		nil, // MCPServerToolRepository
+		nil, // CatalogSourceSyncRepository
+		nil, // CatalogSourceRefreshRepository
+		nil, // CatalogNamedQueryRepository
 	)
 
 	// Insert test data:
@@ -154,7 +157,7 @@ func BenchmarkRecommendedLatencySorting(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		_, err := provider.FindModelsWithRecommendedLatency(ctx, mr_models.Pagination{
 			PageSize: apiutils.Of(int32(20)),
-		}, paretoParams, []string{"benchmark-source"}, "")
+		}, paretoParams, []string{"benchmark-source"}, "", false)
 
 		require.NoError(b, err)
 	}
@@ -186,6 +189,9 @@ func setupBenchmarkProvider(b *testing.B, ctx context.Context, sharedDB *gorm.DB
 		service.NewPropertyOptionsRepository(sharedDB),
 		nil, // MCPServerRepository
 		nil, // MCPServerToolRepository
+		nil, // CatalogSourceSyncRepository
+		nil, // CatalogSourceRefreshRepository
+		nil, // CatalogNamedQueryRepository
 	)
 
 	// Insert 100+ models with performance data for benchmarking
@@ -0,0 +1,38 @@
+package modelcatalog
+
+import (
+	"github.com/kubeflow/hub/catalog/internal/catalog/modelcatalog/models"
+	"github.com/kubeflow/hub/internal/licensepolicy"
+	mrmodels "github.com/kubeflow/hub/internal/platform/db/entity"
+)
+
+const (
+	licensePolicyProperty          = licensepolicy.StatusProperty
+	licenseClassificationsProperty = licensepolicy.ClassificationsProperty
+)
+
+// applyLicensePolicy evaluates the model's license and records the outcome
+// in its license_policy and license_classifications properties. Nothing is
+// recorded when no policy is configured.
+func applyLicensePolicy(policy *licensepolicy.Policy, model models.CatalogModel) {
+	if policy == nil || model == nil {
+		return
+	}
+
+	license := ""
+	if props := model.GetProperties(); props != nil {
+		for _, prop := range *props {
+			if prop.Name == "license" && prop.StringValue != nil {
+				license = *prop.StringValue
+				break
+			}
+		}
+	}
+
+	// Sources store human-readable license names, policies usually use SPDX IDs.
+	result := policy.Evaluate(license, licensepolicy.LicenseIDFromHumanReadable(license))
+
+	setModelProperty(model, mrmodels.NewStringProperty(licensePolicyProperty, string(result.Status), false))
+	// Always stored, even empty, so that stale classifications are overwritten.
+	setModelProperty(model, mrmodels.NewStringProperty(licenseClassificationsProperty, licensepolicy.EncodeClassifications(result.Classifications), false))
+}
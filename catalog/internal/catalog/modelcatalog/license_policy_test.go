@@ -0,0 +1,93 @@
+package modelcatalog
+
+import (
+	"testing"
+
+	"github.com/kubeflow/hub/catalog/internal/catalog/modelcatalog/models"
+	"github.com/kubeflow/hub/internal/licensepolicy"
+	"github.com/kubeflow/hub/internal/platform/apiutils"
+	mrmodels "github.com/kubeflow/hub/internal/platform/db/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyLicensePolicy(t *testing.T) {
+	policy := &licensepolicy.Policy{
+		Allow: []string{"apache-2.0"},
+		Classifications: map[string]licensepolicy.Classification{
+			"copyleft": {Licenses: []string{"gpl-3.0"}, Status: licensepolicy.StatusReview},
+		},
+	}
+
+	newModel := func(license string) *models.CatalogModelImpl {
+		props := []mrmodels.Properties{}
+		if license != "" {
+			props = append(props, mrmodels.NewStringProperty("license", license, false))
+		}
+		return &models.CatalogModelImpl{
+			Attributes: &models.CatalogModelAttributes{Name: apiutils.Of("model")},
+			Properties: &props,
+		}
+	}
+
+	propertyValue := func(model models.CatalogModel, name string) string {
+		for _, prop := range *model.GetProperties() {
+			if prop.Name == name && prop.StringValue != nil {
+				return *prop.StringValue
+			}
+		}
+		return ""
+	}
+
+	tests := []struct {
+		name                    string
+		license                 string
+		expectedStatus          string
+		expectedClassifications string
+	}{
+		{
+			name:                    "human-readable name of an allowed license",
+			license:                 "Apache 2.0",
+			expectedStatus:          "allowed",
+			expectedClassifications: "[]",
+		},
+		{
+			name:                    "classified license",
+			license:                 "GPL 3.0",
+			expectedStatus:          "review",
+			expectedClassifications: `["copyleft"]`,
+		},
+		{
+			name:                    "no license",
+			expectedStatus:          "unknown",
+			expectedClassifications: "[]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := newModel(tt.license)
+			applyLicensePolicy(policy, model)
+
+			assert.Equal(t, tt.expectedStatus, propertyValue(model, licensePolicyProperty))
+			assert.Equal(t, tt.expectedClassifications, propertyValue(model, licenseClassificationsProperty))
+		})
+	}
+
+	t.Run("re-evaluation replaces the outcome", func(t *testing.T) {
+		model := newModel("GPL 3.0")
+		applyLicensePolicy(policy, model)
+		applyLicensePolicy(&licensepolicy.Policy{Deny: []string{"gpl-3.0"}}, model)
+
+		assert.Equal(t, "denied", propertyValue(model, licensePolicyProperty))
+		assert.Equal(t, "[]", propertyValue(model, licenseClassificationsProperty))
+		assert.Len(t, *model.GetProperties(), 3)
+	})
+
+	t.Run("no policy", func(t *testing.T) {
+		model := newModel("Apache 2.0")
+		applyLicensePolicy(nil, model)
+
+		assert.Empty(t, propertyValue(model, licensePolicyProperty))
+		assert.Len(t, *model.GetProperties(), 1)
+	})
+}
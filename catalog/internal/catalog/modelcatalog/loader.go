@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"strings"
 	"sync"
 
 	mapset "github.com/deckarep/golang-set/v2"
@@ -14,6 +15,7 @@ import (
 	sharedmodels "github.com/kubeflow/hub/catalog/internal/db/models"
 	"github.com/kubeflow/hub/catalog/internal/db/service"
 	mrmodels "github.com/kubeflow/hub/internal/platform/db/entity"
+	"github.com/kubeflow/hub/pkg/api"
 )
 
 // PartiallyAvailableError indicates that a source loaded some models successfully
@@ -38,10 +40,26 @@ var ErrPartiallyAvailable error = &PartiallyAvailableError{}
 type ModelProviderRecord struct {
 	Model     models.CatalogModel
 	Artifacts []sharedmodels.CatalogArtifact
+	// Files lists the model's files, for sources that know them. Scanners
+	// use them to inspect the model without downloading it.
+	Files []string
 	// Error can be set here to emit successfully loaded models before updating source status err.
 	Error error
 }
 
+// SourceID returns the ID of the source the record's model was loaded from.
+func (r ModelProviderRecord) SourceID() string {
+	if r.Model == nil || r.Model.GetProperties() == nil {
+		return ""
+	}
+	for _, prop := range *r.Model.GetProperties() {
+		if prop.Name == "source_id" && prop.StringValue != nil {
+			return *prop.StringValue
+		}
+	}
+	return ""
+}
+
 // ModelProviderFunc emits models and related data in the channel it returns. It is
 // expected to spawn a goroutine and return immediately. The returned channel must
 // close when the goroutine ends. The goroutine should end when the context is
@@ -76,6 +94,8 @@ type ModelLoader struct {
 	Labels *LabelCollection
 
 	services      service.Services
+	performance   *PerformanceArtifactService
+	scanning      *modelScanning
 	handlers      []LoaderEventHandler
 	loadedSources map[string]bool // tracks which source IDs have been loaded
 }
@@ -100,6 +120,8 @@ func NewModelLoader(services service.Services, state basecatalog.LoaderState) *M
 		Sources:       NewSourceCollection(absPaths...),
 		Labels:        NewLabelCollection(),
 		services:      services,
+		performance:   NewPerformanceArtifactService(services.CatalogArtifactRepository, services.CatalogModelRepository),
+		scanning:      newModelScanning(),
 		loadedSources: map[string]bool{},
 	}
 }
@@ -141,6 +163,92 @@ func (l *ModelLoader) ReloadParsing() {
 	}
 }
 
+// RefreshSource reads a single source again, without waiting for its file
+// to change or its sync interval to elapse, and returns once its models are
+// saved. It returns the status the source was left in and, unless the
+// source is available, a message describing the problem. An error means
+// the source couldn't be refreshed at all.
+func (l *ModelLoader) RefreshSource(ctx context.Context, sourceID string) (string, string, error) {
+	source, ok := l.Sources.AllSources()[sourceID]
+	if !ok {
+		return "", "", fmt.Errorf("model source %s not found: %w", sourceID, api.ErrNotFound)
+	}
+	if source.Enabled != nil && !*source.Enabled {
+		return "", "", fmt.Errorf("model source %s is disabled: %w", sourceID, api.ErrBadRequest)
+	}
+	if !l.state.ShouldWriteDatabase() {
+		return "", "", errors.New("only the leader can refresh sources")
+	}
+
+	if source.Type == DBSourceType {
+		// Nothing to read, see readProviderRecords.
+		basecatalog.SaveSourceStatus(l.services.CatalogSourceRepository, sourceID, basecatalog.SourceStatusAvailable, "")
+		return basecatalog.SourceStatusAvailable, "", nil
+	}
+
+	registerFunc, ok := registeredModelProviders[source.Type]
+	if !ok {
+		errMsg := fmt.Sprintf("catalog type %q not registered", source.Type)
+		basecatalog.SaveSourceStatus(l.services.CatalogSourceRepository, sourceID, basecatalog.SourceStatusError, errMsg)
+		return basecatalog.SourceStatusError, errMsg, nil
+	}
+
+	glog.Infof("Refreshing models from %s source %s", source.Type, sourceID)
+
+	// Providers keep watching their source until the context is
+	// cancelled, so this one is stopped after its first batch.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	recorder := l.newSyncRecorder(sourceID)
+
+	records, err := registerFunc(ctx, &source, filepath.Dir(source.Origin))
+	if err != nil {
+		glog.Errorf("error reading catalog type %s with id %s: %v", source.Type, sourceID, err)
+		basecatalog.SaveSourceStatus(l.services.CatalogSourceRepository, sourceID, basecatalog.SourceStatusError, err.Error())
+		recorder.Finish(basecatalog.SourceStatusError, err.Error())
+		return basecatalog.SourceStatusError, err.Error(), nil
+	}
+
+	out := make(chan ModelProviderRecord)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for record := range out {
+			if attr := record.Model.GetAttributes(); attr != nil && attr.Name != nil {
+				l.saveRecord(ctx, record)
+			}
+		}
+	}()
+
+	status, errMsg := l.readSourceRecords(ctx, sourceID, records, recorder, out, true)
+	close(out)
+	<-done
+
+	interrupted := ctx.Err()
+	cancel()
+	go func() {
+		//nolint:revive
+		for range records {
+		}
+	}()
+
+	if status == "" {
+		if interrupted != nil {
+			return "", "", fmt.Errorf("refresh of model source %s was interrupted: %w", sourceID, interrupted)
+		}
+		return "", "", fmt.Errorf("model source %s stopped before loading any models", sourceID)
+	}
+
+	return status, errMsg, nil
+}
+
+// ForgetScans drops the scan results kept for a source's models, so that
+// they're scanned again the next time the source is read.
+func (l *ModelLoader) ForgetScans(sourceID string) {
+	l.scanning.forget(sourceID)
+}
+
 // performLeaderWrites executes database write operations: removing orphaned
 // models and loading all models from sources.
 func (l *ModelLoader) performLeaderWrites(ctx context.Context, allKnownSourceIDs mapset.Set[string]) error {
@@ -165,6 +273,9 @@ func (l *ModelLoader) parseAndMerge(path string) error {
 	if err = l.updateSources(path, config); err != nil {
 		return err
 	}
+	l.Sources.MergeHardwareCosts(path, config.HardwareCosts)
+	l.Sources.MergeLicensePolicy(config.LicensePolicy)
+	l.Sources.MergeScanConfig(path, config.Scanning)
 
 	return l.updateLabels(path, config)
 }
@@ -277,66 +388,85 @@ func (l *ModelLoader) updateDatabase(ctx context.Context) error {
 				continue
 			}
 
-			func() {
-				// Track this write operation
-				l.state.TrackWrite()
-				defer l.state.WriteComplete()
+			l.saveRecord(ctx, record)
+		}
+	}()
 
-				glog.Infof("Loading model %s with %d artifact(s)", *attr.Name, len(record.Artifacts))
+	return nil
+}
 
-				model, err := l.services.CatalogModelRepository.Save(record.Model)
-				if err != nil {
-					glog.Errorf("%s: unable to save: %v", *attr.Name, err)
-					return
-				}
+// saveRecord stores a model and its artifacts, replacing the artifacts that
+// were stored before, and calls the event handlers.
+func (l *ModelLoader) saveRecord(ctx context.Context, record ModelProviderRecord) {
+	attr := record.Model.GetAttributes()
 
-				modelID := model.GetID()
-				if modelID == nil {
-					glog.Errorf("%s: model has no ID after save", *attr.Name)
-					return
-				}
+	// Track this write operation
+	l.state.TrackWrite()
+	defer l.state.WriteComplete()
 
-				// Remove artifacts that existed before.
-				err = l.services.CatalogArtifactRepository.DeleteByParentID(service.CatalogModelArtifactTypeName, *modelID)
-				if err != nil {
-					glog.Errorf("%s: unable to remove old catalog model artifacts: %v", *attr.Name, err)
-				}
-				err = l.services.CatalogArtifactRepository.DeleteByParentID(service.CatalogMetricsArtifactTypeName, *modelID)
-				if err != nil {
-					glog.Errorf("%s: unable to remove old catalog metrics artifacts: %v", *attr.Name, err)
-				}
+	glog.Infof("Loading model %s with %d artifact(s)", *attr.Name, len(record.Artifacts))
 
-				for i, artifact := range record.Artifacts {
-					switch {
-					case artifact.CatalogModelArtifact != nil:
-						if ma, ok := artifact.CatalogModelArtifact.(models.CatalogModelArtifact); ok {
-							_, err = l.services.CatalogModelArtifactRepository.Save(ma, modelID)
-						} else {
-							err = fmt.Errorf("invalid model artifact type: %T", artifact.CatalogModelArtifact)
-						}
-					case artifact.CatalogMetricsArtifact != nil:
-						if ma, ok := artifact.CatalogMetricsArtifact.(models.CatalogMetricsArtifact); ok {
-							_, err = l.services.CatalogMetricsArtifactRepository.Save(ma, modelID)
-						} else {
-							err = fmt.Errorf("invalid metrics artifact type: %T", artifact.CatalogMetricsArtifact)
-						}
-					default:
-						err = errors.New("unknown artifact type")
-					}
+	model, err := l.services.CatalogModelRepository.Save(record.Model)
+	if err != nil {
+		glog.Errorf("%s: unable to save: %v", *attr.Name, err)
+		return
+	}
 
-					if err != nil {
-						glog.Errorf("%s, artifact %d: %v", *attr.Name, i, err)
-					}
-				}
+	modelID := model.GetID()
+	if modelID == nil {
+		glog.Errorf("%s: model has no ID after save", *attr.Name)
+		return
+	}
 
-				for _, handler := range l.handlers {
-					handler(ctx, record)
-				}
-			}()
+	// Remove artifacts that existed before.
+	err = l.services.CatalogArtifactRepository.DeleteByParentID(service.CatalogModelArtifactTypeName, *modelID)
+	if err != nil {
+		glog.Errorf("%s: unable to remove old catalog model artifacts: %v", *attr.Name, err)
+	}
+	err = l.services.CatalogArtifactRepository.DeleteByParentID(service.CatalogMetricsArtifactTypeName, *modelID)
+	if err != nil {
+		glog.Errorf("%s: unable to remove old catalog metrics artifacts: %v", *attr.Name, err)
+	}
+
+	for i, artifact := range record.Artifacts {
+		err = saveArtifact(l.services.CatalogModelArtifactRepository, l.services.CatalogMetricsArtifactRepository, modelID, artifact)
+		if err != nil {
+			glog.Errorf("%s, artifact %d: %v", *attr.Name, i, err)
 		}
-	}()
+	}
 
-	return nil
+	for _, handler := range l.handlers {
+		handler(ctx, record)
+	}
+
+	// Handlers can add performance artifacts, so this comes last.
+	err = l.performance.UpdateRecommendedLatencies(*modelID)
+	if err != nil {
+		glog.Errorf("%s: unable to update recommended latencies: %v", *attr.Name, err)
+	}
+}
+
+// saveArtifact stores a model or metrics artifact and links it to the model
+// with the ID provided.
+func saveArtifact(modelArtifacts models.CatalogModelArtifactRepository, metricsArtifacts models.CatalogMetricsArtifactRepository, modelID *int32, artifact sharedmodels.CatalogArtifact) error {
+	switch {
+	case artifact.CatalogModelArtifact != nil:
+		ma, ok := artifact.CatalogModelArtifact.(models.CatalogModelArtifact)
+		if !ok {
+			return fmt.Errorf("invalid model artifact type: %T", artifact.CatalogModelArtifact)
+		}
+		_, err := modelArtifacts.Save(ma, modelID)
+		return err
+	case artifact.CatalogMetricsArtifact != nil:
+		ma, ok := artifact.CatalogMetricsArtifact.(models.CatalogMetricsArtifact)
+		if !ok {
+			return fmt.Errorf("invalid metrics artifact type: %T", artifact.CatalogMetricsArtifact)
+		}
+		_, err := metricsArtifacts.Save(ma, modelID)
+		return err
+	default:
+		return errors.New("unknown artifact type")
+	}
 }
 
 // readProviderRecords calls the provider for every merged source that hasn't
@@ -374,6 +504,13 @@ func (l *ModelLoader) readProviderRecords(ctx context.Context) <-chan ModelProvi
 		// Mark this source as loaded
 		l.loadedSources[source.Id] = true
 
+		if source.Type == DBSourceType {
+			// Models in db sources are written through the API and
+			// already live in the database, so there's nothing to read.
+			basecatalog.SaveSourceStatus(l.services.CatalogSourceRepository, source.Id, basecatalog.SourceStatusAvailable, "")
+			continue
+		}
+
 		glog.Infof("Reading models from %s source %s", source.Type, source.Id)
 
 		registerFunc, ok := registeredModelProviders[source.Type]
@@ -388,106 +525,149 @@ func (l *ModelLoader) readProviderRecords(ctx context.Context) <-chan ModelProvi
 		// different configmaps) to use relative paths correctly.
 		sourceDir := filepath.Dir(source.Origin)
 
+		recorder := l.newSyncRecorder(source.Id)
+
 		records, err := registerFunc(ctx, &source, sourceDir)
 		if err != nil {
 			glog.Errorf("error reading catalog type %s with id %s: %v", source.Type, source.Id, err)
 			basecatalog.SaveSourceStatus(l.services.CatalogSourceRepository, source.Id, basecatalog.SourceStatusError, err.Error())
+			recorder.Finish(basecatalog.SourceStatusError, err.Error())
 			continue
 		}
 
 		wg.Add(1)
 		go func(ctx context.Context, sourceID string) {
 			defer wg.Done()
+			l.readSourceRecords(ctx, sourceID, records, recorder, ch, false)
+		}(ctx, source.Id)
+	}
 
-			modelNames := []string{}
-			failedModels := []string{}
-			statusSaved := false
-
-			for r := range records {
-				// Per-model validation errors (Error set, no Model). The Hugging Face
-				// provider also sends a nil-Model completion record with
-				// ErrPartiallyAvailable; that is handled below as batch completion, not here.
-				if r.Error != nil && r.Model == nil && !errors.Is(r.Error, ErrPartiallyAvailable) {
-					glog.Errorf("%s: model validation error: %v", sourceID, r.Error)
-					failedModels = append(failedModels, r.Error.Error())
-					continue
-				}
+	go func() {
+		defer close(ch)
+		wg.Wait()
+	}()
+
+	return ch
+}
+
+// readSourceRecords forwards the models read from a single source to out.
+// At the end of every batch it saves the status of the source, removes the
+// models that are no longer in the source and records the sync. If once is
+// true, it returns after the first batch with the status of the source, or
+// an empty status if ctx was cancelled first.
+func (l *ModelLoader) readSourceRecords(ctx context.Context, sourceID string, records <-chan ModelProviderRecord, recorder *basecatalog.SourceSyncRecorder, out chan<- ModelProviderRecord, once bool) (string, string) {
+
+	modelNames := []string{}
+	failedModels := []string{}
+	statusSaved := false
+
+	for r := range records {
+		// Per-model validation errors (Error set, no Model). The Hugging Face
+		// provider also sends a nil-Model completion record with
+		// ErrPartiallyAvailable; that is handled below as batch completion, not here.
+		if r.Error != nil && r.Model == nil && !errors.Is(r.Error, ErrPartiallyAvailable) {
+			glog.Errorf("%s: model validation error: %v", sourceID, r.Error)
+			failedModels = append(failedModels, r.Error.Error())
+			continue
+		}
 
-				if r.Model == nil {
-
-					glog.Infof("%s: loaded %d models", sourceID, len(modelNames))
-
-					// Copy the list of model names, then clear it.
-					modelNameSet := mapset.NewSet(modelNames...)
-					modelNames = modelNames[:0]
-
-					go func() {
-						count, err := l.removeOrphanedModelsFromSource(sourceID, modelNameSet)
-						if err != nil {
-							glog.Errorf("error removing orphaned models: %v", err)
-						}
-						glog.Infof("%s: cleaned up %d models", sourceID, count)
-					}()
-
-					// Only save status if context is still valid (no reload in progress)
-					if ctx.Err() == nil {
-						successCount := modelNameSet.Cardinality()
-						hasPartialFailure := errors.Is(r.Error, ErrPartiallyAvailable)
-						hasValidationFailures := len(failedModels) > 0
-
-						if successCount > 0 {
-							if hasPartialFailure {
-								glog.Warningf("%s: partial error after loading models: %v", sourceID, r.Error)
-								basecatalog.SaveSourceStatus(l.services.CatalogSourceRepository, sourceID, basecatalog.SourceStatusPartiallyAvailable, r.Error.Error())
-							} else if hasValidationFailures {
-								errMsg := fmt.Sprintf("Failed to load %d model(s): %v", len(failedModels), failedModels)
-								glog.Warningf("%s: %s", sourceID, errMsg)
-								basecatalog.SaveSourceStatus(l.services.CatalogSourceRepository, sourceID, basecatalog.SourceStatusPartiallyAvailable, errMsg)
-							} else {
-								basecatalog.SaveSourceStatus(l.services.CatalogSourceRepository, sourceID, basecatalog.SourceStatusAvailable, "")
-							}
-						} else if hasPartialFailure || hasValidationFailures {
-							if hasPartialFailure {
-								glog.Warningf("%s: all catalog models failed to load from source: %v", sourceID, r.Error)
-								basecatalog.SaveSourceStatus(l.services.CatalogSourceRepository, sourceID, basecatalog.SourceStatusError, r.Error.Error())
-							} else {
-								errMsg := fmt.Sprintf("all catalog models failed to load from source %s (failed: %v)", sourceID, failedModels)
-								glog.Warningf("%s: %s", sourceID, errMsg)
-								basecatalog.SaveSourceStatus(l.services.CatalogSourceRepository, sourceID, basecatalog.SourceStatusError, errMsg)
-							}
-						} else {
-							basecatalog.SaveSourceStatus(l.services.CatalogSourceRepository, sourceID, basecatalog.SourceStatusAvailable, "")
-						}
-						statusSaved = true
+		if r.Model == nil {
+
+			glog.Infof("%s: loaded %d models", sourceID, len(modelNames))
+
+			// Copy the list of model names, then clear it.
+			modelNameSet := mapset.NewSet(modelNames...)
+			modelNames = modelNames[:0]
+
+			// Hand the recorder for this batch over to the cleanup
+			// below, and start a new one for the next batch.
+			batchRecorder := recorder
+			if batchRecorder == nil {
+				batchRecorder = l.newSyncRecorder(sourceID)
+			}
+			recorder = nil
+
+			// Only save status if context is still valid (no reload in progress)
+			status, errMsg := "", ""
+			if ctx.Err() == nil {
+				successCount := modelNameSet.Cardinality()
+				hasPartialFailure := errors.Is(r.Error, ErrPartiallyAvailable)
+				hasValidationFailures := len(failedModels) > 0
+
+				status = basecatalog.SourceStatusAvailable
+				if successCount > 0 {
+					if hasPartialFailure {
+						glog.Warningf("%s: partial error after loading models: %v", sourceID, r.Error)
+						status, errMsg = basecatalog.SourceStatusPartiallyAvailable, r.Error.Error()
+					} else if hasValidationFailures {
+						errMsg = fmt.Sprintf("Failed to load %d model(s): %v", len(failedModels), failedModels)
+						glog.Warningf("%s: %s", sourceID, errMsg)
+						status = basecatalog.SourceStatusPartiallyAvailable
+					}
+				} else if hasPartialFailure || hasValidationFailures {
+					if hasPartialFailure {
+						glog.Warningf("%s: all catalog models failed to load from source: %v", sourceID, r.Error)
+						status, errMsg = basecatalog.SourceStatusError, r.Error.Error()
+					} else {
+						errMsg = fmt.Sprintf("all catalog models failed to load from source %s (failed: %v)", sourceID, failedModels)
+						glog.Warningf("%s: %s", sourceID, errMsg)
+						status = basecatalog.SourceStatusError
 					}
-					continue
 				}
+				basecatalog.SaveSourceStatus(l.services.CatalogSourceRepository, sourceID, status, errMsg)
+				statusSaved = true
+			}
 
-				// Set source_id and namespaced name on every returned model.
-				l.setModelSourceID(r.Model, sourceID)
-
-				if attr := r.Model.GetAttributes(); attr != nil && attr.Name != nil {
-					// Use namespaced name (source_id:model_name)so removeOrphanedModelsFromSource matches DB (which stores namespaced names).
-					modelNames = append(modelNames, *attr.Name)
+			cleanup := func() {
+				removed, err := l.removeOrphanedModelsFromSource(sourceID, modelNameSet)
+				if err != nil {
+					glog.Errorf("error removing orphaned models: %v", err)
 				}
+				glog.Infof("%s: cleaned up %d models", sourceID, len(removed))
 
-				ch <- r
+				// Interrupted loads aren't recorded; the next
+				// load covers the same changes.
+				if status != "" {
+					for _, name := range removed {
+						batchRecorder.Removed(strings.TrimPrefix(name, sourceID+":"))
+					}
+					batchRecorder.Finish(status, errMsg)
+				}
 			}
+			if once {
+				cleanup()
+				return status, errMsg
+			}
+			go cleanup()
+			continue
+		}
+
+		// Set source_id and namespaced name on every returned model.
+		l.setModelSourceID(r.Model, sourceID)
+		applyLicensePolicy(l.Sources.GetLicensePolicy(), r.Model)
+		l.scanning.scan(ctx, l.Sources.GetScanConfig(), r)
+
+		if attr := r.Model.GetAttributes(); attr != nil && attr.Name != nil {
+			// Use namespaced name (source_id:model_name)so removeOrphanedModelsFromSource matches DB (which stores namespaced names).
+			modelNames = append(modelNames, *attr.Name)
 
-			// If the channel closed without a nil Model marker and status wasn't already saved,
-			// save available status if context is still valid and we processed some models
-			if !statusSaved && ctx.Err() == nil && len(modelNames) > 0 {
-				basecatalog.SaveSourceStatus(l.services.CatalogSourceRepository, sourceID, basecatalog.SourceStatusAvailable, "")
+			if recorder == nil {
+				recorder = l.newSyncRecorder(sourceID)
 			}
-		}(ctx, source.Id)
-	}
+			recorder.Loaded(strings.TrimPrefix(*attr.Name, sourceID+":"), modelDigest(r.Model))
+		}
 
-	go func() {
-		defer close(ch)
-		wg.Wait()
-	}()
+		out <- r
+	}
 
-	return ch
+	// If the channel closed without a nil Model marker and status wasn't already saved,
+	// save available status if context is still valid and we processed some models
+	if !statusSaved && ctx.Err() == nil && len(modelNames) > 0 {
+		basecatalog.SaveSourceStatus(l.services.CatalogSourceRepository, sourceID, basecatalog.SourceStatusAvailable, "")
+		recorder.Finish(basecatalog.SourceStatusAvailable, "")
+		return basecatalog.SourceStatusAvailable, ""
+	}
+	return "", ""
 }
 
 func (l *ModelLoader) setModelSourceID(model models.CatalogModel, sourceID string) {
@@ -495,9 +675,21 @@ func (l *ModelLoader) setModelSourceID(model models.CatalogModel, sourceID strin
 		return
 	}
 
-	// Add a source_id property to the model's properties list.. the hard
-	// way, because we use pointers to slices for some reason.
+	setModelProperty(model, mrmodels.NewStringProperty("source_id", sourceID, false))
 
+	// Prepend sourceId to the model name so DB uniqueness is (sourceId, modelName).
+	// Format: sourceId:modelName — must run for every model so DB and removeOrphanedModelsFromSource stay consistent.
+	attr := model.GetAttributes()
+	if attr != nil && attr.Name != nil && *attr.Name != "" {
+		namespacedName := sourceID + ":" + *attr.Name
+		attr.Name = &namespacedName
+	}
+}
+
+// setModelProperty adds a property to the model's properties list, replacing
+// any property with the same name.
+func setModelProperty(model models.CatalogModel, prop mrmodels.Properties) {
+	// The hard way, because we use pointers to slices for some reason.
 	props := model.GetProperties()
 	if props == nil {
 		if modelImpl, ok := model.(*models.CatalogModelImpl); ok {
@@ -510,36 +702,29 @@ func (l *ModelLoader) setModelSourceID(model models.CatalogModel, sourceID strin
 		}
 	}
 
-	found := false
 	for i := range *props {
-		if (*props)[i].Name == "source_id" {
-			// Already has a source_id, just update it
-			(*props)[i].StringValue = &sourceID
-			found = true
-			break
+		if (*props)[i].Name == prop.Name {
+			(*props)[i] = prop
+			return
 		}
 	}
-	if !found {
-		*props = append(*props, mrmodels.NewStringProperty("source_id", sourceID, false))
-	}
-
-	// Prepend sourceId to the model name so DB uniqueness is (sourceId, modelName).
-	// Format: sourceId:modelName — must run for every model so DB and removeOrphanedModelsFromSource stay consistent.
-	attr := model.GetAttributes()
-	if attr != nil && attr.Name != nil && *attr.Name != "" {
-		namespacedName := sourceID + ":" + *attr.Name
-		attr.Name = &namespacedName
-	}
+	*props = append(*props, prop)
 }
 
 func (l *ModelLoader) removeModelsFromMissingSources(allKnownSourceIDs mapset.Set[string]) error {
 	enabledSourceIDs := mapset.NewSet[string]()
 	modelSourceIDs := mapset.NewSet[string]()
+	// The models of db sources only live in the database, keep them even
+	// if their source is disabled or gone.
+	dbSourceIDs := mapset.NewSet[string]()
 	for id, source := range l.Sources.AllSources() {
 		modelSourceIDs.Add(id)
 		if source.Enabled == nil || *source.Enabled {
 			enabledSourceIDs.Add(id)
 		}
+		if source.Type == DBSourceType {
+			dbSourceIDs.Add(id)
+		}
 	}
 
 	existingSourceIDs, err := l.services.CatalogModelRepository.GetDistinctSourceIDs()
@@ -547,7 +732,13 @@ func (l *ModelLoader) removeModelsFromMissingSources(allKnownSourceIDs mapset.Se
 		return fmt.Errorf("unable to retrieve existing source IDs: %w", err)
 	}
 
-	for oldSource := range mapset.NewSet(existingSourceIDs...).Difference(enabledSourceIDs).Iter() {
+	writtenSourceIDs, err := l.services.CatalogModelRepository.GetDistinctSourceIDsWithProperty(sourceTypeProperty, DBSourceType)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve db source IDs: %w", err)
+	}
+	dbSourceIDs.Append(writtenSourceIDs...)
+
+	for oldSource := range mapset.NewSet(existingSourceIDs...).Difference(enabledSourceIDs).Difference(dbSourceIDs).Iter() {
 		glog.Infof("Removing models from source %s", oldSource)
 
 		err = l.services.CatalogModelRepository.DeleteBySource(oldSource)
@@ -562,6 +753,14 @@ func (l *ModelLoader) removeModelsFromMissingSources(allKnownSourceIDs mapset.Se
 			if delErr := l.services.CatalogSourceRepository.Delete(oldSource); delErr != nil {
 				glog.Errorf("failed to delete status for source %s: %v", oldSource, delErr)
 			}
+
+			// The sync history is shared with the other loaders, so only
+			// drop it when no loader knows about the source anymore.
+			if syncRepo := l.services.CatalogSourceSyncRepository; syncRepo != nil && !allKnownSourceIDs.Contains(oldSource) {
+				if delErr := syncRepo.DeleteBySource(oldSource); delErr != nil {
+					glog.Errorf("failed to delete sync history for source %s: %v", oldSource, delErr)
+				}
+			}
 		}
 	}
 
@@ -576,15 +775,15 @@ func (l *ModelLoader) removeModelsFromMissingSources(allKnownSourceIDs mapset.Se
 	return nil
 }
 
-func (l *ModelLoader) removeOrphanedModelsFromSource(sourceID string, valid mapset.Set[string]) (int, error) {
+func (l *ModelLoader) removeOrphanedModelsFromSource(sourceID string, valid mapset.Set[string]) ([]string, error) {
 	list, err := l.services.CatalogModelRepository.List(models.CatalogModelListOptions{
 		SourceIDs: &[]string{sourceID},
 	})
 	if err != nil {
-		return 0, fmt.Errorf("unable to list models from source %q: %w", sourceID, err)
+		return nil, fmt.Errorf("unable to list models from source %q: %w", sourceID, err)
 	}
 
-	count := 0
+	removed := []string{}
 	for _, model := range list.Items {
 		attr := model.GetAttributes()
 		if attr == nil || attr.Name == nil || model.GetID() == nil {
@@ -599,10 +798,47 @@ func (l *ModelLoader) removeOrphanedModelsFromSource(sourceID string, valid maps
 
 		err = l.services.CatalogModelRepository.DeleteByID(*model.GetID())
 		if err != nil {
-			return count, fmt.Errorf("unable to remove model %d (%s from source %s): %w", *model.GetID(), *attr.Name, sourceID, err)
+			return removed, fmt.Errorf("unable to remove model %d (%s from source %s): %w", *model.GetID(), *attr.Name, sourceID, err)
+		}
+		removed = append(removed, *attr.Name)
+	}
+
+	return removed, nil
+}
+
+// newSyncRecorder starts recording a sync of a source, using the models
+// currently stored for it as the baseline.
+func (l *ModelLoader) newSyncRecorder(sourceID string) *basecatalog.SourceSyncRecorder {
+	repo := l.services.CatalogSourceSyncRepository
+	if repo == nil {
+		return nil
+	}
+
+	existing := map[string]string{}
+	list, err := l.services.CatalogModelRepository.List(models.CatalogModelListOptions{
+		SourceIDs: &[]string{sourceID},
+	})
+	if err != nil {
+		glog.Errorf("%s: unable to list models for sync history: %v", sourceID, err)
+	} else {
+		for _, model := range list.Items {
+			if attr := model.GetAttributes(); attr != nil && attr.Name != nil {
+				existing[strings.TrimPrefix(*attr.Name, sourceID+":")] = modelDigest(model)
+			}
 		}
-		count++
 	}
 
-	return count, nil
+	return basecatalog.NewSourceSyncRecorder(repo, sourceID, sharedmodels.SourceSyncAssetTypeModels, existing)
+}
+
+// modelDigest returns the digest used to tell whether a model changed
+// between loads. last_synced is set on every load and the performance
+// metrics loader adds size, tensor_type and variant_group_id after the
+// model is saved, so they're ignored. The license policy outcome depends on
+// the policy rather than the model, so it's ignored as well, and so is the
+// time of the last scan.
+func modelDigest(model models.CatalogModel) string {
+	return basecatalog.EntityDigest(model.GetProperties(), model.GetCustomProperties(),
+		"last_synced", "size", "tensor_type", "variant_group_id",
+		licensePolicyProperty, licenseClassificationsProperty, scannedAtProperty)
 }
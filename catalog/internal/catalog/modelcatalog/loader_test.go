@@ -14,6 +14,7 @@ import (
 	"github.com/kubeflow/hub/catalog/internal/db/service"
 	apimodels "github.com/kubeflow/hub/catalog/pkg/openapi"
 	"github.com/kubeflow/hub/internal/platform/apiutils"
+	"github.com/kubeflow/hub/pkg/api"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/util/yaml"
@@ -30,6 +31,7 @@ func TestRemoveModelsFromMissingSources(t *testing.T) {
 		name                   string
 		enabledSources         map[string]*bool // source ID -> enabled status (nil means default true)
 		existingSourceIDs      []string         // source IDs currently in database
+		dbSourceIDs            []string         // source IDs of models written to db sources
 		expectedDeletedSources []string         // source IDs that should be deleted
 		repositoryError        string           // if set, repository returns this error
 		expectError            bool
@@ -76,6 +78,16 @@ func TestRemoveModelsFromMissingSources(t *testing.T) {
 			existingSourceIDs:      []string{"source1", "source2", "source3"},
 			expectedDeletedSources: []string{"source3"}, // only source3 (not in config) gets deleted
 		},
+		{
+			name: "keeps models of db sources",
+			enabledSources: map[string]*bool{
+				"source1": apiutils.Of(true),
+				"picks":   apiutils.Of(false),
+			},
+			existingSourceIDs:      []string{"source1", "picks", "old-picks"},
+			dbSourceIDs:            []string{"picks", "old-picks"}, // picks is disabled, old-picks not in config
+			expectedDeletedSources: []string{},
+		},
 		{
 			name: "handles repository error on GetDistinctSourceIDs",
 			enabledSources: map[string]*bool{
@@ -102,6 +114,7 @@ func TestRemoveModelsFromMissingSources(t *testing.T) {
 			// Create mock repository with test data
 			mockModelRepo := &MockCatalogModelRepositoryWithSourceTracking{
 				ExistingSourceIDs: tt.existingSourceIDs,
+				DBSourceIDs:       tt.dbSourceIDs,
 				DeletedSources:    []string{},
 				ErrorType:         tt.repositoryError,
 			}
@@ -115,6 +128,9 @@ func TestRemoveModelsFromMissingSources(t *testing.T) {
 				&MockPropertyOptionsRepository{},
 				nil, // MCPServerRepository
 				nil, // MCPServerToolRepository
+				nil, // CatalogSourceSyncRepository
+				nil, // CatalogSourceRefreshRepository
+				nil, // CatalogNamedQueryRepository
 			)
 
 			// Create loader and populate sources
@@ -173,6 +189,7 @@ func TestRemoveModelsFromMissingSources(t *testing.T) {
 type MockCatalogModelRepositoryWithSourceTracking struct {
 	MockCatalogModelRepository
 	ExistingSourceIDs []string
+	DBSourceIDs       []string
 	DeletedSources    []string
 	ErrorType         string // "get_distinct_source_ids_error" or "delete_by_source_error"
 }
@@ -184,6 +201,10 @@ func (m *MockCatalogModelRepositoryWithSourceTracking) GetDistinctSourceIDs() ([
 	return m.ExistingSourceIDs, nil
 }
 
+func (m *MockCatalogModelRepositoryWithSourceTracking) GetDistinctSourceIDsWithProperty(name, value string) ([]string, error) {
+	return m.DBSourceIDs, nil
+}
+
 func (m *MockCatalogModelRepositoryWithSourceTracking) DeleteBySource(sourceID string) error {
 	if m.ErrorType == "delete_by_source_error" {
 		return NewMockError("failed to delete models from source: " + sourceID)
@@ -311,6 +332,9 @@ func TestLoader_StartWithLeaderElection(t *testing.T) {
 		&MockPropertyOptionsRepository{},
 		nil, // MCPServerRepository
 		nil, // MCPServerToolRepository
+		nil, // CatalogSourceSyncRepository
+		nil, // CatalogSourceRefreshRepository
+		nil, // CatalogNamedQueryRepository
 	)
 
 	// Register a test provider
@@ -526,6 +550,9 @@ func TestSourceStatusPartialVsFull(t *testing.T) {
 				&MockPropertyOptionsRepository{},
 				nil,
 				nil,
+				nil, // CatalogSourceSyncRepository
+				nil, // CatalogSourceRefreshRepository
+				nil, // CatalogNamedQueryRepository
 			)
 
 			baseLoader := basecatalog.NewBaseLoader([]string{})
@@ -565,3 +592,95 @@ func TestSourceStatusPartialVsFull(t *testing.T) {
 		})
 	}
 }
+
+// TestModelLoaderRefreshSource verifies that refreshing a source saves the
+// first batch its provider sends, then stops the provider.
+func TestModelLoaderRefreshSource(t *testing.T) {
+	const sourceID = "refresh-test-source"
+
+	stopped := make(chan struct{})
+	providerName := "refresh-test-provider"
+	require.NoError(t, RegisterModelProvider(providerName, func(ctx context.Context, source *basecatalog.ModelSource, reldir string) (<-chan ModelProviderRecord, error) {
+		ch := make(chan ModelProviderRecord)
+		go func() {
+			defer close(ch)
+			defer close(stopped)
+
+			modelName := "refreshed-model"
+			ch <- ModelProviderRecord{
+				Model: &models.CatalogModelImpl{
+					Attributes: &models.CatalogModelAttributes{Name: &modelName},
+				},
+				Artifacts: []sharedmodels.CatalogArtifact{},
+			}
+			ch <- ModelProviderRecord{}
+
+			// Like the real providers, keep watching the source.
+			<-ctx.Done()
+		}()
+		return ch, nil
+	}))
+
+	mockModelRepo := &MockCatalogModelRepository{}
+	mockSourceRepo := &MockCatalogSourceRepository{}
+	services := service.NewServices(
+		mockModelRepo,
+		&MockCatalogArtifactRepository{},
+		&MockCatalogModelArtifactRepository{},
+		&MockCatalogMetricsArtifactRepository{},
+		mockSourceRepo,
+		&MockPropertyOptionsRepository{},
+		nil, // MCPServerRepository
+		nil, // MCPServerToolRepository
+		nil, // CatalogSourceSyncRepository
+		nil, // CatalogSourceRefreshRepository
+		nil, // CatalogNamedQueryRepository
+	)
+
+	baseLoader := basecatalog.NewBaseLoader([]string{})
+	loader := NewModelLoader(services, baseLoader)
+	require.NoError(t, loader.updateSources("test-path", &basecatalog.SourceConfig{
+		ModelCatalogs: []basecatalog.ModelSource{
+			{
+				CatalogSource: apimodels.CatalogSource{Id: sourceID, Name: "Refresh", Enabled: apiutils.Of(true)},
+				Type:          providerName,
+			},
+			{
+				CatalogSource: apimodels.CatalogSource{Id: "disabled", Name: "Disabled", Enabled: apiutils.Of(false)},
+				Type:          providerName,
+			},
+		},
+	}))
+
+	ctx := context.Background()
+
+	_, _, err := loader.RefreshSource(ctx, sourceID)
+	assert.Error(t, err, "standby pods can't refresh sources")
+
+	baseLoader.SetLeader(true)
+
+	status, errMsg, err := loader.RefreshSource(ctx, sourceID)
+	require.NoError(t, err)
+	assert.Equal(t, basecatalog.SourceStatusAvailable, status)
+	assert.Empty(t, errMsg)
+
+	saved := mockModelRepo.GetSavedModels()
+	require.Len(t, saved, 1, "the model is saved before the refresh returns")
+	assert.Equal(t, sourceID+":refreshed-model", *saved[0].GetAttributes().Name)
+
+	statuses, err := mockSourceRepo.GetAllStatuses()
+	require.NoError(t, err)
+	assert.Equal(t, basecatalog.SourceStatusAvailable, statuses[sourceID].Status)
+
+	select {
+	case <-stopped:
+	case <-time.After(3 * time.Second):
+		t.Fatal("provider wasn't stopped after the refresh")
+	}
+
+	_, _, err = loader.RefreshSource(ctx, "disabled")
+	assert.ErrorIs(t, err, api.ErrBadRequest)
+
+	_, _, err = loader.RefreshSource(ctx, "missing")
+	assert.ErrorIs(t, err, api.ErrNotFound)
+}
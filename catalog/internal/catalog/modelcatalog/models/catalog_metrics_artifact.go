@@ -47,4 +47,7 @@ type CatalogMetricsArtifactRepository interface {
 	Save(metricsArtifact CatalogMetricsArtifact, parentResourceID *int32) (CatalogMetricsArtifact, error)
 	// BatchSave inserts multiple metrics artifacts in a single batch operation
 	BatchSave(metricsArtifacts []CatalogMetricsArtifact, parentResourceID *int32) ([]CatalogMetricsArtifact, error)
+	// ListByParentResourceIDs returns the metrics artifacts of a type that
+	// belong to any of the parent resources, keyed by parent resource ID.
+	ListByParentResourceIDs(metricsType MetricsType, parentResourceIDs []int32) (map[int32][]CatalogMetricsArtifact, error)
 }
@@ -1,17 +1,43 @@
 package models
 
 import (
+	"github.com/kubeflow/hub/catalog/internal/db/facets"
 	"github.com/kubeflow/hub/catalog/internal/db/models"
-	"github.com/kubeflow/hub/internal/platform/db/filter"
 	dbmodels "github.com/kubeflow/hub/internal/platform/db/entity"
+	"github.com/kubeflow/hub/internal/platform/db/filter"
 )
 
+// OrderByRelevance sorts models by how relevant they are to the Query of
+// the list options.
+const OrderByRelevance = "RELEVANCE"
+
+// VariantGroupProperty is the custom property, set from the performance
+// metadata, that groups the variants of a base model.
+const VariantGroupProperty = "variant_group_id"
+
 type CatalogModelListOptions struct {
 	dbmodels.Pagination
 	Name       *string
 	ExternalID *string
 	SourceIDs  *[]string
 	Query      *string
+
+	// RecommendedLatency, when set, sorts the models by their precomputed
+	// recommended latency for a profile, lowest first. Models without a
+	// latency for the profile are sorted last.
+	RecommendedLatency *RecommendedLatencyProfile
+
+	// GroupByVariant lists each variant group once. Of the models that
+	// match the other options and share a variant_group_id, only the one
+	// with the lowest ID is listed.
+	GroupByVariant bool
+
+	// VariantGroupIDs limits the models to these variant groups.
+	VariantGroupIDs []string
+
+	// ExcludeDeprecated leaves out models whose deprecated property is
+	// true.
+	ExcludeDeprecated bool
 }
 
 // GetRestEntityType implements the FilterApplier interface
@@ -26,6 +52,29 @@ type CatalogModelAttributes struct {
 	LastUpdateTimeSinceEpoch *int64
 }
 
+// RecommendedLatency is the lowest latency of a model's recommended
+// performance configurations for one profile.
+type RecommendedLatency struct {
+	LatencyProperty string
+	TargetRPS       int32
+	// UseCase is empty for the latency across all use cases.
+	UseCase      string
+	HardwareType string
+	Latency      float64
+}
+
+// RecommendedLatencyProfile selects the precomputed recommended latencies
+// that models are sorted by.
+type RecommendedLatencyProfile struct {
+	LatencyProperty string
+	TargetRPS       int32
+	// UseCase is empty for the latency across all use cases.
+	UseCase string
+	// HardwareTypes limits the latencies to these hardware types. All
+	// hardware types are used when it's empty.
+	HardwareTypes []string
+}
+
 type CatalogModel interface {
 	dbmodels.Entity[CatalogModelAttributes]
 }
@@ -37,8 +86,21 @@ type CatalogModelRepository interface {
 	GetByName(name string) (CatalogModel, error)
 	List(listOptions CatalogModelListOptions) (*dbmodels.ListWrapper[CatalogModel], error)
 	Save(model CatalogModel) (CatalogModel, error)
+	// Create saves a new model. Unlike Save, it doesn't update the model
+	// with the same name, the save fails with a unique key violation.
+	Create(model CatalogModel) (CatalogModel, error)
 	DeleteBySource(sourceID string) error
 	DeleteByID(id int32) error
 	GetDistinctSourceIDs() ([]string, error)
+	// GetDistinctSourceIDsWithProperty returns the source IDs of the models
+	// with a string property set to value.
+	GetDistinctSourceIDsWithProperty(name, value string) ([]string, error)
 	GetTypeID() int32
+	// SaveRecommendedLatencies replaces the precomputed recommended
+	// latencies of a model.
+	SaveRecommendedLatencies(modelID int32, latencies []RecommendedLatency) error
+	// CountPropertyValues counts the models that match the filters of
+	// listOptions with each value of the string properties in options.
+	// Ordering, pagination and grouping are ignored.
+	CountPropertyValues(listOptions CatalogModelListOptions, options []models.PropertyOption) (facets.Counts, error)
 }
@@ -0,0 +1,457 @@
+package modelcatalog
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/hub/catalog/internal/catalog/basecatalog"
+	mrmodels "github.com/kubeflow/hub/internal/platform/db/entity"
+)
+
+const (
+	ociScannerName = "oci"
+
+	signatureStatusProperty = "signature_status"
+	artifactDigestProperty  = "digest"
+)
+
+// Signature statuses recorded by the OCI scanner.
+const (
+	// signatureStatusVerified means a signature was verified with the
+	// configured public key.
+	signatureStatusVerified = "verified"
+	// signatureStatusSigned means the artifact has a signature, but no
+	// public key is configured to verify it.
+	signatureStatusSigned = "signed"
+	// signatureStatusUnknown means the registry couldn't be checked.
+	signatureStatusUnknown  = "unknown"
+	signatureStatusUnsigned = "unsigned"
+	// signatureStatusInvalid means none of the signatures could be
+	// verified with the configured public key.
+	signatureStatusInvalid = "invalid"
+)
+
+// signatureStatusRank orders signature statuses from the most to the least
+// trustworthy. A model gets the status of its least trustworthy artifact.
+var signatureStatusRank = map[string]int{
+	signatureStatusVerified: 0,
+	signatureStatusSigned:   1,
+	signatureStatusUnknown:  2,
+	signatureStatusUnsigned: 3,
+	signatureStatusInvalid:  4,
+}
+
+const (
+	ociManifestMediaTypes = "application/vnd.oci.image.manifest.v1+json, " +
+		"application/vnd.oci.image.index.v1+json, " +
+		"application/vnd.docker.distribution.manifest.v2+json, " +
+		"application/vnd.docker.distribution.manifest.list.v2+json"
+
+	// cosignSignatureAnnotation holds the signature of a cosign signature
+	// layer, whose blob is the signed payload.
+	cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+
+	// maxOCIResponseSize limits manifests, tokens and signature payloads.
+	maxOCIResponseSize = 4 << 20
+)
+
+// ociScanner records the manifest digest of every oci:// model artifact
+// and checks it for cosign signatures, stored in the registry under the
+// sha256-<digest>.sig tag. Signatures are verified when a public key is
+// configured; keyless signatures aren't supported.
+type ociScanner struct {
+	client    *http.Client
+	publicKey crypto.PublicKey
+}
+
+func newOCIScanner(config *basecatalog.ScanConfig) (ModelScanner, error) {
+	s := &ociScanner{
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+	if config != nil && config.CosignPublicKey != "" {
+		key, err := readPublicKey(config.CosignPublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cosign public key %s: %w", config.CosignPublicKey, err)
+		}
+		s.publicKey = key
+	}
+	return s, nil
+}
+
+func readPublicKey(path string) (crypto.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
+
+func (s *ociScanner) Scan(ctx context.Context, record ModelProviderRecord) (*ScanResult, error) {
+	var result *ScanResult
+	modelStatus := ""
+
+	for _, artifact := range record.Artifacts {
+		uri := modelArtifactURI(artifact.CatalogModelArtifact)
+		ref, ok := parseOCIReference(uri)
+		if !ok {
+			continue
+		}
+
+		digest, status := s.scanArtifact(ctx, ref)
+		if result == nil {
+			result = &ScanResult{ArtifactProperties: map[string][]mrmodels.Properties{}}
+		}
+		props := []mrmodels.Properties{
+			mrmodels.NewStringProperty(signatureStatusProperty, status, true),
+		}
+		if digest != "" {
+			props = append(props, mrmodels.NewStringProperty(artifactDigestProperty, digest, true))
+		}
+		result.ArtifactProperties[uri] = props
+
+		if modelStatus == "" || signatureStatusRank[status] > signatureStatusRank[modelStatus] {
+			modelStatus = status
+		}
+	}
+
+	if result != nil {
+		result.Properties = []mrmodels.Properties{
+			mrmodels.NewStringProperty(signatureStatusProperty, modelStatus, false),
+		}
+	}
+	return result, nil
+}
+
+// scanArtifact returns the manifest digest of an OCI artifact and its
+// signature status.
+func (s *ociScanner) scanArtifact(ctx context.Context, ref ociReference) (string, string) {
+	registry := &ociRegistryClient{client: s.client, ref: ref}
+
+	digest, err := registry.manifestDigest(ctx, ref.reference)
+	if err != nil {
+		glog.Warningf("unable to get the digest of %s: %v", ref, err)
+		return "", signatureStatusUnknown
+	}
+
+	status, err := s.signatureStatus(ctx, registry, digest)
+	if err != nil {
+		glog.Warningf("unable to check the signatures of %s: %v", ref, err)
+		return digest, signatureStatusUnknown
+	}
+	return digest, status
+}
+
+func (s *ociScanner) signatureStatus(ctx context.Context, registry *ociRegistryClient, digest string) (string, error) {
+	algorithm, hash, ok := strings.Cut(digest, ":")
+	if !ok {
+		return "", fmt.Errorf("invalid digest %q", digest)
+	}
+
+	body, err := registry.get(ctx, "manifests/"+algorithm+"-"+hash+".sig", ociManifestMediaTypes)
+	if errors.Is(err, errOCINotFound) {
+		return signatureStatusUnsigned, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	var manifest struct {
+		Layers []struct {
+			Digest      string            `json:"digest"`
+			Annotations map[string]string `json:"annotations"`
+		} `json:"layers"`
+	}
+	if err := json.Unmarshal(body, &manifest); err != nil {
+		return "", fmt.Errorf("invalid signature manifest: %w", err)
+	}
+
+	signed := false
+	for _, layer := range manifest.Layers {
+		signature, ok := layer.Annotations[cosignSignatureAnnotation]
+		if !ok {
+			continue
+		}
+		signed = true
+		if s.publicKey == nil {
+			break
+		}
+
+		payload, err := registry.get(ctx, "blobs/"+layer.Digest, "")
+		if err != nil {
+			return "", err
+		}
+		if verifyCosignSignature(s.publicKey, digest, layer.Digest, payload, signature) {
+			return signatureStatusVerified, nil
+		}
+	}
+
+	switch {
+	case !signed:
+		return signatureStatusUnsigned, nil
+	case s.publicKey == nil:
+		return signatureStatusSigned, nil
+	default:
+		return signatureStatusInvalid, nil
+	}
+}
+
+// verifyCosignSignature reports whether signature is a valid signature of
+// payload, and whether payload is a cosign payload for the manifest digest.
+func verifyCosignSignature(key crypto.PublicKey, digest string, payloadDigest string, payload []byte, signature string) bool {
+	sum := sha256.Sum256(payload)
+	if payloadDigest != "sha256:"+hex.EncodeToString(sum[:]) {
+		return false
+	}
+
+	var simpleSigning struct {
+		Critical struct {
+			Image struct {
+				DockerManifestDigest string `json:"docker-manifest-digest"`
+			} `json:"image"`
+		} `json:"critical"`
+	}
+	if err := json.Unmarshal(payload, &simpleSigning); err != nil || simpleSigning.Critical.Image.DockerManifestDigest != digest {
+		return false
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(k, sum[:], sig)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, sum[:], sig) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(k, payload, sig)
+	}
+	return false
+}
+
+// ociReference is a parsed oci://<registry>/<repository>[:<tag>|@<digest>]
+// URI.
+type ociReference struct {
+	registry   string
+	repository string
+	// reference is the tag or digest.
+	reference string
+}
+
+func (r ociReference) String() string {
+	if strings.Contains(r.reference, ":") {
+		return r.registry + "/" + r.repository + "@" + r.reference
+	}
+	return r.registry + "/" + r.repository + ":" + r.reference
+}
+
+func parseOCIReference(uri string) (ociReference, bool) {
+	rest, ok := strings.CutPrefix(uri, "oci://")
+	if !ok {
+		return ociReference{}, false
+	}
+	registry, repository, ok := strings.Cut(rest, "/")
+	if !ok || registry == "" || repository == "" {
+		return ociReference{}, false
+	}
+
+	ref := ociReference{registry: registry, reference: "latest"}
+	if name, digest, ok := strings.Cut(repository, "@"); ok {
+		repository, ref.reference = name, digest
+	} else if i := strings.LastIndex(repository, ":"); i > strings.LastIndex(repository, "/") {
+		repository, ref.reference = repository[:i], repository[i+1:]
+	}
+	if repository == "" || ref.reference == "" {
+		return ociReference{}, false
+	}
+	ref.repository = repository
+	return ref, true
+}
+
+var errOCINotFound = errors.New("not found")
+
+// ociRegistryClient reads from a repository with the OCI distribution API.
+// Registries that require a token get an anonymous one.
+type ociRegistryClient struct {
+	client *http.Client
+	ref    ociReference
+	token  string
+}
+
+// manifestDigest returns the digest of the manifest a tag or digest refers
+// to.
+func (c *ociRegistryClient) manifestDigest(ctx context.Context, reference string) (string, error) {
+	resp, err := c.do(ctx, http.MethodGet, "manifests/"+reference, ociManifestMediaTypes)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if digest := resp.Header.Get("Docker-Content-Digest"); digest != "" {
+		return digest, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxOCIResponseSize))
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(body)
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
+// get returns the body of a manifest or blob.
+func (c *ociRegistryClient) get(ctx context.Context, path string, accept string) ([]byte, error) {
+	resp, err := c.do(ctx, http.MethodGet, path, accept)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return io.ReadAll(io.LimitReader(resp.Body, maxOCIResponseSize))
+}
+
+func (c *ociRegistryClient) do(ctx context.Context, method string, path string, accept string) (*http.Response, error) {
+	u := fmt.Sprintf("https://%s/v2/%s/%s", c.ref.registry, c.ref.repository, path)
+
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, method, u, nil)
+		if err != nil {
+			return nil, err
+		}
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		if c.token != "" {
+			req.Header.Set("Authorization", "Bearer "+c.token)
+		}
+
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case resp.StatusCode == http.StatusOK:
+			return resp, nil
+		case resp.StatusCode == http.StatusUnauthorized && attempt == 0:
+			challenge := resp.Header.Get("WWW-Authenticate")
+			resp.Body.Close()
+			if err := c.authenticate(ctx, challenge); err != nil {
+				return nil, err
+			}
+			continue
+		case resp.StatusCode == http.StatusNotFound:
+			resp.Body.Close()
+			return nil, fmt.Errorf("%s: %w", path, errOCINotFound)
+		default:
+			resp.Body.Close()
+			return nil, fmt.Errorf("%s: unexpected status %s", path, resp.Status)
+		}
+	}
+}
+
+// authenticate gets an anonymous pull token for the repository, as
+// described by a Bearer WWW-Authenticate challenge.
+func (c *ociRegistryClient) authenticate(ctx context.Context, challenge string) error {
+	scheme, params, _ := strings.Cut(challenge, " ")
+	if !strings.EqualFold(scheme, "Bearer") {
+		return fmt.Errorf("unsupported registry authentication %q", scheme)
+	}
+
+	values := parseAuthParams(params)
+	realm, err := url.Parse(values["realm"])
+	if err != nil || realm.Host == "" {
+		return fmt.Errorf("invalid registry authentication realm %q", values["realm"])
+	}
+	query := realm.Query()
+	if service := values["service"]; service != "" {
+		query.Set("service", service)
+	}
+	scope := values["scope"]
+	if scope == "" {
+		scope = "repository:" + c.ref.repository + ":pull"
+	}
+	query.Set("scope", scope)
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("registry token request failed: %s", resp.Status)
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxOCIResponseSize))
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(body, &token); err != nil {
+		return fmt.Errorf("invalid registry token response: %w", err)
+	}
+
+	c.token = token.Token
+	if c.token == "" {
+		c.token = token.AccessToken
+	}
+	if c.token == "" {
+		return errors.New("registry token response has no token")
+	}
+	return nil
+}
+
+// parseAuthParams parses the comma separated key="value" parameters of a
+// WWW-Authenticate challenge.
+func parseAuthParams(params string) map[string]string {
+	values := map[string]string{}
+	for params != "" {
+		var key, value string
+		key, params, _ = strings.Cut(strings.TrimLeft(params, " ,"), "=")
+		if strings.HasPrefix(params, `"`) {
+			value, params, _ = strings.Cut(params[1:], `"`)
+		} else {
+			value, params, _ = strings.Cut(params, ",")
+		}
+		if key != "" {
+			values[strings.ToLower(strings.TrimSpace(key))] = value
+		}
+	}
+	return values
+}
+
+func init() {
+	if err := RegisterModelScanner(ociScannerName, newOCIScanner); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,267 @@
+package modelcatalog
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kubeflow/hub/catalog/internal/catalog/basecatalog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOCIReference(t *testing.T) {
+	tests := []struct {
+		uri      string
+		expected ociReference
+		ok       bool
+	}{
+		{
+			uri:      "oci://quay.io/org/model:1.0",
+			expected: ociReference{registry: "quay.io", repository: "org/model", reference: "1.0"},
+			ok:       true,
+		},
+		{
+			uri:      "oci://localhost:5000/model",
+			expected: ociReference{registry: "localhost:5000", repository: "model", reference: "latest"},
+			ok:       true,
+		},
+		{
+			uri:      "oci://quay.io/org/model@sha256:abc",
+			expected: ociReference{registry: "quay.io", repository: "org/model", reference: "sha256:abc"},
+			ok:       true,
+		},
+		{uri: "oci://quay.io"},
+		{uri: "hf://org/model"},
+		{uri: "s3://bucket/model/"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.uri, func(t *testing.T) {
+			ref, ok := parseOCIReference(tt.uri)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, ref)
+		})
+	}
+}
+
+func TestParseAuthParams(t *testing.T) {
+	params := parseAuthParams(`realm="https://auth.example.com/token",service="registry.example.com", scope="repository:org/model:pull"`)
+	assert.Equal(t, map[string]string{
+		"realm":   "https://auth.example.com/token",
+		"service": "registry.example.com",
+		"scope":   "repository:org/model:pull",
+	}, params)
+}
+
+// fakeRegistry serves a single repository, requiring an anonymous token.
+type fakeRegistry struct {
+	manifestDigest string
+	signature      []byte // cosign signature manifest, or nil if unsigned
+	blobs          map[string][]byte
+	failManifests  bool
+}
+
+func (f *fakeRegistry) handler(t *testing.T) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "repository:org/model:pull", r.URL.Query().Get("scope"))
+		_ = json.NewEncoder(w).Encode(map[string]string{"token": "anonymous"})
+	})
+	mux.HandleFunc("/v2/org/model/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer anonymous" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="https://%s/token",service="registry"`, r.Host))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		path := strings.TrimPrefix(r.URL.Path, "/v2/org/model/")
+		algorithm, hash, _ := strings.Cut(f.manifestDigest, ":")
+		switch {
+		case f.failManifests:
+			w.WriteHeader(http.StatusInternalServerError)
+		case path == "manifests/1.0":
+			w.Header().Set("Docker-Content-Digest", f.manifestDigest)
+			_, _ = w.Write([]byte(`{}`))
+		case path == "manifests/"+algorithm+"-"+hash+".sig" && f.signature != nil:
+			_, _ = w.Write(f.signature)
+		case strings.HasPrefix(path, "blobs/") && f.blobs[strings.TrimPrefix(path, "blobs/")] != nil:
+			_, _ = w.Write(f.blobs[strings.TrimPrefix(path, "blobs/")])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	return mux
+}
+
+// sign adds a cosign signature of the manifest digest made with key.
+func (f *fakeRegistry) sign(t *testing.T, key *ecdsa.PrivateKey) {
+	payload := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"org/model"},"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"},"optional":null}`, f.manifestDigest))
+	sum := sha256.Sum256(payload)
+	signature, err := ecdsa.SignASN1(rand.Reader, key, sum[:])
+	require.NoError(t, err)
+
+	payloadDigest := "sha256:" + hex.EncodeToString(sum[:])
+	f.blobs = map[string][]byte{payloadDigest: payload}
+	f.signature, err = json.Marshal(map[string]any{
+		"schemaVersion": 2,
+		"layers": []map[string]any{{
+			"mediaType": "application/vnd.dev.cosign.simplesigning.v1+json",
+			"digest":    payloadDigest,
+			"annotations": map[string]string{
+				cosignSignatureAnnotation: base64.StdEncoding.EncodeToString(signature),
+			},
+		}},
+	})
+	require.NoError(t, err)
+}
+
+func writePublicKey(t *testing.T, key *ecdsa.PrivateKey) string {
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "cosign.pub")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600))
+	return path
+}
+
+func TestOCIScanner(t *testing.T) {
+	signingKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	const digest = "sha256:4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945"
+
+	tests := []struct {
+		name           string
+		signed         bool
+		failManifests  bool
+		publicKey      *ecdsa.PrivateKey
+		expectedStatus string
+		expectDigest   bool
+	}{
+		{
+			name:           "unsigned",
+			expectedStatus: signatureStatusUnsigned,
+			expectDigest:   true,
+		},
+		{
+			name:           "signed without a public key",
+			signed:         true,
+			expectedStatus: signatureStatusSigned,
+			expectDigest:   true,
+		},
+		{
+			name:           "verified",
+			signed:         true,
+			publicKey:      signingKey,
+			expectedStatus: signatureStatusVerified,
+			expectDigest:   true,
+		},
+		{
+			name:           "signed with another key",
+			signed:         true,
+			publicKey:      otherKey,
+			expectedStatus: signatureStatusInvalid,
+			expectDigest:   true,
+		},
+		{
+			name:           "registry error",
+			failManifests:  true,
+			expectedStatus: signatureStatusUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := &fakeRegistry{manifestDigest: digest, failManifests: tt.failManifests}
+			if tt.signed {
+				registry.sign(t, signingKey)
+			}
+			server := httptest.NewTLSServer(registry.handler(t))
+			defer server.Close()
+
+			config := &basecatalog.ScanConfig{}
+			if tt.publicKey != nil {
+				config.CosignPublicKey = writePublicKey(t, tt.publicKey)
+			}
+			scanner, err := newOCIScanner(config)
+			require.NoError(t, err)
+			scanner.(*ociScanner).client = server.Client()
+
+			uri := "oci://" + server.Listener.Addr().String() + "/org/model:1.0"
+			record := newScanTestRecord("src:model", nil, uri, "hf://org/model")
+			result, err := scanner.Scan(context.Background(), record)
+			require.NoError(t, err)
+			require.NotNil(t, result)
+
+			status := findProperty(&result.Properties, signatureStatusProperty)
+			require.NotNil(t, status)
+			assert.Equal(t, tt.expectedStatus, *status.StringValue)
+
+			artifactProps := result.ArtifactProperties[uri]
+			assert.Equal(t, tt.expectedStatus, *findProperty(&artifactProps, signatureStatusProperty).StringValue)
+			if tt.expectDigest {
+				assert.Equal(t, digest, *findProperty(&artifactProps, artifactDigestProperty).StringValue)
+			} else {
+				assert.Nil(t, findProperty(&artifactProps, artifactDigestProperty))
+			}
+			assert.NotContains(t, result.ArtifactProperties, "hf://org/model")
+		})
+	}
+
+	t.Run("models without OCI artifacts", func(t *testing.T) {
+		scanner, err := newOCIScanner(nil)
+		require.NoError(t, err)
+
+		result, err := scanner.Scan(context.Background(), newScanTestRecord("src:model", nil, "hf://org/model"))
+		require.NoError(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("invalid public key", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "cosign.pub")
+		require.NoError(t, os.WriteFile(path, []byte("not a key"), 0o600))
+
+		_, err := newOCIScanner(&basecatalog.ScanConfig{CosignPublicKey: path})
+		assert.Error(t, err)
+	})
+}
+
+func TestOCIScannerLeastTrustworthyArtifact(t *testing.T) {
+	signingKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	registry := &fakeRegistry{manifestDigest: "sha256:4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945"}
+	registry.sign(t, signingKey)
+	server := httptest.NewTLSServer(registry.handler(t))
+	defer server.Close()
+
+	scanner := &ociScanner{client: server.Client()}
+	signed := "oci://" + server.Listener.Addr().String() + "/org/model:1.0"
+	missing := "oci://" + server.Listener.Addr().String() + "/org/missing:1.0"
+
+	result, err := scanner.Scan(context.Background(), newScanTestRecord("src:model", nil, signed, missing))
+	require.NoError(t, err)
+	require.NotNil(t, result)
+
+	assert.Equal(t, signatureStatusUnknown, *findProperty(&result.Properties, signatureStatusProperty).StringValue)
+	signedProps := result.ArtifactProperties[signed]
+	assert.Equal(t, signatureStatusSigned, *findProperty(&signedProps, signatureStatusProperty).StringValue)
+	missingProps := result.ArtifactProperties[missing]
+	assert.Equal(t, signatureStatusUnknown, *findProperty(&missingProps, signatureStatusProperty).StringValue)
+}
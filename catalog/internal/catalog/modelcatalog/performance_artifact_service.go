@@ -9,6 +9,7 @@ import (
 
 	"github.com/kubeflow/hub/catalog/internal/catalog/modelcatalog/models"
 	sharedmodels "github.com/kubeflow/hub/catalog/internal/db/models"
+	apimodels "github.com/kubeflow/hub/catalog/pkg/openapi"
 	dbmodels "github.com/kubeflow/hub/internal/platform/db/entity"
 	"github.com/kubeflow/hub/internal/platform/db/scopes"
 )
@@ -36,6 +37,46 @@ type ParetoFilteringParams struct {
 	HardwareTypeProperty  string
 }
 
+// ComparePerformanceParams sets the profile that models' performance is
+// compared for.
+type ComparePerformanceParams struct {
+	TargetRPS             int32
+	HardwareType          string // hardware type with data for the most models when empty
+	RPSProperty           string // configurable "requests_per_second"
+	LatencyProperty       string // configurable "ttft_p90"
+	HardwareCountProperty string // configurable "hardware_count"
+	HardwareTypeProperty  string // configurable "hardware_type"
+}
+
+// SizingParams sets the load and latency objectives that a deployment of a
+// model is sized for.
+type SizingParams struct {
+	TargetRPS             int32
+	MaxLatency            map[string]float64 // latency objectives by property name
+	HardwareCosts         map[string]float64 // hourly cost of one unit by hardware type
+	RPSProperty           string             // configurable "requests_per_second"
+	HardwareCountProperty string             // configurable "hardware_count"
+	HardwareTypeProperty  string             // configurable "hardware_type"
+	FilterQuery           string
+}
+
+// useCaseProperty is the performance artifact property with the use case
+// that the performance was measured for.
+const useCaseProperty = "use_case"
+
+// precomputedTargetRPS and precomputedLatencyProperties are the target RPS
+// values and latency properties that recommended latencies are precomputed
+// for when models are loaded. Sorting by recommended latency for any other
+// profile computes the latencies on every request.
+var (
+	precomputedTargetRPS         = []int32{1, 10, 50, 100, 500, 1000}
+	precomputedLatencyProperties = []string{
+		"ttft_mean", "ttft_p90", "ttft_p95", "ttft_p99",
+		"e2e_mean", "e2e_p90", "e2e_p95", "e2e_p99",
+		"itl_mean", "itl_p90", "itl_p95", "itl_p99",
+	}
+)
+
 type PerformanceArtifactService struct {
 	artifactRepo sharedmodels.CatalogArtifactRepository
 	modelRepo    models.CatalogModelRepository
@@ -491,14 +532,19 @@ func (s *PerformanceArtifactService) GetMinimumRecommendedLatency(
 	}
 
 	// Find minimum latency property across all Pareto-filtered artifacts
+	return s.minimumLatency(result.Items, params.LatencyProperty), nil
+}
+
+// minimumLatency returns the lowest value of the latency property in a list
+// of artifacts, or nil if none of them has it.
+func (s *PerformanceArtifactService) minimumLatency(artifacts []sharedmodels.CatalogMetricsArtifact, latencyProperty string) *float64 {
 	var minLatency *float64
-	artifacts := result.Items
 
 	for _, artifact := range artifacts {
 		customProps := artifact.GetCustomProperties()
 		if customProps != nil {
 			for _, prop := range *customProps {
-				if prop.Name == params.LatencyProperty && prop.DoubleValue != nil {
+				if prop.Name == latencyProperty && prop.DoubleValue != nil {
 					if minLatency == nil || *prop.DoubleValue < *minLatency {
 						minLatency = prop.DoubleValue
 					}
@@ -507,8 +553,428 @@ func (s *PerformanceArtifactService) GetMinimumRecommendedLatency(
 		}
 	}
 
-	if minLatency != nil {
-		return minLatency, nil
+	return minLatency
+}
+
+// UpdateRecommendedLatencies precomputes the recommended latencies of a model
+// from its stored performance artifacts and saves them, replacing the ones
+// saved before.
+func (s *PerformanceArtifactService) UpdateRecommendedLatencies(modelID int32) error {
+	latencies, err := s.RecommendedLatencies(modelID)
+	if err != nil {
+		return err
+	}
+	return s.modelRepo.SaveRecommendedLatencies(modelID, latencies)
+}
+
+// RecommendedLatencies computes the lowest latency of a model's recommended
+// performance configurations for every precomputed target RPS and latency
+// property, per hardware type and use case. This is the same latency
+// GetMinimumRecommendedLatency returns for a filter on those properties. The
+// default names are used for the other performance properties.
+func (s *PerformanceArtifactService) RecommendedLatencies(modelID int32) ([]models.RecommendedLatency, error) {
+	filterQuery := s.buildPerformanceFilterQuery("")
+	list, err := s.artifactRepo.List(sharedmodels.CatalogArtifactListOptions{
+		ParentResourceID:    &modelID,
+		ArtifactTypesFilter: []string{"metrics-artifact"},
+		Pagination: dbmodels.Pagination{
+			FilterQuery: &filterQuery,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list performance artifacts: %w", err)
+	}
+
+	artifacts := make([]sharedmodels.CatalogMetricsArtifact, 0, len(list.Items))
+	for _, item := range list.Items {
+		if item.CatalogMetricsArtifact != nil {
+			artifacts = append(artifacts, item.CatalogMetricsArtifact)
+		}
+	}
+
+	// Artifacts without a use case are only included in the latencies
+	// across all use cases, which are stored with an empty use case.
+	byUseCase := s.groupArtifactsByStringProperty(artifacts, useCaseProperty)
+	byUseCase[""] = artifacts
+
+	var latencies []models.RecommendedLatency
+	for useCase, useCaseArtifacts := range byUseCase {
+		for _, latencyProperty := range precomputedLatencyProperties {
+			// Like GetMinimumRecommendedLatency, there's no latency when a
+			// property is missing from every artifact.
+			err := s.validateCustomProperties(useCaseArtifacts, "requests_per_second", latencyProperty, "hardware_count", "hardware_type")
+			if err != nil {
+				continue
+			}
+
+			for _, targetRPS := range precomputedTargetRPS {
+				// The recommendations for each hardware type are
+				// independent, so one pass covers all of them.
+				recommended := s.processArtifacts(copyArtifacts(useCaseArtifacts), PerformanceArtifactParams{
+					TargetRPS:       targetRPS,
+					Recommendations: true,
+					LatencyProperty: latencyProperty,
+				})
+
+				for hardwareType, hardwareArtifacts := range s.groupArtifactsByStringProperty(recommended, "hardware_type") {
+					latency := s.minimumLatency(hardwareArtifacts, latencyProperty)
+					if latency == nil {
+						continue
+					}
+					latencies = append(latencies, models.RecommendedLatency{
+						LatencyProperty: latencyProperty,
+						TargetRPS:       targetRPS,
+						UseCase:         useCase,
+						HardwareType:    hardwareType,
+						Latency:         *latency,
+					})
+				}
+			}
+		}
+	}
+
+	return latencies, nil
+}
+
+// copyArtifacts returns copies of artifacts with their own custom
+// properties, so the properties processArtifacts adds for one target RPS
+// don't carry over to the next.
+func copyArtifacts(artifacts []sharedmodels.CatalogMetricsArtifact) []sharedmodels.CatalogMetricsArtifact {
+	copies := make([]sharedmodels.CatalogMetricsArtifact, len(artifacts))
+	for i, artifact := range artifacts {
+		var customProperties []dbmodels.Properties
+		if props := artifact.GetCustomProperties(); props != nil {
+			customProperties = slices.Clone(*props)
+		}
+		copies[i] = &models.CatalogMetricsArtifactImpl{
+			ID:               artifact.GetID(),
+			CustomProperties: &customProperties,
+		}
+	}
+	return copies
+}
+
+// ComparePerformance finds the lowest latency configuration of each model
+// that serves the target RPS on the same hardware type. Only configurations
+// kept by the recommendations are considered. It returns the profile that was
+// used and one configuration per model, in the same order as modelIDs. A
+// configuration is nil when the model has no performance data for the
+// hardware type.
+func (s *PerformanceArtifactService) ComparePerformance(modelIDs []int32, params ComparePerformanceParams) (apimodels.CatalogModelComparisonProfile, []*apimodels.CatalogModelComparisonPerformance, error) {
+	if params.TargetRPS <= 0 {
+		params.TargetRPS = 1
+	}
+	if params.RPSProperty == "" {
+		params.RPSProperty = "requests_per_second"
+	}
+	if params.LatencyProperty == "" {
+		params.LatencyProperty = "ttft_p90"
+	}
+	if params.HardwareCountProperty == "" {
+		params.HardwareCountProperty = "hardware_count"
+	}
+	if params.HardwareTypeProperty == "" {
+		params.HardwareTypeProperty = "hardware_type"
+	}
+
+	profile := apimodels.CatalogModelComparisonProfile{
+		TargetRPS:       params.TargetRPS,
+		LatencyProperty: params.LatencyProperty,
+	}
+
+	byModel := make([]map[string][]sharedmodels.CatalogMetricsArtifact, len(modelIDs))
+	modelsByHardware := map[string]int{}
+	for i, modelID := range modelIDs {
+		result, err := s.GetArtifacts(PerformanceArtifactParams{
+			ModelID:               modelID,
+			TargetRPS:             params.TargetRPS,
+			Recommendations:       true,
+			PageSize:              math.MaxInt32,
+			RPSProperty:           params.RPSProperty,
+			LatencyProperty:       params.LatencyProperty,
+			HardwareCountProperty: params.HardwareCountProperty,
+			HardwareTypeProperty:  params.HardwareTypeProperty,
+		})
+		if err != nil {
+			return profile, nil, fmt.Errorf("failed to get performance artifacts: %w", err)
+		}
+
+		byHardware := s.groupArtifactsByStringProperty(result.Items, params.HardwareTypeProperty)
+		// Configurations without a hardware type can't be lined up with
+		// the other models.
+		delete(byHardware, "")
+		for hardwareType := range byHardware {
+			modelsByHardware[hardwareType]++
+		}
+		byModel[i] = byHardware
+	}
+
+	hardwareType := params.HardwareType
+	if hardwareType == "" {
+		for candidate, count := range modelsByHardware {
+			best := modelsByHardware[hardwareType]
+			if count > best || (count == best && candidate < hardwareType) {
+				hardwareType = candidate
+			}
+		}
+	}
+	if hardwareType != "" {
+		profile.HardwareType = &hardwareType
+	}
+
+	performance := make([]*apimodels.CatalogModelComparisonPerformance, len(modelIDs))
+	for i, byHardware := range byModel {
+		artifacts := byHardware[hardwareType]
+		if len(artifacts) == 0 {
+			continue
+		}
+		s.sortArtifacts(artifacts, params.LatencyProperty, params.HardwareCountProperty)
+		performance[i] = s.comparisonPerformance(artifacts[0], hardwareType, params)
+	}
+
+	return profile, performance, nil
+}
+
+// comparisonPerformance describes a configuration that has been through
+// addTargetRPSCalculations.
+func (s *PerformanceArtifactService) comparisonPerformance(artifact sharedmodels.CatalogMetricsArtifact, hardwareType string, params ComparePerformanceParams) *apimodels.CatalogModelComparisonPerformance {
+	props := artifact.GetCustomProperties()
+
+	hardwareCount := s.extractCustomPropertiesIntValue(props, params.HardwareCountProperty, 1)
+	replicas := s.extractCustomPropertiesIntValue(props, "replicas", 1)
+	totalHardwareCount := int64(hardwareCount) * int64(replicas)
+	if totalHardwareCount > math.MaxInt32 {
+		totalHardwareCount = math.MaxInt32
+	}
+
+	performance := &apimodels.CatalogModelComparisonPerformance{
+		HardwareType:           hardwareType,
+		HardwareCount:          hardwareCount,
+		Replicas:               replicas,
+		TotalHardwareCount:     int32(totalHardwareCount),
+		RequestsPerSecond:      s.extractCustomPropertiesDoubleValue(props, params.RPSProperty, 0),
+		TotalRequestsPerSecond: s.extractCustomPropertiesDoubleValue(props, "total_requests_per_second", 0),
+		Metrics:                map[string]float64{},
+	}
+
+	if props != nil {
+		for _, prop := range *props {
+			if _, ok := performance.Metrics[prop.Name]; ok {
+				continue
+			}
+			switch {
+			case prop.DoubleValue != nil:
+				performance.Metrics[prop.Name] = *prop.DoubleValue
+			case prop.IntValue != nil:
+				performance.Metrics[prop.Name] = float64(*prop.IntValue)
+			}
+		}
+	}
+	if latency, ok := performance.Metrics[params.LatencyProperty]; ok {
+		performance.Latency = &latency
+	}
+
+	return performance
+}
+
+// sizingPoint is a single benchmark of a hardware configuration.
+type sizingPoint struct {
+	rps     float64
+	latency map[string]float64
+}
+
+// sizingKey identifies a hardware configuration that performance artifacts
+// were benchmarked on.
+type sizingKey struct {
+	hardwareType  string
+	hardwareCount int32
+	useCase       string
+}
+
+// SizeDeployment ranks the hardware configurations that a model's
+// performance artifacts were benchmarked on by the cost of serving the target
+// RPS within the latency objectives, cheapest first. The benchmarks of a
+// configuration at different loads are interpolated to find the most
+// requests per second a replica serves within the objectives, and therefore
+// the number of replicas needed. Configurations that can't meet the
+// objectives at their lowest benchmarked load are left out. Configurations
+// without a cost come after those with one, ordered by total hardware count.
+func (s *PerformanceArtifactService) SizeDeployment(modelID int32, params SizingParams) ([]apimodels.CatalogModelSizingConfiguration, error) {
+	if params.TargetRPS <= 0 {
+		params.TargetRPS = 1
+	}
+	if params.RPSProperty == "" {
+		params.RPSProperty = "requests_per_second"
+	}
+	if params.HardwareCountProperty == "" {
+		params.HardwareCountProperty = "hardware_count"
+	}
+	if params.HardwareTypeProperty == "" {
+		params.HardwareTypeProperty = "hardware_type"
+	}
+
+	filterQuery := s.buildPerformanceFilterQuery(params.FilterQuery)
+	list, err := s.artifactRepo.List(sharedmodels.CatalogArtifactListOptions{
+		ParentResourceID:    &modelID,
+		ArtifactTypesFilter: []string{"metrics-artifact"},
+		Pagination: dbmodels.Pagination{
+			FilterQuery: &filterQuery,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list performance artifacts: %w", err)
+	}
+
+	byConfiguration := map[sizingKey][]sizingPoint{}
+	for _, item := range list.Items {
+		if item.CatalogMetricsArtifact == nil {
+			continue
+		}
+		props := item.CatalogMetricsArtifact.GetCustomProperties()
+
+		key := sizingKey{
+			hardwareType:  s.extractCustomPropertiesStringValue(props, params.HardwareTypeProperty),
+			hardwareCount: s.extractCustomPropertiesIntValue(props, params.HardwareCountProperty, 1),
+			useCase:       s.extractCustomPropertiesStringValue(props, useCaseProperty),
+		}
+		point := sizingPoint{
+			rps:     s.extractCustomPropertiesDoubleValue(props, params.RPSProperty, 0),
+			latency: make(map[string]float64, len(params.MaxLatency)),
+		}
+		// Benchmarks without a hardware type or load, or without a value
+		// for every objective, can't be used to size a deployment.
+		if key.hardwareType == "" || key.hardwareCount <= 0 || point.rps <= 0 {
+			continue
+		}
+		for property := range params.MaxLatency {
+			latency := s.extractCustomPropertiesDoubleValue(props, property, -1)
+			if latency < 0 {
+				break
+			}
+			point.latency[property] = latency
+		}
+		if len(point.latency) != len(params.MaxLatency) {
+			continue
+		}
+
+		byConfiguration[key] = append(byConfiguration[key], point)
+	}
+
+	configurations := []apimodels.CatalogModelSizingConfiguration{}
+	for key, points := range byConfiguration {
+		slices.SortStableFunc(points, func(a, b sizingPoint) int {
+			return cmp.Compare(a.rps, b.rps)
+		})
+
+		maxRPS := maxRPSWithin(points, params.MaxLatency)
+		if maxRPS <= 0 {
+			continue
+		}
+
+		replicas := math.Ceil(float64(params.TargetRPS) / maxRPS)
+		if replicas > math.MaxInt32 {
+			replicas = math.MaxInt32
+		}
+		totalHardwareCount := int64(replicas) * int64(key.hardwareCount)
+		if totalHardwareCount > math.MaxInt32 {
+			totalHardwareCount = math.MaxInt32
+		}
+
+		// Each replica serves an equal share of the target RPS.
+		replicaRPS := float64(params.TargetRPS) / replicas
+		latency := make(map[string]float64, len(params.MaxLatency))
+		for property := range params.MaxLatency {
+			latency[property] = interpolateLatency(points, property, replicaRPS)
+		}
+
+		configuration := apimodels.CatalogModelSizingConfiguration{
+			HardwareType:       key.hardwareType,
+			HardwareCount:      key.hardwareCount,
+			Replicas:           int32(replicas),
+			TotalHardwareCount: int32(totalHardwareCount),
+			RequestsPerSecond:  maxRPS,
+			Latency:            latency,
+		}
+		if key.useCase != "" {
+			configuration.UseCase = &key.useCase
+		}
+		if cost, ok := params.HardwareCosts[key.hardwareType]; ok {
+			configuration.SetCost(cost * float64(totalHardwareCount))
+		}
+		configurations = append(configurations, configuration)
+	}
+
+	slices.SortFunc(configurations, func(a, b apimodels.CatalogModelSizingConfiguration) int {
+		if a.Cost != nil && b.Cost != nil {
+			if c := cmp.Compare(*a.Cost, *b.Cost); c != 0 {
+				return c
+			}
+		} else if a.Cost != nil {
+			return -1
+		} else if b.Cost != nil {
+			return 1
+		}
+		return cmp.Or(
+			cmp.Compare(a.TotalHardwareCount, b.TotalHardwareCount),
+			cmp.Compare(a.HardwareType, b.HardwareType),
+			cmp.Compare(a.HardwareCount, b.HardwareCount),
+			cmp.Compare(a.GetUseCase(), b.GetUseCase()),
+		)
+	})
+
+	return configurations, nil
+}
+
+// maxRPSWithin returns the most requests per second that a configuration
+// serves within the latency objectives, given its benchmarks sorted by RPS.
+// Latency is assumed to grow with load, so the limit is interpolated between
+// the last benchmark that meets every objective and the first one that
+// doesn't. It's never extrapolated beyond the benchmarked loads, and it's 0
+// when the lowest benchmarked load already misses an objective.
+func maxRPSWithin(points []sizingPoint, maxLatency map[string]float64) float64 {
+	var best float64
+	for i, point := range points {
+		limit, met := point.rps, true
+		for property, maxValue := range maxLatency {
+			latency := point.latency[property]
+			if latency <= maxValue {
+				continue
+			}
+			if i == 0 {
+				return 0
+			}
+			met = false
+
+			// The previous benchmark meets every objective, so its latency
+			// is below this one.
+			prev := points[i-1]
+			prevLatency := prev.latency[property]
+			limit = min(limit, prev.rps+(maxValue-prevLatency)*(point.rps-prev.rps)/(latency-prevLatency))
+		}
+		if !met {
+			return limit
+		}
+		best = point.rps
+	}
+	return best
+}
+
+// interpolateLatency estimates the latency of a configuration at a load from
+// its benchmarks sorted by RPS. Loads outside the benchmarked range get the
+// latency of the closest benchmark.
+func interpolateLatency(points []sizingPoint, property string, rps float64) float64 {
+	if rps <= points[0].rps {
+		return points[0].latency[property]
+	}
+	for i := 1; i < len(points); i++ {
+		prev, next := points[i-1], points[i]
+		if rps > next.rps {
+			continue
+		}
+		if next.rps == prev.rps {
+			return next.latency[property]
+		}
+		return prev.latency[property] + (rps-prev.rps)*(next.latency[property]-prev.latency[property])/(next.rps-prev.rps)
 	}
-	return nil, nil // Latency property not found
+	return points[len(points)-1].latency[property]
 }
@@ -7,7 +7,9 @@ import (
 	"testing"
 
 	"github.com/kubeflow/hub/catalog/internal/catalog/modelcatalog/models"
+	"github.com/kubeflow/hub/catalog/internal/db/facets"
 	sharedmodels "github.com/kubeflow/hub/catalog/internal/db/models"
+	apimodels "github.com/kubeflow/hub/catalog/pkg/openapi"
 	"github.com/kubeflow/hub/internal/platform/apiutils"
 	dbmodels "github.com/kubeflow/hub/internal/platform/db/entity"
 	"github.com/stretchr/testify/assert"
@@ -55,10 +57,27 @@ func (m *mockPerfModelRepo) GetDistinctSourceIDs() ([]string, error) {
 	return nil, nil
 }
 
+func (m *mockPerfModelRepo) GetDistinctSourceIDsWithProperty(name, value string) ([]string, error) {
+	return nil, nil
+}
+
 func (m *mockPerfModelRepo) GetTypeID() int32 {
 	return m.TypeID
 }
 
+func (m *mockPerfModelRepo) Create(model models.CatalogModel) (models.CatalogModel, error) {
+	return m.Save(model)
+}
+
+func (m *mockPerfModelRepo) SaveRecommendedLatencies(modelID int32, latencies []models.RecommendedLatency) error {
+	args := m.Called(modelID, latencies)
+	return args.Error(0)
+}
+
+func (m *mockPerfModelRepo) CountPropertyValues(listOptions models.CatalogModelListOptions, options []sharedmodels.PropertyOption) (facets.Counts, error) {
+	return nil, nil
+}
+
 func (m *mockPerfArtifactRepo) GetByID(id int32) (sharedmodels.CatalogArtifact, error) {
 	args := m.Called(id)
 	return args.Get(0).(sharedmodels.CatalogArtifact), args.Error(1)
@@ -667,3 +686,316 @@ func TestGetMinimumRecommendedLatency_NoArtifacts(t *testing.T) {
 	require.NoError(t, err)
 	require.Nil(t, minLatency) // Should return nil for models without data
 }
+
+func TestRecommendedLatencies(t *testing.T) {
+	perfArtifact := func(id int32, hardwareType string, useCase string, hardwareCount int32, rps float64, latency float64) sharedmodels.CatalogArtifact {
+		props := []dbmodels.Properties{
+			{Name: "hardware_type", StringValue: apiutils.Of(hardwareType)},
+			{Name: "hardware_count", IntValue: apiutils.Of(hardwareCount)},
+			{Name: "requests_per_second", DoubleValue: apiutils.Of(rps)},
+			{Name: "ttft_p90", DoubleValue: apiutils.Of(latency)},
+		}
+		if useCase != "" {
+			props = append(props, dbmodels.Properties{Name: "use_case", StringValue: apiutils.Of(useCase)})
+		}
+		artifact := &dbmodels.BaseEntity[models.CatalogMetricsArtifactAttributes]{
+			Attributes: &models.CatalogMetricsArtifactAttributes{
+				MetricsType: models.MetricsTypePerformance,
+			},
+			CustomProperties: &props,
+		}
+		artifact.SetID(id)
+		return sharedmodels.CatalogArtifact{CatalogMetricsArtifact: artifact}
+	}
+
+	artifacts := []sharedmodels.CatalogArtifact{
+		// The fastest H100 configuration scales poorly, so at higher
+		// target RPS the slightly slower one is recommended instead.
+		perfArtifact(1, "H100", "chatbot", 1, 1, 68),
+		perfArtifact(2, "H100", "chatbot", 2, 100, 70),
+		perfArtifact(3, "A100", "rag", 1, 10, 90),
+		perfArtifact(4, "A100", "", 1, 10, 95),
+	}
+
+	mockArtifactRepo := &mockPerfArtifactRepo{}
+	mockArtifactRepo.On("List", mock.MatchedBy(func(opts sharedmodels.CatalogArtifactListOptions) bool {
+		return opts.ParentResourceID != nil && *opts.ParentResourceID == 7
+	})).Return(&dbmodels.ListWrapper[sharedmodels.CatalogArtifact]{Items: artifacts}, nil)
+	mockModelRepo := &mockPerfModelRepo{}
+	service := NewPerformanceArtifactService(mockArtifactRepo, mockModelRepo)
+
+	latencies, err := service.RecommendedLatencies(7)
+	require.NoError(t, err)
+
+	type profile struct {
+		targetRPS    int32
+		useCase      string
+		hardwareType string
+	}
+	got := map[profile]float64{}
+	for _, latency := range latencies {
+		assert.Equal(t, "ttft_p90", latency.LatencyProperty, "only properties the artifacts have are precomputed")
+		got[profile{latency.TargetRPS, latency.UseCase, latency.HardwareType}] = latency.Latency
+	}
+
+	want := map[profile]float64{}
+	for _, targetRPS := range precomputedTargetRPS {
+		h100 := 70.0
+		if targetRPS == 1 {
+			h100 = 68.0
+		}
+		want[profile{targetRPS, "", "H100"}] = h100
+		want[profile{targetRPS, "chatbot", "H100"}] = h100
+		want[profile{targetRPS, "", "A100"}] = 90
+		want[profile{targetRPS, "rag", "A100"}] = 90
+	}
+	assert.Equal(t, want, got)
+
+	// The artifacts aren't changed by the target RPS calculations.
+	assert.Len(t, *artifacts[0].CatalogMetricsArtifact.GetCustomProperties(), 5)
+
+	mockModelRepo.On("SaveRecommendedLatencies", int32(7), mock.Anything).Return(nil)
+	require.NoError(t, service.UpdateRecommendedLatencies(7))
+	mockModelRepo.AssertCalled(t, "SaveRecommendedLatencies", int32(7), mock.MatchedBy(func(saved []models.RecommendedLatency) bool {
+		return len(saved) == len(want)
+	}))
+}
+
+func TestComparePerformance(t *testing.T) {
+	nextID := int32(0)
+	perfArtifact := func(hardwareType string, hardwareCount int32, rps float64, latency float64) sharedmodels.CatalogArtifact {
+		nextID++
+		artifact := &dbmodels.BaseEntity[models.CatalogMetricsArtifactAttributes]{
+			Attributes: &models.CatalogMetricsArtifactAttributes{
+				MetricsType: models.MetricsTypePerformance,
+			},
+			CustomProperties: &[]dbmodels.Properties{
+				{Name: "hardware_type", StringValue: apiutils.Of(hardwareType)},
+				{Name: "hardware_count", IntValue: apiutils.Of(hardwareCount)},
+				{Name: "requests_per_second", DoubleValue: apiutils.Of(rps)},
+				{Name: "ttft_p90", DoubleValue: apiutils.Of(latency)},
+			},
+		}
+		artifact.SetID(nextID)
+		return sharedmodels.CatalogArtifact{CatalogMetricsArtifact: artifact}
+	}
+
+	// newService returns a service with fresh artifacts, because the
+	// target RPS calculations are added to the artifacts' properties.
+	newService := func() *PerformanceArtifactService {
+		byModel := map[int32][]sharedmodels.CatalogArtifact{
+			1: {
+				perfArtifact("H100", 1, 10, 50),
+				perfArtifact("H100", 2, 20, 30),
+				perfArtifact("A100", 1, 5, 80),
+			},
+			2: {
+				perfArtifact("A100", 1, 10, 40),
+			},
+			3: {},
+		}
+
+		mockArtifactRepo := &mockPerfArtifactRepo{}
+		for modelID, artifacts := range byModel {
+			mockArtifactRepo.On("List", mock.MatchedBy(func(opts sharedmodels.CatalogArtifactListOptions) bool {
+				return opts.ParentResourceID != nil && *opts.ParentResourceID == modelID
+			})).Return(&dbmodels.ListWrapper[sharedmodels.CatalogArtifact]{Items: artifacts}, nil)
+		}
+		return NewPerformanceArtifactService(mockArtifactRepo, nil)
+	}
+
+	t.Run("picks the hardware type most models have data for", func(t *testing.T) {
+		profile, performance, err := newService().ComparePerformance([]int32{1, 2, 3}, ComparePerformanceParams{
+			TargetRPS: 20,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(20), profile.TargetRPS)
+		assert.Equal(t, "ttft_p90", profile.LatencyProperty)
+		require.NotNil(t, profile.HardwareType)
+		assert.Equal(t, "A100", *profile.HardwareType)
+
+		require.Len(t, performance, 3)
+		require.NotNil(t, performance[0])
+		assert.Equal(t, "A100", performance[0].HardwareType)
+		assert.Equal(t, int32(4), performance[0].Replicas)
+		assert.Equal(t, int32(4), performance[0].TotalHardwareCount)
+		assert.Equal(t, 5.0, performance[0].RequestsPerSecond)
+		assert.Equal(t, 20.0, performance[0].TotalRequestsPerSecond)
+		require.NotNil(t, performance[0].Latency)
+		assert.Equal(t, 80.0, *performance[0].Latency)
+
+		require.NotNil(t, performance[1])
+		assert.Equal(t, int32(2), performance[1].Replicas)
+		require.NotNil(t, performance[1].Latency)
+		assert.Equal(t, 40.0, *performance[1].Latency)
+		assert.Equal(t, 10.0, performance[1].Metrics["requests_per_second"])
+
+		assert.Nil(t, performance[2], "models without performance data have no performance")
+	})
+
+	t.Run("uses the requested hardware type", func(t *testing.T) {
+		profile, performance, err := newService().ComparePerformance([]int32{1, 2}, ComparePerformanceParams{
+			TargetRPS:    20,
+			HardwareType: "H100",
+		})
+		require.NoError(t, err)
+
+		require.NotNil(t, profile.HardwareType)
+		assert.Equal(t, "H100", *profile.HardwareType)
+
+		require.NotNil(t, performance[0])
+		assert.Equal(t, int32(2), performance[0].HardwareCount)
+		assert.Equal(t, int32(1), performance[0].Replicas)
+		assert.Equal(t, int32(2), performance[0].TotalHardwareCount)
+		require.NotNil(t, performance[0].Latency)
+		assert.Equal(t, 30.0, *performance[0].Latency)
+
+		assert.Nil(t, performance[1])
+	})
+
+	t.Run("no performance data", func(t *testing.T) {
+		profile, performance, err := newService().ComparePerformance([]int32{3, 3}, ComparePerformanceParams{})
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(1), profile.TargetRPS)
+		assert.Nil(t, profile.HardwareType)
+		assert.Equal(t, []*apimodels.CatalogModelComparisonPerformance{nil, nil}, performance)
+	})
+}
+
+func TestSizeDeployment(t *testing.T) {
+	nextID := int32(0)
+	perfArtifact := func(hardwareType string, hardwareCount int32, rps float64, latency *float64) sharedmodels.CatalogArtifact {
+		nextID++
+		props := []dbmodels.Properties{
+			{Name: "hardware_type", StringValue: apiutils.Of(hardwareType)},
+			{Name: "hardware_count", IntValue: apiutils.Of(hardwareCount)},
+			{Name: "requests_per_second", DoubleValue: apiutils.Of(rps)},
+		}
+		if latency != nil {
+			props = append(props, dbmodels.Properties{Name: "ttft_p90", DoubleValue: latency})
+		}
+		artifact := &dbmodels.BaseEntity[models.CatalogMetricsArtifactAttributes]{
+			Attributes: &models.CatalogMetricsArtifactAttributes{
+				MetricsType: models.MetricsTypePerformance,
+			},
+			CustomProperties: &props,
+		}
+		artifact.SetID(nextID)
+		return sharedmodels.CatalogArtifact{CatalogMetricsArtifact: artifact}
+	}
+
+	artifacts := []sharedmodels.CatalogArtifact{
+		perfArtifact("H100", 1, 20, apiutils.Of(200.0)),
+		perfArtifact("H100", 1, 10, apiutils.Of(100.0)),
+		perfArtifact("H100", 1, 40, apiutils.Of(600.0)),
+		// Without a latency, this benchmark can't be checked against the
+		// objectives.
+		perfArtifact("H100", 1, 80, nil),
+		perfArtifact("A100", 2, 5, apiutils.Of(150.0)),
+		perfArtifact("A100", 2, 15, apiutils.Of(350.0)),
+		// Too slow even at the lowest benchmarked load.
+		perfArtifact("L40S", 1, 5, apiutils.Of(500.0)),
+		perfArtifact("MI300X", 1, 10, apiutils.Of(100.0)),
+	}
+
+	mockArtifactRepo := &mockPerfArtifactRepo{}
+	mockArtifactRepo.On("List", mock.MatchedBy(func(opts sharedmodels.CatalogArtifactListOptions) bool {
+		return opts.ParentResourceID != nil && *opts.ParentResourceID == 1
+	})).Return(&dbmodels.ListWrapper[sharedmodels.CatalogArtifact]{Items: artifacts}, nil)
+	service := NewPerformanceArtifactService(mockArtifactRepo, nil)
+
+	t.Run("interpolates replicas within the objectives", func(t *testing.T) {
+		configurations, err := service.SizeDeployment(1, SizingParams{
+			TargetRPS:     200,
+			MaxLatency:    map[string]float64{"ttft_p90": 400},
+			HardwareCosts: map[string]float64{"H100": 4, "A100": 0.9, "L40S": 0.1},
+		})
+		require.NoError(t, err)
+		require.Len(t, configurations, 3)
+
+		a100 := configurations[0]
+		assert.Equal(t, "A100", a100.HardwareType)
+		assert.Equal(t, int32(2), a100.HardwareCount)
+		assert.Equal(t, 15.0, a100.RequestsPerSecond, "limited to the highest benchmarked load")
+		assert.Equal(t, int32(14), a100.Replicas)
+		assert.Equal(t, int32(28), a100.TotalHardwareCount)
+		assert.InDelta(t, 25.2, a100.GetCost(), 1e-9)
+		assert.InDelta(t, 150+(200.0/14-5)*20, a100.Latency["ttft_p90"], 1e-9)
+
+		h100 := configurations[1]
+		assert.Equal(t, "H100", h100.HardwareType)
+		assert.InDelta(t, 30.0, h100.RequestsPerSecond, 1e-9, "interpolated between 20 and 40 RPS")
+		assert.Equal(t, int32(7), h100.Replicas)
+		assert.Equal(t, int32(7), h100.TotalHardwareCount)
+		assert.InDelta(t, 28.0, h100.GetCost(), 1e-9)
+		assert.InDelta(t, 200+(200.0/7-20)*20, h100.Latency["ttft_p90"], 1e-9)
+		assert.LessOrEqual(t, h100.Latency["ttft_p90"], 400.0)
+
+		mi300x := configurations[2]
+		assert.Equal(t, "MI300X", mi300x.HardwareType)
+		assert.Nil(t, mi300x.Cost, "hardware types without a cost are ranked last")
+		assert.Equal(t, int32(20), mi300x.Replicas)
+	})
+
+	t.Run("without objectives", func(t *testing.T) {
+		configurations, err := service.SizeDeployment(1, SizingParams{TargetRPS: 200})
+		require.NoError(t, err)
+		require.Len(t, configurations, 4)
+
+		// Without costs, configurations are ranked by total hardware count.
+		assert.Equal(t, "H100", configurations[0].HardwareType)
+		assert.Equal(t, 80.0, configurations[0].RequestsPerSecond)
+		assert.Equal(t, int32(3), configurations[0].Replicas)
+		assert.Empty(t, configurations[0].Latency)
+		assert.Equal(t, []string{"H100", "MI300X", "A100", "L40S"}, []string{
+			configurations[0].HardwareType,
+			configurations[1].HardwareType,
+			configurations[2].HardwareType,
+			configurations[3].HardwareType,
+		})
+	})
+
+	t.Run("objective on a missing property", func(t *testing.T) {
+		configurations, err := service.SizeDeployment(1, SizingParams{
+			MaxLatency: map[string]float64{"e2e_p90": 1000},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, configurations)
+	})
+}
+
+func TestMaxRPSWithin(t *testing.T) {
+	point := func(rps float64, ttft float64, e2e float64) sizingPoint {
+		return sizingPoint{rps: rps, latency: map[string]float64{"ttft_p90": ttft, "e2e_p90": e2e}}
+	}
+	points := []sizingPoint{
+		point(10, 100, 1000),
+		point(20, 200, 1500),
+		point(30, 300, 3500),
+	}
+
+	tests := []struct {
+		name       string
+		maxLatency map[string]float64
+		want       float64
+	}{
+		{name: "no objectives", maxLatency: map[string]float64{}, want: 30},
+		{name: "every benchmark meets the objectives", maxLatency: map[string]float64{"ttft_p90": 300}, want: 30},
+		{name: "interpolated", maxLatency: map[string]float64{"ttft_p90": 250}, want: 25},
+		{name: "strictest objective wins", maxLatency: map[string]float64{"ttft_p90": 250, "e2e_p90": 2000}, want: 22.5},
+		{name: "exactly on a benchmark", maxLatency: map[string]float64{"ttft_p90": 200}, want: 20},
+		{name: "lowest load misses an objective", maxLatency: map[string]float64{"ttft_p90": 50}, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, maxRPSWithin(points, tt.maxLatency), 1e-9)
+		})
+	}
+
+	t.Run("benchmarks at the same load", func(t *testing.T) {
+		duplicates := []sizingPoint{point(10, 100, 0), point(10, 300, 0)}
+		assert.Equal(t, 10.0, maxRPSWithin(duplicates, map[string]float64{"ttft_p90": 200}))
+	})
+}
@@ -46,6 +46,9 @@ func TestIntegration_PreservedRecommendationAlgorithm(t *testing.T) {
 		service.NewPropertyOptionsRepository(sharedDB),
 		nil, // MCPServerRepository
 		nil, // MCPServerToolRepository
+		nil, // CatalogSourceSyncRepository
+		nil, // CatalogSourceRefreshRepository
+		nil, // CatalogNamedQueryRepository
 	)
 
 	provider := NewDBCatalog(services, nil)
@@ -304,6 +307,9 @@ func TestIntegration_ServiceLayerBehavior(t *testing.T) {
 		service.NewPropertyOptionsRepository(sharedDB),
 		nil,
 		nil,
+		nil, // CatalogSourceSyncRepository
+		nil, // CatalogSourceRefreshRepository
+		nil, // CatalogNamedQueryRepository
 	)
 
 	provider := NewDBCatalog(services, nil)
@@ -468,6 +474,9 @@ func TestIntegration_ConfigurableProperties(t *testing.T) {
 		service.NewPropertyOptionsRepository(sharedDB),
 		nil,
 		nil,
+		nil, // CatalogSourceSyncRepository
+		nil, // CatalogSourceRefreshRepository
+		nil, // CatalogNamedQueryRepository
 	)
 
 	provider := NewDBCatalog(services, nil)
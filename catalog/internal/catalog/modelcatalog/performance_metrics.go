@@ -19,6 +19,16 @@ import (
 	models "github.com/kubeflow/hub/internal/platform/db/entity"
 )
 
+const (
+	// accuracyArtifactPrefix, followed by the model ID, is the name and
+	// external ID of the accuracy-metrics artifact of a model written by the
+	// loader. Its custom properties are the scores of each benchmark, and
+	// overallAccuracyProperty.
+	accuracyArtifactPrefix = "accuracy-metrics-model-"
+	// overallAccuracyProperty holds the overall accuracy of the model.
+	overallAccuracyProperty = "overall_average"
+)
+
 // metadataJSON represents the minimal structure needed from metadata.json files
 // Only the ID field is needed to look up existing models
 type metadataJSON struct {
@@ -369,7 +379,7 @@ func processModelArtifactsBatch(dirPath string, modelID int32, modelName string,
 
 	// Check evaluation artifacts
 	if len(evaluationRecords) > 0 {
-		externalID := fmt.Sprintf("accuracy-metrics-model-%d", modelID)
+		externalID := fmt.Sprintf("%s%d", accuracyArtifactPrefix, modelID)
 		if !existingArtifactsMap[externalID] {
 			artifact := createAccuracyMetricsArtifact(evaluationRecords, modelID, metricsArtifactTypeID, overallAccuracy, nil, nil)
 			artifactsToInsert = append(artifactsToInsert, artifact)
@@ -476,8 +486,8 @@ func parsePerformanceFile(filePath string) ([]performanceRecord, error) {
 
 // createAccuracyMetricsArtifact creates a single metrics artifact from all evaluation records
 func createAccuracyMetricsArtifact(evalRecords []evaluationRecord, modelID int32, typeID int32, overallAccuracy *float64, existingID *int32, existingCreateTime *int64) *dbmodels.CatalogMetricsArtifactImpl {
-	artifactName := fmt.Sprintf("accuracy-metrics-model-%d", modelID)
-	externalID := fmt.Sprintf("accuracy-metrics-model-%d", modelID)
+	artifactName := fmt.Sprintf("%s%d", accuracyArtifactPrefix, modelID)
+	externalID := artifactName
 
 	// Use existing create time if provided, otherwise find from evaluation records
 	createTime := existingCreateTime
@@ -521,7 +531,7 @@ func createAccuracyMetricsArtifact(evalRecords []evaluationRecord, modelID int32
 	// Add overall_average custom property from metadata.json overall_accuracy field
 	if overallAccuracy != nil {
 		customProperties = append(customProperties, models.Properties{
-			Name:        "overall_average",
+			Name:        overallAccuracyProperty,
 			DoubleValue: overallAccuracy,
 		})
 	}
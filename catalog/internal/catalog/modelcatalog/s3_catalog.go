@@ -0,0 +1,599 @@
+package modelcatalog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/hub/catalog/internal/catalog/basecatalog"
+	catalogmodels "github.com/kubeflow/hub/catalog/internal/catalog/modelcatalog/models"
+	sharedmodels "github.com/kubeflow/hub/catalog/internal/db/models"
+	apimodels "github.com/kubeflow/hub/catalog/pkg/openapi"
+	"github.com/kubeflow/hub/internal/licensepolicy"
+	models "github.com/kubeflow/hub/internal/platform/db/entity"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+const (
+	s3BucketKey                = "bucket"
+	s3PrefixesKey              = "prefixes"
+	s3EndpointKey              = "endpoint"
+	s3RegionKey                = "region"
+	s3ForcePathStyleKey        = "forcePathStyle"
+	s3AccessKeyIDEnvVarKey     = "accessKeyIdEnvVar"
+	s3SecretAccessKeyEnvVarKey = "secretAccessKeyEnvVar"
+	s3SessionTokenEnvVarKey    = "sessionTokenEnvVar"
+
+	defaultS3Region                = "us-east-1"
+	defaultS3AccessKeyIDEnvVar     = "AWS_ACCESS_KEY_ID"
+	defaultS3SecretAccessKeyEnvVar = "AWS_SECRET_ACCESS_KEY"
+	defaultS3SessionTokenEnvVar    = "AWS_SESSION_TOKEN"
+
+	// maxS3MetadataFileSize caps how much of a model card or config.json
+	// is read from the bucket.
+	maxS3MetadataFileSize = 1 << 20
+)
+
+// s3ModelFormats maps weight file extensions to the model format they
+// indicate. A directory containing any of these (or a config.json) is
+// treated as a model.
+var s3ModelFormats = map[string]string{
+	".safetensors": "safetensors",
+	".gguf":        "gguf",
+	".onnx":        "onnx",
+}
+
+// s3ModelCardFilenames lists the model card files that are checked, in
+// order of preference.
+var s3ModelCardFilenames = []string{"README.md", "readme.md", "Readme.md", "model-card.md", "MODEL_CARD.md"}
+
+const s3ConfigFilename = "config.json"
+
+type s3ModelProvider struct {
+	client       *s3Client
+	sourceId     string
+	prefixes     []string
+	filter       *ModelFilter
+	syncInterval time.Duration
+}
+
+// s3ModelDir is a directory in the bucket that holds a recognizable model
+// layout.
+type s3ModelDir struct {
+	// dir is the object key prefix of the directory, without a trailing slash.
+	dir     string
+	name    string
+	files   map[string]s3Object
+	formats []string
+}
+
+func (p *s3ModelProvider) Models(ctx context.Context) (<-chan ModelProviderRecord, error) {
+	records, fetchErr := p.getModelsFromS3(ctx)
+	if fetchErr != nil && len(records) == 0 {
+		return nil, fetchErr
+	}
+
+	ch := make(chan ModelProviderRecord)
+	go func() {
+		defer close(ch)
+
+		p.emitWithError(ctx, records, fetchErr, ch)
+
+		ticker := time.NewTicker(p.syncInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				glog.Infof("Periodic sync: rescanning bucket for source %s", p.sourceId)
+				records, err := p.getModelsFromS3(ctx)
+				if len(records) > 0 || err == nil {
+					p.emitWithError(ctx, records, err, ch)
+				} else {
+					glog.Errorf("unable to rescan S3 models: %v", err)
+				}
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// getModelsFromS3 lists every configured prefix, finds model directories
+// and converts each one into a record. Directories whose metadata can't be
+// read are reported through a PartiallyAvailableError.
+func (p *s3ModelProvider) getModelsFromS3(ctx context.Context) ([]ModelProviderRecord, error) {
+	dirs, err := p.findModelDirs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lastSyncedStr := strconv.FormatInt(time.Now().UnixMilli(), 10)
+
+	var records []ModelProviderRecord
+	var failedModels []string
+
+	for _, dir := range dirs {
+		if !p.filter.Allows(dir.name) {
+			glog.V(2).Infof("Skipping excluded model: %s", dir.name)
+			continue
+		}
+
+		record, err := p.convertS3ModelToRecord(ctx, dir)
+		if err != nil {
+			glog.Errorf("Failed to read model %s from s3://%s/%s: %v", dir.name, p.client.bucket, dir.dir, err)
+			failedModels = append(failedModels, dir.name)
+			continue
+		}
+
+		if modelImpl, ok := record.Model.(*catalogmodels.CatalogModelImpl); ok {
+			customProps := modelImpl.CustomProperties
+			if customProps == nil {
+				customProps = &[]models.Properties{}
+			}
+			*customProps = append(*customProps, models.NewStringProperty("last_synced", lastSyncedStr, true))
+			modelImpl.CustomProperties = customProps
+		}
+
+		records = append(records, record)
+	}
+
+	if len(failedModels) > 0 {
+		return records, &PartiallyAvailableError{FailedModels: failedModels}
+	}
+
+	return records, nil
+}
+
+// findModelDirs lists the objects under every prefix and groups them into
+// model directories. When a model directory contains nested directories
+// that also look like models (e.g. an onnx/ export next to safetensors
+// weights), the nested files are folded into the outermost model.
+func (p *s3ModelProvider) findModelDirs(ctx context.Context) ([]*s3ModelDir, error) {
+	var result []*s3ModelDir
+	seenNames := map[string]string{}
+
+	for _, prefix := range p.prefixes {
+		objects, err := p.client.listObjects(ctx, prefix)
+		if err != nil {
+			return nil, err
+		}
+
+		byDir := map[string]map[string]s3Object{}
+		for _, obj := range objects {
+			if strings.HasSuffix(obj.Key, "/") {
+				// Directory placeholder objects
+				continue
+			}
+			dir := path.Dir(obj.Key)
+			if dir == "." {
+				dir = ""
+			}
+			if byDir[dir] == nil {
+				byDir[dir] = map[string]s3Object{}
+			}
+			byDir[dir][path.Base(obj.Key)] = obj
+		}
+
+		candidates := make([]string, 0, len(byDir))
+		for dir, files := range byDir {
+			if len(s3DetectFormats(files)) > 0 || hasFile(files, s3ConfigFilename) {
+				candidates = append(candidates, dir)
+			}
+		}
+		// Sorting puts parents before their children.
+		sort.Strings(candidates)
+
+		var dirs []*s3ModelDir
+		for _, dir := range candidates {
+			var parent *s3ModelDir
+			for _, d := range dirs {
+				if d.dir == "" || strings.HasPrefix(dir, d.dir+"/") {
+					parent = d
+					break
+				}
+			}
+
+			if parent != nil {
+				rel := strings.TrimPrefix(strings.TrimPrefix(dir, parent.dir), "/")
+				for name, obj := range byDir[dir] {
+					parent.files[rel+"/"+name] = obj
+				}
+				continue
+			}
+
+			dirs = append(dirs, &s3ModelDir{
+				dir:   dir,
+				name:  s3ModelName(prefix, dir, p.client.bucket),
+				files: byDir[dir],
+			})
+		}
+
+		for _, d := range dirs {
+			d.formats = s3DetectFormats(d.files)
+
+			if other, exists := seenNames[d.name]; exists {
+				glog.Warningf("%s: skipping s3://%s/%s, model name %q is already used by s3://%s/%s", p.sourceId, p.client.bucket, d.dir, d.name, p.client.bucket, other)
+				continue
+			}
+			seenNames[d.name] = d.dir
+			result = append(result, d)
+		}
+	}
+
+	return result, nil
+}
+
+// s3ModelName derives a model name from the directory's path relative to
+// the configured prefix. A prefix that points directly at a model directory
+// yields the directory's base name.
+func s3ModelName(prefix, dir, bucket string) string {
+	// Names are relative to the "directory" part of the prefix, so that a
+	// partial prefix such as "models/gran" still yields "granite-3b".
+	base := prefix[:strings.LastIndex(prefix, "/")+1]
+
+	name := strings.Trim(strings.TrimPrefix(dir+"/", base), "/")
+	if name == "" {
+		name = path.Base(dir)
+	}
+	if dir == "" {
+		name = bucket
+	}
+	return name
+}
+
+func s3DetectFormats(files map[string]s3Object) []string {
+	formats := []string{}
+	for name := range files {
+		if format, ok := s3ModelFormats[strings.ToLower(path.Ext(name))]; ok && !slices.Contains(formats, format) {
+			formats = append(formats, format)
+		}
+	}
+	sort.Strings(formats)
+	return formats
+}
+
+func hasFile(files map[string]s3Object, name string) bool {
+	_, ok := files[name]
+	return ok
+}
+
+func (p *s3ModelProvider) convertS3ModelToRecord(ctx context.Context, dir *s3ModelDir) (ModelProviderRecord, error) {
+	m := &apimodels.CatalogModel{
+		Name:     dir.name,
+		SourceId: &p.sourceId,
+		Logo:     &catalogModelLogo,
+	}
+
+	for _, filename := range s3ModelCardFilenames {
+		if _, ok := dir.files[filename]; !ok {
+			continue
+		}
+		content, err := p.client.getObject(ctx, path.Join(dir.dir, filename), maxS3MetadataFileSize)
+		if err != nil {
+			return ModelProviderRecord{}, err
+		}
+		readme := string(content)
+		m.Readme = &readme
+
+		frontMatter, err := parseFrontMatter(content)
+		if err != nil {
+			glog.Warningf("%s: ignoring invalid front matter in s3://%s/%s/%s: %v", p.sourceId, p.client.bucket, dir.dir, filename, err)
+		} else if frontMatter != nil {
+			applyS3CardData(m, &hfCard{Data: frontMatter})
+		}
+		break
+	}
+
+	customProps := map[string]apimodels.MetadataValue{}
+
+	if _, ok := dir.files[s3ConfigFilename]; ok {
+		content, err := p.client.getObject(ctx, path.Join(dir.dir, s3ConfigFilename), maxS3MetadataFileSize)
+		if err != nil {
+			return ModelProviderRecord{}, err
+		}
+		var config hfConfig
+		if err := json.Unmarshal(content, &config); err != nil {
+			glog.Warningf("%s: ignoring invalid s3://%s/%s/%s: %v", p.sourceId, p.client.bucket, dir.dir, s3ConfigFilename, err)
+		} else if len(config.Architectures) > 0 {
+			if archJSON, err := json.Marshal(config.Architectures); err == nil {
+				customProps["architectures"] = stringMetadataValue(string(archJSON))
+			}
+		}
+	}
+
+	customProps["model_type"] = stringMetadataValue(string(classifyModelTypeFromTasks(m.Tasks)))
+	if len(dir.formats) > 0 {
+		customProps["model_format"] = stringMetadataValue(strings.Join(dir.formats, ","))
+	}
+	m.SetCustomProperties(customProps)
+
+	// Timestamps come from the objects in the directory: the oldest object
+	// approximates creation and the newest the last update.
+	var createTime, updateTime int64
+	for _, obj := range dir.files {
+		if obj.LastModified.IsZero() {
+			continue
+		}
+		ms := obj.LastModified.UnixMilli()
+		if createTime == 0 || ms < createTime {
+			createTime = ms
+		}
+		if ms > updateTime {
+			updateTime = ms
+		}
+	}
+
+	model := catalogmodels.CatalogModelImpl{}
+	modelName := m.Name
+	externalID := fmt.Sprintf("s3://%s/%s", p.client.bucket, dir.dir)
+	attrs := &catalogmodels.CatalogModelAttributes{
+		Name:       &modelName,
+		ExternalID: &externalID,
+	}
+	if createTime != 0 {
+		attrs.CreateTimeSinceEpoch = &createTime
+		attrs.LastUpdateTimeSinceEpoch = &updateTime
+	}
+	model.Attributes = attrs
+
+	properties, customProperties := convertHFModelProperties(m)
+	if len(properties) > 0 {
+		model.Properties = &properties
+	}
+	if len(customProperties) > 0 {
+		model.CustomProperties = &customProperties
+	}
+
+	artifactName := fmt.Sprintf("%s-s3-artifact", modelName)
+	artifactType := "model-artifact"
+	uri := externalID
+	if dir.dir != "" {
+		uri += "/"
+	}
+	modelArtifact := &catalogmodels.CatalogModelArtifactImpl{}
+	modelArtifact.Attributes = &catalogmodels.CatalogModelArtifactAttributes{
+		Name:         &artifactName,
+		URI:          &uri,
+		ArtifactType: &artifactType,
+		ExternalID:   &externalID,
+	}
+	if createTime != 0 {
+		modelArtifact.Attributes.CreateTimeSinceEpoch = attrs.CreateTimeSinceEpoch
+		modelArtifact.Attributes.LastUpdateTimeSinceEpoch = attrs.LastUpdateTimeSinceEpoch
+	}
+	if len(dir.formats) > 0 {
+		modelArtifact.CustomProperties = &[]models.Properties{
+			models.NewStringProperty("model_format", strings.Join(dir.formats, ","), true),
+		}
+	}
+
+	files := make([]string, 0, len(dir.files))
+	for name := range dir.files {
+		files = append(files, name)
+	}
+	sort.Strings(files)
+
+	return ModelProviderRecord{
+		Model: &model,
+		Artifacts: []sharedmodels.CatalogArtifact{
+			{CatalogModelArtifact: modelArtifact},
+		},
+		Files: files,
+	}, nil
+}
+
+// applyS3CardData applies the model card front matter to m. It uses the
+// same mapping as Hugging Face card data and additionally reads the fields
+// that the Hugging Face API reports outside of the card (license,
+// pipeline_tag and library_name).
+func applyS3CardData(m *apimodels.CatalogModel, card *hfCard) {
+	card.applyTo(m)
+
+	if license, ok := card.Data["license"].(string); ok && license != "" &&
+		!strings.HasPrefix(license, "http://") && !strings.HasPrefix(license, "https://") {
+		license = licensepolicy.TransformLicenseToHumanReadable(license)
+		m.License = &license
+	}
+
+	if task, ok := card.Data["pipeline_tag"].(string); ok && task != "" {
+		m.Tasks = []string{task}
+	}
+
+	if library, ok := card.Data["library_name"].(string); ok && library != "" {
+		m.LibraryName = &library
+	}
+}
+
+// parseFrontMatter returns the YAML front matter at the start of a
+// Markdown document, or nil if there is none.
+func parseFrontMatter(content []byte) (map[string]any, error) {
+	content = bytes.TrimPrefix(content, []byte("\ufeff"))
+	content = bytes.ReplaceAll(content, []byte("\r\n"), []byte("\n"))
+
+	rest, ok := bytes.CutPrefix(content, []byte("---\n"))
+	if !ok {
+		return nil, nil
+	}
+
+	block, _, ok := bytes.Cut(append([]byte("\n"), rest...), []byte("\n---"))
+	if !ok {
+		return nil, fmt.Errorf("unterminated front matter")
+	}
+
+	var data map[string]any
+	if err := yaml.Unmarshal(block, &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func stringMetadataValue(value string) apimodels.MetadataValue {
+	return apimodels.MetadataValue{
+		MetadataStringValue: &apimodels.MetadataStringValue{
+			StringValue: value,
+		},
+	}
+}
+
+// emitWithError sends all models to the channel, then sends a final empty
+// record carrying err, if any, to signal a partial failure.
+func (p *s3ModelProvider) emitWithError(ctx context.Context, records []ModelProviderRecord, err error, out chan<- ModelProviderRecord) {
+	done := ctx.Done()
+	for _, record := range records {
+		select {
+		case out <- record:
+		case <-done:
+			return
+		}
+	}
+
+	select {
+	case out <- ModelProviderRecord{Error: err}:
+	case <-done:
+	}
+}
+
+// newS3Client creates an s3Client from source properties. Credentials are
+// read from environment variables whose names can be overridden per source.
+func newS3Client(properties map[string]any) (*s3Client, error) {
+	bucket, _ := properties[s3BucketKey].(string)
+	if bucket == "" {
+		return nil, fmt.Errorf("missing %s string property", s3BucketKey)
+	}
+
+	region := defaultS3Region
+	if r, ok := properties[s3RegionKey].(string); ok && r != "" {
+		region = r
+	}
+
+	endpoint := fmt.Sprintf("https://s3.%s.amazonaws.com", region)
+	pathStyle := false
+	if e, ok := properties[s3EndpointKey].(string); ok && e != "" {
+		endpoint = e
+		// S3-compatible servers usually don't have wildcard DNS for
+		// virtual-hosted buckets.
+		pathStyle = true
+	}
+	if raw, ok := properties[s3ForcePathStyleKey]; ok {
+		v, ok := raw.(bool)
+		if !ok {
+			return nil, fmt.Errorf("%q property should be a boolean", s3ForcePathStyleKey)
+		}
+		pathStyle = v
+	}
+
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s %q: %w", s3EndpointKey, endpoint, err)
+	}
+	if endpointURL.Scheme != "http" && endpointURL.Scheme != "https" {
+		return nil, fmt.Errorf("invalid %s %q: scheme must be http or https", s3EndpointKey, endpoint)
+	}
+
+	envVar := func(key, def string) string {
+		name := def
+		if v, ok := properties[key].(string); ok && v != "" {
+			name = v
+		}
+		return os.Getenv(name)
+	}
+
+	c := &s3Client{
+		httpClient:      &http.Client{Timeout: 30 * time.Second},
+		endpoint:        endpointURL,
+		region:          region,
+		bucket:          bucket,
+		pathStyle:       pathStyle,
+		accessKeyID:     envVar(s3AccessKeyIDEnvVarKey, defaultS3AccessKeyIDEnvVar),
+		secretAccessKey: envVar(s3SecretAccessKeyEnvVarKey, defaultS3SecretAccessKeyEnvVar),
+		sessionToken:    envVar(s3SessionTokenEnvVarKey, defaultS3SessionTokenEnvVar),
+	}
+
+	if c.accessKeyID == "" || c.secretAccessKey == "" {
+		glog.Infof("No credentials configured for S3 bucket %s, requests will be anonymous", bucket)
+	}
+
+	return c, nil
+}
+
+func parseS3Prefixes(properties map[string]any) ([]string, error) {
+	raw, exists := properties[s3PrefixesKey]
+	if !exists {
+		return []string{""}, nil
+	}
+
+	values, ok := raw.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%q property should be a list", s3PrefixesKey)
+	}
+	if len(values) == 0 {
+		return []string{""}, nil
+	}
+
+	prefixes := make([]string, len(values))
+	for i, value := range values {
+		prefix, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s: invalid list: index %d: wanted string, got %T", s3PrefixesKey, i, value)
+		}
+		prefixes[i] = strings.TrimPrefix(prefix, "/")
+	}
+	return prefixes, nil
+}
+
+func newS3ModelProvider(ctx context.Context, source *basecatalog.ModelSource, reldir string) (<-chan ModelProviderRecord, error) {
+	p := &s3ModelProvider{}
+
+	p.sourceId = source.GetId()
+	if p.sourceId == "" {
+		return nil, fmt.Errorf("missing source ID for S3 catalog")
+	}
+
+	client, err := newS3Client(source.Properties)
+	if err != nil {
+		return nil, err
+	}
+	p.client = client
+
+	p.prefixes, err = parseS3Prefixes(source.Properties)
+	if err != nil {
+		return nil, err
+	}
+
+	p.syncInterval = defaultSyncInterval
+	if syncInterval, ok := source.Properties[syncIntervalKey].(string); ok && syncInterval != "" {
+		if parsed, err := time.ParseDuration(syncInterval); err == nil {
+			p.syncInterval = parsed
+		} else {
+			glog.Warningf("Invalid syncInterval duration string %q, using default: %v", syncInterval, err)
+		}
+	}
+
+	filter, err := NewModelFilterFromSource(source, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	p.filter = filter
+
+	return p.Models(ctx)
+}
+
+func init() {
+	if err := RegisterModelProvider("s3", newS3ModelProvider); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,316 @@
+package modelcatalog
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	s3EmptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	s3SigningAlgorithm = "AWS4-HMAC-SHA256"
+	s3AmzDateFormat    = "20060102T150405Z"
+	s3ShortDateFormat  = "20060102"
+)
+
+// s3Client is a minimal, read-only client for S3-compatible object stores
+// (AWS S3, MinIO, Ceph RGW, ...). It only implements the two calls the
+// catalog needs, ListObjectsV2 and GetObject, and signs requests with AWS
+// Signature Version 4 when credentials are configured.
+type s3Client struct {
+	httpClient *http.Client
+	endpoint   *url.URL
+	region     string
+	bucket     string
+	// pathStyle addresses the bucket as the first path segment
+	// (http://host/bucket/key) instead of as a virtual host
+	// (http://bucket.host/key). Most S3-compatible servers need this.
+	pathStyle bool
+
+	accessKeyID     string
+	secretAccessKey string
+	sessionToken    string
+
+	// now returns the signing time; overridden in tests.
+	now func() time.Time
+}
+
+// s3Object is a single entry returned by ListObjectsV2.
+type s3Object struct {
+	Key          string    `xml:"Key"`
+	LastModified time.Time `xml:"LastModified"`
+	ETag         string    `xml:"ETag"`
+	Size         int64     `xml:"Size"`
+}
+
+type s3ListBucketResult struct {
+	IsTruncated           bool       `xml:"IsTruncated"`
+	Contents              []s3Object `xml:"Contents"`
+	NextContinuationToken string     `xml:"NextContinuationToken"`
+}
+
+type s3ErrorResponse struct {
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+// listObjects returns every object in the bucket whose key starts with
+// prefix, following continuation tokens until the listing is complete.
+func (c *s3Client) listObjects(ctx context.Context, prefix string) ([]s3Object, error) {
+	var objects []s3Object
+	token := ""
+
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		if prefix != "" {
+			query.Set("prefix", prefix)
+		}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+
+		body, err := c.do(ctx, "", query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list objects in s3://%s/%s: %w", c.bucket, prefix, err)
+		}
+
+		var result s3ListBucketResult
+		err = xml.Unmarshal(body, &result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode object list for s3://%s/%s: %w", c.bucket, prefix, err)
+		}
+
+		objects = append(objects, result.Contents...)
+
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			break
+		}
+		token = result.NextContinuationToken
+	}
+
+	return objects, nil
+}
+
+// getObject returns the content of a single object. Objects larger than
+// maxBytes are rejected so that a stray file can't exhaust memory.
+func (c *s3Client) getObject(ctx context.Context, key string, maxBytes int64) ([]byte, error) {
+	req, err := c.newRequest(ctx, key, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch s3://%s/%s: %w", c.bucket, key, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch s3://%s/%s: %w", c.bucket, key, readS3Error(resp))
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read s3://%s/%s: %w", c.bucket, key, err)
+	}
+	if int64(len(body)) > maxBytes {
+		return nil, fmt.Errorf("s3://%s/%s is larger than %d bytes", c.bucket, key, maxBytes)
+	}
+
+	return body, nil
+}
+
+func (c *s3Client) do(ctx context.Context, key string, query url.Values) ([]byte, error) {
+	req, err := c.newRequest(ctx, key, query)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, readS3Error(resp)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// newRequest builds a signed GET request for key (or the bucket itself when
+// key is empty).
+func (c *s3Client) newRequest(ctx context.Context, key string, query url.Values) (*http.Request, error) {
+	u := *c.endpoint
+
+	var rawPath string
+	if c.pathStyle {
+		rawPath = "/" + s3URIEncode(c.bucket, true)
+	} else {
+		u.Host = c.bucket + "." + u.Host
+	}
+	rawPath += "/" + s3URIEncode(key, false)
+
+	u.RawPath = strings.TrimSuffix(c.endpoint.EscapedPath(), "/") + rawPath
+	p, err := url.PathUnescape(u.RawPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid object key %q: %w", key, err)
+	}
+	u.Path = p
+	u.RawQuery = s3CanonicalQuery(query)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", "model-registry-catalog")
+
+	c.sign(req)
+	return req, nil
+}
+
+// sign adds AWS Signature Version 4 headers to req. Requests are left
+// unsigned when no credentials are configured, which works for public
+// buckets.
+func (c *s3Client) sign(req *http.Request) {
+	if c.accessKeyID == "" || c.secretAccessKey == "" {
+		return
+	}
+
+	now := time.Now
+	if c.now != nil {
+		now = c.now
+	}
+	t := now().UTC()
+	amzDate := t.Format(s3AmzDateFormat)
+	shortDate := t.Format(s3ShortDateFormat)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", s3EmptyPayloadHash)
+	if c.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", c.sessionToken)
+	}
+
+	headers := map[string]string{
+		"host":                 req.URL.Host,
+		"x-amz-content-sha256": s3EmptyPayloadHash,
+		"x-amz-date":           amzDate,
+	}
+	if c.sessionToken != "" {
+		headers["x-amz-security-token"] = c.sessionToken
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name)
+		canonicalHeaders.WriteByte(':')
+		canonicalHeaders.WriteString(strings.TrimSpace(headers[name]))
+		canonicalHeaders.WriteByte('\n')
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		s3EmptyPayloadHash,
+	}, "\n")
+
+	scope := shortDate + "/" + c.region + "/s3/aws4_request"
+	canonicalHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		s3SigningAlgorithm,
+		amzDate,
+		scope,
+		hex.EncodeToString(canonicalHash[:]),
+	}, "\n")
+
+	key := s3HMAC([]byte("AWS4"+c.secretAccessKey), shortDate)
+	key = s3HMAC(key, c.region)
+	key = s3HMAC(key, "s3")
+	key = s3HMAC(key, "aws4_request")
+	signature := hex.EncodeToString(s3HMAC(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3SigningAlgorithm, c.accessKeyID, scope, signedHeaders, signature))
+}
+
+func s3HMAC(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// s3URIEncode percent-encodes s the way SigV4 expects: every byte except
+// unreserved characters is encoded, and '/' is only encoded when
+// encodeSlash is true.
+func s3URIEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		switch {
+		case 'A' <= ch && ch <= 'Z', 'a' <= ch && ch <= 'z', '0' <= ch && ch <= '9',
+			ch == '-', ch == '_', ch == '.', ch == '~':
+			b.WriteByte(ch)
+		case ch == '/' && !encodeSlash:
+			b.WriteByte(ch)
+		default:
+			fmt.Fprintf(&b, "%%%02X", ch)
+		}
+	}
+	return b.String()
+}
+
+// s3CanonicalQuery encodes query parameters sorted by name, as required by
+// SigV4. The same string is used as the request's raw query so the signed
+// and sent forms always agree.
+func s3CanonicalQuery(query url.Values) string {
+	if len(query) == 0 {
+		return ""
+	}
+
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		values := append([]string(nil), query[k]...)
+		sort.Strings(values)
+		for _, v := range values {
+			parts = append(parts, s3URIEncode(k, true)+"="+s3URIEncode(v, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+func readS3Error(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+
+	var s3Err s3ErrorResponse
+	if err := xml.Unmarshal(body, &s3Err); err == nil && s3Err.Code != "" {
+		return fmt.Errorf("status %d: %s: %s", resp.StatusCode, s3Err.Code, s3Err.Message)
+	}
+	return fmt.Errorf("status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+}
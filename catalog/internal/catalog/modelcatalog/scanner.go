@@ -0,0 +1,282 @@
+package modelcatalog
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/hub/catalog/internal/catalog/basecatalog"
+	"github.com/kubeflow/hub/catalog/internal/catalog/modelcatalog/models"
+	mrmodels "github.com/kubeflow/hub/internal/platform/db/entity"
+)
+
+// scannedAtProperty records when a model was last scanned, in milliseconds
+// since epoch.
+const scannedAtProperty = "scanned_at"
+
+// ScanResult is what a scanner found out about a model.
+type ScanResult struct {
+	// Properties are set on the model, replacing properties with the same
+	// name.
+	Properties []mrmodels.Properties
+
+	// ArtifactProperties are set as custom properties of the model's
+	// artifacts, keyed by artifact URI.
+	ArtifactProperties map[string][]mrmodels.Properties
+}
+
+// ModelScanner inspects models before they're saved, for example to flag
+// unsafe files or to verify signatures.
+type ModelScanner interface {
+	// Scan returns what the scanner found out about the record's model, or
+	// nil if there's nothing it can tell. It must not modify the record.
+	Scan(ctx context.Context, record ModelProviderRecord) (*ScanResult, error)
+}
+
+// ModelScannerFunc creates a scanner from the scanning configuration, which
+// may be nil.
+type ModelScannerFunc func(config *basecatalog.ScanConfig) (ModelScanner, error)
+
+var registeredModelScanners = map[string]ModelScannerFunc{}
+
+// RegisterModelScanner makes a scanner available to the scanners list of
+// the scanning configuration.
+func RegisterModelScanner(name string, callback ModelScannerFunc) error {
+	if _, exists := registeredModelScanners[name]; exists {
+		return fmt.Errorf("scanner %s already exists", name)
+	}
+	registeredModelScanners[name] = callback
+	return nil
+}
+
+// defaultModelScanners are run when the scanning configuration doesn't list
+// any. They don't need network access.
+var defaultModelScanners = []string{serializationScannerName}
+
+type namedModelScanner struct {
+	name    string
+	scanner ModelScanner
+}
+
+type scanCacheEntry struct {
+	fingerprint string
+	scannedAt   time.Time
+	result      ScanResult
+}
+
+// modelScanning runs the configured scanners on the models read from
+// sources. Results are reused until the rescan interval elapses, as long as
+// the model's files and artifacts stay the same, so that periodic syncs
+// don't scan everything again.
+type modelScanning struct {
+	mu       sync.Mutex
+	built    bool
+	config   *basecatalog.ScanConfig
+	scanners []namedModelScanner
+	interval time.Duration
+	cache    map[string]scanCacheEntry // by namespaced model name
+}
+
+func newModelScanning() *modelScanning {
+	return &modelScanning{
+		cache: map[string]scanCacheEntry{},
+	}
+}
+
+// setup returns the scanners for config, creating them if config changed
+// since the last call. Cached results are dropped when that happens.
+func (s *modelScanning) setup(config *basecatalog.ScanConfig) ([]namedModelScanner, time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Config files are parsed again whenever one of them changes, so
+	// compare values rather than pointers.
+	if s.built && reflect.DeepEqual(s.config, config) {
+		return s.scanners, s.interval
+	}
+
+	names := defaultModelScanners
+	if config != nil && len(config.Scanners) > 0 {
+		names = config.Scanners
+	}
+
+	scanners := make([]namedModelScanner, 0, len(names))
+	for _, name := range names {
+		newScanner, ok := registeredModelScanners[name]
+		if !ok {
+			glog.Errorf("scanner %q not registered", name)
+			continue
+		}
+		scanner, err := newScanner(config)
+		if err != nil {
+			glog.Errorf("unable to create scanner %q: %v", name, err)
+			continue
+		}
+		scanners = append(scanners, namedModelScanner{name: name, scanner: scanner})
+	}
+
+	interval, err := config.GetRescanInterval()
+	if err != nil {
+		// Already validated when the configuration was read.
+		interval = basecatalog.DefaultRescanInterval
+	}
+
+	s.built = true
+	s.config = config
+	s.scanners = scanners
+	s.interval = interval
+	s.cache = map[string]scanCacheEntry{}
+	return scanners, interval
+}
+
+// scan runs the scanners configured by config on the record's model and
+// applies their results to the model and its artifacts. The model must
+// already have its namespaced name.
+func (s *modelScanning) scan(ctx context.Context, config *basecatalog.ScanConfig, record ModelProviderRecord) {
+	if record.Model == nil {
+		return
+	}
+	attr := record.Model.GetAttributes()
+	if attr == nil || attr.Name == nil {
+		return
+	}
+	name := *attr.Name
+
+	scanners, interval := s.setup(config)
+	if len(scanners) == 0 {
+		return
+	}
+
+	fingerprint := scanFingerprint(record)
+
+	s.mu.Lock()
+	entry, ok := s.cache[name]
+	s.mu.Unlock()
+
+	if !ok || entry.fingerprint != fingerprint || time.Since(entry.scannedAt) >= interval {
+		entry = scanCacheEntry{
+			fingerprint: fingerprint,
+			scannedAt:   time.Now(),
+		}
+		for _, scanner := range scanners {
+			result, err := scanner.scanner.Scan(ctx, record)
+			if err != nil {
+				glog.Warningf("%s: %s scan failed: %v", name, scanner.name, err)
+				continue
+			}
+			entry.result.merge(result)
+		}
+		if len(entry.result.Properties) > 0 || len(entry.result.ArtifactProperties) > 0 {
+			entry.result.Properties = append(entry.result.Properties,
+				mrmodels.NewStringProperty(scannedAtProperty, strconv.FormatInt(entry.scannedAt.UnixMilli(), 10), false))
+		}
+
+		// Results of an interrupted scan may be incomplete.
+		if ctx.Err() == nil {
+			s.mu.Lock()
+			s.cache[name] = entry
+			s.mu.Unlock()
+		}
+	}
+
+	applyScanResult(record, entry.result)
+}
+
+// forget drops the cached results of a source's models, so that they're
+// scanned again the next time the source is read.
+func (s *modelScanning) forget(sourceID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for name := range s.cache {
+		if strings.HasPrefix(name, sourceID+":") {
+			delete(s.cache, name)
+		}
+	}
+}
+
+func (r *ScanResult) merge(other *ScanResult) {
+	if other == nil {
+		return
+	}
+	r.Properties = append(r.Properties, other.Properties...)
+	for uri, props := range other.ArtifactProperties {
+		if r.ArtifactProperties == nil {
+			r.ArtifactProperties = map[string][]mrmodels.Properties{}
+		}
+		r.ArtifactProperties[uri] = append(r.ArtifactProperties[uri], props...)
+	}
+}
+
+// scanFingerprint identifies the contents scanners look at: the model's
+// files and the URIs of its artifacts.
+func scanFingerprint(record ModelProviderRecord) string {
+	parts := slices.Clone(record.Files)
+	slices.Sort(parts)
+	for _, artifact := range record.Artifacts {
+		if uri := modelArtifactURI(artifact.CatalogModelArtifact); uri != "" {
+			parts = append(parts, "artifact:"+uri)
+		}
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+func applyScanResult(record ModelProviderRecord, result ScanResult) {
+	for _, prop := range result.Properties {
+		setModelProperty(record.Model, prop)
+	}
+
+	if len(result.ArtifactProperties) == 0 {
+		return
+	}
+	for _, artifact := range record.Artifacts {
+		modelArtifact, ok := artifact.CatalogModelArtifact.(*models.CatalogModelArtifactImpl)
+		if !ok {
+			continue
+		}
+		for _, prop := range result.ArtifactProperties[modelArtifactURI(modelArtifact)] {
+			setArtifactCustomProperty(modelArtifact, prop)
+		}
+	}
+}
+
+// modelArtifactURI returns the URI of a model artifact, or "" if artifact
+// isn't a model artifact.
+func modelArtifactURI(artifact any) string {
+	modelArtifact, ok := artifact.(models.CatalogModelArtifact)
+	if !ok || modelArtifact == nil {
+		return ""
+	}
+	attr := modelArtifact.GetAttributes()
+	if attr == nil || attr.URI == nil {
+		return ""
+	}
+	return *attr.URI
+}
+
+// setArtifactCustomProperty adds a custom property to a model artifact,
+// replacing any custom property with the same name.
+func setArtifactCustomProperty(artifact *models.CatalogModelArtifactImpl, prop mrmodels.Properties) {
+	prop.IsCustomProperty = true
+	if artifact.CustomProperties == nil {
+		artifact.CustomProperties = &[]mrmodels.Properties{}
+	}
+	props := artifact.CustomProperties
+	for i := range *props {
+		if (*props)[i].Name == prop.Name {
+			(*props)[i] = prop
+			return
+		}
+	}
+	*props = append(*props, prop)
+}
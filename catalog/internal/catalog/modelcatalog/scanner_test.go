@@ -0,0 +1,206 @@
+package modelcatalog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kubeflow/hub/catalog/internal/catalog/basecatalog"
+	"github.com/kubeflow/hub/catalog/internal/catalog/modelcatalog/models"
+	sharedmodels "github.com/kubeflow/hub/catalog/internal/db/models"
+	"github.com/kubeflow/hub/internal/platform/apiutils"
+	mrmodels "github.com/kubeflow/hub/internal/platform/db/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newScanTestRecord(name string, files []string, artifactURIs ...string) ModelProviderRecord {
+	props := []mrmodels.Properties{}
+	record := ModelProviderRecord{
+		Model: &models.CatalogModelImpl{
+			Attributes: &models.CatalogModelAttributes{Name: apiutils.Of(name)},
+			Properties: &props,
+		},
+		Files: files,
+	}
+	for _, uri := range artifactURIs {
+		record.Artifacts = append(record.Artifacts, sharedmodels.CatalogArtifact{
+			CatalogModelArtifact: &models.CatalogModelArtifactImpl{
+				Attributes: &models.CatalogModelArtifactAttributes{URI: apiutils.Of(uri)},
+			},
+		})
+	}
+	return record
+}
+
+func findProperty(props *[]mrmodels.Properties, name string) *mrmodels.Properties {
+	if props == nil {
+		return nil
+	}
+	for i := range *props {
+		if (*props)[i].Name == name {
+			return &(*props)[i]
+		}
+	}
+	return nil
+}
+
+func TestSerializationScanner(t *testing.T) {
+	tests := []struct {
+		name            string
+		files           []string
+		expectResult    bool
+		expectedFormats string
+		expectedUnsafe  bool
+	}{
+		{
+			name:            "safetensors only",
+			files:           []string{"README.md", "config.json", "model-00001-of-00002.safetensors", "model-00002-of-00002.safetensors"},
+			expectResult:    true,
+			expectedFormats: `["safetensors"]`,
+		},
+		{
+			name:            "pickled PyTorch weights",
+			files:           []string{"config.json", "pytorch_model.bin", "model.safetensors"},
+			expectResult:    true,
+			expectedFormats: `["pickle","safetensors"]`,
+			expectedUnsafe:  true,
+		},
+		{
+			name:            "nested files and upper case extensions",
+			files:           []string{"onnx/model.ONNX", "checkpoints/last.ckpt"},
+			expectResult:    true,
+			expectedFormats: `["onnx","pickle"]`,
+			expectedUnsafe:  true,
+		},
+		{
+			name:            "no weight files",
+			files:           []string{"README.md"},
+			expectResult:    true,
+			expectedFormats: `[]`,
+		},
+		{
+			name: "no file list",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := serializationScanner{}.Scan(context.Background(), newScanTestRecord("model", tt.files))
+			require.NoError(t, err)
+			if !tt.expectResult {
+				assert.Nil(t, result)
+				return
+			}
+			require.NotNil(t, result)
+
+			formats := findProperty(&result.Properties, serializationFormatsProperty)
+			require.NotNil(t, formats)
+			assert.Equal(t, tt.expectedFormats, *formats.StringValue)
+
+			unsafe := findProperty(&result.Properties, unsafeSerializationProperty)
+			require.NotNil(t, unsafe)
+			assert.Equal(t, tt.expectedUnsafe, *unsafe.BoolValue)
+		})
+	}
+}
+
+type countingScanner struct {
+	scans int
+}
+
+func (s *countingScanner) Scan(ctx context.Context, record ModelProviderRecord) (*ScanResult, error) {
+	s.scans++
+	return &ScanResult{
+		Properties: []mrmodels.Properties{
+			mrmodels.NewStringProperty("test_scan", "done", false),
+		},
+		ArtifactProperties: map[string][]mrmodels.Properties{
+			"oci://registry.example.com/model:1.0": {
+				mrmodels.NewStringProperty("test_artifact_scan", "done", true),
+			},
+		},
+	}, nil
+}
+
+func TestModelScanning(t *testing.T) {
+	scanner := &countingScanner{}
+	require.NoError(t, RegisterModelScanner("test-counting", func(*basecatalog.ScanConfig) (ModelScanner, error) {
+		return scanner, nil
+	}))
+	t.Cleanup(func() { delete(registeredModelScanners, "test-counting") })
+
+	config := &basecatalog.ScanConfig{Scanners: []string{"test-counting"}}
+	scanning := newModelScanning()
+	ctx := context.Background()
+
+	t.Run("results are applied to the model and its artifacts", func(t *testing.T) {
+		record := newScanTestRecord("src:model", []string{"model.safetensors"}, "oci://registry.example.com/model:1.0", "hf://org/model")
+		scanning.scan(ctx, config, record)
+
+		assert.Equal(t, 1, scanner.scans)
+		assert.Equal(t, "done", *findProperty(record.Model.GetProperties(), "test_scan").StringValue)
+		assert.NotNil(t, findProperty(record.Model.GetProperties(), scannedAtProperty))
+
+		ociArtifact := record.Artifacts[0].CatalogModelArtifact.(*models.CatalogModelArtifactImpl)
+		prop := findProperty(ociArtifact.CustomProperties, "test_artifact_scan")
+		require.NotNil(t, prop)
+		assert.True(t, prop.IsCustomProperty)
+
+		hfArtifact := record.Artifacts[1].CatalogModelArtifact.(*models.CatalogModelArtifactImpl)
+		assert.Nil(t, hfArtifact.CustomProperties)
+	})
+
+	t.Run("unchanged models reuse the last results", func(t *testing.T) {
+		record := newScanTestRecord("src:model", []string{"model.safetensors"}, "oci://registry.example.com/model:1.0", "hf://org/model")
+		scanning.scan(ctx, config, record)
+
+		assert.Equal(t, 1, scanner.scans)
+		assert.Equal(t, "done", *findProperty(record.Model.GetProperties(), "test_scan").StringValue)
+	})
+
+	t.Run("changed files are scanned again", func(t *testing.T) {
+		record := newScanTestRecord("src:model", []string{"model.safetensors", "pytorch_model.bin"}, "oci://registry.example.com/model:1.0", "hf://org/model")
+		scanning.scan(ctx, config, record)
+
+		assert.Equal(t, 2, scanner.scans)
+	})
+
+	t.Run("forgotten sources are scanned again", func(t *testing.T) {
+		scanning.forget("other")
+		record := newScanTestRecord("src:model", []string{"model.safetensors", "pytorch_model.bin"}, "oci://registry.example.com/model:1.0", "hf://org/model")
+		scanning.scan(ctx, config, record)
+		assert.Equal(t, 2, scanner.scans)
+
+		scanning.forget("src")
+		scanning.scan(ctx, config, record)
+		assert.Equal(t, 3, scanner.scans)
+	})
+
+	t.Run("configuration changes drop the last results", func(t *testing.T) {
+		changed := &basecatalog.ScanConfig{Scanners: []string{"test-counting"}, RescanInterval: "1h"}
+		record := newScanTestRecord("src:model", []string{"model.safetensors", "pytorch_model.bin"}, "oci://registry.example.com/model:1.0", "hf://org/model")
+		scanning.scan(ctx, changed, record)
+		assert.Equal(t, 4, scanner.scans)
+
+		// Equal configurations read again don't.
+		scanning.scan(ctx, &basecatalog.ScanConfig{Scanners: []string{"test-counting"}, RescanInterval: "1h"}, record)
+		assert.Equal(t, 4, scanner.scans)
+	})
+
+	t.Run("default scanners", func(t *testing.T) {
+		record := newScanTestRecord("src:default", []string{"model.bin"})
+		newModelScanning().scan(ctx, nil, record)
+
+		unsafe := findProperty(record.Model.GetProperties(), unsafeSerializationProperty)
+		require.NotNil(t, unsafe)
+		assert.True(t, *unsafe.BoolValue)
+		assert.Equal(t, 4, scanner.scans)
+	})
+
+	t.Run("models nothing applies to aren't marked as scanned", func(t *testing.T) {
+		record := newScanTestRecord("src:yaml", nil)
+		newModelScanning().scan(ctx, nil, record)
+
+		assert.Empty(t, *record.Model.GetProperties())
+	})
+}
@@ -0,0 +1,82 @@
+package modelcatalog
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/kubeflow/hub/catalog/internal/catalog/basecatalog"
+	mrmodels "github.com/kubeflow/hub/internal/platform/db/entity"
+)
+
+const (
+	serializationScannerName = "serialization"
+
+	serializationFormatsProperty = "serialization_formats"
+	unsafeSerializationProperty  = "unsafe_serialization"
+)
+
+// serializationFormats maps weight file extensions to the format they're
+// serialized with. PyTorch checkpoints (.bin, .pt, ...) are pickles.
+var serializationFormats = map[string]string{
+	".safetensors": "safetensors",
+	".gguf":        "gguf",
+	".onnx":        "onnx",
+	".bin":         "pickle",
+	".pt":          "pickle",
+	".pth":         "pickle",
+	".ckpt":        "pickle",
+	".pkl":         "pickle",
+	".pickle":      "pickle",
+	".joblib":      "pickle",
+}
+
+// unsafeSerializationFormats can run arbitrary code when they're loaded.
+var unsafeSerializationFormats = []string{"pickle"}
+
+// serializationScanner flags models whose weights are stored in formats
+// that are unsafe to load. It works from the model's file list, so it only
+// scans models from sources that list files, such as Hugging Face and S3.
+type serializationScanner struct{}
+
+func newSerializationScanner(*basecatalog.ScanConfig) (ModelScanner, error) {
+	return serializationScanner{}, nil
+}
+
+func (serializationScanner) Scan(ctx context.Context, record ModelProviderRecord) (*ScanResult, error) {
+	if len(record.Files) == 0 {
+		return nil, nil
+	}
+
+	formats := []string{}
+	unsafe := false
+	for _, file := range record.Files {
+		format, ok := serializationFormats[strings.ToLower(path.Ext(file))]
+		if !ok || slices.Contains(formats, format) {
+			continue
+		}
+		formats = append(formats, format)
+		unsafe = unsafe || slices.Contains(unsafeSerializationFormats, format)
+	}
+	slices.Sort(formats)
+
+	formatsJSON, err := json.Marshal(formats)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ScanResult{
+		Properties: []mrmodels.Properties{
+			mrmodels.NewStringProperty(serializationFormatsProperty, string(formatsJSON), false),
+			mrmodels.NewBoolProperty(unsafeSerializationProperty, unsafe, false),
+		},
+	}, nil
+}
+
+func init() {
+	if err := RegisterModelScanner(serializationScannerName, newSerializationScanner); err != nil {
+		panic(err)
+	}
+}
@@ -194,6 +194,69 @@ func (r *CatalogMetricsArtifactRepositoryImpl) lookupMetricsArtifactByName(name
 	return &entity, nil
 }
 
+func (r *CatalogMetricsArtifactRepositoryImpl) ListByParentResourceIDs(metricsType models.MetricsType, parentResourceIDs []int32) (map[int32][]models.CatalogMetricsArtifact, error) {
+	result := map[int32][]models.CatalogMetricsArtifact{}
+	if len(parentResourceIDs) == 0 {
+		return result, nil
+	}
+
+	config := r.GetConfig()
+	db := config.DB
+
+	artifactTable := utils.GetTableName(db, &schema.Artifact{})
+	attributionTable := utils.GetTableName(db, &schema.Attribution{})
+	propertyTable := utils.GetTableName(db, &schema.ArtifactProperty{})
+
+	var attributions []schema.Attribution
+	err := db.Table(attributionTable).
+		Select(attributionTable+".context_id, "+attributionTable+".artifact_id").
+		Joins(fmt.Sprintf("JOIN %s ON %s.id = %s.artifact_id", artifactTable, artifactTable, attributionTable)).
+		Joins(fmt.Sprintf("JOIN %s ON %s.artifact_id = %s.id AND %s.name = ? AND %s.is_custom_property = ?",
+			propertyTable, propertyTable, artifactTable, propertyTable, propertyTable), "metricsType", false).
+		Where(fmt.Sprintf("%s.type_id = ? AND %s.string_value = ? AND %s.context_id IN ?", artifactTable, propertyTable, attributionTable),
+			config.TypeID, string(metricsType), parentResourceIDs).
+		Scan(&attributions).Error
+	if err != nil {
+		return nil, fmt.Errorf("error listing %s attributions: %w", config.EntityName, err)
+	}
+	if len(attributions) == 0 {
+		return result, nil
+	}
+
+	artifactIDs := make([]int32, 0, len(attributions))
+	for _, attribution := range attributions {
+		artifactIDs = append(artifactIDs, attribution.ArtifactID)
+	}
+
+	var artifacts []schema.Artifact
+	if err := db.Where("id IN ?", artifactIDs).Find(&artifacts).Error; err != nil {
+		return nil, fmt.Errorf("error listing %s: %w", config.EntityName, err)
+	}
+
+	var properties []schema.ArtifactProperty
+	if err := db.Where("artifact_id IN ?", artifactIDs).Find(&properties).Error; err != nil {
+		return nil, fmt.Errorf("error listing %s properties: %w", config.EntityName, err)
+	}
+
+	propertiesByArtifact := map[int32][]schema.ArtifactProperty{}
+	for _, prop := range properties {
+		propertiesByArtifact[prop.ArtifactID] = append(propertiesByArtifact[prop.ArtifactID], prop)
+	}
+
+	artifactsByID := make(map[int32]models.CatalogMetricsArtifact, len(artifacts))
+	for _, artifact := range artifacts {
+		artifactsByID[artifact.ID] = mapDataLayerToCatalogMetricsArtifact(artifact, propertiesByArtifact[artifact.ID])
+	}
+
+	for _, attribution := range attributions {
+		if artifact, ok := artifactsByID[attribution.ArtifactID]; ok {
+			result[attribution.ContextID] = append(result[attribution.ContextID], artifact)
+		}
+	}
+
+	return result, nil
+}
+
 func applyCatalogMetricsArtifactListFilters(query *gorm.DB, listOptions *models.CatalogMetricsArtifactListOptions) *gorm.DB {
 	if listOptions.Name != nil {
 		query = query.Where("name LIKE ?", fmt.Sprintf("%%:%s", *listOptions.Name))
@@ -429,6 +429,56 @@ func TestCatalogMetricsArtifactRepository(t *testing.T) {
 		}
 	})
 
+	t.Run("TestListByParentResourceIDs", func(t *testing.T) {
+		var modelIDs []int32
+		for i := range 3 {
+			savedCatalogModel, err := catalogModelRepo.Save(&models.CatalogModelImpl{
+				Attributes: &models.CatalogModelAttributes{
+					Name:       apiutils.Of(fmt.Sprintf("test-catalog-model-by-parent-%d", i)),
+					ExternalID: apiutils.Of(fmt.Sprintf("catalog-model-by-parent-ext-%d", i)),
+				},
+			})
+			require.NoError(t, err)
+			modelIDs = append(modelIDs, *savedCatalogModel.GetID())
+		}
+
+		saveMetrics := func(name string, metricsType models.MetricsType, modelID int32) {
+			_, err := repo.Save(&models.CatalogMetricsArtifactImpl{
+				Attributes: &models.CatalogMetricsArtifactAttributes{
+					Name:        apiutils.Of(name),
+					ExternalID:  apiutils.Of(name + "-ext"),
+					MetricsType: metricsType,
+				},
+				CustomProperties: &[]dbmodels.Properties{
+					{Name: "overall_average", DoubleValue: apiutils.Of(0.5)},
+				},
+			}, &modelID)
+			require.NoError(t, err)
+		}
+		saveMetrics("by-parent-accuracy-0a", models.MetricsTypeAccuracy, modelIDs[0])
+		saveMetrics("by-parent-accuracy-0b", models.MetricsTypeAccuracy, modelIDs[0])
+		saveMetrics("by-parent-performance-0", models.MetricsTypePerformance, modelIDs[0])
+		saveMetrics("by-parent-accuracy-1", models.MetricsTypeAccuracy, modelIDs[1])
+		saveMetrics("by-parent-accuracy-2", models.MetricsTypeAccuracy, modelIDs[2])
+
+		result, err := repo.ListByParentResourceIDs(models.MetricsTypeAccuracy, modelIDs[:2])
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		require.Len(t, result[modelIDs[0]], 2)
+		require.Len(t, result[modelIDs[1]], 1)
+
+		artifact := result[modelIDs[1]][0]
+		assert.Equal(t, "by-parent-accuracy-1", *artifact.GetAttributes().Name)
+		assert.Equal(t, models.MetricsTypeAccuracy, artifact.GetAttributes().MetricsType)
+		require.NotNil(t, artifact.GetCustomProperties())
+		require.Len(t, *artifact.GetCustomProperties(), 1)
+		assert.Equal(t, 0.5, *(*artifact.GetCustomProperties())[0].DoubleValue)
+
+		empty, err := repo.ListByParentResourceIDs(models.MetricsTypeAccuracy, nil)
+		require.NoError(t, err)
+		assert.Empty(t, empty)
+	})
+
 	t.Run("TestSaveWithTypeIDSetting", func(t *testing.T) {
 		// Create a catalog model
 		catalogModel := &models.CatalogModelImpl{
@@ -7,7 +7,9 @@ import (
 
 	"github.com/golang/glog"
 	"github.com/kubeflow/hub/catalog/internal/catalog/modelcatalog/models"
+	"github.com/kubeflow/hub/catalog/internal/db/facets"
 	"github.com/kubeflow/hub/catalog/internal/db/filter"
+	sharedmodels "github.com/kubeflow/hub/catalog/internal/db/models"
 	catpagination "github.com/kubeflow/hub/catalog/internal/db/pagination"
 	"github.com/kubeflow/hub/internal/platform/db/dbutil"
 	dbfilter "github.com/kubeflow/hub/internal/platform/db/filter"
@@ -21,6 +23,18 @@ import (
 
 var ErrCatalogModelNotFound = errors.New("catalog model by id not found")
 
+const deprecatedProperty = "deprecated"
+
+// Weights of the fields that q matches for orderBy=RELEVANCE. Each weight is
+// larger than the sum of the ones after it, so a match in a field outranks
+// matches in all of the fields after it.
+const (
+	nameRelevance        = 8
+	tasksRelevance       = 4
+	descriptionRelevance = 2
+	readmeRelevance      = 1
+)
+
 type CatalogModelRepositoryImpl struct {
 	*service.GenericRepository[models.CatalogModel, schema.Context, schema.ContextProperty, *models.CatalogModelListOptions]
 }
@@ -59,17 +73,7 @@ func (r *CatalogModelRepositoryImpl) Save(model models.CatalogModel) (models.Cat
 
 	attr := model.GetAttributes()
 	if model.GetID() == nil && attr != nil && attr.Name != nil {
-		lookupName := *attr.Name
-		// When source_id is present, use namespaced name (sourceId:modelName) for uniqueness and storage.
-		// Only prefix if not already in sourceId:modelName form (avoid double-prefix; allow display names containing ":").
-		if sourceID := getSourceIDFromProperties(model); sourceID != "" {
-			prefix := sourceID + ":"
-			if !strings.HasPrefix(lookupName, prefix) {
-				namespacedName := prefix + lookupName
-				attr.Name = &namespacedName
-				lookupName = namespacedName
-			}
-		}
+		lookupName := namespaceModelName(model)
 		existing, err := r.lookupModelByName(lookupName)
 		if err != nil {
 			if !errors.Is(err, ErrCatalogModelNotFound) {
@@ -83,6 +87,36 @@ func (r *CatalogModelRepositoryImpl) Save(model models.CatalogModel) (models.Cat
 	return r.GenericRepository.Save(model, nil)
 }
 
+func (r *CatalogModelRepositoryImpl) Create(model models.CatalogModel) (models.CatalogModel, error) {
+	config := r.GetConfig()
+	if model.GetTypeID() == nil && config.TypeID > 0 {
+		model.SetTypeID(config.TypeID)
+	}
+
+	if attr := model.GetAttributes(); attr != nil && attr.Name != nil {
+		namespaceModelName(model)
+	}
+
+	return r.GenericRepository.Save(model, nil)
+}
+
+// namespaceModelName prefixes the name of model with its source ID, as
+// sourceId:modelName, if it has one, and returns the name.
+func namespaceModelName(model models.CatalogModel) string {
+	attr := model.GetAttributes()
+	name := *attr.Name
+	// When source_id is present, use namespaced name (sourceId:modelName) for uniqueness and storage.
+	// Only prefix if not already in sourceId:modelName form (avoid double-prefix; allow display names containing ":").
+	if sourceID := getSourceIDFromProperties(model); sourceID != "" {
+		prefix := sourceID + ":"
+		if !strings.HasPrefix(name, prefix) {
+			name = prefix + name
+			attr.Name = &name
+		}
+	}
+	return name
+}
+
 // getSourceIDFromProperties returns the source_id property value if present.
 func getSourceIDFromProperties(model models.CatalogModel) string {
 	if model.GetProperties() == nil {
@@ -121,6 +155,12 @@ func escapeLike(s string) string {
 	return b.String()
 }
 
+// queryLikePattern returns the LIKE pattern, for lowercased values, of the
+// fields that contain q.
+func queryLikePattern(q string) string {
+	return "%" + escapeLike(strings.ToLower(q)) + "%"
+}
+
 // ApplyStandardPagination overrides the base implementation to use catalog-specific allowed columns
 func (r *CatalogModelRepositoryImpl) ApplyStandardPagination(query *gorm.DB, listOptions *models.CatalogModelListOptions, entities any) *gorm.DB {
 	pageSize := listOptions.GetPageSize()
@@ -273,6 +313,25 @@ func (r *CatalogModelRepositoryImpl) DeleteByID(id int32) error {
 // GetDistinctSourceIDs retrieves all unique source_id values from catalog models.
 // This method queries the ContextProperty table to find distinct string_value entries
 // where the property name is 'source_id'.
+func (r *CatalogModelRepositoryImpl) GetDistinctSourceIDsWithProperty(name, value string) ([]string, error) {
+	config := r.GetConfig()
+
+	var sourceIDs []string
+
+	query := `SELECT DISTINCT source.string_value FROM "ContextProperty" source
+		INNER JOIN "ContextProperty" prop ON prop.context_id=source.context_id
+			AND prop.name=? AND prop.string_value=?
+		INNER JOIN "Context" ON "Context".id=source.context_id AND "Context".type_id=?
+		WHERE source.name='source_id'`
+
+	if err := config.DB.Raw(query, name, value, config.TypeID).Scan(&sourceIDs).Error; err != nil {
+		err = dbutil.SanitizeDatabaseError(err)
+		return nil, fmt.Errorf("error querying source IDs with property %s: %w", name, err)
+	}
+
+	return sourceIDs, nil
+}
+
 func (r *CatalogModelRepositoryImpl) GetDistinctSourceIDs() ([]string, error) {
 	config := r.GetConfig()
 
@@ -310,6 +369,39 @@ func (r *CatalogModelRepositoryImpl) GetTypeID() int32 {
 	return r.GetConfig().TypeID
 }
 
+// SaveRecommendedLatencies replaces the precomputed recommended latencies of
+// a model. They're removed with the model via foreign key cascade.
+func (r *CatalogModelRepositoryImpl) SaveRecommendedLatencies(modelID int32, latencies []models.RecommendedLatency) error {
+	config := r.GetConfig()
+
+	rows := make([]schema.ModelRecommendedLatency, len(latencies))
+	for i, latency := range latencies {
+		rows[i] = schema.ModelRecommendedLatency{
+			ContextID:       modelID,
+			LatencyProperty: latency.LatencyProperty,
+			TargetRps:       latency.TargetRPS,
+			UseCase:         latency.UseCase,
+			HardwareType:    latency.HardwareType,
+			Latency:         latency.Latency,
+		}
+	}
+
+	err := config.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("context_id = ?", modelID).Delete(&schema.ModelRecommendedLatency{}).Error; err != nil {
+			return fmt.Errorf("unable to delete recommended latencies for model %d: %w", modelID, err)
+		}
+
+		if len(rows) == 0 {
+			return nil
+		}
+		if err := tx.CreateInBatches(rows, 500).Error; err != nil {
+			return fmt.Errorf("unable to save recommended latencies for model %d: %w", modelID, err)
+		}
+		return nil
+	})
+	return dbutil.SanitizeDatabaseError(err)
+}
+
 func applyCatalogModelListFilters(query *gorm.DB, listOptions *models.CatalogModelListOptions) *gorm.DB {
 	contextTable := utils.GetTableName(query.Statement.DB, &schema.Context{})
 
@@ -324,14 +416,14 @@ func applyCatalogModelListFilters(query *gorm.DB, listOptions *models.CatalogMod
 	}
 
 	if listOptions.Query != nil && *listOptions.Query != "" {
-		queryPattern := fmt.Sprintf("%%%s%%", strings.ToLower(*listOptions.Query))
+		queryPattern := queryLikePattern(*listOptions.Query)
 		propertyTable := utils.GetTableName(query.Statement.DB, &schema.ContextProperty{})
 
 		// Search in name (context table)
 		nameCondition := fmt.Sprintf("LOWER(%s.name) LIKE ?", contextTable)
 
-		// Search in description, provider, libraryName properties
-		propertyCondition := fmt.Sprintf("EXISTS (SELECT 1 FROM %s cp WHERE cp.context_id = %s.id AND cp.name IN (?, ?, ?) AND LOWER(cp.string_value) LIKE ?)",
+		// Search in description, readme, provider, libraryName properties
+		propertyCondition := fmt.Sprintf("EXISTS (SELECT 1 FROM %s cp WHERE cp.context_id = %s.id AND cp.name IN (?, ?, ?, ?) AND LOWER(cp.string_value) LIKE ?)",
 			propertyTable, contextTable)
 
 		// Search in tasks (assuming tasks are stored as comma-separated or multiple properties)
@@ -339,8 +431,8 @@ func applyCatalogModelListFilters(query *gorm.DB, listOptions *models.CatalogMod
 			propertyTable, contextTable)
 
 		query = query.Where(fmt.Sprintf("(%s OR %s OR %s)", nameCondition, propertyCondition, tasksCondition),
-			queryPattern,                                           // for name
-			"description", "provider", "libraryName", queryPattern, // for properties
+			queryPattern,                                                     // for name
+			"description", "readme", "provider", "libraryName", queryPattern, // for properties
 			"tasks", queryPattern, // for tasks
 		)
 	}
@@ -363,6 +455,22 @@ func applyCatalogModelListFilters(query *gorm.DB, listOptions *models.CatalogMod
 			Where("cp.name = ? AND cp.string_value IN ?", "source_id", nonEmptySourceIDs)
 	}
 
+	if len(listOptions.VariantGroupIDs) > 0 {
+		propertyTable := utils.GetTableName(query.Statement.DB, &schema.ContextProperty{})
+
+		query = query.Where(fmt.Sprintf("EXISTS (SELECT 1 FROM %s vg WHERE vg.context_id = %s.id AND vg.name = ? AND vg.string_value IN ?)", propertyTable, contextTable),
+			models.VariantGroupProperty, listOptions.VariantGroupIDs)
+	}
+
+	if listOptions.ExcludeDeprecated {
+		propertyTable := utils.GetTableName(query.Statement.DB, &schema.ContextProperty{})
+
+		// Models without the property, such as models from Hugging Face,
+		// aren't deprecated.
+		query = query.Where(fmt.Sprintf("NOT EXISTS (SELECT 1 FROM %s dp WHERE dp.context_id = %s.id AND dp.name = ? AND dp.bool_value = ?)", propertyTable, contextTable),
+			deprecatedProperty, true)
+	}
+
 	return query
 }
 
@@ -472,8 +580,12 @@ func (r *CatalogModelRepositoryImpl) applyCustomOrdering(query *gorm.DB, listOpt
 	contextTable := utils.GetTableName(db, &schema.Context{})
 	orderBy := listOptions.GetOrderBy()
 
+	if listOptions.GroupByVariant {
+		query = r.applyVariantGrouping(query, listOptions)
+	}
+
 	// Handle NAME ordering specially (catalog-specific)
-	if orderBy == "NAME" {
+	if orderBy == "NAME" && listOptions.RecommendedLatency == nil {
 		return catpagination.ApplyNameOrdering(query, contextTable, listOptions.GetSortOrder(), listOptions.GetNextPageToken(), listOptions.GetPageSize(), true)
 	}
 
@@ -490,7 +602,9 @@ func (r *CatalogModelRepositoryImpl) applyCustomOrdering(query *gorm.DB, listOpt
 
 	// Apply sorting order
 	sortOrder := listOptions.GetSortOrder()
-	if sortOrder != "ASC" {
+	if listOptions.RecommendedLatency != nil {
+		sortOrder = "ASC"
+	} else if sortOrder != "ASC" {
 		sortOrder = "DESC"
 	}
 	query = query.Order(fmt.Sprintf("sort_value.%s %s NULLS LAST, %s.id", sortColumn, sortOrder, contextTable))
@@ -517,6 +631,51 @@ func (r *CatalogModelRepositoryImpl) applyCustomOrdering(query *gorm.DB, listOpt
 	return query
 }
 
+// applyVariantGrouping leaves out the models that share a variant group
+// with a model of a lower ID that matches the same list options. Grouping is
+// a plain condition on the listed models, so it works with every ordering
+// and with cursor pagination.
+func (r *CatalogModelRepositoryImpl) applyVariantGrouping(query *gorm.DB, listOptions *models.CatalogModelListOptions) *gorm.DB {
+	config := r.GetConfig()
+	contextTable := utils.GetTableName(config.DB, &schema.Context{})
+	propertyTable := utils.GetTableName(config.DB, &schema.ContextProperty{})
+
+	matching, err := r.matchingIDs(listOptions)
+	if err != nil {
+		_ = query.AddError(err)
+		return query
+	}
+
+	grouped := config.DB.Table(propertyTable+" later").
+		Select("later.context_id").
+		Joins(fmt.Sprintf("JOIN %s earlier ON earlier.name=later.name AND earlier.string_value=later.string_value AND earlier.context_id<later.context_id", propertyTable)).
+		Where("later.name = ? AND earlier.context_id IN (?)", models.VariantGroupProperty, matching)
+
+	return query.Where(fmt.Sprintf("%s.id NOT IN (?)", contextTable), grouped)
+}
+
+// matchingIDs returns a query for the IDs of the models that match the
+// filters of listOptions.
+func (r *CatalogModelRepositoryImpl) matchingIDs(listOptions *models.CatalogModelListOptions) (*gorm.DB, error) {
+	config := r.GetConfig()
+	contextTable := utils.GetTableName(config.DB, &schema.Context{})
+
+	matching := config.DB.Table(contextTable).
+		Select(contextTable+".id").
+		Where(contextTable+".type_id=?", config.TypeID)
+	matching = applyCatalogModelListFilters(matching, listOptions)
+	return service.ApplyFilterQuery(matching, listOptions, config.EntityMappingFuncs)
+}
+
+func (r *CatalogModelRepositoryImpl) CountPropertyValues(listOptions models.CatalogModelListOptions, options []sharedmodels.PropertyOption) (facets.Counts, error) {
+	matching, err := r.matchingIDs(&listOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return facets.CountContextPropertyValues(r.GetConfig().DB, matching, options)
+}
+
 // applyCursorPagination applies WHERE clause for cursor-based pagination with ACCURACY sorting
 func (r *CatalogModelRepositoryImpl) applyCursorPagination(query *gorm.DB, cursor *scopes.Cursor, sortColumn, sortOrder string) *gorm.DB {
 	contextTable := utils.GetTableName(query, &schema.Context{})
@@ -539,7 +698,7 @@ func (r *CatalogModelRepositoryImpl) applyCursorPagination(query *gorm.DB, curso
 
 func (r *CatalogModelRepositoryImpl) createPaginationToken(lastItem schema.Context, listOptions *models.CatalogModelListOptions) string {
 	// Handle NAME ordering (catalog-specific)
-	if listOptions.GetOrderBy() == "NAME" {
+	if listOptions.GetOrderBy() == "NAME" && listOptions.RecommendedLatency == nil {
 		displayName := lastItem.Name
 		if _, after, ok := strings.Cut(lastItem.Name, ":"); ok {
 			displayName = after
@@ -593,6 +752,38 @@ func (r *CatalogModelRepositoryImpl) sortValueQuery(listOptions *models.CatalogM
 	var valueColumn string
 
 	switch {
+	case listOptions.RecommendedLatency != nil:
+		// The precomputed latency for the profile. With several hardware
+		// types, the lowest latency on any of them is used.
+		profile := listOptions.RecommendedLatency
+		latencyTable := utils.GetTableName(db, &schema.ModelRecommendedLatency{})
+		valueColumn = "double_value"
+
+		joinCondition := fmt.Sprintf("LEFT JOIN %s ON %s.id=%s.context_id AND %s.latency_property=? AND %s.target_rps=? AND %s.use_case=?",
+			latencyTable, contextTable, latencyTable, latencyTable, latencyTable, latencyTable)
+		joinArgs := []any{profile.LatencyProperty, profile.TargetRPS, profile.UseCase}
+		if len(profile.HardwareTypes) > 0 {
+			joinCondition += fmt.Sprintf(" AND %s.hardware_type IN ?", latencyTable)
+			joinArgs = append(joinArgs, profile.HardwareTypes)
+		}
+
+		query = query.
+			Select(fmt.Sprintf("min(%s.latency) AS %s", latencyTable, valueColumn), extraColumns...).
+			Joins(joinCondition, joinArgs...)
+	case listOptions.GetOrderBy() == models.OrderByRelevance && listOptions.Query != nil && *listOptions.Query != "":
+		// The sum of the weights of the fields that the query matches.
+		// Each property is joined at most once per model, so the sum
+		// counts every matching field once.
+		propertyTable := utils.GetTableName(db, &schema.ContextProperty{})
+		queryPattern := queryLikePattern(*listOptions.Query)
+		valueColumn = "int_value"
+
+		query = query.
+			Select(fmt.Sprintf("max(CASE WHEN relevance_name.id IS NULL THEN 0 ELSE %d END) + COALESCE(sum(CASE relevance_property.name WHEN 'tasks' THEN %d WHEN 'description' THEN %d WHEN 'readme' THEN %d ELSE 0 END), 0) AS %s",
+				nameRelevance, tasksRelevance, descriptionRelevance, readmeRelevance, valueColumn), extraColumns...).
+			Joins(fmt.Sprintf("LEFT JOIN %s relevance_name ON relevance_name.id=%s.id AND LOWER(relevance_name.name) LIKE ?", contextTable, contextTable), queryPattern).
+			Joins(fmt.Sprintf("LEFT JOIN %s relevance_property ON relevance_property.context_id=%s.id AND relevance_property.name IN ? AND LOWER(relevance_property.string_value) LIKE ?", propertyTable, contextTable),
+				[]string{"tasks", "description", "readme"}, queryPattern)
 	case len(orderBy) == 3 && orderBy[0] == "artifacts":
 		// artifacts.<property>.<value_column> e.g. artifacts.ttft_p90.double_value
 
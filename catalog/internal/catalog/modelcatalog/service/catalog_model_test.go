@@ -1128,6 +1128,203 @@ func TestCatalogModelRepository(t *testing.T) {
 		}
 	})
 
+	t.Run("TestRecommendedLatencySortingPagination", func(t *testing.T) {
+		sourceID := "recommended-latency-source"
+		saveModel := func(name string) int32 {
+			saved, err := repo.Save(&models.CatalogModelImpl{
+				Attributes: &models.CatalogModelAttributes{
+					Name: apiutils.Of(sourceID + ":" + name),
+				},
+				Properties: &[]dbmodels.Properties{
+					{Name: "source_id", StringValue: apiutils.Of(sourceID)},
+				},
+			})
+			require.NoError(t, err)
+			return *saved.GetID()
+		}
+		latency := func(hardwareType string, useCase string, value float64) models.RecommendedLatency {
+			return models.RecommendedLatency{
+				LatencyProperty: "ttft_p90",
+				TargetRPS:       1,
+				UseCase:         useCase,
+				HardwareType:    hardwareType,
+				Latency:         value,
+			}
+		}
+
+		fast := saveModel("fast")
+		slow := saveModel("slow")
+		none := saveModel("none")
+		mixed := saveModel("mixed")
+
+		require.NoError(t, repo.SaveRecommendedLatencies(fast, []models.RecommendedLatency{
+			latency("H100", "", 10),
+			latency("H100", "chatbot", 10),
+		}))
+		require.NoError(t, repo.SaveRecommendedLatencies(slow, []models.RecommendedLatency{
+			latency("H100", "", 90),
+		}))
+		require.NoError(t, repo.SaveRecommendedLatencies(mixed, []models.RecommendedLatency{
+			latency("H100", "", 100),
+			latency("A100", "", 5),
+			latency("A100", "chatbot", 50),
+		}))
+		// Saving again replaces the latencies that were saved before.
+		require.NoError(t, repo.SaveRecommendedLatencies(slow, []models.RecommendedLatency{
+			latency("H100", "", 20),
+		}))
+
+		list := func(profile models.RecommendedLatencyProfile) []int32 {
+			var ids []int32
+			listOptions := models.CatalogModelListOptions{
+				SourceIDs:          &[]string{sourceID},
+				RecommendedLatency: &profile,
+				Pagination: dbmodels.Pagination{
+					PageSize: apiutils.Of(int32(1)),
+				},
+			}
+			for {
+				result, err := repo.List(listOptions)
+				require.NoError(t, err)
+				for _, item := range result.Items {
+					ids = append(ids, *item.GetID())
+				}
+				if result.NextPageToken == "" {
+					return ids
+				}
+				listOptions.NextPageToken = apiutils.Of(result.NextPageToken)
+			}
+		}
+
+		assert.Equal(t, []int32{mixed, fast, slow, none},
+			list(models.RecommendedLatencyProfile{LatencyProperty: "ttft_p90", TargetRPS: 1}),
+			"the lowest latency on any hardware type is used")
+		assert.Equal(t, []int32{fast, slow, mixed, none},
+			list(models.RecommendedLatencyProfile{LatencyProperty: "ttft_p90", TargetRPS: 1, HardwareTypes: []string{"H100"}}))
+		assert.Equal(t, []int32{fast, mixed, slow, none},
+			list(models.RecommendedLatencyProfile{LatencyProperty: "ttft_p90", TargetRPS: 1, UseCase: "chatbot"}))
+		assert.Equal(t, []int32{fast, slow, none, mixed},
+			list(models.RecommendedLatencyProfile{LatencyProperty: "e2e_p90", TargetRPS: 1}),
+			"models without a latency for the profile are sorted by ID")
+
+		// Latencies are removed with the model.
+		require.NoError(t, repo.DeleteByID(mixed))
+		var remaining int64
+		require.NoError(t, sharedDB.Model(&schema.ModelRecommendedLatency{}).Where("context_id = ?", mixed).Count(&remaining).Error)
+		assert.Zero(t, remaining)
+	})
+
+	t.Run("TestVariantGroupingPagination", func(t *testing.T) {
+		sourceID := "variant-grouping-source"
+		saveModel := func(name string, groupID string, tensorType string) int32 {
+			customProperties := []dbmodels.Properties{}
+			if groupID != "" {
+				customProperties = append(customProperties, dbmodels.Properties{Name: "variant_group_id", StringValue: apiutils.Of(groupID), IsCustomProperty: true})
+			}
+			if tensorType != "" {
+				customProperties = append(customProperties, dbmodels.Properties{Name: "tensor_type", StringValue: apiutils.Of(tensorType), IsCustomProperty: true})
+			}
+			saved, err := repo.Save(&models.CatalogModelImpl{
+				Attributes: &models.CatalogModelAttributes{
+					Name: apiutils.Of(sourceID + ":" + name),
+				},
+				Properties: &[]dbmodels.Properties{
+					{Name: "source_id", StringValue: apiutils.Of(sourceID)},
+				},
+				CustomProperties: &customProperties,
+			})
+			require.NoError(t, err)
+			return *saved.GetID()
+		}
+
+		aINT4 := saveModel("a-int4", "group-a", "INT4")
+		aFP16 := saveModel("a-fp16", "group-a", "FP16")
+		saveModel("a-fp8", "group-a", "FP8")
+		bFP8 := saveModel("b-fp8", "group-b", "FP8")
+		bFP16 := saveModel("b-fp16", "group-b", "FP16")
+		c := saveModel("c", "", "")
+
+		list := func(listOptions models.CatalogModelListOptions) []int32 {
+			var ids []int32
+			listOptions.SourceIDs = &[]string{sourceID}
+			listOptions.PageSize = apiutils.Of(int32(1))
+			for {
+				result, err := repo.List(listOptions)
+				require.NoError(t, err)
+				for _, item := range result.Items {
+					ids = append(ids, *item.GetID())
+				}
+				if result.NextPageToken == "" {
+					return ids
+				}
+				listOptions.NextPageToken = apiutils.Of(result.NextPageToken)
+			}
+		}
+
+		assert.Equal(t, []int32{aINT4, bFP8, c}, list(models.CatalogModelListOptions{
+			GroupByVariant: true,
+			Pagination:     dbmodels.Pagination{OrderBy: apiutils.Of("NAME"), SortOrder: apiutils.Of("ASC")},
+		}), "each variant group is listed once, as its first model")
+		assert.Equal(t, []int32{aINT4, bFP8, c}, list(models.CatalogModelListOptions{
+			GroupByVariant: true,
+			Pagination:     dbmodels.Pagination{OrderBy: apiutils.Of("ID"), SortOrder: apiutils.Of("ASC")},
+		}))
+		assert.Equal(t, []int32{aFP16, bFP16}, list(models.CatalogModelListOptions{
+			GroupByVariant: true,
+			Pagination:     dbmodels.Pagination{FilterQuery: apiutils.Of("tensor_type='FP16'")},
+		}), "groups are represented by the first model that matches the filter")
+
+		result, err := repo.List(models.CatalogModelListOptions{
+			VariantGroupIDs: []string{"group-b"},
+		})
+		require.NoError(t, err)
+		var ids []int32
+		for _, item := range result.Items {
+			ids = append(ids, *item.GetID())
+		}
+		assert.ElementsMatch(t, []int32{bFP8, bFP16}, ids)
+	})
+
+	t.Run("TestExcludeDeprecated", func(t *testing.T) {
+		sourceID := "deprecation-source"
+		saveModel := func(name string, deprecated *bool) int32 {
+			properties := []dbmodels.Properties{
+				{Name: "source_id", StringValue: apiutils.Of(sourceID)},
+			}
+			if deprecated != nil {
+				properties = append(properties, dbmodels.Properties{Name: "deprecated", BoolValue: deprecated})
+			}
+			saved, err := repo.Save(&models.CatalogModelImpl{
+				Attributes: &models.CatalogModelAttributes{
+					Name: apiutils.Of(sourceID + ":" + name),
+				},
+				Properties: &properties,
+			})
+			require.NoError(t, err)
+			return *saved.GetID()
+		}
+
+		old := saveModel("granite-3.0", apiutils.Of(true))
+		current := saveModel("granite-3.1", apiutils.Of(false))
+		unset := saveModel("granite-3.2", nil)
+
+		list := func(excludeDeprecated bool) []int32 {
+			result, err := repo.List(models.CatalogModelListOptions{
+				SourceIDs:         &[]string{sourceID},
+				ExcludeDeprecated: excludeDeprecated,
+			})
+			require.NoError(t, err)
+			var ids []int32
+			for _, item := range result.Items {
+				ids = append(ids, *item.GetID())
+			}
+			return ids
+		}
+
+		assert.ElementsMatch(t, []int32{current, unset}, list(true))
+		assert.ElementsMatch(t, []int32{old, current, unset}, list(false))
+	})
+
 	t.Run("TestDeleteBySource", func(t *testing.T) {
 		// Setup: Create models with different source IDs
 		sourceID1 := "test_source_1"
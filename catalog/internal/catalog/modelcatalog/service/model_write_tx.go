@@ -0,0 +1,57 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/kubeflow/hub/catalog/internal/catalog/modelcatalog/models"
+	"gorm.io/gorm"
+)
+
+// ModelWriteTx holds the repositories that write a model and its artifacts,
+// bound to a single database transaction.
+type ModelWriteTx struct {
+	Models           models.CatalogModelRepository
+	ModelArtifacts   models.CatalogModelArtifactRepository
+	MetricsArtifacts models.CatalogMetricsArtifactRepository
+
+	tx                    *gorm.DB
+	modelArtifactTypeID   int32
+	metricsArtifactTypeID int32
+}
+
+// InModelWriteTx runs fn in a transaction of the database behind the given
+// repositories, which is rolled back if fn returns an error.
+func InModelWriteTx(modelRepo models.CatalogModelRepository, modelArtifactRepo models.CatalogModelArtifactRepository, metricsArtifactRepo models.CatalogMetricsArtifactRepository, fn func(ModelWriteTx) error) error {
+	m, ok := modelRepo.(*CatalogModelRepositoryImpl)
+	if !ok {
+		return fmt.Errorf("transactions not supported by %T", modelRepo)
+	}
+	ma, ok := modelArtifactRepo.(*CatalogModelArtifactRepositoryImpl)
+	if !ok {
+		return fmt.Errorf("transactions not supported by %T", modelArtifactRepo)
+	}
+	mta, ok := metricsArtifactRepo.(*CatalogMetricsArtifactRepositoryImpl)
+	if !ok {
+		return fmt.Errorf("transactions not supported by %T", metricsArtifactRepo)
+	}
+
+	return m.GetConfig().DB.Transaction(func(tx *gorm.DB) error {
+		return fn(ModelWriteTx{
+			Models:                NewCatalogModelRepository(tx, m.GetConfig().TypeID),
+			ModelArtifacts:        NewCatalogModelArtifactRepository(tx, ma.GetConfig().TypeID),
+			MetricsArtifacts:      NewCatalogMetricsArtifactRepository(tx, mta.GetConfig().TypeID),
+			tx:                    tx,
+			modelArtifactTypeID:   ma.GetConfig().TypeID,
+			metricsArtifactTypeID: mta.GetConfig().TypeID,
+		})
+	})
+}
+
+// DeleteArtifacts removes the model and metrics artifacts of a model.
+func (t ModelWriteTx) DeleteArtifacts(modelID int32) error {
+	err := t.tx.Exec(`DELETE FROM "Artifact" WHERE id IN (SELECT artifact_id from "Attribution" INNER JOIN "Artifact" artifact ON artifact.id=artifact_id where context_id=? and type_id IN (?, ?))`, modelID, t.modelArtifactTypeID, t.metricsArtifactTypeID).Error
+	if err != nil {
+		return fmt.Errorf("unable to delete artifacts of model %d: %w", modelID, err)
+	}
+	return nil
+}
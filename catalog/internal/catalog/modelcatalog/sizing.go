@@ -0,0 +1,90 @@
+package modelcatalog
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	apimodels "github.com/kubeflow/hub/catalog/pkg/openapi"
+	"github.com/kubeflow/hub/pkg/api"
+)
+
+type ModelSizingParams struct {
+	TargetRPS             int32
+	MaxLatency            []string // latency objectives as "property:value"
+	RPSProperty           string   // configurable "requests_per_second"
+	HardwareCountProperty string   // configurable "hardware_count"
+	HardwareTypeProperty  string   // configurable "hardware_type"
+	FilterQuery           string
+}
+
+func (d *dbCatalogImpl) GetModelSizing(ctx context.Context, modelName string, sourceID string, params ModelSizingParams) (*apimodels.CatalogModelSizing, error) {
+	maxLatency, err := parseMaxLatency(params.MaxLatency)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := d.getDBModel(modelName, sourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var hardwareCosts map[string]float64
+	if d.sources != nil {
+		hardwareCosts = d.sources.GetHardwareCosts()
+	}
+
+	sizingParams := SizingParams{
+		TargetRPS:             params.TargetRPS,
+		MaxLatency:            maxLatency,
+		HardwareCosts:         hardwareCosts,
+		RPSProperty:           params.RPSProperty,
+		HardwareCountProperty: params.HardwareCountProperty,
+		HardwareTypeProperty:  params.HardwareTypeProperty,
+		FilterQuery:           params.FilterQuery,
+	}
+	items, err := d.performanceService.SizeDeployment(*model.GetID(), sizingParams)
+	if err != nil {
+		return nil, err
+	}
+
+	targetRPS := params.TargetRPS
+	if targetRPS <= 0 {
+		targetRPS = 1
+	}
+
+	return &apimodels.CatalogModelSizing{
+		TargetRPS:  targetRPS,
+		MaxLatency: maxLatency,
+		Items:      items,
+	}, nil
+}
+
+// parseMaxLatency parses latency objectives in the form "property:value".
+func parseMaxLatency(values []string) (map[string]float64, error) {
+	maxLatency := make(map[string]float64, len(values))
+	for _, value := range values {
+		if value == "" {
+			continue
+		}
+
+		property, limit, ok := strings.Cut(value, ":")
+		property = strings.TrimSpace(property)
+		if !ok || property == "" {
+			return nil, fmt.Errorf("invalid latency objective %q, expected property:value: %w", value, api.ErrBadRequest)
+		}
+
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(limit), 64)
+		if err != nil || parsed <= 0 {
+			return nil, fmt.Errorf("invalid latency objective %q, the value must be a positive number: %w", value, api.ErrBadRequest)
+		}
+
+		if existing, ok := maxLatency[property]; ok && existing < parsed {
+			// The strictest objective for a property wins.
+			continue
+		}
+		maxLatency[property] = parsed
+	}
+	return maxLatency, nil
+}
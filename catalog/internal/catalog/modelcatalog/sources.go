@@ -2,27 +2,32 @@ package modelcatalog
 
 import (
 	"maps"
+	"path/filepath"
 	"slices"
 	"strings"
 	"sync"
 
 	"github.com/kubeflow/hub/catalog/internal/catalog/basecatalog"
 	model "github.com/kubeflow/hub/catalog/pkg/openapi"
+	"github.com/kubeflow/hub/internal/licensepolicy"
 	"github.com/kubeflow/hub/internal/platform/apiutils"
 )
 
 // originEntry holds sources from a single origin (config file).
 type originEntry struct {
-	origin  string
-	sources map[string]basecatalog.ModelSource
+	origin        string
+	sources       map[string]basecatalog.ModelSource
+	hardwareCosts map[string]float64
 }
 
 // SourceCollection manages catalog sources from multiple origins with priority-based merging.
 // Later entries in the slice take precedence over earlier ones.
 type SourceCollection struct {
-	mu           sync.RWMutex
-	entries      []originEntry
-	namedQueries map[string]map[string]basecatalog.FieldFilter
+	mu            sync.RWMutex
+	entries       []originEntry
+	namedQueries  map[string]map[string]basecatalog.FieldFilter
+	licensePolicy *licensepolicy.Policy
+	scanConfig    *basecatalog.ScanConfig
 }
 
 // NewSourceCollection creates a new SourceCollection with the given origin order.
@@ -77,17 +82,21 @@ func (sc *SourceCollection) MergeWithNamedQueries(origin string, sources map[str
 
 // mergeSourcesInternal extracts the internal logic from Merge
 func (sc *SourceCollection) mergeSourcesInternal(origin string, sources map[string]basecatalog.ModelSource) error {
-	// Find existing entry for this origin
+	sc.entry(origin).sources = sources
+	return nil
+}
+
+// entry returns the entry of an origin, appending it if it's not found
+// (dynamic registration).
+func (sc *SourceCollection) entry(origin string) *originEntry {
 	for i := range sc.entries {
 		if sc.entries[i].origin == origin {
-			sc.entries[i].sources = sources
-			return nil
+			return &sc.entries[i]
 		}
 	}
 
-	// Origin not found, append it (dynamic registration)
-	sc.entries = append(sc.entries, originEntry{origin: origin, sources: sources})
-	return nil
+	sc.entries = append(sc.entries, originEntry{origin: origin})
+	return &sc.entries[len(sc.entries)-1]
 }
 
 // GetNamedQueries returns all merged named queries
@@ -104,6 +113,72 @@ func (sc *SourceCollection) GetNamedQueries() map[string]map[string]basecatalog.
 	return result
 }
 
+// MergeHardwareCosts sets the hourly cost of hardware types from one origin,
+// completely replacing the costs previously from that origin. Costs from
+// higher-priority origins override the costs of the same hardware types from
+// lower-priority ones.
+func (sc *SourceCollection) MergeHardwareCosts(origin string, costs map[string]float64) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	sc.entry(origin).hardwareCosts = maps.Clone(costs)
+}
+
+// GetHardwareCosts returns all merged hardware costs
+func (sc *SourceCollection) GetHardwareCosts() map[string]float64 {
+	sc.mu.RLock()
+	defer sc.mu.RUnlock()
+
+	costs := map[string]float64{}
+	for _, entry := range sc.entries {
+		maps.Copy(costs, entry.hardwareCosts)
+	}
+	return costs
+}
+
+// MergeLicensePolicy sets the license policy. A policy from a later call
+// replaces the one from an earlier call; nil policies are ignored.
+func (sc *SourceCollection) MergeLicensePolicy(policy *licensepolicy.Policy) {
+	if policy == nil {
+		return
+	}
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	sc.licensePolicy = policy
+}
+
+// GetLicensePolicy returns the license policy, or nil if none is configured.
+func (sc *SourceCollection) GetLicensePolicy() *licensepolicy.Policy {
+	sc.mu.RLock()
+	defer sc.mu.RUnlock()
+	return sc.licensePolicy
+}
+
+// MergeScanConfig sets the scanning configuration read from the config
+// file at origin. A configuration from a later call replaces the one from an
+// earlier call; nil configurations are ignored. A relative cosign public key
+// path is resolved against the directory of origin.
+func (sc *SourceCollection) MergeScanConfig(origin string, config *basecatalog.ScanConfig) {
+	if config == nil {
+		return
+	}
+	resolved := *config
+	if resolved.CosignPublicKey != "" && !filepath.IsAbs(resolved.CosignPublicKey) {
+		resolved.CosignPublicKey = filepath.Join(filepath.Dir(origin), resolved.CosignPublicKey)
+	}
+
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	sc.scanConfig = &resolved
+}
+
+// GetScanConfig returns the scanning configuration, or nil if none is
+// configured.
+func (sc *SourceCollection) GetScanConfig() *basecatalog.ScanConfig {
+	sc.mu.RLock()
+	defer sc.mu.RUnlock()
+	return sc.scanConfig
+}
+
 // mergeSources performs field-level merging of two Source structs.
 // Fields from 'override' take precedence over 'base' when they are explicitly set.
 // A field is considered "set" if:
@@ -7,6 +7,7 @@ import (
 
 	"github.com/kubeflow/hub/catalog/internal/catalog/basecatalog"
 	model "github.com/kubeflow/hub/catalog/pkg/openapi"
+	"github.com/kubeflow/hub/internal/licensepolicy"
 	"github.com/kubeflow/hub/internal/platform/apiutils"
 )
 
@@ -1166,3 +1167,70 @@ func TestSourceCollection_NamedQueries(t *testing.T) {
 		t.Errorf("Expected value 70, got %v", queries["validation-default"]["ttft_p90"].Value)
 	}
 }
+
+func TestSourceCollection_HardwareCosts(t *testing.T) {
+	sc := NewSourceCollection("base.yaml", "override.yaml", "empty.yaml")
+
+	// Merged out of order, the origin order decides.
+	sc.MergeHardwareCosts("override.yaml", map[string]float64{"H100": 5})
+	sc.MergeHardwareCosts("base.yaml", map[string]float64{"H100": 4.5, "A100": 2})
+	sc.MergeHardwareCosts("empty.yaml", nil)
+
+	costs := sc.GetHardwareCosts()
+	if len(costs) != 2 || costs["H100"] != 5 || costs["A100"] != 2 {
+		t.Errorf("GetHardwareCosts() = %v, want map[A100:2 H100:5]", costs)
+	}
+
+	// The returned map is a copy.
+	costs["H100"] = 1
+	if got := sc.GetHardwareCosts()["H100"]; got != 5 {
+		t.Errorf("GetHardwareCosts()[H100] = %v after modifying a copy, want 5", got)
+	}
+
+	// Reloading an origin replaces its costs, dropping the removed ones.
+	sc.MergeHardwareCosts("base.yaml", map[string]float64{"L4": 0.8})
+	sc.MergeHardwareCosts("override.yaml", nil)
+
+	costs = sc.GetHardwareCosts()
+	if len(costs) != 1 || costs["L4"] != 0.8 {
+		t.Errorf("GetHardwareCosts() after reload = %v, want map[L4:0.8]", costs)
+	}
+}
+
+func TestSourceCollection_LicensePolicy(t *testing.T) {
+	sc := NewSourceCollection()
+	if sc.GetLicensePolicy() != nil {
+		t.Fatalf("GetLicensePolicy() = %v, want nil", sc.GetLicensePolicy())
+	}
+
+	first := &licensepolicy.Policy{Allow: []string{"mit"}}
+	second := &licensepolicy.Policy{Deny: []string{"mit"}}
+	sc.MergeLicensePolicy(first)
+	sc.MergeLicensePolicy(second)
+	sc.MergeLicensePolicy(nil)
+
+	if got := sc.GetLicensePolicy(); got != second {
+		t.Errorf("GetLicensePolicy() = %v, want the last merged policy %v", got, second)
+	}
+}
+
+func TestSourceCollection_ScanConfig(t *testing.T) {
+	sc := NewSourceCollection()
+	if sc.GetScanConfig() != nil {
+		t.Fatalf("GetScanConfig() = %v, want nil", sc.GetScanConfig())
+	}
+
+	sc.MergeScanConfig("/etc/catalog/first.yaml", &basecatalog.ScanConfig{Scanners: []string{"serialization"}})
+	sc.MergeScanConfig("/etc/catalog/second.yaml", &basecatalog.ScanConfig{Scanners: []string{"oci"}, CosignPublicKey: "keys/cosign.pub"})
+	sc.MergeScanConfig("/etc/catalog/third.yaml", nil)
+
+	want := &basecatalog.ScanConfig{Scanners: []string{"oci"}, CosignPublicKey: "/etc/catalog/keys/cosign.pub"}
+	if got := sc.GetScanConfig(); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetScanConfig() = %+v, want %+v", got, want)
+	}
+
+	sc.MergeScanConfig("/etc/catalog/fourth.yaml", &basecatalog.ScanConfig{CosignPublicKey: "/keys/cosign.pub"})
+	if got := sc.GetScanConfig().CosignPublicKey; got != "/keys/cosign.pub" {
+		t.Errorf("CosignPublicKey = %q, want the absolute path unchanged", got)
+	}
+}
@@ -0,0 +1,144 @@
+package modelcatalog
+
+import (
+	"context"
+	"sort"
+
+	"github.com/kubeflow/hub/catalog/internal/catalog/modelcatalog/models"
+	apimodels "github.com/kubeflow/hub/catalog/pkg/openapi"
+	mrmodels "github.com/kubeflow/hub/internal/platform/db/entity"
+)
+
+func (d *dbCatalogImpl) GetModelVariants(ctx context.Context, modelName string, sourceID string) (*apimodels.CatalogModelVariantList, error) {
+	model, err := d.getDBModel(modelName, sourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	variantModels := []models.CatalogModel{model}
+	if groupID := variantGroupID(model); groupID != "" {
+		list, err := d.catalogModelRepository.List(models.CatalogModelListOptions{
+			VariantGroupIDs: []string{groupID},
+		})
+		if err != nil {
+			return nil, err
+		}
+		variantModels = list.Items
+	}
+
+	variants, err := d.mapVariants(variantModels)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apimodels.CatalogModelVariantList{
+		Items: variants,
+		Size:  int32(len(variants)),
+	}, nil
+}
+
+// addVariants sets the variants of the grouped models in a page of a list.
+// Only the variants that match the options of the list are included.
+func (d *dbCatalogImpl) addVariants(items []apimodels.CatalogModel, page []models.CatalogModel, options models.CatalogModelListOptions) error {
+	var groupIDs []string
+	for _, model := range page {
+		if groupID := variantGroupID(model); groupID != "" {
+			groupIDs = append(groupIDs, groupID)
+		}
+	}
+	if len(groupIDs) == 0 {
+		return nil
+	}
+
+	list, err := d.catalogModelRepository.List(models.CatalogModelListOptions{
+		SourceIDs:       options.SourceIDs,
+		Query:           options.Query,
+		VariantGroupIDs: groupIDs,
+		Pagination: mrmodels.Pagination{
+			FilterQuery: options.FilterQuery,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	groups := map[string][]models.CatalogModel{}
+	for _, model := range list.Items {
+		groupID := variantGroupID(model)
+		groups[groupID] = append(groups[groupID], model)
+	}
+
+	for i, model := range page {
+		groupID := variantGroupID(model)
+		if groupID == "" {
+			continue
+		}
+		variants, err := d.mapVariants(groups[groupID])
+		if err != nil {
+			return err
+		}
+		items[i].Variants = variants
+	}
+	return nil
+}
+
+// mapVariants describes models as variants, ordered by name.
+func (d *dbCatalogImpl) mapVariants(variantModels []models.CatalogModel) ([]apimodels.CatalogModelVariant, error) {
+	modelIDs := make([]int32, 0, len(variantModels))
+	for _, model := range variantModels {
+		modelIDs = append(modelIDs, *model.GetID())
+	}
+	accuracy, err := d.getAccuracyScores(modelIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	variants := make([]apimodels.CatalogModelVariant, 0, len(variantModels))
+	for _, model := range variantModels {
+		apiModel := mapDBModelToAPIModel(model)
+		variant := apimodels.CatalogModelVariant{
+			Name: apiModel.Name,
+		}
+		if apiModel.SourceId != nil {
+			variant.SourceId = *apiModel.SourceId
+		}
+		if tensorType := customStringProperty(model, "tensor_type"); tensorType != "" {
+			variant.TensorType = &tensorType
+		}
+		if size := customStringProperty(model, "size"); size != "" {
+			variant.Size = &size
+		}
+
+		if overall, ok := accuracy[*model.GetID()]["overall_average"]; ok {
+			variant.Accuracy = &overall
+		}
+
+		variants = append(variants, variant)
+	}
+
+	sort.SliceStable(variants, func(i, j int) bool {
+		if variants[i].Name != variants[j].Name {
+			return variants[i].Name < variants[j].Name
+		}
+		return variants[i].SourceId < variants[j].SourceId
+	})
+	return variants, nil
+}
+
+// variantGroupID returns the variant group of a model, or "" when it isn't
+// in one.
+func variantGroupID(model models.CatalogModel) string {
+	return customStringProperty(model, models.VariantGroupProperty)
+}
+
+func customStringProperty(model models.CatalogModel, name string) string {
+	if model.GetCustomProperties() == nil {
+		return ""
+	}
+	for _, prop := range *model.GetCustomProperties() {
+		if prop.Name == name && prop.StringValue != nil {
+			return *prop.StringValue
+		}
+	}
+	return ""
+}
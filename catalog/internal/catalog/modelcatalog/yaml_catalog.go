@@ -15,6 +15,7 @@ import (
 	catalogmodels "github.com/kubeflow/hub/catalog/internal/catalog/modelcatalog/models"
 	sharedmodels "github.com/kubeflow/hub/catalog/internal/db/models"
 	apimodels "github.com/kubeflow/hub/catalog/pkg/openapi"
+	"github.com/kubeflow/hub/internal/licensepolicy"
 	models "github.com/kubeflow/hub/internal/platform/db/entity"
 )
 
@@ -123,7 +124,7 @@ func (ym *yamlModel) convertModelProperties() ([]models.Properties, []models.Pro
 		properties = append(properties, models.NewStringProperty("logo", *ym.Logo, false))
 	}
 	if ym.License != nil {
-		humanReadableLicense := basecatalog.TransformLicenseToHumanReadable(*ym.License)
+		humanReadableLicense := licensepolicy.TransformLicenseToHumanReadable(*ym.License)
 		properties = append(properties, models.NewStringProperty("license", humanReadableLicense, false))
 	}
 	if ym.LicenseLink != nil {
@@ -151,6 +152,8 @@ func (ym *yamlModel) convertModelProperties() ([]models.Properties, []models.Pro
 		properties = append(properties, models.NewStringProperty("tasks", string(tasksJSON), false))
 	}
 
+	properties = append(properties, deprecationProperties(ym.Deprecated, ym.DeprecationDate, ym.ReplacedBy)...)
+
 	// Convert custom properties from the YAML model
 	if customProps := convertCustomProperties(&ym.CustomProperties); customProps != nil {
 		customProperties = append(customProperties, customProps...)
@@ -236,10 +239,31 @@ func convertMetricsArtifact(artifact *apimodels.CatalogMetricsArtifact) *sharedm
 	}
 }
 
+// convertArtifact converts an API artifact to database format, validating
+// the URI of model artifacts. It returns nil if the artifact is empty.
+func convertArtifact(artifact *apimodels.CatalogArtifact) (*sharedmodels.CatalogArtifact, error) {
+	switch {
+	case artifact.CatalogModelArtifact != nil:
+		if err := basecatalog.ValidateArtifactURI(artifact.CatalogModelArtifact.Uri); err != nil {
+			return nil, err
+		}
+		return convertModelArtifact(artifact.CatalogModelArtifact), nil
+	case artifact.CatalogMetricsArtifact != nil:
+		return convertMetricsArtifact(artifact.CatalogMetricsArtifact), nil
+	}
+	return nil, nil
+}
+
 func (ym *yamlModel) ToModelProviderRecord() ModelProviderRecord {
 	model := catalogmodels.CatalogModelImpl{}
 	artifacts := make([]sharedmodels.CatalogArtifact, len(ym.Artifacts))
 
+	if err := validateDeprecation(ym.DeprecationDate, ym.ReplacedBy); err != nil {
+		return ModelProviderRecord{
+			Error: fmt.Errorf("model %q: %w", ym.Name, err),
+		}
+	}
+
 	// Convert model attributes
 	model.Attributes = ym.convertModelAttributes()
 
@@ -254,16 +278,14 @@ func (ym *yamlModel) ToModelProviderRecord() ModelProviderRecord {
 
 	// Convert artifacts with URI validation
 	for j := range ym.Artifacts {
-		if ym.Artifacts[j].CatalogModelArtifact != nil {
-			// Validate artifact URI before processing
-			if err := basecatalog.ValidateArtifactURI(ym.Artifacts[j].CatalogModelArtifact.Uri); err != nil {
-				return ModelProviderRecord{
-					Error: fmt.Errorf("model %q artifact %d: %w", ym.Name, j, err),
-				}
+		artifact, err := convertArtifact(&ym.Artifacts[j].CatalogArtifact)
+		if err != nil {
+			return ModelProviderRecord{
+				Error: fmt.Errorf("model %q artifact %d: %w", ym.Name, j, err),
 			}
-			artifacts[j] = *convertModelArtifact(ym.Artifacts[j].CatalogModelArtifact)
-		} else if ym.Artifacts[j].CatalogMetricsArtifact != nil {
-			artifacts[j] = *convertMetricsArtifact(ym.Artifacts[j].CatalogMetricsArtifact)
+		}
+		if artifact != nil {
+			artifacts[j] = *artifact
 		}
 	}
 
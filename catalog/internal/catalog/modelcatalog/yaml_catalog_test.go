@@ -275,7 +275,7 @@ func TestYamlModelToModelProviderRecord(t *testing.T) {
 				if regularProps != nil {
 					*regularProps = slices.DeleteFunc(*regularProps, func(p models.Properties) bool {
 						switch p.Name {
-						case "language", "tasks":
+						case "language", "tasks", deprecatedProperty:
 							return true
 						}
 						return false
@@ -539,6 +539,60 @@ func TestYamlModelToModelProviderRecord(t *testing.T) {
 				assert.Contains(t, record.Error.Error(), "must have a scheme")
 			},
 		},
+		{
+			name: "deprecated model",
+			yamlModel: yamlModel{
+				CatalogModel: model.CatalogModel{
+					Name:            "granite-3.0-8b-instruct",
+					Deprecated:      apiutils.Of(true),
+					DeprecationDate: apiutils.Of("2025-06-30"),
+					ReplacedBy:      model.NewCatalogModelReference("rhoai", "granite-3.1-8b-instruct"),
+				},
+			},
+			validateFunc: func(t *testing.T, record ModelProviderRecord) {
+				require.NoError(t, record.Error)
+
+				props := record.Model.GetProperties()
+				assert.True(t, *findProperty(props, deprecatedProperty).BoolValue)
+				assert.Equal(t, "2025-06-30", *findProperty(props, deprecationDateProperty).StringValue)
+				assert.Equal(t, "rhoai:granite-3.1-8b-instruct", *findProperty(props, replacedByProperty).StringValue)
+
+				record.Model.SetID(1)
+				apiModel := mapDBModelToAPIModel(record.Model)
+				assert.True(t, apiModel.GetDeprecated())
+				assert.Equal(t, "2025-06-30", apiModel.GetDeprecationDate())
+				assert.Equal(t, model.NewCatalogModelReference("rhoai", "granite-3.1-8b-instruct"), apiModel.ReplacedBy)
+			},
+		},
+		{
+			name: "model that isn't deprecated",
+			yamlModel: yamlModel{
+				CatalogModel: model.CatalogModel{
+					Name: "granite-3.1-8b-instruct",
+				},
+			},
+			validateFunc: func(t *testing.T, record ModelProviderRecord) {
+				props := record.Model.GetProperties()
+				assert.False(t, *findProperty(props, deprecatedProperty).BoolValue)
+				assert.Nil(t, findProperty(props, deprecationDateProperty))
+				assert.Nil(t, findProperty(props, replacedByProperty))
+			},
+		},
+		{
+			name: "invalid deprecation date",
+			yamlModel: yamlModel{
+				CatalogModel: model.CatalogModel{
+					Name:            "granite-3.0-8b-instruct",
+					Deprecated:      apiutils.Of(true),
+					DeprecationDate: apiutils.Of("June 2025"),
+				},
+			},
+			expectError: true,
+			validateFunc: func(t *testing.T, record ModelProviderRecord) {
+				require.Error(t, record.Error)
+				assert.Contains(t, record.Error.Error(), "deprecationDate")
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -549,6 +603,30 @@ func TestYamlModelToModelProviderRecord(t *testing.T) {
 	}
 }
 
+func TestYamlCatalogDeprecation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "models.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+source: test
+models:
+  - name: granite-3.0-8b-instruct
+    deprecated: true
+    deprecationDate: 2025-06-30
+    replacedBy:
+      sourceId: rhoai
+      name: granite-3.1-8b-instruct
+`), 0o600))
+
+	catalog, err := (&yamlModelProvider{path: path}).read()
+	require.NoError(t, err)
+	require.Len(t, catalog.Models, 1)
+
+	m := catalog.Models[0]
+	assert.True(t, m.GetDeprecated())
+	assert.Equal(t, "2025-06-30", m.GetDeprecationDate())
+	assert.Equal(t, "rhoai", m.GetReplacedBy().SourceId)
+	assert.Equal(t, "granite-3.1-8b-instruct", m.GetReplacedBy().Name)
+}
+
 func TestNewYamlModelProviderAbsolutePath(t *testing.T) {
 	// Create a temporary YAML file
 	tempDir, err := os.MkdirTemp("", "yaml_catalog_test")
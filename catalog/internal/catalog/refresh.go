@@ -0,0 +1,120 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/hub/catalog/internal/catalog/basecatalog"
+	"github.com/kubeflow/hub/catalog/internal/db/models"
+	"github.com/kubeflow/hub/pkg/api"
+)
+
+const (
+	// refreshPollInterval is how often the leader looks for new source
+	// refreshes.
+	refreshPollInterval = 2 * time.Second
+
+	// refreshHistoryLimit is the number of finished refreshes kept for
+	// each source.
+	refreshHistoryLimit = 10
+)
+
+// runRefreshes runs the source refreshes requested through the API, one at
+// a time, until ctx is cancelled. Any pod can accept a refresh, but only
+// the leader writes to the database, so refreshes are queued there.
+func (l *Loader) runRefreshes(ctx context.Context) {
+	// A previous leader may have stopped in the middle of a refresh, and
+	// the source can't be refreshed again until it's finished.
+	if err := l.refreshes.FailRunning("refresh was interrupted by a change of leader"); err != nil {
+		glog.Errorf("unable to fail interrupted source refreshes: %v", err)
+	}
+
+	ticker := time.NewTicker(refreshPollInterval)
+	defer ticker.Stop()
+
+	for {
+		//nolint:revive
+		for ctx.Err() == nil && l.runNextRefresh(ctx) {
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runNextRefresh runs the oldest new refresh, and reports whether there was
+// one.
+func (l *Loader) runNextRefresh(ctx context.Context) bool {
+	refresh, err := l.refreshes.ClaimNext()
+	if err != nil {
+		glog.Errorf("unable to get the next source refresh: %v", err)
+		return false
+	}
+	if refresh == nil {
+		return false
+	}
+
+	glog.Infof("Refreshing source %s (refresh %d)", refresh.SourceID, *refresh.ID)
+
+	if refresh.Rescan {
+		l.modelLoader.ForgetScans(refresh.SourceID)
+	}
+
+	state := models.SourceRefreshStateComplete
+	status, errMsg, err := l.RefreshSource(ctx, refresh.SourceID)
+	l.notifyReload()
+	if err != nil {
+		state, errMsg = models.SourceRefreshStateFailed, err.Error()
+	} else if status == basecatalog.SourceStatusError {
+		state = models.SourceRefreshStateFailed
+	}
+
+	if _, err := l.refreshes.Finish(*refresh.ID, state, status, errMsg); err != nil {
+		glog.Errorf("unable to finish refresh %d of source %s: %v", *refresh.ID, refresh.SourceID, err)
+		return true
+	}
+	glog.Infof("Refresh %d of source %s finished: %s", *refresh.ID, refresh.SourceID, state)
+
+	if err := l.refreshes.Prune(refresh.SourceID, refreshHistoryLimit); err != nil {
+		glog.Errorf("unable to prune refreshes of source %s: %v", refresh.SourceID, err)
+	}
+	return true
+}
+
+// RefreshSource reads a single source again. A model source and an MCP
+// source can share an ID, in which case both are refreshed. It returns the
+// status the source was left in, like the RefreshSource methods of the
+// model and MCP loaders.
+func (l *Loader) RefreshSource(ctx context.Context, sourceID string) (string, string, error) {
+	_, isModelSource := l.modelLoader.Sources.AllSources()[sourceID]
+	_, isMCPSource := l.mcpLoader.Sources.AllSources()[sourceID]
+	if !isModelSource && !isMCPSource {
+		return "", "", fmt.Errorf("source %s not found: %w", sourceID, api.ErrNotFound)
+	}
+
+	status, errMsg := "", ""
+	if isModelSource {
+		var err error
+		status, errMsg, err = l.modelLoader.RefreshSource(ctx, sourceID)
+		if err != nil {
+			return "", "", err
+		}
+	}
+	if isMCPSource {
+		mcpStatus, mcpErrMsg, err := l.mcpLoader.RefreshSource(ctx, sourceID)
+		if err != nil {
+			return "", "", err
+		}
+		// Report the MCP servers' problem, if the models didn't have one.
+		if status == "" || status == basecatalog.SourceStatusAvailable {
+			status, errMsg = mcpStatus, mcpErrMsg
+		}
+	}
+
+	return status, errMsg, nil
+}
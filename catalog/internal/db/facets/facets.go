@@ -0,0 +1,94 @@
+// Package facets counts how many catalog entities have each value of their
+// properties, for the counts of filter options.
+package facets
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/kubeflow/hub/catalog/internal/db/models"
+	"github.com/kubeflow/hub/internal/platform/db/dbutil"
+	"github.com/kubeflow/hub/internal/platform/db/schema"
+	"github.com/kubeflow/hub/internal/platform/db/utils"
+	"gorm.io/gorm"
+)
+
+// Counts holds the number of entities with each value of a property. It's
+// keyed by the full name of the property, as used in filterQuery, and then
+// by value.
+type Counts map[string]map[string]int32
+
+type propertyKey struct {
+	name             string
+	isCustomProperty bool
+}
+
+// CountContextPropertyValues counts the contexts selected by contextIDs, a
+// query for context IDs, that have each value of the string properties in
+// options. A property holding a JSON array, such as tasks, counts each of
+// its elements. Options of other types are left out.
+func CountContextPropertyValues(db *gorm.DB, contextIDs *gorm.DB, options []models.PropertyOption) (Counts, error) {
+	names := make([]string, 0, len(options))
+	keys := make(map[propertyKey]string, len(options))
+	for _, option := range options {
+		switch option.ValueField() {
+		case models.StringValueField, models.ArrayValueField:
+		default:
+			continue
+		}
+		names = append(names, option.Name)
+		keys[propertyKey{option.Name, option.IsCustomProperty}] = option.FullName("")
+	}
+
+	counts := make(Counts, len(keys))
+	if len(names) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		Name             string
+		IsCustomProperty bool
+		StringValue      string
+		Count            int32
+	}
+	err := db.Table(utils.GetTableName(db, &schema.ContextProperty{})).
+		Select("name, is_custom_property, string_value, COUNT(*) AS count").
+		Where("context_id IN (?) AND name IN ? AND string_value IS NOT NULL", contextIDs, names).
+		Group("name, is_custom_property, string_value").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("error counting property values: %w", dbutil.SanitizeDatabaseError(err))
+	}
+
+	for _, row := range rows {
+		if key, ok := keys[propertyKey{row.Name, row.IsCustomProperty}]; ok {
+			counts.add(key, row.StringValue, row.Count)
+		}
+	}
+
+	return counts, nil
+}
+
+// add adds n entities with value to the counts of a property. When value is
+// a JSON array, n is added to each distinct element.
+func (c Counts) add(key string, value string, n int32) {
+	values := []string{value}
+	if strings.HasPrefix(value, "[") {
+		var elements []string
+		if json.Unmarshal([]byte(value), &elements) == nil {
+			slices.Sort(elements)
+			values = slices.Compact(elements)
+		}
+	}
+
+	valueCounts, ok := c[key]
+	if !ok {
+		valueCounts = map[string]int32{}
+		c[key] = valueCounts
+	}
+	for _, v := range values {
+		valueCounts[v] += n
+	}
+}
@@ -0,0 +1,22 @@
+package facets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountsAdd(t *testing.T) {
+	counts := Counts{}
+
+	counts.add("license", "apache-2.0", 3)
+	counts.add("license", "mit", 1)
+	counts.add("tasks", `["text-generation","summarization"]`, 2)
+	counts.add("tasks", `["text-generation","text-generation"]`, 1)
+	counts.add("tasks", "[not json", 1)
+
+	assert.Equal(t, Counts{
+		"license": {"apache-2.0": 3, "mit": 1},
+		"tasks":   {"text-generation": 3, "summarization": 2, "[not json": 1},
+	}, counts)
+}
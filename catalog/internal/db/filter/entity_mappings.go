@@ -154,6 +154,21 @@ var catalogModelProperties = map[string]filter.PropertyDefinition{
 	"tasks":          {Location: filter.PropertyTable, ValueType: filter.ArrayValueType, Column: "tasks"},
 	"tags":           {Location: filter.PropertyTable, ValueType: filter.ArrayValueType, Column: "tags"},
 	"verifiedSource": {Location: filter.PropertyTable, ValueType: filter.BoolValueType, Column: "verifiedSource"},
+
+	// Deprecation of models replaced by newer ones
+	"deprecated":       {Location: filter.PropertyTable, ValueType: filter.BoolValueType, Column: "deprecated"},
+	"deprecation_date": {Location: filter.PropertyTable, ValueType: filter.StringValueType, Column: "deprecation_date"},
+	"replaced_by":      {Location: filter.PropertyTable, ValueType: filter.StringValueType, Column: "replaced_by"},
+
+	// License policy outcome recorded when sources load
+	"license_policy":          {Location: filter.PropertyTable, ValueType: filter.StringValueType, Column: "license_policy"},
+	"license_classifications": {Location: filter.PropertyTable, ValueType: filter.ArrayValueType, Column: "license_classifications"},
+
+	// Scan results recorded when sources load
+	"serialization_formats": {Location: filter.PropertyTable, ValueType: filter.ArrayValueType, Column: "serialization_formats"},
+	"unsafe_serialization":  {Location: filter.PropertyTable, ValueType: filter.BoolValueType, Column: "unsafe_serialization"},
+	"signature_status":      {Location: filter.PropertyTable, ValueType: filter.StringValueType, Column: "signature_status"},
+	"scanned_at":            {Location: filter.PropertyTable, ValueType: filter.StringValueType, Column: "scanned_at"},
 }
 
 // catalogArtifactProperties defines the allowed properties for CatalogArtifact entities
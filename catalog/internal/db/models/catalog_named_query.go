@@ -0,0 +1,42 @@
+package models
+
+// NamedQuery is a named query saved through the API, as opposed to one
+// defined in a source config file.
+type NamedQuery struct {
+	// ID is set by the repository when the query is created.
+	ID *int32
+
+	Name string
+
+	// AssetType is the kind of entity the query filters, "models" or
+	// "mcp_servers". Names are unique per asset type.
+	AssetType string
+
+	// Filters is the JSON encoding of the query's field filters.
+	Filters string
+
+	CreateTimeSinceEpoch     int64
+	LastUpdateTimeSinceEpoch int64
+}
+
+// CatalogNamedQueryRepository defines the interface for named query
+// persistence.
+type CatalogNamedQueryRepository interface {
+	// List returns the named queries of an asset type, ordered by name.
+	List(assetType string) ([]*NamedQuery, error)
+
+	// Get returns a named query, or an api.ErrNotFound error.
+	Get(assetType string, name string) (*NamedQuery, error)
+
+	// Create saves a new named query. It returns an api.ErrConflict error
+	// if the asset type already has a query with the same name.
+	Create(query *NamedQuery) (*NamedQuery, error)
+
+	// Update replaces the filters of a named query. It returns an
+	// api.ErrNotFound error if the query doesn't exist.
+	Update(query *NamedQuery) (*NamedQuery, error)
+
+	// Delete removes a named query. It returns an api.ErrNotFound error if
+	// the query doesn't exist.
+	Delete(assetType string, name string) error
+}
@@ -0,0 +1,63 @@
+package models
+
+// States of a source refresh. They're the execution states used by the
+// model registry, so they map directly to Execution.LastKnownState.
+const (
+	SourceRefreshStateNew      = "NEW"
+	SourceRefreshStateRunning  = "RUNNING"
+	SourceRefreshStateComplete = "COMPLETE"
+	SourceRefreshStateFailed   = "FAILED"
+)
+
+// SourceRefresh is a request to reload a single catalog source, run by the
+// leader.
+type SourceRefresh struct {
+	// ID is set by the repository when the refresh is created.
+	ID *int32
+
+	SourceID string
+	State    string
+
+	// Rescan is true if the source's models are scanned again, even if
+	// their last scan is recent.
+	Rescan bool
+
+	// SourceStatus is the status of the source after the refresh
+	// finished, and Error describes why it failed, if it did.
+	SourceStatus string
+	Error        string
+
+	CreateTimeSinceEpoch     int64
+	LastUpdateTimeSinceEpoch int64
+}
+
+// IsFinished reports whether the refresh is complete or failed.
+func (r *SourceRefresh) IsFinished() bool {
+	return r.State == SourceRefreshStateComplete || r.State == SourceRefreshStateFailed
+}
+
+// CatalogSourceRefreshRepository defines the interface for source refresh
+// persistence.
+type CatalogSourceRefreshRepository interface {
+	// Create queues a refresh of a source. It returns an api.ErrConflict
+	// error if the source already has a refresh that isn't finished.
+	Create(sourceID string, rescan bool) (*SourceRefresh, error)
+
+	// Get returns a refresh by ID, or an api.ErrNotFound error.
+	Get(id int32) (*SourceRefresh, error)
+
+	// ClaimNext marks the oldest new refresh as running and returns it.
+	// It returns nil if there's nothing to run.
+	ClaimNext() (*SourceRefresh, error)
+
+	// Finish sets the final state of a refresh.
+	Finish(id int32, state string, sourceStatus string, errorMsg string) (*SourceRefresh, error)
+
+	// FailRunning marks every running refresh as failed. It's used when a
+	// new leader takes over refreshes that the previous one didn't finish.
+	FailRunning(errorMsg string) error
+
+	// Prune removes all but the most recent keep finished refreshes of a
+	// source.
+	Prune(sourceID string, keep int) error
+}
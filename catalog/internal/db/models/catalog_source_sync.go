@@ -0,0 +1,75 @@
+package models
+
+import (
+	models "github.com/kubeflow/hub/internal/platform/db/entity"
+)
+
+// Kinds of change recorded for an entity in a source sync.
+const (
+	SourceSyncChangeAdded   = "ADDED"
+	SourceSyncChangeUpdated = "UPDATED"
+	SourceSyncChangeRemoved = "REMOVED"
+)
+
+// Asset types that a source sync can load.
+const (
+	SourceSyncAssetTypeModels     = "models"
+	SourceSyncAssetTypeMCPServers = "mcp_servers"
+)
+
+// SourceSyncChange is a single entity that was added, updated or removed by a
+// source sync.
+type SourceSyncChange struct {
+	Name   string `json:"name"`
+	Change string `json:"change"`
+}
+
+// SourceSync records one run of loading a catalog source into the database.
+type SourceSync struct {
+	// ID is set by the repository when the sync is saved.
+	ID *int32
+
+	SourceID  string
+	AssetType string
+	Status    string
+	Error     string
+
+	StartTimeSinceEpoch int64
+	EndTimeSinceEpoch   int64
+
+	Added   int32
+	Updated int32
+	Removed int32
+
+	// Changes lists the entities that changed. It may be shorter than
+	// Added+Updated+Removed, in which case ChangesTruncated is true.
+	Changes          []SourceSyncChange
+	ChangesTruncated bool
+}
+
+// SourceSyncListOptions holds the options for listing source syncs.
+type SourceSyncListOptions struct {
+	models.Pagination
+
+	SourceID string
+
+	// Since, when set, only returns syncs that ended at or after this
+	// time, in milliseconds since epoch.
+	Since *int64
+}
+
+// CatalogSourceSyncRepository defines the interface for source sync history
+// persistence.
+type CatalogSourceSyncRepository interface {
+	// Save stores a new source sync.
+	Save(sync *SourceSync) (*SourceSync, error)
+
+	// List returns the syncs of a source, most recent first.
+	List(listOptions SourceSyncListOptions) (*models.ListWrapper[*SourceSync], error)
+
+	// DeleteBySource removes the history of a source.
+	DeleteBySource(sourceID string) error
+
+	// Prune removes all but the most recent keep syncs of a source.
+	Prune(sourceID string, keep int) error
+}
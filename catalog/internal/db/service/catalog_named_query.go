@@ -0,0 +1,211 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kubeflow/hub/catalog/internal/db/models"
+	"github.com/kubeflow/hub/internal/platform/db/dbutil"
+	dbmodels "github.com/kubeflow/hub/internal/platform/db/entity"
+	service "github.com/kubeflow/hub/internal/platform/db/repository"
+	"github.com/kubeflow/hub/internal/platform/db/schema"
+	"github.com/kubeflow/hub/pkg/api"
+	"gorm.io/gorm"
+)
+
+// CatalogNamedQueryRepositoryImpl implements CatalogNamedQueryRepository
+// using GORM. Each query is stored as a Context named
+// "<asset type>:<query name>", with its filters in a ContextProperty row.
+type CatalogNamedQueryRepositoryImpl struct {
+	db     *gorm.DB
+	typeID int32
+}
+
+// NewCatalogNamedQueryRepository creates a new CatalogNamedQueryRepository.
+func NewCatalogNamedQueryRepository(db *gorm.DB, typeID int32) models.CatalogNamedQueryRepository {
+	return &CatalogNamedQueryRepositoryImpl{
+		db:     db,
+		typeID: typeID,
+	}
+}
+
+// List returns the named queries of an asset type, ordered by name.
+func (r *CatalogNamedQueryRepositoryImpl) List(assetType string) ([]*models.NamedQuery, error) {
+	var contexts []schema.Context
+	err := r.db.Where("type_id = ? AND name LIKE ?", r.typeID, namedQueryContextName(assetType, "%")).
+		Order("name").
+		Find(&contexts).Error
+	if err != nil {
+		err = dbutil.SanitizeDatabaseError(err)
+		return nil, fmt.Errorf("error listing named queries: %w", err)
+	}
+
+	if len(contexts) == 0 {
+		return []*models.NamedQuery{}, nil
+	}
+
+	ids := make([]int32, len(contexts))
+	for i, context := range contexts {
+		ids[i] = context.ID
+	}
+
+	var properties []schema.ContextProperty
+	if err := r.db.Where("context_id IN ?", ids).Find(&properties).Error; err != nil {
+		err = dbutil.SanitizeDatabaseError(err)
+		return nil, fmt.Errorf("error getting named query properties: %w", err)
+	}
+
+	propsByContext := make(map[int32][]schema.ContextProperty, len(contexts))
+	for _, prop := range properties {
+		propsByContext[prop.ContextID] = append(propsByContext[prop.ContextID], prop)
+	}
+
+	result := make([]*models.NamedQuery, len(contexts))
+	for i, context := range contexts {
+		result[i] = mapContextToNamedQuery(context, propsByContext[context.ID])
+	}
+	return result, nil
+}
+
+// Get returns a named query.
+func (r *CatalogNamedQueryRepositoryImpl) Get(assetType string, name string) (*models.NamedQuery, error) {
+	var context schema.Context
+	err := r.db.Where("type_id = ? AND name = ?", r.typeID, namedQueryContextName(assetType, name)).First(&context).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("named query %q not found: %w", name, api.ErrNotFound)
+		}
+		err = dbutil.SanitizeDatabaseError(err)
+		return nil, fmt.Errorf("error getting named query: %w", err)
+	}
+
+	var properties []schema.ContextProperty
+	if err := r.db.Where("context_id = ?", context.ID).Find(&properties).Error; err != nil {
+		err = dbutil.SanitizeDatabaseError(err)
+		return nil, fmt.Errorf("error getting named query properties: %w", err)
+	}
+
+	return mapContextToNamedQuery(context, properties), nil
+}
+
+// Create saves a new named query.
+func (r *CatalogNamedQueryRepositoryImpl) Create(query *models.NamedQuery) (*models.NamedQuery, error) {
+	if query == nil || query.Name == "" || query.AssetType == "" {
+		return nil, fmt.Errorf("named query name and asset type are required: %w", api.ErrBadRequest)
+	}
+
+	now := time.Now().UnixMilli()
+	context := schema.Context{
+		TypeID:                   r.typeID,
+		Name:                     namedQueryContextName(query.AssetType, query.Name),
+		CreateTimeSinceEpoch:     now,
+		LastUpdateTimeSinceEpoch: now,
+	}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&context).Error; err != nil {
+			return err
+		}
+
+		rows := []schema.ContextProperty{
+			service.MapPropertiesToContextProperty(dbmodels.NewStringProperty("asset_type", query.AssetType, false), context.ID, false),
+			service.MapPropertiesToContextProperty(dbmodels.NewStringProperty("filters", query.Filters, false), context.ID, false),
+		}
+		return tx.Create(&rows).Error
+	})
+	if err != nil {
+		if dbutil.IsDuplicateKeyError(err) {
+			return nil, fmt.Errorf("named query %q already exists: %w", query.Name, api.ErrConflict)
+		}
+		err = dbutil.SanitizeDatabaseError(err)
+		return nil, fmt.Errorf("error creating named query: %w", err)
+	}
+
+	created := *query
+	created.ID = &context.ID
+	created.CreateTimeSinceEpoch = now
+	created.LastUpdateTimeSinceEpoch = now
+	return &created, nil
+}
+
+// Update replaces the filters of a named query.
+func (r *CatalogNamedQueryRepositoryImpl) Update(query *models.NamedQuery) (*models.NamedQuery, error) {
+	if query == nil {
+		return nil, fmt.Errorf("named query is required: %w", api.ErrBadRequest)
+	}
+
+	existing, err := r.Get(query.AssetType, query.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UnixMilli()
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&schema.Context{}).
+			Where("id = ?", *existing.ID).
+			Update("last_update_time_since_epoch", now).Error
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Where("context_id = ? AND name = ?", *existing.ID, "filters").Delete(&schema.ContextProperty{}).Error; err != nil {
+			return err
+		}
+		row := service.MapPropertiesToContextProperty(dbmodels.NewStringProperty("filters", query.Filters, false), *existing.ID, false)
+		return tx.Create(&row).Error
+	})
+	if err != nil {
+		err = dbutil.SanitizeDatabaseError(err)
+		return nil, fmt.Errorf("error updating named query: %w", err)
+	}
+
+	existing.Filters = query.Filters
+	existing.LastUpdateTimeSinceEpoch = now
+	return existing, nil
+}
+
+// Delete removes a named query.
+func (r *CatalogNamedQueryRepositoryImpl) Delete(assetType string, name string) error {
+	result := r.db.Where("type_id = ? AND name = ?", r.typeID, namedQueryContextName(assetType, name)).Delete(&schema.Context{})
+	if result.Error != nil {
+		err := dbutil.SanitizeDatabaseError(result.Error)
+		return fmt.Errorf("error deleting named query: %w", err)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("named query %q not found: %w", name, api.ErrNotFound)
+	}
+	return nil
+}
+
+// namedQueryContextName returns the name of the context that stores a
+// query. Context names are unique per type, which keeps query names unique
+// per asset type.
+func namedQueryContextName(assetType string, name string) string {
+	return assetType + ":" + name
+}
+
+// mapContextToNamedQuery converts database schema to a NamedQuery.
+func mapContextToNamedQuery(context schema.Context, properties []schema.ContextProperty) *models.NamedQuery {
+	query := &models.NamedQuery{
+		ID:                       &context.ID,
+		CreateTimeSinceEpoch:     context.CreateTimeSinceEpoch,
+		LastUpdateTimeSinceEpoch: context.LastUpdateTimeSinceEpoch,
+	}
+
+	for _, prop := range properties {
+		if prop.StringValue == nil {
+			continue
+		}
+		switch prop.Name {
+		case "asset_type":
+			query.AssetType = *prop.StringValue
+		case "filters":
+			query.Filters = *prop.StringValue
+		}
+	}
+
+	query.Name = strings.TrimPrefix(context.Name, query.AssetType+":")
+	return query
+}
@@ -0,0 +1,109 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/kubeflow/hub/catalog/internal/db/models"
+	"github.com/kubeflow/hub/catalog/internal/db/service"
+	"github.com/kubeflow/hub/internal/platform/db/schema"
+	"github.com/kubeflow/hub/internal/testutils"
+	"github.com/kubeflow/hub/pkg/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestCatalogNamedQueryRepository(t *testing.T) {
+	sharedDB, cleanup := testutils.SetupPostgresWithMigrations(t, service.DatastoreSpec())
+	defer cleanup()
+
+	repo := service.NewCatalogNamedQueryRepository(sharedDB, getCatalogNamedQueryTypeID(t, sharedDB))
+
+	t.Run("CreateAndGet", func(t *testing.T) {
+		created, err := repo.Create(&models.NamedQuery{
+			Name:      "apache",
+			AssetType: "models",
+			Filters:   `{"license":{"operator":"=","value":"apache-2.0"}}`,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, created.ID)
+		assert.NotZero(t, created.CreateTimeSinceEpoch)
+
+		got, err := repo.Get("models", "apache")
+		require.NoError(t, err)
+		assert.Equal(t, *created.ID, *got.ID)
+		assert.Equal(t, "apache", got.Name)
+		assert.Equal(t, "models", got.AssetType)
+		assert.Equal(t, created.Filters, got.Filters)
+	})
+
+	t.Run("NamesAreUniquePerAssetType", func(t *testing.T) {
+		query := &models.NamedQuery{
+			Name:      "unique",
+			AssetType: "models",
+			Filters:   `{"provider":{"operator":"=","value":"IBM"}}`,
+		}
+		_, err := repo.Create(query)
+		require.NoError(t, err)
+
+		_, err = repo.Create(query)
+		assert.ErrorIs(t, err, api.ErrConflict)
+
+		query.AssetType = "mcp_servers"
+		_, err = repo.Create(query)
+		assert.NoError(t, err, "the same name can be used for another asset type")
+
+		_, err = repo.Get("mcp_servers", "apache")
+		assert.ErrorIs(t, err, api.ErrNotFound)
+	})
+
+	t.Run("List", func(t *testing.T) {
+		queries, err := repo.List("mcp_servers")
+		require.NoError(t, err)
+		require.Len(t, queries, 1)
+		assert.Equal(t, "unique", queries[0].Name)
+
+		queries, err = repo.List("models")
+		require.NoError(t, err)
+		names := make([]string, len(queries))
+		for i, query := range queries {
+			names[i] = query.Name
+		}
+		assert.Equal(t, []string{"apache", "unique"}, names)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		updated, err := repo.Update(&models.NamedQuery{
+			Name:      "apache",
+			AssetType: "models",
+			Filters:   `{"license":{"operator":"IN","value":["apache-2.0","mit"]}}`,
+		})
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, updated.LastUpdateTimeSinceEpoch, updated.CreateTimeSinceEpoch)
+
+		got, err := repo.Get("models", "apache")
+		require.NoError(t, err)
+		assert.Equal(t, updated.Filters, got.Filters)
+
+		_, err = repo.Update(&models.NamedQuery{Name: "missing", AssetType: "models"})
+		assert.ErrorIs(t, err, api.ErrNotFound)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		require.NoError(t, repo.Delete("mcp_servers", "unique"))
+
+		_, err := repo.Get("mcp_servers", "unique")
+		assert.ErrorIs(t, err, api.ErrNotFound)
+		_, err = repo.Get("models", "unique")
+		assert.NoError(t, err, "the query for the other asset type is kept")
+
+		assert.ErrorIs(t, repo.Delete("mcp_servers", "unique"), api.ErrNotFound)
+	})
+}
+
+func getCatalogNamedQueryTypeID(t *testing.T, db *gorm.DB) int32 {
+	var typeRecord schema.Type
+	err := db.Where("name = ?", service.CatalogNamedQueryTypeName).First(&typeRecord).Error
+	require.NoError(t, err, "Failed to query CatalogNamedQuery type")
+	return typeRecord.ID
+}
@@ -0,0 +1,286 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kubeflow/hub/catalog/internal/db/models"
+	"github.com/kubeflow/hub/internal/platform/db/constants"
+	"github.com/kubeflow/hub/internal/platform/db/dbutil"
+	dbmodels "github.com/kubeflow/hub/internal/platform/db/entity"
+	service "github.com/kubeflow/hub/internal/platform/db/repository"
+	"github.com/kubeflow/hub/internal/platform/db/schema"
+	"github.com/kubeflow/hub/internal/platform/db/utils"
+	"github.com/kubeflow/hub/pkg/api"
+	"gorm.io/gorm"
+)
+
+// CatalogSourceRefreshRepositoryImpl implements
+// CatalogSourceRefreshRepository using GORM. Each refresh is stored as an
+// Execution, with its state in LastKnownState and its details in
+// ExecutionProperty rows.
+//
+// A refresh that isn't finished is named after its source. Execution names
+// are unique per type, so the database rejects a second refresh of the same
+// source until the first one is finished and renamed.
+type CatalogSourceRefreshRepositoryImpl struct {
+	db     *gorm.DB
+	typeID int32
+}
+
+// NewCatalogSourceRefreshRepository creates a new
+// CatalogSourceRefreshRepository.
+func NewCatalogSourceRefreshRepository(db *gorm.DB, typeID int32) models.CatalogSourceRefreshRepository {
+	return &CatalogSourceRefreshRepositoryImpl{
+		db:     db,
+		typeID: typeID,
+	}
+}
+
+// Create queues a refresh of a source.
+func (r *CatalogSourceRefreshRepositoryImpl) Create(sourceID string, rescan bool) (*models.SourceRefresh, error) {
+	if sourceID == "" {
+		return nil, fmt.Errorf("source ID is required: %w", api.ErrBadRequest)
+	}
+
+	now := time.Now().UnixMilli()
+	state := constants.ExecutionStateMapping[models.SourceRefreshStateNew]
+	execution := schema.Execution{
+		TypeID:                   r.typeID,
+		Name:                     &sourceID,
+		LastKnownState:           &state,
+		CreateTimeSinceEpoch:     now,
+		LastUpdateTimeSinceEpoch: now,
+	}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&execution).Error; err != nil {
+			return err
+		}
+
+		props := []dbmodels.Properties{dbmodels.NewStringProperty("source_id", sourceID, false)}
+		if rescan {
+			props = append(props, dbmodels.NewBoolProperty("rescan", true, false))
+		}
+		for _, prop := range props {
+			row := service.MapPropertiesToExecutionProperty(prop, execution.ID, false)
+			if err := tx.Create(&row).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if dbutil.IsDuplicateKeyError(err) {
+			return nil, fmt.Errorf("source %q is already being refreshed: %w", sourceID, api.ErrConflict)
+		}
+		err = dbutil.SanitizeDatabaseError(err)
+		return nil, fmt.Errorf("error creating source refresh: %w", err)
+	}
+
+	return &models.SourceRefresh{
+		ID:                       &execution.ID,
+		SourceID:                 sourceID,
+		State:                    models.SourceRefreshStateNew,
+		Rescan:                   rescan,
+		CreateTimeSinceEpoch:     now,
+		LastUpdateTimeSinceEpoch: now,
+	}, nil
+}
+
+// Get returns a refresh by ID.
+func (r *CatalogSourceRefreshRepositoryImpl) Get(id int32) (*models.SourceRefresh, error) {
+	var execution schema.Execution
+	err := r.db.Where("id = ? AND type_id = ?", id, r.typeID).First(&execution).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("source refresh %d not found: %w", id, api.ErrNotFound)
+		}
+		err = dbutil.SanitizeDatabaseError(err)
+		return nil, fmt.Errorf("error getting source refresh: %w", err)
+	}
+
+	var properties []schema.ExecutionProperty
+	if err := r.db.Where("execution_id = ?", id).Find(&properties).Error; err != nil {
+		err = dbutil.SanitizeDatabaseError(err)
+		return nil, fmt.Errorf("error getting source refresh properties: %w", err)
+	}
+
+	return mapExecutionToSourceRefresh(execution, properties), nil
+}
+
+// ClaimNext marks the oldest new refresh as running and returns it.
+func (r *CatalogSourceRefreshRepositoryImpl) ClaimNext() (*models.SourceRefresh, error) {
+	newState := constants.ExecutionStateMapping[models.SourceRefreshStateNew]
+
+	var execution schema.Execution
+	err := r.db.Where("type_id = ? AND last_known_state = ?", r.typeID, newState).
+		Order("id").
+		First(&execution).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		err = dbutil.SanitizeDatabaseError(err)
+		return nil, fmt.Errorf("error finding new source refresh: %w", err)
+	}
+
+	// Only update the state if it's still new, in case another claim got
+	// there first.
+	result := r.db.Model(&schema.Execution{}).
+		Where("id = ? AND last_known_state = ?", execution.ID, newState).
+		Updates(map[string]any{
+			"last_known_state":             constants.ExecutionStateMapping[models.SourceRefreshStateRunning],
+			"last_update_time_since_epoch": time.Now().UnixMilli(),
+		})
+	if result.Error != nil {
+		err = dbutil.SanitizeDatabaseError(result.Error)
+		return nil, fmt.Errorf("error claiming source refresh: %w", err)
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return r.Get(execution.ID)
+}
+
+// Finish sets the final state of a refresh.
+func (r *CatalogSourceRefreshRepositoryImpl) Finish(id int32, state string, sourceStatus string, errorMsg string) (*models.SourceRefresh, error) {
+	stateValue, ok := constants.ExecutionStateMapping[state]
+	if !ok || (state != models.SourceRefreshStateComplete && state != models.SourceRefreshStateFailed) {
+		return nil, fmt.Errorf("invalid final source refresh state %q: %w", state, api.ErrBadRequest)
+	}
+
+	refresh, err := r.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Release the source's name so that it can be refreshed again.
+	name := fmt.Sprintf("%s:%d", refresh.SourceID, id)
+	now := time.Now().UnixMilli()
+
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&schema.Execution{}).
+			Where("id = ?", id).
+			Updates(map[string]any{
+				"name":                         name,
+				"last_known_state":             stateValue,
+				"last_update_time_since_epoch": now,
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		names := []string{"source_status", "error"}
+		if err := tx.Where("execution_id = ? AND name IN ?", id, names).Delete(&schema.ExecutionProperty{}).Error; err != nil {
+			return err
+		}
+
+		var rows []schema.ExecutionProperty
+		if sourceStatus != "" {
+			rows = append(rows, service.MapPropertiesToExecutionProperty(dbmodels.NewStringProperty("source_status", sourceStatus, false), id, false))
+		}
+		if errorMsg != "" {
+			rows = append(rows, service.MapPropertiesToExecutionProperty(dbmodels.NewStringProperty("error", errorMsg, false), id, false))
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		return tx.Create(&rows).Error
+	})
+	if err != nil {
+		err = dbutil.SanitizeDatabaseError(err)
+		return nil, fmt.Errorf("error finishing source refresh: %w", err)
+	}
+
+	refresh.State = state
+	refresh.SourceStatus = sourceStatus
+	refresh.Error = errorMsg
+	refresh.LastUpdateTimeSinceEpoch = now
+	return refresh, nil
+}
+
+// FailRunning marks every running refresh as failed.
+func (r *CatalogSourceRefreshRepositoryImpl) FailRunning(errorMsg string) error {
+	var ids []int32
+	err := r.db.Model(&schema.Execution{}).
+		Where("type_id = ? AND last_known_state = ?", r.typeID, constants.ExecutionStateMapping[models.SourceRefreshStateRunning]).
+		Pluck("id", &ids).Error
+	if err != nil {
+		err = dbutil.SanitizeDatabaseError(err)
+		return fmt.Errorf("error finding running source refreshes: %w", err)
+	}
+
+	for _, id := range ids {
+		if _, err := r.Finish(id, models.SourceRefreshStateFailed, "", errorMsg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Prune removes all but the most recent keep finished refreshes of a source.
+func (r *CatalogSourceRefreshRepositoryImpl) Prune(sourceID string, keep int) error {
+	executionTable := utils.GetTableName(r.db, &schema.Execution{})
+	propertyTable := utils.GetTableName(r.db, &schema.ExecutionProperty{})
+
+	var ids []int32
+	err := r.db.Model(&schema.Execution{}).
+		Joins(fmt.Sprintf("INNER JOIN %s sp ON sp.execution_id = %s.id AND sp.name = ?", propertyTable, executionTable), "source_id").
+		Where(fmt.Sprintf("%s.type_id = ? AND sp.string_value = ? AND %s.last_known_state IN ?", executionTable, executionTable),
+			r.typeID, sourceID, []int32{
+				constants.ExecutionStateMapping[models.SourceRefreshStateComplete],
+				constants.ExecutionStateMapping[models.SourceRefreshStateFailed],
+			}).
+		Order(executionTable+".id DESC").
+		Offset(keep).
+		Pluck(executionTable+".id", &ids).Error
+	if err != nil {
+		err = dbutil.SanitizeDatabaseError(err)
+		return fmt.Errorf("error finding old source refreshes: %w", err)
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	if err := r.db.Where("id IN ?", ids).Delete(&schema.Execution{}).Error; err != nil {
+		err = dbutil.SanitizeDatabaseError(err)
+		return fmt.Errorf("error deleting old source refreshes: %w", err)
+	}
+	return nil
+}
+
+// mapExecutionToSourceRefresh converts database schema to a SourceRefresh.
+func mapExecutionToSourceRefresh(execution schema.Execution, properties []schema.ExecutionProperty) *models.SourceRefresh {
+	refresh := &models.SourceRefresh{
+		ID:                       &execution.ID,
+		CreateTimeSinceEpoch:     execution.CreateTimeSinceEpoch,
+		LastUpdateTimeSinceEpoch: execution.LastUpdateTimeSinceEpoch,
+	}
+	if execution.LastKnownState != nil {
+		refresh.State = constants.ExecutionStateNames[*execution.LastKnownState]
+	}
+
+	for _, prop := range properties {
+		if prop.Name == "rescan" && prop.BoolValue != nil {
+			refresh.Rescan = *prop.BoolValue
+			continue
+		}
+		if prop.StringValue == nil {
+			continue
+		}
+		switch prop.Name {
+		case "source_id":
+			refresh.SourceID = *prop.StringValue
+		case "source_status":
+			refresh.SourceStatus = *prop.StringValue
+		case "error":
+			refresh.Error = *prop.StringValue
+		}
+	}
+
+	return refresh
+}
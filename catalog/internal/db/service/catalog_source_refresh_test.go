@@ -0,0 +1,175 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/kubeflow/hub/catalog/internal/db/models"
+	"github.com/kubeflow/hub/catalog/internal/db/service"
+	"github.com/kubeflow/hub/internal/platform/db/schema"
+	"github.com/kubeflow/hub/internal/testutils"
+	"github.com/kubeflow/hub/pkg/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestCatalogSourceRefreshRepository(t *testing.T) {
+	sharedDB, cleanup := testutils.SetupPostgresWithMigrations(t, service.DatastoreSpec())
+	defer cleanup()
+
+	repo := service.NewCatalogSourceRefreshRepository(sharedDB, getCatalogSourceRefreshTypeID(t, sharedDB))
+
+	t.Run("CreateAndGet", func(t *testing.T) {
+		created, err := repo.Create("refresh-get", false)
+		require.NoError(t, err)
+		require.NotNil(t, created.ID)
+		assert.Equal(t, models.SourceRefreshStateNew, created.State)
+
+		got, err := repo.Get(*created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "refresh-get", got.SourceID)
+		assert.Equal(t, models.SourceRefreshStateNew, got.State)
+		assert.Equal(t, created.CreateTimeSinceEpoch, got.CreateTimeSinceEpoch)
+		assert.Empty(t, got.SourceStatus)
+		assert.Empty(t, got.Error)
+		assert.False(t, got.Rescan)
+	})
+
+	t.Run("CreateRescan", func(t *testing.T) {
+		created, err := repo.Create("refresh-rescan", true)
+		require.NoError(t, err)
+		assert.True(t, created.Rescan)
+
+		got, err := repo.Get(*created.ID)
+		require.NoError(t, err)
+		assert.True(t, got.Rescan)
+	})
+
+	t.Run("GetMissing", func(t *testing.T) {
+		_, err := repo.Get(999999)
+		assert.ErrorIs(t, err, api.ErrNotFound)
+	})
+
+	t.Run("CreateWithoutSource", func(t *testing.T) {
+		_, err := repo.Create("", false)
+		assert.ErrorIs(t, err, api.ErrBadRequest)
+	})
+
+	t.Run("OneUnfinishedRefreshPerSource", func(t *testing.T) {
+		first, err := repo.Create("refresh-conflict", false)
+		require.NoError(t, err)
+
+		_, err = repo.Create("refresh-conflict", false)
+		assert.ErrorIs(t, err, api.ErrConflict)
+
+		_, err = repo.Create("refresh-conflict-other", false)
+		assert.NoError(t, err, "other sources can be refreshed at the same time")
+
+		finished, err := repo.Finish(*first.ID, models.SourceRefreshStateComplete, "available", "")
+		require.NoError(t, err)
+		assert.True(t, finished.IsFinished())
+
+		_, err = repo.Create("refresh-conflict", false)
+		assert.NoError(t, err, "a source can be refreshed again once the last refresh finished")
+	})
+
+	t.Run("ClaimNextAndFinish", func(t *testing.T) {
+		// Drain the refreshes created by the other subtests.
+		for {
+			claimed, err := repo.ClaimNext()
+			require.NoError(t, err)
+			if claimed == nil {
+				break
+			}
+			_, err = repo.Finish(*claimed.ID, models.SourceRefreshStateComplete, "", "")
+			require.NoError(t, err)
+		}
+
+		first, err := repo.Create("refresh-claim-1", false)
+		require.NoError(t, err)
+		second, err := repo.Create("refresh-claim-2", false)
+		require.NoError(t, err)
+
+		claimed, err := repo.ClaimNext()
+		require.NoError(t, err)
+		require.NotNil(t, claimed)
+		assert.Equal(t, *first.ID, *claimed.ID, "the oldest refresh is claimed first")
+		assert.Equal(t, models.SourceRefreshStateRunning, claimed.State)
+
+		claimed, err = repo.ClaimNext()
+		require.NoError(t, err)
+		require.NotNil(t, claimed)
+		assert.Equal(t, *second.ID, *claimed.ID)
+
+		claimed, err = repo.ClaimNext()
+		require.NoError(t, err)
+		assert.Nil(t, claimed, "running refreshes aren't claimed again")
+
+		_, err = repo.Finish(*first.ID, models.SourceRefreshStateFailed, "error", "source unreachable")
+		require.NoError(t, err)
+
+		got, err := repo.Get(*first.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.SourceRefreshStateFailed, got.State)
+		assert.Equal(t, "error", got.SourceStatus)
+		assert.Equal(t, "source unreachable", got.Error)
+		assert.Equal(t, "refresh-claim-1", got.SourceID)
+
+		_, err = repo.Finish(*second.ID, models.SourceRefreshStateRunning, "", "")
+		assert.ErrorIs(t, err, api.ErrBadRequest, "running isn't a final state")
+	})
+
+	t.Run("FailRunning", func(t *testing.T) {
+		created, err := repo.Create("refresh-interrupted", false)
+		require.NoError(t, err)
+
+		for {
+			claimed, err := repo.ClaimNext()
+			require.NoError(t, err)
+			if claimed == nil {
+				break
+			}
+		}
+
+		require.NoError(t, repo.FailRunning("leader changed"))
+
+		got, err := repo.Get(*created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.SourceRefreshStateFailed, got.State)
+		assert.Equal(t, "leader changed", got.Error)
+
+		_, err = repo.Create("refresh-interrupted", false)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Prune", func(t *testing.T) {
+		var ids []int32
+		for i := 0; i < 3; i++ {
+			created, err := repo.Create("refresh-prune", false)
+			require.NoError(t, err)
+			_, err = repo.Finish(*created.ID, models.SourceRefreshStateComplete, "available", "")
+			require.NoError(t, err)
+			ids = append(ids, *created.ID)
+		}
+		pending, err := repo.Create("refresh-prune", false)
+		require.NoError(t, err)
+
+		require.NoError(t, repo.Prune("refresh-prune", 1))
+
+		_, err = repo.Get(ids[0])
+		assert.ErrorIs(t, err, api.ErrNotFound)
+		_, err = repo.Get(ids[1])
+		assert.ErrorIs(t, err, api.ErrNotFound)
+		_, err = repo.Get(ids[2])
+		assert.NoError(t, err, "the most recent finished refresh is kept")
+		_, err = repo.Get(*pending.ID)
+		assert.NoError(t, err, "unfinished refreshes are never pruned")
+	})
+}
+
+func getCatalogSourceRefreshTypeID(t *testing.T, db *gorm.DB) int32 {
+	var typeRecord schema.Type
+	err := db.Where("name = ?", service.CatalogSourceRefreshTypeName).First(&typeRecord).Error
+	require.NoError(t, err, "Failed to query CatalogSourceRefresh type")
+	return typeRecord.ID
+}
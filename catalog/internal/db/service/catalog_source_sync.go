@@ -0,0 +1,255 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/kubeflow/hub/catalog/internal/db/models"
+	"github.com/kubeflow/hub/internal/platform/db/dbutil"
+	dbmodels "github.com/kubeflow/hub/internal/platform/db/entity"
+	service "github.com/kubeflow/hub/internal/platform/db/repository"
+	"github.com/kubeflow/hub/internal/platform/db/schema"
+	"github.com/kubeflow/hub/internal/platform/db/scopes"
+	"github.com/kubeflow/hub/internal/platform/db/utils"
+	"gorm.io/gorm"
+)
+
+// CatalogSourceSyncRepositoryImpl implements CatalogSourceSyncRepository
+// using GORM. Each sync is stored as an Execution, with its details in
+// ExecutionProperty rows.
+type CatalogSourceSyncRepositoryImpl struct {
+	db     *gorm.DB
+	typeID int32
+}
+
+// NewCatalogSourceSyncRepository creates a new CatalogSourceSyncRepository.
+func NewCatalogSourceSyncRepository(db *gorm.DB, typeID int32) models.CatalogSourceSyncRepository {
+	return &CatalogSourceSyncRepositoryImpl{
+		db:     db,
+		typeID: typeID,
+	}
+}
+
+// Save stores a new source sync.
+func (r *CatalogSourceSyncRepositoryImpl) Save(sync *models.SourceSync) (*models.SourceSync, error) {
+	if sync == nil || sync.SourceID == "" {
+		return nil, errors.New("source ID is required")
+	}
+
+	changes, err := json.Marshal(sync.Changes)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding source sync changes: %w", err)
+	}
+
+	// Executions need a unique name per type; the source and start time
+	// are unique in practice, the asset type covers the rest.
+	name := fmt.Sprintf("%s:%s:%d", sync.SourceID, sync.AssetType, sync.StartTimeSinceEpoch)
+	execution := schema.Execution{
+		TypeID:                   r.typeID,
+		Name:                     &name,
+		CreateTimeSinceEpoch:     sync.StartTimeSinceEpoch,
+		LastUpdateTimeSinceEpoch: sync.EndTimeSinceEpoch,
+	}
+
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&execution).Error; err != nil {
+			return fmt.Errorf("error creating source sync: %w", err)
+		}
+
+		properties := []dbmodels.Properties{
+			dbmodels.NewStringProperty("source_id", sync.SourceID, false),
+			dbmodels.NewStringProperty("asset_type", sync.AssetType, false),
+			dbmodels.NewStringProperty("status", sync.Status, false),
+			dbmodels.NewIntProperty("added", sync.Added, false),
+			dbmodels.NewIntProperty("updated", sync.Updated, false),
+			dbmodels.NewIntProperty("removed", sync.Removed, false),
+			dbmodels.NewStringProperty("changes", string(changes), false),
+			dbmodels.NewBoolProperty("changes_truncated", sync.ChangesTruncated, false),
+		}
+		if sync.Error != "" {
+			properties = append(properties, dbmodels.NewStringProperty("error", sync.Error, false))
+		}
+
+		rows := make([]schema.ExecutionProperty, 0, len(properties))
+		for _, prop := range properties {
+			rows = append(rows, service.MapPropertiesToExecutionProperty(prop, execution.ID, false))
+		}
+		if err := tx.Create(&rows).Error; err != nil {
+			return fmt.Errorf("error saving source sync properties: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, dbutil.SanitizeDatabaseError(err)
+	}
+
+	saved := *sync
+	saved.ID = &execution.ID
+	return &saved, nil
+}
+
+// List returns the syncs of a source, most recent first.
+func (r *CatalogSourceSyncRepositoryImpl) List(listOptions models.SourceSyncListOptions) (*dbmodels.ListWrapper[*models.SourceSync], error) {
+	executionTable := utils.GetTableName(r.db, &schema.Execution{})
+	query := r.bySource(listOptions.SourceID).Order(executionTable + ".id DESC")
+
+	if listOptions.Since != nil {
+		query = query.Where(executionTable+".last_update_time_since_epoch >= ?", *listOptions.Since)
+	}
+
+	if token := listOptions.GetNextPageToken(); token != "" {
+		cursor, err := scopes.DecodeCursor(token)
+		if err != nil {
+			return nil, fmt.Errorf("invalid nextPageToken: %w", err)
+		}
+		query = query.Where(executionTable+".id < ?", cursor.ID)
+	}
+
+	pageSize := listOptions.GetPageSize()
+	if pageSize > 0 {
+		query = query.Limit(int(pageSize) + 1)
+	}
+
+	var executions []schema.Execution
+	if err := query.Find(&executions).Error; err != nil {
+		err = dbutil.SanitizeDatabaseError(err)
+		return nil, fmt.Errorf("error listing source syncs: %w", err)
+	}
+
+	result := &dbmodels.ListWrapper[*models.SourceSync]{
+		PageSize: pageSize,
+	}
+
+	if pageSize > 0 && len(executions) > int(pageSize) {
+		executions = executions[:pageSize]
+		last := executions[len(executions)-1].ID
+		result.NextPageToken = scopes.CreateNextPageToken(last, strconv.Itoa(int(last)))
+	}
+
+	if len(executions) == 0 {
+		result.Items = []*models.SourceSync{}
+		return result, nil
+	}
+
+	ids := make([]int32, len(executions))
+	for i, execution := range executions {
+		ids[i] = execution.ID
+	}
+
+	var properties []schema.ExecutionProperty
+	if err := r.db.Where("execution_id IN ?", ids).Find(&properties).Error; err != nil {
+		err = dbutil.SanitizeDatabaseError(err)
+		return nil, fmt.Errorf("error getting source sync properties: %w", err)
+	}
+
+	propsByExecution := make(map[int32][]schema.ExecutionProperty, len(executions))
+	for _, prop := range properties {
+		propsByExecution[prop.ExecutionID] = append(propsByExecution[prop.ExecutionID], prop)
+	}
+
+	result.Items = make([]*models.SourceSync, len(executions))
+	for i, execution := range executions {
+		result.Items[i] = mapExecutionToSourceSync(execution, propsByExecution[execution.ID])
+	}
+	result.Size = int32(len(result.Items))
+
+	return result, nil
+}
+
+// DeleteBySource removes the history of a source.
+func (r *CatalogSourceSyncRepositoryImpl) DeleteBySource(sourceID string) error {
+	err := r.db.Where("id IN (?)", r.bySource(sourceID).Select(utils.GetTableName(r.db, &schema.Execution{})+".id")).
+		Delete(&schema.Execution{}).Error
+	if err != nil {
+		err = dbutil.SanitizeDatabaseError(err)
+		return fmt.Errorf("error deleting source syncs: %w", err)
+	}
+	return nil
+}
+
+// Prune removes all but the most recent keep syncs of a source.
+func (r *CatalogSourceSyncRepositoryImpl) Prune(sourceID string, keep int) error {
+	executionTable := utils.GetTableName(r.db, &schema.Execution{})
+
+	var ids []int32
+	err := r.bySource(sourceID).
+		Order(executionTable+".id DESC").
+		Offset(keep).
+		Pluck(executionTable+".id", &ids).Error
+	if err != nil {
+		err = dbutil.SanitizeDatabaseError(err)
+		return fmt.Errorf("error finding old source syncs: %w", err)
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	if err := r.db.Where("id IN ?", ids).Delete(&schema.Execution{}).Error; err != nil {
+		err = dbutil.SanitizeDatabaseError(err)
+		return fmt.Errorf("error deleting old source syncs: %w", err)
+	}
+	return nil
+}
+
+// bySource returns a query for the executions of this type that belong to
+// sourceID.
+func (r *CatalogSourceSyncRepositoryImpl) bySource(sourceID string) *gorm.DB {
+	executionTable := utils.GetTableName(r.db, &schema.Execution{})
+	propertyTable := utils.GetTableName(r.db, &schema.ExecutionProperty{})
+
+	return r.db.Model(&schema.Execution{}).
+		Joins(fmt.Sprintf("INNER JOIN %s sp ON sp.execution_id = %s.id AND sp.name = ?", propertyTable, executionTable), "source_id").
+		Where(fmt.Sprintf("%s.type_id = ? AND sp.string_value = ?", executionTable), r.typeID, sourceID)
+}
+
+// mapExecutionToSourceSync converts database schema to a SourceSync.
+func mapExecutionToSourceSync(execution schema.Execution, properties []schema.ExecutionProperty) *models.SourceSync {
+	sync := &models.SourceSync{
+		ID:                  &execution.ID,
+		StartTimeSinceEpoch: execution.CreateTimeSinceEpoch,
+		EndTimeSinceEpoch:   execution.LastUpdateTimeSinceEpoch,
+	}
+
+	stringValue := func(prop schema.ExecutionProperty) string {
+		if prop.StringValue == nil {
+			return ""
+		}
+		return *prop.StringValue
+	}
+	intValue := func(prop schema.ExecutionProperty) int32 {
+		if prop.IntValue == nil {
+			return 0
+		}
+		return *prop.IntValue
+	}
+
+	for _, prop := range properties {
+		switch prop.Name {
+		case "source_id":
+			sync.SourceID = stringValue(prop)
+		case "asset_type":
+			sync.AssetType = stringValue(prop)
+		case "status":
+			sync.Status = stringValue(prop)
+		case "error":
+			sync.Error = stringValue(prop)
+		case "added":
+			sync.Added = intValue(prop)
+		case "updated":
+			sync.Updated = intValue(prop)
+		case "removed":
+			sync.Removed = intValue(prop)
+		case "changes":
+			// Ignore bad data rather than failing the whole list.
+			_ = json.Unmarshal([]byte(stringValue(prop)), &sync.Changes)
+		case "changes_truncated":
+			sync.ChangesTruncated = prop.BoolValue != nil && *prop.BoolValue
+		}
+	}
+
+	return sync
+}
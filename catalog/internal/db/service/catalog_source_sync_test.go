@@ -0,0 +1,150 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/kubeflow/hub/catalog/internal/db/models"
+	"github.com/kubeflow/hub/catalog/internal/db/service"
+	"github.com/kubeflow/hub/internal/platform/apiutils"
+	dbmodels "github.com/kubeflow/hub/internal/platform/db/entity"
+	"github.com/kubeflow/hub/internal/platform/db/schema"
+	"github.com/kubeflow/hub/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestCatalogSourceSyncRepository(t *testing.T) {
+	sharedDB, cleanup := testutils.SetupPostgresWithMigrations(t, service.DatastoreSpec())
+	defer cleanup()
+
+	repo := service.NewCatalogSourceSyncRepository(sharedDB, getCatalogSourceSyncTypeID(t, sharedDB))
+
+	save := func(t *testing.T, sourceID string, start int64) *models.SourceSync {
+		saved, err := repo.Save(&models.SourceSync{
+			SourceID:            sourceID,
+			AssetType:           models.SourceSyncAssetTypeModels,
+			Status:              "available",
+			StartTimeSinceEpoch: start,
+			EndTimeSinceEpoch:   start + 10,
+		})
+		require.NoError(t, err)
+		return saved
+	}
+
+	t.Run("SaveAndList", func(t *testing.T) {
+		saved, err := repo.Save(&models.SourceSync{
+			SourceID:            "sync-save",
+			AssetType:           models.SourceSyncAssetTypeMCPServers,
+			Status:              "partially-available",
+			Error:               "server b failed",
+			StartTimeSinceEpoch: 1000,
+			EndTimeSinceEpoch:   1500,
+			Added:               1,
+			Updated:             1,
+			Removed:             1,
+			Changes: []models.SourceSyncChange{
+				{Name: "a", Change: models.SourceSyncChangeAdded},
+				{Name: "c", Change: models.SourceSyncChangeUpdated},
+				{Name: "d", Change: models.SourceSyncChangeRemoved},
+			},
+			ChangesTruncated: true,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, saved.ID)
+
+		list, err := repo.List(models.SourceSyncListOptions{SourceID: "sync-save"})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 1)
+
+		got := list.Items[0]
+		assert.Equal(t, *saved.ID, *got.ID)
+		assert.Equal(t, "sync-save", got.SourceID)
+		assert.Equal(t, models.SourceSyncAssetTypeMCPServers, got.AssetType)
+		assert.Equal(t, "partially-available", got.Status)
+		assert.Equal(t, "server b failed", got.Error)
+		assert.Equal(t, int64(1000), got.StartTimeSinceEpoch)
+		assert.Equal(t, int64(1500), got.EndTimeSinceEpoch)
+		assert.Equal(t, int32(1), got.Added)
+		assert.Equal(t, int32(1), got.Updated)
+		assert.Equal(t, int32(1), got.Removed)
+		assert.Len(t, got.Changes, 3)
+		assert.True(t, got.ChangesTruncated)
+	})
+
+	t.Run("SaveWithoutSource", func(t *testing.T) {
+		_, err := repo.Save(&models.SourceSync{})
+		assert.Error(t, err)
+	})
+
+	t.Run("ListPagesMostRecentFirst", func(t *testing.T) {
+		for i := int64(1); i <= 5; i++ {
+			save(t, "sync-pages", i*100)
+		}
+		save(t, "sync-other", 100)
+
+		var starts []int64
+		token := ""
+		for {
+			list, err := repo.List(models.SourceSyncListOptions{
+				Pagination: dbmodels.Pagination{
+					PageSize:      apiutils.Of(int32(2)),
+					NextPageToken: &token,
+				},
+				SourceID: "sync-pages",
+			})
+			require.NoError(t, err)
+			for _, item := range list.Items {
+				starts = append(starts, item.StartTimeSinceEpoch)
+			}
+			if list.NextPageToken == "" {
+				break
+			}
+			token = list.NextPageToken
+		}
+		assert.Equal(t, []int64{500, 400, 300, 200, 100}, starts)
+	})
+
+	t.Run("ListSince", func(t *testing.T) {
+		list, err := repo.List(models.SourceSyncListOptions{
+			SourceID: "sync-pages",
+			Since:    apiutils.Of(int64(310)),
+		})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 3)
+		assert.Equal(t, int64(300), list.Items[2].StartTimeSinceEpoch)
+	})
+
+	t.Run("Prune", func(t *testing.T) {
+		require.NoError(t, repo.Prune("sync-pages", 2))
+
+		list, err := repo.List(models.SourceSyncListOptions{SourceID: "sync-pages"})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 2)
+		assert.Equal(t, int64(500), list.Items[0].StartTimeSinceEpoch)
+		assert.Equal(t, int64(400), list.Items[1].StartTimeSinceEpoch)
+
+		other, err := repo.List(models.SourceSyncListOptions{SourceID: "sync-other"})
+		require.NoError(t, err)
+		assert.Len(t, other.Items, 1, "other sources are not pruned")
+	})
+
+	t.Run("DeleteBySource", func(t *testing.T) {
+		require.NoError(t, repo.DeleteBySource("sync-pages"))
+
+		list, err := repo.List(models.SourceSyncListOptions{SourceID: "sync-pages"})
+		require.NoError(t, err)
+		assert.Empty(t, list.Items)
+
+		other, err := repo.List(models.SourceSyncListOptions{SourceID: "sync-other"})
+		require.NoError(t, err)
+		assert.Len(t, other.Items, 1)
+	})
+}
+
+func getCatalogSourceSyncTypeID(t *testing.T, db *gorm.DB) int32 {
+	var typeRecord schema.Type
+	err := db.Where("name = ?", service.CatalogSourceSyncTypeName).First(&typeRecord).Error
+	require.NoError(t, err, "Failed to query CatalogSourceSync type")
+	return typeRecord.ID
+}
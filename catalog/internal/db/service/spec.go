@@ -14,6 +14,9 @@ const (
 	CatalogModelArtifactTypeName   = "kf.CatalogModelArtifact"
 	CatalogMetricsArtifactTypeName = "kf.CatalogMetricsArtifact"
 	CatalogSourceTypeName          = "kf.CatalogSource"
+	CatalogSourceSyncTypeName      = "kf.CatalogSourceSync"
+	CatalogSourceRefreshTypeName   = "kf.CatalogSourceRefresh"
+	CatalogNamedQueryTypeName      = "kf.CatalogNamedQuery"
 	MCPServerTypeName              = "kf.MCPServer"
 	MCPServerToolTypeName          = "kf.MCPServerTool"
 )
@@ -29,6 +32,15 @@ func DatastoreSpec() *datastore.Spec {
 			AddString("library_name").
 			AddString("license_link").
 			AddString("license").
+			AddBoolean("deprecated").
+			AddString("deprecation_date").
+			AddString("replaced_by").
+			AddString("license_policy").
+			AddStruct("license_classifications").
+			AddStruct("serialization_formats").
+			AddBoolean("unsafe_serialization").
+			AddString("signature_status").
+			AddString("scanned_at").
 			AddString("logo").
 			AddString("maturity").
 			AddString("provider").
@@ -39,6 +51,10 @@ func DatastoreSpec() *datastore.Spec {
 			AddString("status").
 			AddString("error"),
 		).
+		AddContext(CatalogNamedQueryTypeName, datastore.NewSpecType(NewCatalogNamedQueryRepository).
+			AddString("asset_type").
+			AddString("filters"),
+		).
 		AddContext(MCPServerTypeName, datastore.NewSpecType(mcpcatalogservice.NewMCPServerRepository).
 			AddString("source_id").
 			AddString("base_name").
@@ -57,6 +73,23 @@ func DatastoreSpec() *datastore.Spec {
 			AddBoolean("sast").
 			AddBoolean("readOnlyTools"),
 		).
+		AddExecution(CatalogSourceSyncTypeName, datastore.NewSpecType(NewCatalogSourceSyncRepository).
+			AddString("source_id").
+			AddString("asset_type").
+			AddString("status").
+			AddString("error").
+			AddInt("added").
+			AddInt("updated").
+			AddInt("removed").
+			AddString("changes").
+			AddBoolean("changes_truncated"),
+		).
+		AddExecution(CatalogSourceRefreshTypeName, datastore.NewSpecType(NewCatalogSourceRefreshRepository).
+			AddString("source_id").
+			AddBoolean("rescan").
+			AddString("source_status").
+			AddString("error"),
+		).
 		AddExecution(MCPServerToolTypeName, datastore.NewSpecType(mcpcatalogservice.NewMCPServerToolRepository).
 			AddString("accessType").
 			AddString("description").
@@ -82,6 +115,9 @@ type Services struct {
 	PropertyOptionsRepository        sharedmodels.PropertyOptionsRepository
 	MCPServerRepository              mcpcatalogmodels.MCPServerRepository
 	MCPServerToolRepository          mcpcatalogmodels.MCPServerToolRepository
+	CatalogSourceSyncRepository      sharedmodels.CatalogSourceSyncRepository
+	CatalogSourceRefreshRepository   sharedmodels.CatalogSourceRefreshRepository
+	CatalogNamedQueryRepository      sharedmodels.CatalogNamedQueryRepository
 }
 
 func NewServices(
@@ -93,6 +129,9 @@ func NewServices(
 	propertyOptionsRepository sharedmodels.PropertyOptionsRepository,
 	mcpServerRepository mcpcatalogmodels.MCPServerRepository,
 	mcpServerToolRepository mcpcatalogmodels.MCPServerToolRepository,
+	catalogSourceSyncRepository sharedmodels.CatalogSourceSyncRepository,
+	catalogSourceRefreshRepository sharedmodels.CatalogSourceRefreshRepository,
+	catalogNamedQueryRepository sharedmodels.CatalogNamedQueryRepository,
 ) Services {
 	return Services{
 		CatalogModelRepository:           catalogModelRepository,
@@ -103,5 +142,8 @@ func NewServices(
 		PropertyOptionsRepository:        propertyOptionsRepository,
 		MCPServerRepository:              mcpServerRepository,
 		MCPServerToolRepository:          mcpServerToolRepository,
+		CatalogSourceSyncRepository:      catalogSourceSyncRepository,
+		CatalogSourceRefreshRepository:   catalogSourceRefreshRepository,
+		CatalogNamedQueryRepository:      catalogNamedQueryRepository,
 	}
 }
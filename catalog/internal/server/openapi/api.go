@@ -27,6 +27,11 @@ type MCPCatalogServiceAPIRouter interface {
 	GetMCPServer(http.ResponseWriter, *http.Request)
 	FindMCPServerTools(http.ResponseWriter, *http.Request)
 	GetMCPServerTool(http.ResponseWriter, *http.Request)
+	FindMCPNamedQueries(http.ResponseWriter, *http.Request)
+	CreateMCPNamedQuery(http.ResponseWriter, *http.Request)
+	GetMCPNamedQuery(http.ResponseWriter, *http.Request)
+	UpdateMCPNamedQuery(http.ResponseWriter, *http.Request)
+	DeleteMCPNamedQuery(http.ResponseWriter, *http.Request)
 }
 
 // ModelCatalogServiceAPIRouter defines the required methods for binding the api requests to a responses for the ModelCatalogServiceAPI
@@ -36,11 +41,25 @@ type ModelCatalogServiceAPIRouter interface {
 	FindLabels(http.ResponseWriter, *http.Request)
 	FindModels(http.ResponseWriter, *http.Request)
 	FindModelsFilterOptions(http.ResponseWriter, *http.Request)
+	CompareModels(http.ResponseWriter, *http.Request)
+	FindModelNamedQueries(http.ResponseWriter, *http.Request)
+	CreateModelNamedQuery(http.ResponseWriter, *http.Request)
+	GetModelNamedQuery(http.ResponseWriter, *http.Request)
+	UpdateModelNamedQuery(http.ResponseWriter, *http.Request)
+	DeleteModelNamedQuery(http.ResponseWriter, *http.Request)
 	FindSources(http.ResponseWriter, *http.Request)
 	PreviewCatalogSource(http.ResponseWriter, *http.Request)
+	CreateModel(http.ResponseWriter, *http.Request)
 	GetModel(http.ResponseWriter, *http.Request)
+	DeleteModel(http.ResponseWriter, *http.Request)
+	UpdateModel(http.ResponseWriter, *http.Request)
 	GetAllModelArtifacts(http.ResponseWriter, *http.Request)
 	GetAllModelPerformanceArtifacts(http.ResponseWriter, *http.Request)
+	GetModelSizing(http.ResponseWriter, *http.Request)
+	GetModelVariants(http.ResponseWriter, *http.Request)
+	GetSourceRefresh(http.ResponseWriter, *http.Request)
+	GetSourceSyncHistory(http.ResponseWriter, *http.Request)
+	RefreshSource(http.ResponseWriter, *http.Request)
 }
 
 // MCPCatalogServiceAPIServicer defines the api actions for the MCPCatalogServiceAPI service
@@ -49,10 +68,15 @@ type ModelCatalogServiceAPIRouter interface {
 // and updated with the logic required for the API.
 type MCPCatalogServiceAPIServicer interface {
 	FindMCPServers(context.Context, string, string, []string, string, string, bool, int32, string, model.OrderByField, model.SortOrder, string) (ImplResponse, error)
-	FindMCPServersFilterOptions(context.Context) (ImplResponse, error)
+	FindMCPServersFilterOptions(context.Context, string, []string, string, string) (ImplResponse, error)
 	GetMCPServer(context.Context, string, bool, int32) (ImplResponse, error)
 	FindMCPServerTools(context.Context, string, string, string, model.OrderByField, model.SortOrder, string) (ImplResponse, error)
 	GetMCPServerTool(context.Context, string, string) (ImplResponse, error)
+	FindMCPNamedQueries(context.Context) (ImplResponse, error)
+	CreateMCPNamedQuery(context.Context, model.CatalogNamedQuery) (ImplResponse, error)
+	GetMCPNamedQuery(context.Context, string) (ImplResponse, error)
+	UpdateMCPNamedQuery(context.Context, string, model.CatalogNamedQueryUpdate) (ImplResponse, error)
+	DeleteMCPNamedQuery(context.Context, string) (ImplResponse, error)
 }
 
 // ModelCatalogServiceAPIServicer defines the api actions for the ModelCatalogServiceAPI service
@@ -61,11 +85,25 @@ type MCPCatalogServiceAPIServicer interface {
 // and updated with the logic required for the API.
 type ModelCatalogServiceAPIServicer interface {
 	FindLabels(context.Context, model.CatalogAssetType, string, string, model.SortOrder, string) (ImplResponse, error)
-	FindModels(context.Context, bool, int32, string, string, string, string, []string, string, []string, string, string, model.OrderByField, model.SortOrder, string) (ImplResponse, error)
-	FindModelsFilterOptions(context.Context) (ImplResponse, error)
+	FindModels(context.Context, bool, int32, string, string, string, string, []string, string, []string, string, bool, string, string, string, model.OrderByField, model.SortOrder, string) (ImplResponse, error)
+	FindModelsFilterOptions(context.Context, []string, string, []string, bool, string) (ImplResponse, error)
+	CompareModels(context.Context, model.CatalogModelCompareRequest) (ImplResponse, error)
+	FindModelNamedQueries(context.Context) (ImplResponse, error)
+	CreateModelNamedQuery(context.Context, model.CatalogNamedQuery) (ImplResponse, error)
+	GetModelNamedQuery(context.Context, string) (ImplResponse, error)
+	UpdateModelNamedQuery(context.Context, string, model.CatalogNamedQueryUpdate) (ImplResponse, error)
+	DeleteModelNamedQuery(context.Context, string) (ImplResponse, error)
 	FindSources(context.Context, string, model.CatalogAssetType, string, model.OrderByField, model.SortOrder, string) (ImplResponse, error)
 	PreviewCatalogSource(context.Context, *os.File, string, string, string, *os.File) (ImplResponse, error)
+	CreateModel(context.Context, string, model.CatalogModelCreate) (ImplResponse, error)
 	GetModel(context.Context, string, string) (ImplResponse, error)
+	DeleteModel(context.Context, string, string) (ImplResponse, error)
+	UpdateModel(context.Context, string, string, model.CatalogModelUpdate) (ImplResponse, error)
 	GetAllModelArtifacts(context.Context, string, string, []model.ArtifactTypeQueryParam, []model.ArtifactTypeQueryParam, string, string, string, model.SortOrder, string) (ImplResponse, error)
 	GetAllModelPerformanceArtifacts(context.Context, string, string, int32, bool, string, string, string, string, string, string, string, model.SortOrder, string) (ImplResponse, error)
+	GetModelSizing(context.Context, string, string, int32, []string, string, string, string, string) (ImplResponse, error)
+	GetModelVariants(context.Context, string, string) (ImplResponse, error)
+	GetSourceRefresh(context.Context, string, string) (ImplResponse, error)
+	GetSourceSyncHistory(context.Context, string, string, string, string) (ImplResponse, error)
+	RefreshSource(context.Context, string, bool) (ImplResponse, error)
 }
@@ -11,6 +11,7 @@
 package openapi
 
 import (
+	"encoding/json"
 	"net/http"
 	"strings"
 
@@ -82,6 +83,36 @@ func (c *MCPCatalogServiceAPIController) Routes() Routes {
 			"/api/mcp_catalog/v1alpha1/mcp_servers/{server_id}/tools/{tool_name}",
 			c.GetMCPServerTool,
 		},
+		"FindMCPNamedQueries": Route{
+			"FindMCPNamedQueries",
+			strings.ToUpper("Get"),
+			"/api/mcp_catalog/v1alpha1/named_queries",
+			c.FindMCPNamedQueries,
+		},
+		"CreateMCPNamedQuery": Route{
+			"CreateMCPNamedQuery",
+			strings.ToUpper("Post"),
+			"/api/mcp_catalog/v1alpha1/named_queries",
+			c.CreateMCPNamedQuery,
+		},
+		"GetMCPNamedQuery": Route{
+			"GetMCPNamedQuery",
+			strings.ToUpper("Get"),
+			"/api/mcp_catalog/v1alpha1/named_queries/{query_name}",
+			c.GetMCPNamedQuery,
+		},
+		"UpdateMCPNamedQuery": Route{
+			"UpdateMCPNamedQuery",
+			strings.ToUpper("Put"),
+			"/api/mcp_catalog/v1alpha1/named_queries/{query_name}",
+			c.UpdateMCPNamedQuery,
+		},
+		"DeleteMCPNamedQuery": Route{
+			"DeleteMCPNamedQuery",
+			strings.ToUpper("Delete"),
+			"/api/mcp_catalog/v1alpha1/named_queries/{query_name}",
+			c.DeleteMCPNamedQuery,
+		},
 	}
 }
 
@@ -118,6 +149,36 @@ func (c *MCPCatalogServiceAPIController) OrderedRoutes() []Route {
 			"/api/mcp_catalog/v1alpha1/mcp_servers/{server_id}/tools/{tool_name}",
 			c.GetMCPServerTool,
 		},
+		Route{
+			"FindMCPNamedQueries",
+			strings.ToUpper("Get"),
+			"/api/mcp_catalog/v1alpha1/named_queries",
+			c.FindMCPNamedQueries,
+		},
+		Route{
+			"CreateMCPNamedQuery",
+			strings.ToUpper("Post"),
+			"/api/mcp_catalog/v1alpha1/named_queries",
+			c.CreateMCPNamedQuery,
+		},
+		Route{
+			"GetMCPNamedQuery",
+			strings.ToUpper("Get"),
+			"/api/mcp_catalog/v1alpha1/named_queries/{query_name}",
+			c.GetMCPNamedQuery,
+		},
+		Route{
+			"UpdateMCPNamedQuery",
+			strings.ToUpper("Put"),
+			"/api/mcp_catalog/v1alpha1/named_queries/{query_name}",
+			c.UpdateMCPNamedQuery,
+		},
+		Route{
+			"DeleteMCPNamedQuery",
+			strings.ToUpper("Delete"),
+			"/api/mcp_catalog/v1alpha1/named_queries/{query_name}",
+			c.DeleteMCPNamedQuery,
+		},
 	}
 }
 
@@ -234,7 +295,37 @@ func (c *MCPCatalogServiceAPIController) FindMCPServers(w http.ResponseWriter, r
 
 // FindMCPServersFilterOptions - Lists fields, values, and named queries that can be used in `filterQuery` on the list MCP servers endpoint.
 func (c *MCPCatalogServiceAPIController) FindMCPServersFilterOptions(w http.ResponseWriter, r *http.Request) {
-	result, err := c.service.FindMCPServersFilterOptions(r.Context())
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	var qParam string
+	if query.Has("q") {
+		param := query.Get("q")
+
+		qParam = param
+	} else {
+	}
+	var sourceLabelParam []string
+	if query.Has("sourceLabel") {
+		sourceLabelParam = strings.Split(query.Get("sourceLabel"), ",")
+	}
+	var filterQueryParam string
+	if query.Has("filterQuery") {
+		param := query.Get("filterQuery")
+
+		filterQueryParam = param
+	} else {
+	}
+	var namedQueryParam string
+	if query.Has("namedQuery") {
+		param := query.Get("namedQuery")
+
+		namedQueryParam = param
+	} else {
+	}
+	result, err := c.service.FindMCPServersFilterOptions(r.Context(), qParam, sourceLabelParam, filterQueryParam, namedQueryParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -378,3 +469,108 @@ func (c *MCPCatalogServiceAPIController) GetMCPServerTool(w http.ResponseWriter,
 	// If no error, encode the body and the result code
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
+
+// FindMCPNamedQueries - List named queries for MCP servers.
+func (c *MCPCatalogServiceAPIController) FindMCPNamedQueries(w http.ResponseWriter, r *http.Request) {
+	result, err := c.service.FindMCPNamedQueries(r.Context())
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// CreateMCPNamedQuery - Create a named query for MCP servers.
+func (c *MCPCatalogServiceAPIController) CreateMCPNamedQuery(w http.ResponseWriter, r *http.Request) {
+	catalogNamedQueryParam := *model.NewCatalogNamedQueryWithDefaults()
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&catalogNamedQueryParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertCatalogNamedQueryRequired(catalogNamedQueryParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertCatalogNamedQueryConstraints(catalogNamedQueryParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.CreateMCPNamedQuery(r.Context(), catalogNamedQueryParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetMCPNamedQuery - Get a named query for MCP servers.
+func (c *MCPCatalogServiceAPIController) GetMCPNamedQuery(w http.ResponseWriter, r *http.Request) {
+	queryNameParam := chi.URLParam(r, "query_name")
+	if queryNameParam == "" {
+		c.errorHandler(w, r, &RequiredError{"query_name"}, nil)
+		return
+	}
+	result, err := c.service.GetMCPNamedQuery(r.Context(), queryNameParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// UpdateMCPNamedQuery - Update a named query for MCP servers.
+func (c *MCPCatalogServiceAPIController) UpdateMCPNamedQuery(w http.ResponseWriter, r *http.Request) {
+	queryNameParam := chi.URLParam(r, "query_name")
+	if queryNameParam == "" {
+		c.errorHandler(w, r, &RequiredError{"query_name"}, nil)
+		return
+	}
+	catalogNamedQueryUpdateParam := *model.NewCatalogNamedQueryUpdateWithDefaults()
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&catalogNamedQueryUpdateParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertCatalogNamedQueryUpdateRequired(catalogNamedQueryUpdateParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertCatalogNamedQueryUpdateConstraints(catalogNamedQueryUpdateParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.UpdateMCPNamedQuery(r.Context(), queryNameParam, catalogNamedQueryUpdateParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// DeleteMCPNamedQuery - Delete a named query for MCP servers.
+func (c *MCPCatalogServiceAPIController) DeleteMCPNamedQuery(w http.ResponseWriter, r *http.Request) {
+	queryNameParam := chi.URLParam(r, "query_name")
+	if queryNameParam == "" {
+		c.errorHandler(w, r, &RequiredError{"query_name"}, nil)
+		return
+	}
+	result, err := c.service.DeleteMCPNamedQuery(r.Context(), queryNameParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
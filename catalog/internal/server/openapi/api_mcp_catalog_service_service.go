@@ -78,11 +78,38 @@ func (m *MCPCatalogServiceAPIService) FindMCPServers(ctx context.Context, name s
 }
 
 // FindMCPServersFilterOptions - Lists fields, values, and named queries that can be used in `filterQuery` on the list MCP servers endpoint.
-func (m *MCPCatalogServiceAPIService) FindMCPServersFilterOptions(ctx context.Context) (ImplResponse, error) {
-	filterOptions, err := m.mcpProvider.GetFilterOptions(ctx)
+func (m *MCPCatalogServiceAPIService) FindMCPServersFilterOptions(ctx context.Context, q string, sourceLabel []string, filterQuery string, namedQuery string) (ImplResponse, error) {
+	// Clean up empty sourceLabel values
+	if len(sourceLabel) == 1 && sourceLabel[0] == "" {
+		sourceLabel = nil
+	}
+
+	// Convert sourceLabels to sourceIDs
+	var sourceIDs []string
+	noMatchingSources := false
+	if len(sourceLabel) > 0 && m.mcpSources != nil {
+		sources := m.mcpSources.ByLabel(sourceLabel)
+		noMatchingSources = len(sources) == 0
+		sourceIDs = make([]string, len(sources))
+		for i, source := range sources {
+			sourceIDs[i] = source.ID
+		}
+	}
+
+	filterOptions, err := m.mcpProvider.GetFilterOptions(ctx, catalog.MCPFilterOptionsParams{
+		Query:       q,
+		FilterQuery: filterQuery,
+		NamedQuery:  namedQuery,
+		SourceIDs:   sourceIDs,
+	})
 	if err != nil {
-		return ErrorResponse(http.StatusInternalServerError, err), err
+		return ErrorResponse(api.ErrToStatus(err), err), err
 	}
+
+	if noMatchingSources {
+		clearFilterOptionCounts(filterOptions)
+	}
+
 	return Response(http.StatusOK, *filterOptions), nil
 }
 
@@ -137,3 +164,87 @@ func (m *MCPCatalogServiceAPIService) GetMCPServerTool(ctx context.Context, serv
 
 	return Response(http.StatusOK, tool), nil
 }
+
+// FindMCPNamedQueries - List named queries for MCP servers.
+func (m *MCPCatalogServiceAPIService) FindMCPNamedQueries(ctx context.Context) (ImplResponse, error) {
+	queries, err := m.namedQueries()
+	if err != nil {
+		return ErrorResponse(http.StatusNotImplemented, err), err
+	}
+
+	list, err := queries.ListNamedQueries(ctx)
+	if err != nil {
+		return ErrorResponse(api.ErrToStatus(err), err), err
+	}
+
+	return Response(http.StatusOK, list), nil
+}
+
+// CreateMCPNamedQuery - Create a named query for MCP servers.
+func (m *MCPCatalogServiceAPIService) CreateMCPNamedQuery(ctx context.Context, catalogNamedQuery model.CatalogNamedQuery) (ImplResponse, error) {
+	queries, err := m.namedQueries()
+	if err != nil {
+		return ErrorResponse(http.StatusNotImplemented, err), err
+	}
+
+	created, err := queries.CreateNamedQuery(ctx, catalogNamedQuery)
+	if err != nil {
+		return ErrorResponse(api.ErrToStatus(err), err), err
+	}
+
+	return Response(http.StatusCreated, created), nil
+}
+
+// GetMCPNamedQuery - Get a named query for MCP servers.
+func (m *MCPCatalogServiceAPIService) GetMCPNamedQuery(ctx context.Context, queryName string) (ImplResponse, error) {
+	queries, err := m.namedQueries()
+	if err != nil {
+		return ErrorResponse(http.StatusNotImplemented, err), err
+	}
+
+	query, err := queries.GetNamedQuery(ctx, queryName)
+	if err != nil {
+		return ErrorResponse(api.ErrToStatus(err), err), err
+	}
+
+	return Response(http.StatusOK, query), nil
+}
+
+// UpdateMCPNamedQuery - Update a named query for MCP servers.
+func (m *MCPCatalogServiceAPIService) UpdateMCPNamedQuery(ctx context.Context, queryName string, catalogNamedQueryUpdate model.CatalogNamedQueryUpdate) (ImplResponse, error) {
+	queries, err := m.namedQueries()
+	if err != nil {
+		return ErrorResponse(http.StatusNotImplemented, err), err
+	}
+
+	updated, err := queries.UpdateNamedQuery(ctx, queryName, catalogNamedQueryUpdate)
+	if err != nil {
+		return ErrorResponse(api.ErrToStatus(err), err), err
+	}
+
+	return Response(http.StatusOK, updated), nil
+}
+
+// DeleteMCPNamedQuery - Delete a named query for MCP servers.
+func (m *MCPCatalogServiceAPIService) DeleteMCPNamedQuery(ctx context.Context, queryName string) (ImplResponse, error) {
+	queries, err := m.namedQueries()
+	if err != nil {
+		return ErrorResponse(http.StatusNotImplemented, err), err
+	}
+
+	if err := queries.DeleteNamedQuery(ctx, queryName); err != nil {
+		return ErrorResponse(api.ErrToStatus(err), err), err
+	}
+
+	return Response(http.StatusNoContent, nil), nil
+}
+
+// namedQueries returns the provider as a NamedQueryManager, or an error if
+// it can't manage named queries.
+func (m *MCPCatalogServiceAPIService) namedQueries() (catalog.NamedQueryManager, error) {
+	queries, ok := m.mcpProvider.(catalog.NamedQueryManager)
+	if !ok {
+		return nil, errors.New("the MCP catalog does not support named queries")
+	}
+	return queries, nil
+}
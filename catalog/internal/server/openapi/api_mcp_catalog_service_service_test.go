@@ -35,6 +35,8 @@ type mockMCPProvider struct {
 	filterOptions *model.FilterOptionsList
 	// capturedParams captures the last params passed to ListMCPServers.
 	capturedParams *catalog.ListMCPServersParams
+	// capturedFilterOptionsParams captures the last params passed to GetFilterOptions.
+	capturedFilterOptionsParams *catalog.MCPFilterOptionsParams
 }
 
 func newMockMCPProvider() *mockMCPProvider {
@@ -165,10 +167,14 @@ func (m *mockMCPProvider) ListMCPServerTools(ctx context.Context, serverID strin
 	}, nil
 }
 
-func (m *mockMCPProvider) GetFilterOptions(ctx context.Context) (*model.FilterOptionsList, error) {
+func (m *mockMCPProvider) GetFilterOptions(ctx context.Context, params catalog.MCPFilterOptionsParams) (*model.FilterOptionsList, error) {
+	m.capturedFilterOptionsParams = &params
 	if m.shouldErrorOnGetFilterOptions {
 		return nil, fmt.Errorf("mock error in GetFilterOptions")
 	}
+	if err, ok := m.namedQueryErr[params.NamedQuery]; ok {
+		return nil, err
+	}
 	if m.filterOptions != nil {
 		return m.filterOptions, nil
 	}
@@ -761,7 +767,7 @@ func TestFindMCPServersFilterOptions(t *testing.T) {
 	t.Run("provider returns empty FilterOptionsList", func(t *testing.T) {
 		provider := newMockMCPProvider()
 		service := NewMCPCatalogServiceAPIService(provider, nil)
-		result, err := service.FindMCPServersFilterOptions(ctx)
+		result, err := service.FindMCPServersFilterOptions(ctx, "", nil, "", "")
 
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, result.Code)
@@ -787,7 +793,7 @@ func TestFindMCPServersFilterOptions(t *testing.T) {
 		}
 
 		service := NewMCPCatalogServiceAPIService(provider, nil)
-		result, err := service.FindMCPServersFilterOptions(ctx)
+		result, err := service.FindMCPServersFilterOptions(ctx, "", nil, "", "")
 
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, result.Code)
@@ -806,11 +812,63 @@ func TestFindMCPServersFilterOptions(t *testing.T) {
 		provider.shouldErrorOnGetFilterOptions = true
 
 		service := NewMCPCatalogServiceAPIService(provider, nil)
-		result, err := service.FindMCPServersFilterOptions(ctx)
+		result, err := service.FindMCPServersFilterOptions(ctx, "", nil, "", "")
 
 		assert.Error(t, err)
 		assert.Equal(t, http.StatusInternalServerError, result.Code)
 	})
+
+	t.Run("counts are for the MCP servers that match the parameters", func(t *testing.T) {
+		mcpSources := makeMCPSources(map[string]basecatalog.MCPSource{
+			"src-openai": {ID: "src-openai", Labels: []string{"openai"}, Enabled: apiutils.Of(true)},
+		})
+		provider := newMockMCPProvider()
+
+		service := NewMCPCatalogServiceAPIService(provider, mcpSources)
+		result, err := service.FindMCPServersFilterOptions(ctx, "assistant", []string{"openai"}, "license='MIT'", "production_ready")
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, result.Code)
+		require.NotNil(t, provider.capturedFilterOptionsParams)
+		assert.Equal(t, catalog.MCPFilterOptionsParams{
+			Query:       "assistant",
+			FilterQuery: "license='MIT'",
+			NamedQuery:  "production_ready",
+			SourceIDs:   []string{"src-openai"},
+		}, *provider.capturedFilterOptionsParams)
+	})
+
+	t.Run("non-matching label counts no MCP servers", func(t *testing.T) {
+		mcpSources := makeMCPSources(map[string]basecatalog.MCPSource{
+			"src-openai": {ID: "src-openai", Labels: []string{"openai"}, Enabled: apiutils.Of(true)},
+		})
+		filters := map[string]model.FilterOption{
+			"provider": {Type: "string", Values: []any{"GitHub", "OpenAI"}, Counts: &map[string]int32{"GitHub": 2, "OpenAI": 1}},
+		}
+		provider := newMockMCPProvider()
+		provider.filterOptions = &model.FilterOptionsList{Filters: &filters}
+
+		service := NewMCPCatalogServiceAPIService(provider, mcpSources)
+		result, err := service.FindMCPServersFilterOptions(ctx, "", []string{"no-match"}, "", "")
+
+		require.NoError(t, err)
+		body, ok := result.Body.(model.FilterOptionsList)
+		require.True(t, ok)
+		assert.Equal(t, map[string]int32{"GitHub": 0, "OpenAI": 0}, *body.GetFilters()["provider"].Counts)
+	})
+
+	t.Run("unknown named query returns bad request", func(t *testing.T) {
+		provider := newMockMCPProvider()
+		provider.namedQueryErr = map[string]error{
+			"unknown": fmt.Errorf("unknown named query %q: %w", "unknown", api.ErrBadRequest),
+		}
+
+		service := NewMCPCatalogServiceAPIService(provider, nil)
+		result, err := service.FindMCPServersFilterOptions(ctx, "", nil, "", "unknown")
+
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusBadRequest, result.Code)
+	})
 }
 
 func makeMCPSources(sources map[string]basecatalog.MCPSource) *catalog.MCPSourceCollection {
@@ -891,3 +949,31 @@ func TestFindMCPServers_SourceLabel(t *testing.T) {
 		assert.Equal(t, http.StatusOK, result.Code)
 	})
 }
+
+func TestMCPNamedQueries(t *testing.T) {
+	provider := &struct {
+		*mockMCPProvider
+		*basecatalog.NamedQueryStore
+	}{
+		mockMCPProvider: newMockMCPProvider(),
+		NamedQueryStore: basecatalog.NewNamedQueryStore(basecatalog.AssetTypeMCPServers, func() map[string]map[string]basecatalog.FieldFilter {
+			return map[string]map[string]basecatalog.FieldFilter{
+				"remote": {"deploymentMode": {Operator: "=", Value: "remote"}},
+			}
+		}, nil),
+	}
+	service := NewMCPCatalogServiceAPIService(provider, nil)
+
+	result, err := service.FindMCPNamedQueries(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, result.Code)
+	list := result.Body.(*model.CatalogNamedQueryList)
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, "remote", list.Items[0].Name)
+
+	result, _ = service.UpdateMCPNamedQuery(context.Background(), "remote", model.CatalogNamedQueryUpdate{})
+	assert.Equal(t, http.StatusBadRequest, result.Code, "saving queries needs a repository")
+
+	result, _ = NewMCPCatalogServiceAPIService(newMockMCPProvider(), nil).GetMCPNamedQuery(context.Background(), "remote")
+	assert.Equal(t, http.StatusNotImplemented, result.Code)
+}
@@ -11,6 +11,7 @@
 package openapi
 
 import (
+	"encoding/json"
 	"net/http"
 	"os"
 	"strings"
@@ -71,6 +72,42 @@ func (c *ModelCatalogServiceAPIController) Routes() Routes {
 			"/api/model_catalog/v1alpha1/models/filter_options",
 			c.FindModelsFilterOptions,
 		},
+		"CompareModels": Route{
+			"CompareModels",
+			strings.ToUpper("Post"),
+			"/api/model_catalog/v1alpha1/models:compare",
+			c.CompareModels,
+		},
+		"FindModelNamedQueries": Route{
+			"FindModelNamedQueries",
+			strings.ToUpper("Get"),
+			"/api/model_catalog/v1alpha1/named_queries",
+			c.FindModelNamedQueries,
+		},
+		"CreateModelNamedQuery": Route{
+			"CreateModelNamedQuery",
+			strings.ToUpper("Post"),
+			"/api/model_catalog/v1alpha1/named_queries",
+			c.CreateModelNamedQuery,
+		},
+		"GetModelNamedQuery": Route{
+			"GetModelNamedQuery",
+			strings.ToUpper("Get"),
+			"/api/model_catalog/v1alpha1/named_queries/{query_name}",
+			c.GetModelNamedQuery,
+		},
+		"UpdateModelNamedQuery": Route{
+			"UpdateModelNamedQuery",
+			strings.ToUpper("Put"),
+			"/api/model_catalog/v1alpha1/named_queries/{query_name}",
+			c.UpdateModelNamedQuery,
+		},
+		"DeleteModelNamedQuery": Route{
+			"DeleteModelNamedQuery",
+			strings.ToUpper("Delete"),
+			"/api/model_catalog/v1alpha1/named_queries/{query_name}",
+			c.DeleteModelNamedQuery,
+		},
 		"FindSources": Route{
 			"FindSources",
 			strings.ToUpper("Get"),
@@ -83,12 +120,30 @@ func (c *ModelCatalogServiceAPIController) Routes() Routes {
 			"/api/model_catalog/v1alpha1/sources/preview",
 			c.PreviewCatalogSource,
 		},
+		"CreateModel": Route{
+			"CreateModel",
+			strings.ToUpper("Post"),
+			"/api/model_catalog/v1alpha1/sources/{source_id}/models",
+			c.CreateModel,
+		},
 		"GetModel": Route{
 			"GetModel",
 			strings.ToUpper("Get"),
 			"/api/model_catalog/v1alpha1/sources/{source_id}/models/*",
 			c.GetModel,
 		},
+		"DeleteModel": Route{
+			"DeleteModel",
+			strings.ToUpper("Delete"),
+			"/api/model_catalog/v1alpha1/sources/{source_id}/models/*",
+			c.DeleteModel,
+		},
+		"UpdateModel": Route{
+			"UpdateModel",
+			strings.ToUpper("Patch"),
+			"/api/model_catalog/v1alpha1/sources/{source_id}/models/*",
+			c.UpdateModel,
+		},
 		"GetAllModelArtifacts": Route{
 			"GetAllModelArtifacts",
 			strings.ToUpper("Get"),
@@ -101,6 +156,36 @@ func (c *ModelCatalogServiceAPIController) Routes() Routes {
 			"/api/model_catalog/v1alpha1/sources/{source_id}/models/{model_name}/artifacts/performance",
 			c.GetAllModelPerformanceArtifacts,
 		},
+		"GetModelSizing": Route{
+			"GetModelSizing",
+			strings.ToUpper("Get"),
+			"/api/model_catalog/v1alpha1/sources/{source_id}/models/{model_name}/sizing",
+			c.GetModelSizing,
+		},
+		"GetModelVariants": Route{
+			"GetModelVariants",
+			strings.ToUpper("Get"),
+			"/api/model_catalog/v1alpha1/sources/{source_id}/models/{model_name}/variants",
+			c.GetModelVariants,
+		},
+		"GetSourceRefresh": Route{
+			"GetSourceRefresh",
+			strings.ToUpper("Get"),
+			"/api/model_catalog/v1alpha1/sources/{source_id}/refreshes/{refresh_id}",
+			c.GetSourceRefresh,
+		},
+		"GetSourceSyncHistory": Route{
+			"GetSourceSyncHistory",
+			strings.ToUpper("Get"),
+			"/api/model_catalog/v1alpha1/sources/{source_id}/sync_history",
+			c.GetSourceSyncHistory,
+		},
+		"RefreshSource": Route{
+			"RefreshSource",
+			strings.ToUpper("Post"),
+			"/api/model_catalog/v1alpha1/sources/{source_id}:refresh",
+			c.RefreshSource,
+		},
 	}
 }
 
@@ -125,6 +210,42 @@ func (c *ModelCatalogServiceAPIController) OrderedRoutes() []Route {
 			"/api/model_catalog/v1alpha1/models/filter_options",
 			c.FindModelsFilterOptions,
 		},
+		Route{
+			"CompareModels",
+			strings.ToUpper("Post"),
+			"/api/model_catalog/v1alpha1/models:compare",
+			c.CompareModels,
+		},
+		Route{
+			"FindModelNamedQueries",
+			strings.ToUpper("Get"),
+			"/api/model_catalog/v1alpha1/named_queries",
+			c.FindModelNamedQueries,
+		},
+		Route{
+			"CreateModelNamedQuery",
+			strings.ToUpper("Post"),
+			"/api/model_catalog/v1alpha1/named_queries",
+			c.CreateModelNamedQuery,
+		},
+		Route{
+			"GetModelNamedQuery",
+			strings.ToUpper("Get"),
+			"/api/model_catalog/v1alpha1/named_queries/{query_name}",
+			c.GetModelNamedQuery,
+		},
+		Route{
+			"UpdateModelNamedQuery",
+			strings.ToUpper("Put"),
+			"/api/model_catalog/v1alpha1/named_queries/{query_name}",
+			c.UpdateModelNamedQuery,
+		},
+		Route{
+			"DeleteModelNamedQuery",
+			strings.ToUpper("Delete"),
+			"/api/model_catalog/v1alpha1/named_queries/{query_name}",
+			c.DeleteModelNamedQuery,
+		},
 		Route{
 			"FindSources",
 			strings.ToUpper("Get"),
@@ -137,12 +258,30 @@ func (c *ModelCatalogServiceAPIController) OrderedRoutes() []Route {
 			"/api/model_catalog/v1alpha1/sources/preview",
 			c.PreviewCatalogSource,
 		},
+		Route{
+			"CreateModel",
+			strings.ToUpper("Post"),
+			"/api/model_catalog/v1alpha1/sources/{source_id}/models",
+			c.CreateModel,
+		},
 		Route{
 			"GetModel",
 			strings.ToUpper("Get"),
 			"/api/model_catalog/v1alpha1/sources/{source_id}/models/*",
 			c.GetModel,
 		},
+		Route{
+			"DeleteModel",
+			strings.ToUpper("Delete"),
+			"/api/model_catalog/v1alpha1/sources/{source_id}/models/*",
+			c.DeleteModel,
+		},
+		Route{
+			"UpdateModel",
+			strings.ToUpper("Patch"),
+			"/api/model_catalog/v1alpha1/sources/{source_id}/models/*",
+			c.UpdateModel,
+		},
 		Route{
 			"GetAllModelArtifacts",
 			strings.ToUpper("Get"),
@@ -155,6 +294,36 @@ func (c *ModelCatalogServiceAPIController) OrderedRoutes() []Route {
 			"/api/model_catalog/v1alpha1/sources/{source_id}/models/{model_name}/artifacts/performance",
 			c.GetAllModelPerformanceArtifacts,
 		},
+		Route{
+			"GetModelSizing",
+			strings.ToUpper("Get"),
+			"/api/model_catalog/v1alpha1/sources/{source_id}/models/{model_name}/sizing",
+			c.GetModelSizing,
+		},
+		Route{
+			"GetModelVariants",
+			strings.ToUpper("Get"),
+			"/api/model_catalog/v1alpha1/sources/{source_id}/models/{model_name}/variants",
+			c.GetModelVariants,
+		},
+		Route{
+			"GetSourceRefresh",
+			strings.ToUpper("Get"),
+			"/api/model_catalog/v1alpha1/sources/{source_id}/refreshes/{refresh_id}",
+			c.GetSourceRefresh,
+		},
+		Route{
+			"GetSourceSyncHistory",
+			strings.ToUpper("Get"),
+			"/api/model_catalog/v1alpha1/sources/{source_id}/sync_history",
+			c.GetSourceSyncHistory,
+		},
+		Route{
+			"RefreshSource",
+			strings.ToUpper("Post"),
+			"/api/model_catalog/v1alpha1/sources/{source_id}:refresh",
+			c.RefreshSource,
+		},
 	}
 }
 
@@ -298,6 +467,29 @@ func (c *ModelCatalogServiceAPIController) FindModels(w http.ResponseWriter, r *
 	if query.Has("sourceLabel") {
 		sourceLabelParam = strings.Split(query.Get("sourceLabel"), ",")
 	}
+	var groupByParam string
+	if query.Has("groupBy") {
+		param := query.Get("groupBy")
+
+		groupByParam = param
+	} else {
+	}
+	var includeDeprecatedParam bool
+	if query.Has("includeDeprecated") {
+		param, err := parseBoolParameter(
+			query.Get("includeDeprecated"),
+			WithParse[bool](parseBool),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "includeDeprecated", Err: err}, nil)
+			return
+		}
+
+		includeDeprecatedParam = param
+	} else {
+		var param bool = false
+		includeDeprecatedParam = param
+	}
 	var filterQueryParam string
 	if query.Has("filterQuery") {
 		param := query.Get("filterQuery")
@@ -305,6 +497,13 @@ func (c *ModelCatalogServiceAPIController) FindModels(w http.ResponseWriter, r *
 		filterQueryParam = param
 	} else {
 	}
+	var namedQueryParam string
+	if query.Has("namedQuery") {
+		param := query.Get("namedQuery")
+
+		namedQueryParam = param
+	} else {
+	}
 	var pageSizeParam string
 	if query.Has("pageSize") {
 		param := query.Get("pageSize")
@@ -333,7 +532,7 @@ func (c *ModelCatalogServiceAPIController) FindModels(w http.ResponseWriter, r *
 		nextPageTokenParam = param
 	} else {
 	}
-	result, err := c.service.FindModels(r.Context(), recommendationsParam, targetRPSParam, latencyPropertyParam, rpsPropertyParam, hardwareCountPropertyParam, hardwareTypePropertyParam, sourceParam, qParam, sourceLabelParam, filterQueryParam, pageSizeParam, orderByParam, sortOrderParam, nextPageTokenParam)
+	result, err := c.service.FindModels(r.Context(), recommendationsParam, targetRPSParam, latencyPropertyParam, rpsPropertyParam, hardwareCountPropertyParam, hardwareTypePropertyParam, sourceParam, qParam, sourceLabelParam, groupByParam, includeDeprecatedParam, filterQueryParam, namedQueryParam, pageSizeParam, orderByParam, sortOrderParam, nextPageTokenParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -345,7 +544,182 @@ func (c *ModelCatalogServiceAPIController) FindModels(w http.ResponseWriter, r *
 
 // FindModelsFilterOptions - Lists fields and available options that can be used in `filterQuery` on the list models endpoint.
 func (c *ModelCatalogServiceAPIController) FindModelsFilterOptions(w http.ResponseWriter, r *http.Request) {
-	result, err := c.service.FindModelsFilterOptions(r.Context())
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	var sourceParam []string
+	if query.Has("source") {
+		sourceParam = strings.Split(query.Get("source"), ",")
+	}
+	var qParam string
+	if query.Has("q") {
+		param := query.Get("q")
+
+		qParam = param
+	} else {
+	}
+	var sourceLabelParam []string
+	if query.Has("sourceLabel") {
+		sourceLabelParam = strings.Split(query.Get("sourceLabel"), ",")
+	}
+	var includeDeprecatedParam bool
+	if query.Has("includeDeprecated") {
+		param, err := parseBoolParameter(
+			query.Get("includeDeprecated"),
+			WithParse[bool](parseBool),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "includeDeprecated", Err: err}, nil)
+			return
+		}
+
+		includeDeprecatedParam = param
+	} else {
+		var param bool = false
+		includeDeprecatedParam = param
+	}
+	var filterQueryParam string
+	if query.Has("filterQuery") {
+		param := query.Get("filterQuery")
+
+		filterQueryParam = param
+	} else {
+	}
+	result, err := c.service.FindModelsFilterOptions(r.Context(), sourceParam, qParam, sourceLabelParam, includeDeprecatedParam, filterQueryParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// CompareModels - Compare catalog models.
+func (c *ModelCatalogServiceAPIController) CompareModels(w http.ResponseWriter, r *http.Request) {
+	catalogModelCompareRequestParam := *model.NewCatalogModelCompareRequestWithDefaults()
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&catalogModelCompareRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertCatalogModelCompareRequestRequired(catalogModelCompareRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertCatalogModelCompareRequestConstraints(catalogModelCompareRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.CompareModels(r.Context(), catalogModelCompareRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// FindModelNamedQueries - List named queries for models.
+func (c *ModelCatalogServiceAPIController) FindModelNamedQueries(w http.ResponseWriter, r *http.Request) {
+	result, err := c.service.FindModelNamedQueries(r.Context())
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// CreateModelNamedQuery - Create a named query for models.
+func (c *ModelCatalogServiceAPIController) CreateModelNamedQuery(w http.ResponseWriter, r *http.Request) {
+	catalogNamedQueryParam := *model.NewCatalogNamedQueryWithDefaults()
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&catalogNamedQueryParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertCatalogNamedQueryRequired(catalogNamedQueryParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertCatalogNamedQueryConstraints(catalogNamedQueryParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.CreateModelNamedQuery(r.Context(), catalogNamedQueryParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetModelNamedQuery - Get a named query for models.
+func (c *ModelCatalogServiceAPIController) GetModelNamedQuery(w http.ResponseWriter, r *http.Request) {
+	queryNameParam := chi.URLParam(r, "query_name")
+	if queryNameParam == "" {
+		c.errorHandler(w, r, &RequiredError{"query_name"}, nil)
+		return
+	}
+	result, err := c.service.GetModelNamedQuery(r.Context(), queryNameParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// UpdateModelNamedQuery - Update a named query for models.
+func (c *ModelCatalogServiceAPIController) UpdateModelNamedQuery(w http.ResponseWriter, r *http.Request) {
+	queryNameParam := chi.URLParam(r, "query_name")
+	if queryNameParam == "" {
+		c.errorHandler(w, r, &RequiredError{"query_name"}, nil)
+		return
+	}
+	catalogNamedQueryUpdateParam := *model.NewCatalogNamedQueryUpdateWithDefaults()
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&catalogNamedQueryUpdateParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertCatalogNamedQueryUpdateRequired(catalogNamedQueryUpdateParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertCatalogNamedQueryUpdateConstraints(catalogNamedQueryUpdateParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.UpdateModelNamedQuery(r.Context(), queryNameParam, catalogNamedQueryUpdateParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// DeleteModelNamedQuery - Delete a named query for models.
+func (c *ModelCatalogServiceAPIController) DeleteModelNamedQuery(w http.ResponseWriter, r *http.Request) {
+	queryNameParam := chi.URLParam(r, "query_name")
+	if queryNameParam == "" {
+		c.errorHandler(w, r, &RequiredError{"query_name"}, nil)
+		return
+	}
+	result, err := c.service.DeleteModelNamedQuery(r.Context(), queryNameParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -483,6 +857,38 @@ func (c *ModelCatalogServiceAPIController) PreviewCatalogSource(w http.ResponseW
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// CreateModel - Create a `CatalogModel`.
+func (c *ModelCatalogServiceAPIController) CreateModel(w http.ResponseWriter, r *http.Request) {
+	sourceIdParam := chi.URLParam(r, "source_id")
+	if sourceIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"source_id"}, nil)
+		return
+	}
+	catalogModelCreateParam := *model.NewCatalogModelCreateWithDefaults()
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&catalogModelCreateParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertCatalogModelCreateRequired(catalogModelCreateParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertCatalogModelCreateConstraints(catalogModelCreateParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.CreateModel(r.Context(), sourceIdParam, catalogModelCreateParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // GetModel - Get a `CatalogModel`.
 func (c *ModelCatalogServiceAPIController) GetModel(w http.ResponseWriter, r *http.Request) {
 	sourceIdParam := chi.URLParam(r, "source_id")
@@ -518,6 +924,22 @@ func (c *ModelCatalogServiceAPIController) GetModel(w http.ResponseWriter, r *ht
 		return
 	}
 
+	// And /sizing requests to getModelSizing
+	if strings.HasSuffix(r.URL.Path, "/sizing") {
+		modelName := strings.TrimSuffix(modelNameParam, "/sizing")
+		chi.RouteContext(r.Context()).URLParams.Add("model_name", modelName)
+		c.GetModelSizing(w, r)
+		return
+	}
+
+	// And /variants requests to getModelVariants
+	if strings.HasSuffix(r.URL.Path, "/variants") {
+		modelName := strings.TrimSuffix(modelNameParam, "/variants")
+		chi.RouteContext(r.Context()).URLParams.Add("model_name", modelName)
+		c.GetModelVariants(w, r)
+		return
+	}
+
 	result, err := c.service.GetModel(r.Context(), sourceIdParam, modelNameParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
@@ -528,6 +950,65 @@ func (c *ModelCatalogServiceAPIController) GetModel(w http.ResponseWriter, r *ht
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// DeleteModel - Delete a `CatalogModel`.
+func (c *ModelCatalogServiceAPIController) DeleteModel(w http.ResponseWriter, r *http.Request) {
+	sourceIdParam := chi.URLParam(r, "source_id")
+	if sourceIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"source_id"}, nil)
+		return
+	}
+	modelNameParam := chi.URLParam(r, "*")
+	if modelNameParam == "" {
+		c.errorHandler(w, r, &RequiredError{"*"}, nil)
+		return
+	}
+	result, err := c.service.DeleteModel(r.Context(), sourceIdParam, modelNameParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// UpdateModel - Update a `CatalogModel`.
+func (c *ModelCatalogServiceAPIController) UpdateModel(w http.ResponseWriter, r *http.Request) {
+	sourceIdParam := chi.URLParam(r, "source_id")
+	if sourceIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"source_id"}, nil)
+		return
+	}
+	modelNameParam := chi.URLParam(r, "*")
+	if modelNameParam == "" {
+		c.errorHandler(w, r, &RequiredError{"*"}, nil)
+		return
+	}
+	catalogModelUpdateParam := *model.NewCatalogModelUpdateWithDefaults()
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&catalogModelUpdateParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertCatalogModelUpdateRequired(catalogModelUpdateParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertCatalogModelUpdateConstraints(catalogModelUpdateParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.UpdateModel(r.Context(), sourceIdParam, modelNameParam, catalogModelUpdateParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // GetAllModelArtifacts - List CatalogArtifacts.
 func (c *ModelCatalogServiceAPIController) GetAllModelArtifacts(w http.ResponseWriter, r *http.Request) {
 	query, err := parseQuery(r.URL.RawQuery)
@@ -743,3 +1224,209 @@ func (c *ModelCatalogServiceAPIController) GetAllModelPerformanceArtifacts(w htt
 	// If no error, encode the body and the result code
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
+
+// GetModelSizing - Get deployment sizing recommendations for a model.
+func (c *ModelCatalogServiceAPIController) GetModelSizing(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	sourceIdParam := chi.URLParam(r, "source_id")
+	if sourceIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"source_id"}, nil)
+		return
+	}
+	modelNameParam := chi.URLParam(r, "model_name")
+	if modelNameParam == "" {
+		c.errorHandler(w, r, &RequiredError{"model_name"}, nil)
+		return
+	}
+	var targetRPSParam int32
+	if query.Has("targetRPS") {
+		param, err := parseNumericParameter[int32](
+			query.Get("targetRPS"),
+			WithParse[int32](parseInt32),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "targetRPS", Err: err}, nil)
+			return
+		}
+
+		targetRPSParam = param
+	} else {
+		var param int32 = 1
+		targetRPSParam = param
+	}
+	var maxLatencyParam []string
+	if query.Has("maxLatency") {
+		maxLatencyParam = strings.Split(query.Get("maxLatency"), ",")
+	}
+	var rpsPropertyParam string
+	if query.Has("rpsProperty") {
+		param := query.Get("rpsProperty")
+
+		rpsPropertyParam = param
+	} else {
+		param := "requests_per_second"
+		rpsPropertyParam = param
+	}
+	var hardwareCountPropertyParam string
+	if query.Has("hardwareCountProperty") {
+		param := query.Get("hardwareCountProperty")
+
+		hardwareCountPropertyParam = param
+	} else {
+		param := "hardware_count"
+		hardwareCountPropertyParam = param
+	}
+	var hardwareTypePropertyParam string
+	if query.Has("hardwareTypeProperty") {
+		param := query.Get("hardwareTypeProperty")
+
+		hardwareTypePropertyParam = param
+	} else {
+		param := "hardware_type"
+		hardwareTypePropertyParam = param
+	}
+	var filterQueryParam string
+	if query.Has("filterQuery") {
+		param := query.Get("filterQuery")
+
+		filterQueryParam = param
+	} else {
+	}
+	result, err := c.service.GetModelSizing(r.Context(), sourceIdParam, modelNameParam, targetRPSParam, maxLatencyParam, rpsPropertyParam, hardwareCountPropertyParam, hardwareTypePropertyParam, filterQueryParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetModelVariants - List the variants of a model.
+func (c *ModelCatalogServiceAPIController) GetModelVariants(w http.ResponseWriter, r *http.Request) {
+	sourceIdParam := chi.URLParam(r, "source_id")
+	if sourceIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"source_id"}, nil)
+		return
+	}
+	modelNameParam := chi.URLParam(r, "model_name")
+	if modelNameParam == "" {
+		c.errorHandler(w, r, &RequiredError{"model_name"}, nil)
+		return
+	}
+	result, err := c.service.GetModelVariants(r.Context(), sourceIdParam, modelNameParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetSourceRefresh - Get a CatalogSource refresh.
+func (c *ModelCatalogServiceAPIController) GetSourceRefresh(w http.ResponseWriter, r *http.Request) {
+	sourceIdParam := chi.URLParam(r, "source_id")
+	if sourceIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"source_id"}, nil)
+		return
+	}
+	refreshIdParam := chi.URLParam(r, "refresh_id")
+	if refreshIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"refresh_id"}, nil)
+		return
+	}
+	result, err := c.service.GetSourceRefresh(r.Context(), sourceIdParam, refreshIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// GetSourceSyncHistory - List CatalogSource sync history.
+func (c *ModelCatalogServiceAPIController) GetSourceSyncHistory(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	sourceIdParam := chi.URLParam(r, "source_id")
+	if sourceIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"source_id"}, nil)
+		return
+	}
+	var sinceParam string
+	if query.Has("since") {
+		param := query.Get("since")
+
+		sinceParam = param
+	} else {
+	}
+	var pageSizeParam string
+	if query.Has("pageSize") {
+		param := query.Get("pageSize")
+
+		pageSizeParam = param
+	} else {
+	}
+	var nextPageTokenParam string
+	if query.Has("nextPageToken") {
+		param := query.Get("nextPageToken")
+
+		nextPageTokenParam = param
+	} else {
+	}
+	result, err := c.service.GetSourceSyncHistory(r.Context(), sourceIdParam, sinceParam, pageSizeParam, nextPageTokenParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// RefreshSource - Refresh a CatalogSource.
+func (c *ModelCatalogServiceAPIController) RefreshSource(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	sourceIdParam := chi.URLParam(r, "source_id")
+	if sourceIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"source_id"}, nil)
+		return
+	}
+	var rescanParam bool
+	if query.Has("rescan") {
+		param, err := parseBoolParameter(
+			query.Get("rescan"),
+			WithParse[bool](parseBool),
+		)
+		if err != nil {
+			c.errorHandler(w, r, &ParsingError{Param: "rescan", Err: err}, nil)
+			return
+		}
+
+		rescanParam = param
+	} else {
+		var param bool = false
+		rescanParam = param
+	}
+	result, err := c.service.RefreshSource(r.Context(), sourceIdParam, rescanParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
@@ -21,6 +21,10 @@ import (
 	"github.com/kubeflow/hub/pkg/api"
 )
 
+// groupByVariant is the groupBy value that collapses the variants of a base
+// model into one entry when finding models.
+const groupByVariant = "variant"
+
 // ModelCatalogServiceAPIService is a service that implements the logic for the ModelCatalogServiceAPIServicer
 // This service should implement the business logic for every endpoint for the ModelCatalogServiceAPI s.coreApi.
 // Include any external packages or services that will be required by this service.
@@ -30,6 +34,10 @@ type ModelCatalogServiceAPIService struct {
 	mcpSources       *catalog.MCPSourceCollection
 	labels           *catalog.LabelCollection
 	sourceRepository models.CatalogSourceRepository
+	syncRepository   models.CatalogSourceSyncRepository
+
+	// refreshRepository queues source refreshes for the leader to run.
+	refreshRepository models.CatalogSourceRefreshRepository
 }
 
 // GetAllModelArtifacts retrieves all model artifacts for a given model from the specified source.
@@ -126,6 +134,59 @@ func (m *ModelCatalogServiceAPIService) GetAllModelPerformanceArtifacts(ctx cont
 	return Response(http.StatusOK, artifacts), nil
 }
 
+// GetModelSizing ranks the hardware configurations a model can be deployed
+// on to serve the target RPS within the latency objectives.
+func (m *ModelCatalogServiceAPIService) GetModelSizing(ctx context.Context, sourceID string, modelName string, targetRPS int32, maxLatency []string, rpsProperty string, hardwareCountProperty string, hardwareTypeProperty string, filterQuery string) (ImplResponse, error) {
+	if newName, err := url.PathUnescape(modelName); err == nil {
+		modelName = newName
+	}
+
+	sizing, err := m.provider.GetModelSizing(ctx, modelName, sourceID, catalog.ModelSizingParams{
+		TargetRPS:             targetRPS,
+		MaxLatency:            maxLatency,
+		RPSProperty:           rpsProperty,
+		HardwareCountProperty: hardwareCountProperty,
+		HardwareTypeProperty:  hardwareTypeProperty,
+		FilterQuery:           filterQuery,
+	})
+	if err != nil {
+		return ErrorResponse(api.ErrToStatus(err), err), err
+	}
+
+	return Response(http.StatusOK, sizing), nil
+}
+
+func (m *ModelCatalogServiceAPIService) GetModelVariants(ctx context.Context, sourceID string, modelName string) (ImplResponse, error) {
+	if newName, err := url.PathUnescape(modelName); err == nil {
+		modelName = newName
+	}
+
+	variants, err := m.provider.GetModelVariants(ctx, modelName, sourceID)
+	if err != nil {
+		return ErrorResponse(api.ErrToStatus(err), err), err
+	}
+
+	return Response(http.StatusOK, variants), nil
+}
+
+// CompareModels lines up two or more models, from any sources, side by side.
+func (m *ModelCatalogServiceAPIService) CompareModels(ctx context.Context, request model.CatalogModelCompareRequest) (ImplResponse, error) {
+	comparison, err := m.provider.CompareModels(ctx, catalog.CompareModelsParams{
+		Models:                request.Models,
+		TargetRPS:             request.GetTargetRPS(),
+		HardwareType:          request.GetHardwareType(),
+		RPSProperty:           request.GetRpsProperty(),
+		LatencyProperty:       request.GetLatencyProperty(),
+		HardwareCountProperty: request.GetHardwareCountProperty(),
+		HardwareTypeProperty:  request.GetHardwareTypeProperty(),
+	})
+	if err != nil {
+		return ErrorResponse(api.ErrToStatus(err), err), err
+	}
+
+	return Response(http.StatusOK, comparison), nil
+}
+
 func (m *ModelCatalogServiceAPIService) FindLabels(ctx context.Context, assetType model.CatalogAssetType, pageSize string, orderBy string, sortOrder model.SortOrder, nextPageToken string) (ImplResponse, error) {
 	if assetType == "" {
 		assetType = model.CATALOGASSETTYPE_MODELS
@@ -216,7 +277,7 @@ func (m *ModelCatalogServiceAPIService) FindLabels(ctx context.Context, assetTyp
 	return Response(http.StatusOK, res), nil
 }
 
-func (m *ModelCatalogServiceAPIService) FindModels(ctx context.Context, recommended bool, targetRPS int32, latencyProperty string, rpsProperty string, hardwareCountProperty string, hardwareTypeProperty string, sourceIDs []string, q string, sourceLabels []string, filterQuery string, pageSize string, orderBy model.OrderByField, sortOrder model.SortOrder, nextPageToken string) (ImplResponse, error) {
+func (m *ModelCatalogServiceAPIService) FindModels(ctx context.Context, recommended bool, targetRPS int32, latencyProperty string, rpsProperty string, hardwareCountProperty string, hardwareTypeProperty string, sourceIDs []string, q string, sourceLabels []string, groupBy string, includeDeprecated bool, filterQuery string, namedQuery string, pageSize string, orderBy model.OrderByField, sortOrder model.SortOrder, nextPageToken string) (ImplResponse, error) {
 	// Validate pagination parameters
 	pageSizeInt, err := parsePaginationParams(pageSize, nextPageToken)
 	if err != nil {
@@ -235,6 +296,28 @@ func (m *ModelCatalogServiceAPIService) FindModels(ctx context.Context, recommen
 		return ErrorResponse(http.StatusBadRequest, err), err
 	}
 
+	switch groupBy {
+	case "", groupByVariant:
+	default:
+		err := fmt.Errorf("unsupported groupBy %q, the only supported value is %q", groupBy, groupByVariant)
+		return ErrorResponse(http.StatusBadRequest, err), err
+	}
+	if groupBy == groupByVariant && recommended {
+		err := fmt.Errorf("groupBy cannot be used together with recommendations")
+		return ErrorResponse(http.StatusBadRequest, err), err
+	}
+
+	if namedQuery != "" {
+		queries, err := m.namedQueries()
+		if err != nil {
+			return ErrorResponse(http.StatusBadRequest, err), err
+		}
+		filterQuery, err = queries.ResolveFilterQuery(filterQuery, namedQuery)
+		if err != nil {
+			return ErrorResponse(api.ErrToStatus(err), err), err
+		}
+	}
+
 	// Convert sourceLabels to sourceIDs
 	if len(sourceIDs) == 0 && len(sourceLabels) > 0 {
 		sources := m.sources.ByLabel(sourceLabels)
@@ -293,7 +376,7 @@ func (m *ModelCatalogServiceAPIService) FindModels(ctx context.Context, recommen
 		}
 
 		// Use recommended latency sorting (ignores orderBy)
-		models, err := m.provider.FindModelsWithRecommendedLatency(ctx, pagination, paretoParams, sourceIDs, q)
+		models, err := m.provider.FindModelsWithRecommendedLatency(ctx, pagination, paretoParams, sourceIDs, q, includeDeprecated)
 		if err != nil {
 			return ErrorResponse(http.StatusInternalServerError, fmt.Errorf("failed to find models with recommended latency: %w", err)), err
 		}
@@ -307,13 +390,15 @@ func (m *ModelCatalogServiceAPIService) FindModels(ctx context.Context, recommen
 	}
 
 	listModelsParams := catalog.ListModelsParams{
-		Query:         q,
-		FilterQuery:   filterQuery,
-		SourceIDs:     sourceIDs,
-		PageSize:      pageSizeInt,
-		OrderBy:       orderBy,
-		SortOrder:     sortOrder,
-		NextPageToken: &nextPageToken,
+		Query:             q,
+		FilterQuery:       filterQuery,
+		SourceIDs:         sourceIDs,
+		PageSize:          pageSizeInt,
+		OrderBy:           orderBy,
+		SortOrder:         sortOrder,
+		NextPageToken:     &nextPageToken,
+		GroupByVariant:    groupBy == groupByVariant,
+		IncludeDeprecated: includeDeprecated,
 	}
 
 	models, err := m.provider.ListModels(ctx, listModelsParams)
@@ -324,15 +409,58 @@ func (m *ModelCatalogServiceAPIService) FindModels(ctx context.Context, recommen
 	return Response(http.StatusOK, models), nil
 }
 
-func (m *ModelCatalogServiceAPIService) FindModelsFilterOptions(ctx context.Context) (ImplResponse, error) {
-	filterOptions, err := m.provider.GetFilterOptions(ctx)
+func (m *ModelCatalogServiceAPIService) FindModelsFilterOptions(ctx context.Context, sourceIDs []string, q string, sourceLabels []string, includeDeprecated bool, filterQuery string) (ImplResponse, error) {
+	if len(sourceIDs) == 1 && sourceIDs[0] == "" {
+		sourceIDs = nil
+	}
+	if len(sourceLabels) == 1 && sourceLabels[0] == "" {
+		sourceLabels = nil
+	}
+
+	if len(sourceIDs) > 0 && len(sourceLabels) > 0 {
+		err := fmt.Errorf("source and sourceLabel cannot be used together")
+		return ErrorResponse(http.StatusBadRequest, err), err
+	}
+
+	// Convert sourceLabels to sourceIDs
+	noMatchingSources := false
+	if len(sourceIDs) == 0 && len(sourceLabels) > 0 {
+		sources := m.sources.ByLabel(sourceLabels)
+		noMatchingSources = len(sources) == 0
+		sourceIDs = make([]string, len(sources))
+		for i, source := range sources {
+			sourceIDs[i] = source.Id
+		}
+	}
+
+	filterOptions, err := m.provider.GetFilterOptions(ctx, catalog.FilterOptionsParams{
+		Query:             q,
+		FilterQuery:       filterQuery,
+		SourceIDs:         sourceIDs,
+		IncludeDeprecated: includeDeprecated,
+	})
 	if err != nil {
-		return ErrorResponse(http.StatusInternalServerError, err), err
+		return ErrorResponse(api.ErrToStatus(err), err), err
+	}
+
+	if noMatchingSources {
+		clearFilterOptionCounts(filterOptions)
 	}
 
 	return Response(http.StatusOK, filterOptions), nil
 }
 
+// clearFilterOptionCounts sets all of the counts of the filter options to 0,
+// for requests that no source matches.
+func clearFilterOptionCounts(filterOptions *model.FilterOptionsList) {
+	for _, option := range filterOptions.GetFilters() {
+		counts := option.GetCounts()
+		for value := range counts {
+			counts[value] = 0
+		}
+	}
+}
+
 func (m *ModelCatalogServiceAPIService) GetModel(ctx context.Context, sourceID, modelName string) (ImplResponse, error) {
 	if newName, err := url.PathUnescape(modelName); err == nil {
 		modelName = newName
@@ -357,6 +485,145 @@ func (m *ModelCatalogServiceAPIService) GetModel(ctx context.Context, sourceID,
 	return Response(http.StatusOK, model), nil
 }
 
+func (m *ModelCatalogServiceAPIService) CreateModel(ctx context.Context, sourceID string, catalogModelCreate model.CatalogModelCreate) (ImplResponse, error) {
+	writer, err := m.modelWriter()
+	if err != nil {
+		return ErrorResponse(http.StatusNotImplemented, err), err
+	}
+
+	created, err := writer.CreateModel(ctx, sourceID, catalogModelCreate)
+	if err != nil {
+		return ErrorResponse(api.ErrToStatus(err), err), err
+	}
+
+	return Response(http.StatusCreated, created), nil
+}
+
+func (m *ModelCatalogServiceAPIService) UpdateModel(ctx context.Context, sourceID string, modelName string, catalogModelUpdate model.CatalogModelUpdate) (ImplResponse, error) {
+	if newName, err := url.PathUnescape(modelName); err == nil {
+		modelName = newName
+	}
+
+	writer, err := m.modelWriter()
+	if err != nil {
+		return ErrorResponse(http.StatusNotImplemented, err), err
+	}
+
+	updated, err := writer.UpdateModel(ctx, sourceID, modelName, catalogModelUpdate)
+	if err != nil {
+		return ErrorResponse(api.ErrToStatus(err), err), err
+	}
+
+	return Response(http.StatusOK, updated), nil
+}
+
+func (m *ModelCatalogServiceAPIService) DeleteModel(ctx context.Context, sourceID string, modelName string) (ImplResponse, error) {
+	if newName, err := url.PathUnescape(modelName); err == nil {
+		modelName = newName
+	}
+
+	writer, err := m.modelWriter()
+	if err != nil {
+		return ErrorResponse(http.StatusNotImplemented, err), err
+	}
+
+	err = writer.DeleteModel(ctx, sourceID, modelName)
+	if err != nil {
+		return ErrorResponse(api.ErrToStatus(err), err), err
+	}
+
+	return Response(http.StatusNoContent, nil), nil
+}
+
+// modelWriter returns the provider as a catalog.ModelWriter, or an error if
+// the provider is read-only.
+func (m *ModelCatalogServiceAPIService) modelWriter() (catalog.ModelWriter, error) {
+	writer, ok := m.provider.(catalog.ModelWriter)
+	if !ok {
+		return nil, errors.New("the model catalog does not support writes")
+	}
+	return writer, nil
+}
+
+func (m *ModelCatalogServiceAPIService) FindModelNamedQueries(ctx context.Context) (ImplResponse, error) {
+	queries, err := m.namedQueries()
+	if err != nil {
+		return ErrorResponse(http.StatusNotImplemented, err), err
+	}
+
+	list, err := queries.ListNamedQueries(ctx)
+	if err != nil {
+		return ErrorResponse(api.ErrToStatus(err), err), err
+	}
+
+	return Response(http.StatusOK, list), nil
+}
+
+func (m *ModelCatalogServiceAPIService) CreateModelNamedQuery(ctx context.Context, catalogNamedQuery model.CatalogNamedQuery) (ImplResponse, error) {
+	queries, err := m.namedQueries()
+	if err != nil {
+		return ErrorResponse(http.StatusNotImplemented, err), err
+	}
+
+	created, err := queries.CreateNamedQuery(ctx, catalogNamedQuery)
+	if err != nil {
+		return ErrorResponse(api.ErrToStatus(err), err), err
+	}
+
+	return Response(http.StatusCreated, created), nil
+}
+
+func (m *ModelCatalogServiceAPIService) GetModelNamedQuery(ctx context.Context, queryName string) (ImplResponse, error) {
+	queries, err := m.namedQueries()
+	if err != nil {
+		return ErrorResponse(http.StatusNotImplemented, err), err
+	}
+
+	query, err := queries.GetNamedQuery(ctx, queryName)
+	if err != nil {
+		return ErrorResponse(api.ErrToStatus(err), err), err
+	}
+
+	return Response(http.StatusOK, query), nil
+}
+
+func (m *ModelCatalogServiceAPIService) UpdateModelNamedQuery(ctx context.Context, queryName string, catalogNamedQueryUpdate model.CatalogNamedQueryUpdate) (ImplResponse, error) {
+	queries, err := m.namedQueries()
+	if err != nil {
+		return ErrorResponse(http.StatusNotImplemented, err), err
+	}
+
+	updated, err := queries.UpdateNamedQuery(ctx, queryName, catalogNamedQueryUpdate)
+	if err != nil {
+		return ErrorResponse(api.ErrToStatus(err), err), err
+	}
+
+	return Response(http.StatusOK, updated), nil
+}
+
+func (m *ModelCatalogServiceAPIService) DeleteModelNamedQuery(ctx context.Context, queryName string) (ImplResponse, error) {
+	queries, err := m.namedQueries()
+	if err != nil {
+		return ErrorResponse(http.StatusNotImplemented, err), err
+	}
+
+	if err := queries.DeleteNamedQuery(ctx, queryName); err != nil {
+		return ErrorResponse(api.ErrToStatus(err), err), err
+	}
+
+	return Response(http.StatusNoContent, nil), nil
+}
+
+// namedQueries returns the provider as a NamedQueryManager, or an error if
+// it can't manage named queries.
+func (m *ModelCatalogServiceAPIService) namedQueries() (catalog.NamedQueryManager, error) {
+	queries, ok := m.provider.(catalog.NamedQueryManager)
+	if !ok {
+		return nil, errors.New("the model catalog does not support named queries")
+	}
+	return queries, nil
+}
+
 func (m *ModelCatalogServiceAPIService) FindSources(ctx context.Context, name string, assetType model.CatalogAssetType, strPageSize string, orderBy model.OrderByField, sortOrder model.SortOrder, nextPageToken string) (ImplResponse, error) {
 	// Collect all sources (model + MCP) as CatalogSource objects
 	sources := m.sources.All()
@@ -458,6 +725,195 @@ func mcpSourceToCatalogSource(src basecatalog.MCPSource) model.CatalogSource {
 	return cs
 }
 
+// GetSourceSyncHistory lists the most recent syncs of a model or MCP source.
+func (m *ModelCatalogServiceAPIService) GetSourceSyncHistory(ctx context.Context, sourceID string, since string, pageSize string, nextPageToken string) (ImplResponse, error) {
+	if !m.hasSource(sourceID) {
+		return notFound(fmt.Sprintf("source %q not found", sourceID)), nil
+	}
+
+	if m.syncRepository == nil {
+		err := errors.New("source sync history is not available")
+		return ErrorResponse(http.StatusNotImplemented, err), err
+	}
+
+	pageSizeInt, err := parsePaginationParams(pageSize, nextPageToken)
+	if err != nil {
+		return ErrorResponse(http.StatusBadRequest, err), err
+	}
+
+	listOptions := models.SourceSyncListOptions{
+		Pagination: mrmodels.Pagination{
+			PageSize:      &pageSizeInt,
+			NextPageToken: &nextPageToken,
+		},
+		SourceID: sourceID,
+	}
+	if since != "" {
+		sinceInt, err := strconv.ParseInt(since, 10, 64)
+		if err != nil {
+			err = fmt.Errorf("invalid since: %w", err)
+			return ErrorResponse(http.StatusBadRequest, err), err
+		}
+		listOptions.Since = &sinceInt
+	}
+
+	syncs, err := m.syncRepository.List(listOptions)
+	if err != nil {
+		return ErrorResponse(api.ErrToStatus(err), err), err
+	}
+
+	res := model.CatalogSourceSyncList{
+		Items:         make([]model.CatalogSourceSync, 0, len(syncs.Items)),
+		PageSize:      syncs.PageSize,
+		NextPageToken: syncs.NextPageToken,
+	}
+	for _, sync := range syncs.Items {
+		res.Items = append(res.Items, sourceSyncToAPI(sync))
+	}
+	res.Size = int32(len(res.Items))
+
+	return Response(http.StatusOK, res), nil
+}
+
+// hasSource reports whether sourceID is a configured model or MCP source.
+func (m *ModelCatalogServiceAPIService) hasSource(sourceID string) bool {
+	if _, ok := m.sources.Get(sourceID); ok {
+		return true
+	}
+	if m.mcpSources != nil {
+		if _, ok := m.mcpSources.AllSources()[sourceID]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// sourceSyncToAPI converts a stored source sync to the API type.
+func sourceSyncToAPI(sync *models.SourceSync) model.CatalogSourceSync {
+	res := model.CatalogSourceSync{
+		SourceId:            sync.SourceID,
+		Status:              model.CatalogSourceStatus(sync.Status),
+		StartTimeSinceEpoch: strconv.FormatInt(sync.StartTimeSinceEpoch, 10),
+		EndTimeSinceEpoch:   strconv.FormatInt(sync.EndTimeSinceEpoch, 10),
+		Added:               sync.Added,
+		Updated:             sync.Updated,
+		Removed:             sync.Removed,
+		Changes:             make([]model.CatalogSourceSyncChange, 0, len(sync.Changes)),
+	}
+	if sync.ID != nil {
+		res.Id = strconv.FormatInt(int64(*sync.ID), 10)
+	}
+	if sync.AssetType != "" {
+		res.AssetType = model.CatalogAssetType(sync.AssetType).Ptr()
+	}
+	if sync.Error != "" {
+		res.Error = &sync.Error
+	}
+	if sync.ChangesTruncated {
+		res.ChangesTruncated = &sync.ChangesTruncated
+	}
+	for _, change := range sync.Changes {
+		res.Changes = append(res.Changes, model.CatalogSourceSyncChange{
+			Name:   change.Name,
+			Change: change.Change,
+		})
+	}
+	return res
+}
+
+// RefreshSource queues a refresh of a model or MCP source. The leader picks
+// it up and reads the source again, scanning its models again if rescan is
+// true.
+func (m *ModelCatalogServiceAPIService) RefreshSource(ctx context.Context, sourceID string, rescan bool) (ImplResponse, error) {
+	known, enabled := m.sourceState(sourceID)
+	if !known {
+		return notFound(fmt.Sprintf("source %q not found", sourceID)), nil
+	}
+	if !enabled {
+		err := fmt.Errorf("source %q is disabled", sourceID)
+		return ErrorResponse(http.StatusBadRequest, err), err
+	}
+
+	if m.refreshRepository == nil {
+		err := errors.New("source refresh is not available")
+		return ErrorResponse(http.StatusNotImplemented, err), err
+	}
+
+	refresh, err := m.refreshRepository.Create(sourceID, rescan)
+	if err != nil {
+		return ErrorResponse(api.ErrToStatus(err), err), err
+	}
+
+	return Response(http.StatusAccepted, sourceRefreshToAPI(refresh)), nil
+}
+
+// GetSourceRefresh returns a refresh queued by RefreshSource.
+func (m *ModelCatalogServiceAPIService) GetSourceRefresh(ctx context.Context, sourceID string, refreshID string) (ImplResponse, error) {
+	if m.refreshRepository == nil {
+		err := errors.New("source refresh is not available")
+		return ErrorResponse(http.StatusNotImplemented, err), err
+	}
+
+	notFoundMsg := fmt.Sprintf("refresh %q of source %q not found", refreshID, sourceID)
+
+	id, err := strconv.ParseInt(refreshID, 10, 32)
+	if err != nil {
+		return notFound(notFoundMsg), nil
+	}
+
+	refresh, err := m.refreshRepository.Get(int32(id))
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			return notFound(notFoundMsg), nil
+		}
+		return ErrorResponse(api.ErrToStatus(err), err), err
+	}
+	if refresh.SourceID != sourceID {
+		return notFound(notFoundMsg), nil
+	}
+
+	return Response(http.StatusOK, sourceRefreshToAPI(refresh)), nil
+}
+
+// sourceState reports whether sourceID is a configured model or MCP source,
+// and whether it's enabled as either.
+func (m *ModelCatalogServiceAPIService) sourceState(sourceID string) (known bool, enabled bool) {
+	if source, ok := m.sources.AllSources()[sourceID]; ok {
+		known = true
+		enabled = source.Enabled == nil || *source.Enabled
+	}
+	if m.mcpSources != nil {
+		if source, ok := m.mcpSources.AllSources()[sourceID]; ok {
+			known = true
+			enabled = enabled || source.IsEnabled()
+		}
+	}
+	return known, enabled
+}
+
+// sourceRefreshToAPI converts a stored source refresh to the API type.
+func sourceRefreshToAPI(refresh *models.SourceRefresh) model.CatalogSourceRefresh {
+	res := model.CatalogSourceRefresh{
+		SourceId:                 refresh.SourceID,
+		State:                    refresh.State,
+		CreateTimeSinceEpoch:     strconv.FormatInt(refresh.CreateTimeSinceEpoch, 10),
+		LastUpdateTimeSinceEpoch: strconv.FormatInt(refresh.LastUpdateTimeSinceEpoch, 10),
+	}
+	if refresh.ID != nil {
+		res.Id = strconv.FormatInt(int64(*refresh.ID), 10)
+	}
+	if refresh.Rescan {
+		res.Rescan = &refresh.Rescan
+	}
+	if refresh.SourceStatus != "" {
+		res.SourceStatus = model.CatalogSourceStatus(refresh.SourceStatus).Ptr()
+	}
+	if refresh.Error != "" {
+		res.Error = &refresh.Error
+	}
+	return res
+}
+
 func (m *ModelCatalogServiceAPIService) PreviewCatalogSource(ctx context.Context, configParam *os.File, pageSizeParam string, nextPageTokenParam string, filterStatusParam string, catalogDataParam *os.File) (ImplResponse, error) {
 	// Parse page size
 	pageSize := int32(10)
@@ -674,13 +1130,15 @@ func genLabelCmpFunc(orderByKey string, sortOrder model.SortOrder) func(sortable
 var _ ModelCatalogServiceAPIServicer = &ModelCatalogServiceAPIService{}
 
 // NewModelCatalogServiceAPIService creates a default api service
-func NewModelCatalogServiceAPIService(provider catalog.APIProvider, sources *catalog.SourceCollection, mcpSources *catalog.MCPSourceCollection, labels *catalog.LabelCollection, sourceRepository models.CatalogSourceRepository) ModelCatalogServiceAPIServicer {
+func NewModelCatalogServiceAPIService(provider catalog.APIProvider, sources *catalog.SourceCollection, mcpSources *catalog.MCPSourceCollection, labels *catalog.LabelCollection, sourceRepository models.CatalogSourceRepository, syncRepository models.CatalogSourceSyncRepository, refreshRepository models.CatalogSourceRefreshRepository) ModelCatalogServiceAPIServicer {
 	return &ModelCatalogServiceAPIService{
-		provider:         provider,
-		sources:          sources,
-		mcpSources:       mcpSources,
-		labels:           labels,
-		sourceRepository: sourceRepository,
+		provider:          provider,
+		sources:           sources,
+		mcpSources:        mcpSources,
+		labels:            labels,
+		sourceRepository:  sourceRepository,
+		syncRepository:    syncRepository,
+		refreshRepository: refreshRepository,
 	}
 }
 
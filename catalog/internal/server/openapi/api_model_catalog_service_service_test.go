@@ -11,9 +11,13 @@ import (
 	"time"
 
 	"github.com/kubeflow/hub/catalog/internal/catalog"
+	"github.com/kubeflow/hub/catalog/internal/catalog/basecatalog"
 	"github.com/kubeflow/hub/catalog/internal/catalog/modelcatalog"
+	"github.com/kubeflow/hub/catalog/internal/db/models"
 	model "github.com/kubeflow/hub/catalog/pkg/openapi"
+	"github.com/kubeflow/hub/internal/platform/apiutils"
 	mrmodels "github.com/kubeflow/hub/internal/platform/db/entity"
+	"github.com/kubeflow/hub/pkg/api"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -44,13 +48,17 @@ func TestFindModels(t *testing.T) {
 	modelB := &model.CatalogModel{Name: "Model B", CreateTimeSinceEpoch: timeToMillisStringPointer(time2), LastUpdateTimeSinceEpoch: timeToMillisStringPointer(time3)}
 	modelC := &model.CatalogModel{Name: "Another Model C", CreateTimeSinceEpoch: timeToMillisStringPointer(time3), LastUpdateTimeSinceEpoch: timeToMillisStringPointer(time2)}
 	modelD := &model.CatalogModel{Name: "My Model D", CreateTimeSinceEpoch: timeToMillisStringPointer(time4), LastUpdateTimeSinceEpoch: timeToMillisStringPointer(time1)}
+	modelE := &model.CatalogModel{Name: "Model E", Deprecated: model.PtrBool(true), ReplacedBy: model.NewCatalogModelReference("source1", "Model A")}
 
 	testCases := []struct {
 		name              string
 		sourceID          string
 		mockModels        map[string]*model.CatalogModel
 		filterQuery       string
+		namedQuery        string
 		q                 string
+		groupBy           string
+		includeDeprecated bool
 		pageSize          string
 		orderBy           model.OrderByField
 		sortOrder         model.SortOrder
@@ -213,6 +221,52 @@ func TestFindModels(t *testing.T) {
 			expectedStatus:    http.StatusBadRequest,
 			expectedModelList: nil,
 		},
+		{
+			name:     "Unsupported groupBy",
+			sourceID: "source1",
+			mockModels: map[string]*model.CatalogModel{
+				"modelA": modelA,
+			},
+			groupBy:           "family",
+			pageSize:          "10",
+			orderBy:           model.ORDERBYFIELD_NAME,
+			sortOrder:         model.SORTORDER_ASC,
+			expectedStatus:    http.StatusBadRequest,
+			expectedModelList: nil,
+		},
+		{
+			name:     "Deprecated models are left out",
+			sourceID: "source1",
+			mockModels: map[string]*model.CatalogModel{
+				"modelA": modelA, "modelE": modelE,
+			},
+			pageSize:       "10",
+			orderBy:        model.ORDERBYFIELD_NAME,
+			sortOrder:      model.SORTORDER_ASC,
+			expectedStatus: http.StatusOK,
+			expectedModelList: &model.CatalogModelList{
+				Items:    []model.CatalogModel{*modelA},
+				Size:     1,
+				PageSize: 10,
+			},
+		},
+		{
+			name:     "Include deprecated models",
+			sourceID: "source1",
+			mockModels: map[string]*model.CatalogModel{
+				"modelA": modelA, "modelE": modelE,
+			},
+			includeDeprecated: true,
+			pageSize:          "10",
+			orderBy:           model.ORDERBYFIELD_NAME,
+			sortOrder:         model.SORTORDER_ASC,
+			expectedStatus:    http.StatusOK,
+			expectedModelList: &model.CatalogModelList{
+				Items:    []model.CatalogModel{*modelA, *modelE},
+				Size:     2,
+				PageSize: 10,
+			},
+		},
 		{
 			name:     "Unsupported orderBy field",
 			sourceID: "source1",
@@ -305,7 +359,7 @@ func TestFindModels(t *testing.T) {
 				models: tc.mockModels,
 			}
 
-			service := NewModelCatalogServiceAPIService(provider, sources, nil, sourceLabels, nil)
+			service := NewModelCatalogServiceAPIService(provider, sources, nil, sourceLabels, nil, nil, nil)
 
 			resp, err := service.FindModels(
 				context.Background(),
@@ -318,7 +372,10 @@ func TestFindModels(t *testing.T) {
 				[]string{tc.sourceID},
 				tc.q,
 				[]string{""},
+				tc.groupBy,
+				tc.includeDeprecated,
 				tc.filterQuery,
+				tc.namedQuery,
 				tc.pageSize,
 				tc.orderBy,
 				tc.sortOrder,
@@ -750,7 +807,7 @@ func TestFindSources(t *testing.T) {
 			sources := catalog.NewSourceCollection()
 			sources.Merge("", tc.catalogs)
 			sourceLabels := catalog.NewLabelCollection()
-			service := NewModelCatalogServiceAPIService(&mockModelProvider{}, sources, nil, sourceLabels, nil)
+			service := NewModelCatalogServiceAPIService(&mockModelProvider{}, sources, nil, sourceLabels, nil, nil, nil)
 
 			// Call FindSources
 			resp, err := service.FindSources(
@@ -1125,7 +1182,7 @@ func TestFindLabels(t *testing.T) {
 			labelCollection := catalog.NewLabelCollection()
 			labelCollection.Merge("test-source", tc.labels)
 
-			service := NewModelCatalogServiceAPIService(&mockModelProvider{}, sources, nil, labelCollection, nil)
+			service := NewModelCatalogServiceAPIService(&mockModelProvider{}, sources, nil, labelCollection, nil, nil, nil)
 
 			// Call FindLabels
 			resp, err := service.FindLabels(
@@ -1258,17 +1315,20 @@ func (m *mockProviderThatFailsOnRecommended) GetPerformanceArtifacts(ctx context
 	return m.mockModelProvider.GetPerformanceArtifacts(ctx, modelName, sourceID, params)
 }
 
-func (m *mockProviderThatFailsOnRecommended) GetFilterOptions(ctx context.Context) (*model.FilterOptionsList, error) {
-	return m.mockModelProvider.GetFilterOptions(ctx)
+func (m *mockProviderThatFailsOnRecommended) GetFilterOptions(ctx context.Context, params catalog.FilterOptionsParams) (*model.FilterOptionsList, error) {
+	return m.mockModelProvider.GetFilterOptions(ctx, params)
 }
 
-func (m *mockProviderThatFailsOnRecommended) FindModelsWithRecommendedLatency(ctx context.Context, pagination mrmodels.Pagination, paretoParams modelcatalog.ParetoFilteringParams, sourceIDs []string, query string) (*model.CatalogModelList, error) {
+func (m *mockProviderThatFailsOnRecommended) FindModelsWithRecommendedLatency(ctx context.Context, pagination mrmodels.Pagination, paretoParams modelcatalog.ParetoFilteringParams, sourceIDs []string, query string, includeDeprecated bool) (*model.CatalogModelList, error) {
 	return nil, fmt.Errorf("recommended sorting not implemented")
 }
 
 func (m *mockModelProvider) ListModels(ctx context.Context, params catalog.ListModelsParams) (model.CatalogModelList, error) {
 	var filteredModels []*model.CatalogModel
 	for _, mdl := range m.models {
+		if mdl.GetDeprecated() && !params.IncludeDeprecated {
+			continue
+		}
 		if params.Query == "" || strings.Contains(strings.ToLower(mdl.Name), strings.ToLower(params.Query)) {
 			filteredModels = append(filteredModels, mdl)
 		}
@@ -1347,12 +1407,55 @@ func (m *mockModelProvider) GetArtifacts(ctx context.Context, name string, sourc
 	}, nil
 }
 
-func (m *mockModelProvider) GetFilterOptions(ctx context.Context) (*model.FilterOptionsList, error) {
+func (m *mockModelProvider) GetFilterOptions(ctx context.Context, params catalog.FilterOptionsParams) (*model.FilterOptionsList, error) {
 	emptyFilters := make(map[string]model.FilterOption)
 	return &model.FilterOptionsList{Filters: &emptyFilters}, nil
 }
 
-func (m *mockModelProvider) FindModelsWithRecommendedLatency(ctx context.Context, pagination mrmodels.Pagination, paretoParams modelcatalog.ParetoFilteringParams, sourceIDs []string, query string) (*model.CatalogModelList, error) {
+func (m *mockModelProvider) CompareModels(ctx context.Context, params catalog.CompareModelsParams) (*model.CatalogModelComparison, error) {
+	items := make([]model.CatalogModelComparisonItem, 0, len(params.Models))
+	for _, ref := range params.Models {
+		mdl, exists := m.models[ref.Name]
+		if !exists {
+			return nil, fmt.Errorf("no model %q found in source %q: %w", ref.Name, ref.SourceId, api.ErrNotFound)
+		}
+		items = append(items, model.CatalogModelComparisonItem{
+			SourceId: ref.SourceId,
+			Name:     ref.Name,
+			License:  mdl.License,
+			Tasks:    mdl.Tasks,
+			Accuracy: map[string]float64{},
+		})
+	}
+	return &model.CatalogModelComparison{
+		Profile:    model.CatalogModelComparisonProfile{TargetRPS: params.TargetRPS, LatencyProperty: params.LatencyProperty},
+		Benchmarks: []string{},
+		Items:      items,
+	}, nil
+}
+
+func (m *mockModelProvider) GetModelSizing(ctx context.Context, modelName string, sourceID string, params catalog.ModelSizingParams) (*model.CatalogModelSizing, error) {
+	if _, exists := m.models[modelName]; !exists {
+		return nil, fmt.Errorf("no models found for name=%v: %w", modelName, api.ErrNotFound)
+	}
+	return &model.CatalogModelSizing{
+		TargetRPS:  params.TargetRPS,
+		MaxLatency: map[string]float64{},
+		Items:      []model.CatalogModelSizingConfiguration{},
+	}, nil
+}
+
+func (m *mockModelProvider) GetModelVariants(ctx context.Context, modelName string, sourceID string) (*model.CatalogModelVariantList, error) {
+	if _, exists := m.models[modelName]; !exists {
+		return nil, fmt.Errorf("no models found for name=%v: %w", modelName, api.ErrNotFound)
+	}
+	return &model.CatalogModelVariantList{
+		Items: []model.CatalogModelVariant{{SourceId: sourceID, Name: modelName}},
+		Size:  1,
+	}, nil
+}
+
+func (m *mockModelProvider) FindModelsWithRecommendedLatency(ctx context.Context, pagination mrmodels.Pagination, paretoParams modelcatalog.ParetoFilteringParams, sourceIDs []string, query string, includeDeprecated bool) (*model.CatalogModelList, error) {
 	// Basic mock implementation - just return models sorted by name
 	var allModels []*model.CatalogModel
 	for _, mdl := range m.models {
@@ -1501,7 +1604,7 @@ func TestGetModel(t *testing.T) {
 			sources := catalog.NewSourceCollection()
 			sources.Merge("", tc.sources)
 			sourceLabels := catalog.NewLabelCollection()
-			service := NewModelCatalogServiceAPIService(tc.provider, sources, nil, sourceLabels, nil)
+			service := NewModelCatalogServiceAPIService(tc.provider, sources, nil, sourceLabels, nil, nil, nil)
 
 			// Call GetModel
 			resp, _ := service.GetModel(
@@ -1612,7 +1715,7 @@ func TestGetAllModelArtifacts(t *testing.T) {
 			sources := catalog.NewSourceCollection()
 			sources.Merge("", tc.sources)
 			sourceLabels := catalog.NewLabelCollection()
-			service := NewModelCatalogServiceAPIService(tc.provider, sources, nil, sourceLabels, nil)
+			service := NewModelCatalogServiceAPIService(tc.provider, sources, nil, sourceLabels, nil, nil, nil)
 
 			// Call GetAllModelArtifacts
 			resp, _ := service.GetAllModelArtifacts(
@@ -1671,9 +1774,9 @@ func TestFindModelsFilterOptions(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			sources := catalog.NewSourceCollection()
 			sourceLabels := catalog.NewLabelCollection()
-			service := NewModelCatalogServiceAPIService(tc.provider, sources, nil, sourceLabels, nil)
+			service := NewModelCatalogServiceAPIService(tc.provider, sources, nil, sourceLabels, nil, nil, nil)
 
-			resp, err := service.FindModelsFilterOptions(context.Background())
+			resp, err := service.FindModelsFilterOptions(context.Background(), nil, "", nil, false, "")
 
 			assert.Equal(t, tc.expectedStatus, resp.Code)
 
@@ -1692,6 +1795,86 @@ func TestFindModelsFilterOptions(t *testing.T) {
 	}
 }
 
+// mockFilterOptionsProvider returns filter options with counts and records
+// the parameters they're requested with.
+type mockFilterOptionsProvider struct {
+	*mockModelProvider
+	params *catalog.FilterOptionsParams
+	err    error
+}
+
+func (m *mockFilterOptionsProvider) GetFilterOptions(ctx context.Context, params catalog.FilterOptionsParams) (*model.FilterOptionsList, error) {
+	m.params = &params
+	if m.err != nil {
+		return nil, m.err
+	}
+	filters := map[string]model.FilterOption{
+		"license": {Type: "string", Values: []any{"apache-2.0", "mit"}, Counts: &map[string]int32{"apache-2.0": 42, "mit": 7}},
+	}
+	return &model.FilterOptionsList{Filters: &filters}, nil
+}
+
+func TestFindModelsFilterOptionsCounts(t *testing.T) {
+	newService := func(provider catalog.APIProvider) ModelCatalogServiceAPIServicer {
+		sources := catalog.NewSourceCollection()
+		sources.Merge("", map[string]catalog.ModelSource{
+			"hf":    {CatalogSource: model.CatalogSource{Id: "hf", Name: "Hugging Face", Enabled: apiutils.Of(true), Labels: []string{"community"}}},
+			"local": {CatalogSource: model.CatalogSource{Id: "local", Name: "Local", Enabled: apiutils.Of(true)}},
+		})
+		return NewModelCatalogServiceAPIService(provider, sources, nil, catalog.NewLabelCollection(), nil, nil, nil)
+	}
+
+	t.Run("counts are for the models that match the parameters", func(t *testing.T) {
+		provider := &mockFilterOptionsProvider{mockModelProvider: &mockModelProvider{}}
+
+		resp, err := newService(provider).FindModelsFilterOptions(context.Background(), nil, "granite", []string{"community"}, true, "provider='IBM'")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.Code)
+
+		require.NotNil(t, provider.params)
+		assert.Equal(t, catalog.FilterOptionsParams{
+			Query:             "granite",
+			FilterQuery:       "provider='IBM'",
+			SourceIDs:         []string{"hf"},
+			IncludeDeprecated: true,
+		}, *provider.params)
+
+		filterOptions := resp.Body.(*model.FilterOptionsList)
+		assert.Equal(t, map[string]int32{"apache-2.0": 42, "mit": 7}, *filterOptions.GetFilters()["license"].Counts)
+	})
+
+	t.Run("non-matching label counts no models", func(t *testing.T) {
+		provider := &mockFilterOptionsProvider{mockModelProvider: &mockModelProvider{}}
+
+		resp, err := newService(provider).FindModelsFilterOptions(context.Background(), nil, "", []string{"no-match"}, false, "")
+		require.NoError(t, err)
+
+		filterOptions := resp.Body.(*model.FilterOptionsList)
+		assert.Equal(t, []any{"apache-2.0", "mit"}, filterOptions.GetFilters()["license"].Values)
+		assert.Equal(t, map[string]int32{"apache-2.0": 0, "mit": 0}, *filterOptions.GetFilters()["license"].Counts)
+	})
+
+	t.Run("source and sourceLabel together", func(t *testing.T) {
+		provider := &mockFilterOptionsProvider{mockModelProvider: &mockModelProvider{}}
+
+		resp, err := newService(provider).FindModelsFilterOptions(context.Background(), []string{"hf"}, "", []string{"community"}, false, "")
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Nil(t, provider.params)
+	})
+
+	t.Run("invalid filterQuery", func(t *testing.T) {
+		provider := &mockFilterOptionsProvider{
+			mockModelProvider: &mockModelProvider{},
+			err:               fmt.Errorf("invalid filter query: %w", api.ErrBadRequest),
+		}
+
+		resp, err := newService(provider).FindModelsFilterOptions(context.Background(), nil, "", nil, false, "license =")
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+}
+
 func TestGetAllModelPerformanceArtifacts(t *testing.T) {
 	// Define test artifacts
 	artifact1Name := "performance-artifact-1"
@@ -1831,7 +2014,7 @@ func TestGetAllModelPerformanceArtifacts(t *testing.T) {
 			})
 			sourceLabels := catalog.NewLabelCollection()
 
-			service := NewModelCatalogServiceAPIService(tc.provider, sources, nil, sourceLabels, nil)
+			service := NewModelCatalogServiceAPIService(tc.provider, sources, nil, sourceLabels, nil, nil, nil)
 
 			resp, err := service.GetAllModelPerformanceArtifacts(
 				context.Background(),
@@ -1904,7 +2087,7 @@ func TestFindModelsRecommended(t *testing.T) {
 		},
 	}
 
-	service := NewModelCatalogServiceAPIService(provider, sources, nil, sourceLabels, nil)
+	service := NewModelCatalogServiceAPIService(provider, sources, nil, sourceLabels, nil, nil, nil)
 
 	// Test recommended=true with default parameters
 	resp, err := service.FindModels(
@@ -1919,6 +2102,9 @@ func TestFindModelsRecommended(t *testing.T) {
 		"",
 		[]string{""},
 		"",
+		false,
+		"",
+		"",
 		"10",
 		model.ORDERBYFIELD_NAME,
 		model.SORTORDER_ASC,
@@ -1953,7 +2139,7 @@ func TestFindModelsRecommendedWithCustomParams(t *testing.T) {
 		},
 	}
 
-	service := NewModelCatalogServiceAPIService(provider, sources, nil, sourceLabels, nil)
+	service := NewModelCatalogServiceAPIService(provider, sources, nil, sourceLabels, nil, nil, nil)
 
 	// Test with custom latency property and targetRPS
 	resp, err := service.FindModels(
@@ -1968,6 +2154,9 @@ func TestFindModelsRecommendedWithCustomParams(t *testing.T) {
 		"",
 		[]string{""},
 		"",
+		false,
+		"",
+		"",
 		"10",
 		model.ORDERBYFIELD_NAME,
 		model.SORTORDER_ASC,
@@ -2002,7 +2191,7 @@ func TestFindModelsRecommendedIgnoresOrderBy(t *testing.T) {
 		},
 	}
 
-	service := NewModelCatalogServiceAPIService(provider, sources, nil, sourceLabels, nil)
+	service := NewModelCatalogServiceAPIService(provider, sources, nil, sourceLabels, nil, nil, nil)
 
 	// Test that orderBy is ignored when recommended=true
 	resp, err := service.FindModels(
@@ -2017,6 +2206,9 @@ func TestFindModelsRecommendedIgnoresOrderBy(t *testing.T) {
 		"",
 		[]string{""},
 		"",
+		false,
+		"",
+		"",
 		"10",
 		model.ORDERBYFIELD_NAME, // This should be ignored
 		model.SORTORDER_ASC,
@@ -2110,7 +2302,7 @@ func TestGetAllModelPerformanceArtifactsWithConfigurableProperties(t *testing.T)
 			})
 			sourceLabels := catalog.NewLabelCollection()
 
-			service := NewModelCatalogServiceAPIService(tc.provider, sources, nil, sourceLabels, nil)
+			service := NewModelCatalogServiceAPIService(tc.provider, sources, nil, sourceLabels, nil, nil, nil)
 
 			resp, err := service.GetAllModelPerformanceArtifacts(
 				context.Background(),
@@ -2133,3 +2325,459 @@ func TestGetAllModelPerformanceArtifactsWithConfigurableProperties(t *testing.T)
 		})
 	}
 }
+
+// mockModelWriter is a mockModelProvider that also supports writes.
+type mockModelWriter struct {
+	*mockModelProvider
+	err error
+}
+
+func (m *mockModelWriter) CreateModel(ctx context.Context, sourceID string, create model.CatalogModelCreate) (*model.CatalogModel, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	created := &model.CatalogModel{Name: create.Name, SourceId: &sourceID, Description: create.Description}
+	m.models[create.Name] = created
+	return created, nil
+}
+
+func (m *mockModelWriter) UpdateModel(ctx context.Context, sourceID string, modelName string, update model.CatalogModelUpdate) (*model.CatalogModel, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	existing, ok := m.models[modelName]
+	if !ok {
+		return nil, fmt.Errorf("no model found for name=%v: %w", modelName, api.ErrNotFound)
+	}
+	if update.Description != nil {
+		existing.Description = update.Description
+	}
+	return existing, nil
+}
+
+func (m *mockModelWriter) DeleteModel(ctx context.Context, sourceID string, modelName string) error {
+	if m.err != nil {
+		return m.err
+	}
+	if _, ok := m.models[modelName]; !ok {
+		return fmt.Errorf("no model found for name=%v: %w", modelName, api.ErrNotFound)
+	}
+	delete(m.models, modelName)
+	return nil
+}
+
+func TestModelWrites(t *testing.T) {
+	newService := func(provider catalog.APIProvider) ModelCatalogServiceAPIServicer {
+		return NewModelCatalogServiceAPIService(provider, catalog.NewSourceCollection(), nil, catalog.NewLabelCollection(), nil, nil, nil)
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		writer := &mockModelWriter{mockModelProvider: &mockModelProvider{models: map[string]*model.CatalogModel{}}}
+		resp, err := newService(writer).CreateModel(context.Background(), "picks", model.CatalogModelCreate{
+			Name:        "org/model",
+			Description: model.PtrString("A model"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, resp.Code)
+
+		created, ok := resp.Body.(*model.CatalogModel)
+		require.True(t, ok)
+		assert.Equal(t, "org/model", created.Name)
+		assert.Equal(t, "picks", created.GetSourceId())
+	})
+
+	t.Run("UpdateEscapedName", func(t *testing.T) {
+		writer := &mockModelWriter{mockModelProvider: &mockModelProvider{models: map[string]*model.CatalogModel{
+			"org/model": {Name: "org/model"},
+		}}}
+		resp, err := newService(writer).UpdateModel(context.Background(), "picks", "org%2Fmodel", model.CatalogModelUpdate{
+			Description: model.PtrString("Updated"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "Updated", resp.Body.(*model.CatalogModel).GetDescription())
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		writer := &mockModelWriter{mockModelProvider: &mockModelProvider{models: map[string]*model.CatalogModel{
+			"org/model": {Name: "org/model"},
+		}}}
+		svc := newService(writer)
+
+		resp, err := svc.DeleteModel(context.Background(), "picks", "org/model")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, resp.Code)
+		assert.Empty(t, writer.models)
+
+		resp, _ = svc.DeleteModel(context.Background(), "picks", "org/model")
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		for _, tc := range []struct {
+			err  error
+			want int
+		}{
+			{err: api.ErrBadRequest, want: http.StatusBadRequest},
+			{err: api.ErrNotFound, want: http.StatusNotFound},
+			{err: api.ErrConflict, want: http.StatusConflict},
+		} {
+			writer := &mockModelWriter{mockModelProvider: &mockModelProvider{models: map[string]*model.CatalogModel{}}, err: tc.err}
+			resp, _ := newService(writer).CreateModel(context.Background(), "picks", model.CatalogModelCreate{Name: "m"})
+			assert.Equal(t, tc.want, resp.Code, "error %v", tc.err)
+		}
+	})
+
+	t.Run("ReadOnlyProvider", func(t *testing.T) {
+		svc := newService(&mockModelProvider{models: map[string]*model.CatalogModel{}})
+
+		resp, _ := svc.CreateModel(context.Background(), "picks", model.CatalogModelCreate{Name: "m"})
+		assert.Equal(t, http.StatusNotImplemented, resp.Code)
+
+		resp, _ = svc.UpdateModel(context.Background(), "picks", "m", model.CatalogModelUpdate{})
+		assert.Equal(t, http.StatusNotImplemented, resp.Code)
+
+		resp, _ = svc.DeleteModel(context.Background(), "picks", "m")
+		assert.Equal(t, http.StatusNotImplemented, resp.Code)
+	})
+}
+
+// mockNamedQueryProvider is a mockModelProvider that also manages named
+// queries, and records the filter query of the last ListModels call.
+type mockNamedQueryProvider struct {
+	*mockModelProvider
+	*basecatalog.NamedQueryStore
+	filterQuery string
+}
+
+func (m *mockNamedQueryProvider) ListModels(ctx context.Context, params catalog.ListModelsParams) (model.CatalogModelList, error) {
+	m.filterQuery = params.FilterQuery
+	return m.mockModelProvider.ListModels(ctx, params)
+}
+
+func TestModelNamedQueries(t *testing.T) {
+	newProvider := func() *mockNamedQueryProvider {
+		return &mockNamedQueryProvider{
+			mockModelProvider: &mockModelProvider{models: map[string]*model.CatalogModel{}},
+			NamedQueryStore: basecatalog.NewNamedQueryStore(basecatalog.AssetTypeModels, func() map[string]map[string]basecatalog.FieldFilter {
+				return map[string]map[string]basecatalog.FieldFilter{
+					"apache": {"license": {Operator: "=", Value: "apache-2.0"}},
+				}
+			}, nil),
+		}
+	}
+	newService := func(provider catalog.APIProvider) ModelCatalogServiceAPIServicer {
+		return NewModelCatalogServiceAPIService(provider, catalog.NewSourceCollection(), nil, catalog.NewLabelCollection(), nil, nil, nil)
+	}
+
+	t.Run("ListAndGet", func(t *testing.T) {
+		svc := newService(newProvider())
+
+		resp, err := svc.FindModelNamedQueries(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		list := resp.Body.(*model.CatalogNamedQueryList)
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, "apache", list.Items[0].Name)
+		assert.True(t, list.Items[0].GetFromConfig())
+
+		resp, err = svc.GetModelNamedQuery(context.Background(), "apache")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.Code)
+
+		resp, _ = svc.GetModelNamedQuery(context.Background(), "missing")
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+	})
+
+	t.Run("ConfigQueriesAreReadOnly", func(t *testing.T) {
+		svc := newService(newProvider())
+
+		resp, _ := svc.DeleteModelNamedQuery(context.Background(), "apache")
+		assert.Equal(t, http.StatusBadRequest, resp.Code, "saving queries needs a repository")
+	})
+
+	t.Run("FindModels", func(t *testing.T) {
+		provider := newProvider()
+		svc := newService(provider)
+
+		resp, err := svc.FindModels(context.Background(), false, 0, "", "", "", "", nil, "", nil, "", false, "provider='IBM'", "apache", "10", model.ORDERBYFIELD_NAME, model.SORTORDER_ASC, "")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "(provider='IBM') AND (license = 'apache-2.0')", provider.filterQuery)
+
+		resp, _ = svc.FindModels(context.Background(), false, 0, "", "", "", "", nil, "", nil, "", false, "", "missing", "10", model.ORDERBYFIELD_NAME, model.SORTORDER_ASC, "")
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+
+	t.Run("UnsupportedProvider", func(t *testing.T) {
+		svc := newService(&mockModelProvider{models: map[string]*model.CatalogModel{}})
+
+		resp, _ := svc.FindModelNamedQueries(context.Background())
+		assert.Equal(t, http.StatusNotImplemented, resp.Code)
+
+		resp, _ = svc.CreateModelNamedQuery(context.Background(), model.CatalogNamedQuery{Name: "q"})
+		assert.Equal(t, http.StatusNotImplemented, resp.Code)
+
+		resp, _ = svc.FindModels(context.Background(), false, 0, "", "", "", "", nil, "", nil, "", false, "", "apache", "10", model.ORDERBYFIELD_NAME, model.SORTORDER_ASC, "")
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+}
+
+type mockSourceSyncRepository struct {
+	syncs []*models.SourceSync
+	opts  models.SourceSyncListOptions
+}
+
+func (m *mockSourceSyncRepository) Save(sync *models.SourceSync) (*models.SourceSync, error) {
+	return sync, nil
+}
+
+func (m *mockSourceSyncRepository) List(listOptions models.SourceSyncListOptions) (*mrmodels.ListWrapper[*models.SourceSync], error) {
+	m.opts = listOptions
+	return &mrmodels.ListWrapper[*models.SourceSync]{
+		Items:         m.syncs,
+		PageSize:      listOptions.GetPageSize(),
+		Size:          int32(len(m.syncs)),
+		NextPageToken: "next",
+	}, nil
+}
+
+func (m *mockSourceSyncRepository) DeleteBySource(sourceID string) error {
+	return nil
+}
+
+func (m *mockSourceSyncRepository) Prune(sourceID string, keep int) error {
+	return nil
+}
+
+func TestGetSourceSyncHistory(t *testing.T) {
+	sources := catalog.NewSourceCollection()
+	require.NoError(t, sources.Merge("", map[string]catalog.ModelSource{
+		"hf": {CatalogSource: model.CatalogSource{Id: "hf", Name: "Hugging Face"}},
+	}))
+	mcpSources := makeMCPSources(map[string]basecatalog.MCPSource{
+		"tools": {ID: "tools", Name: "Tools"},
+	})
+
+	repo := &mockSourceSyncRepository{syncs: []*models.SourceSync{{
+		ID:                  apiutils.Of(int32(7)),
+		SourceID:            "hf",
+		AssetType:           models.SourceSyncAssetTypeModels,
+		Status:              basecatalog.SourceStatusPartiallyAvailable,
+		Error:               "1 model failed",
+		StartTimeSinceEpoch: 1000,
+		EndTimeSinceEpoch:   2000,
+		Added:               1,
+		Removed:             1,
+		Changes: []models.SourceSyncChange{
+			{Name: "org/new", Change: models.SourceSyncChangeAdded},
+			{Name: "org/old", Change: models.SourceSyncChangeRemoved},
+		},
+	}}}
+	svc := NewModelCatalogServiceAPIService(&mockModelProvider{}, sources, mcpSources, catalog.NewLabelCollection(), nil, repo, nil)
+
+	t.Run("List", func(t *testing.T) {
+		resp, err := svc.GetSourceSyncHistory(context.Background(), "hf", "1500", "5", "")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.Code)
+
+		assert.Equal(t, "hf", repo.opts.SourceID)
+		require.NotNil(t, repo.opts.Since)
+		assert.Equal(t, int64(1500), *repo.opts.Since)
+		assert.Equal(t, int32(5), repo.opts.GetPageSize())
+
+		list, ok := resp.Body.(model.CatalogSourceSyncList)
+		require.True(t, ok)
+		assert.Equal(t, int32(1), list.Size)
+		assert.Equal(t, "next", list.NextPageToken)
+		require.Len(t, list.Items, 1)
+
+		sync := list.Items[0]
+		assert.Equal(t, "7", sync.Id)
+		assert.Equal(t, model.CATALOGSOURCESTATUS_PARTIALLY_AVAILABLE, sync.Status)
+		assert.Equal(t, model.CATALOGASSETTYPE_MODELS, sync.GetAssetType())
+		assert.Equal(t, "1 model failed", sync.GetError())
+		assert.Equal(t, "1000", sync.StartTimeSinceEpoch)
+		assert.Equal(t, "2000", sync.EndTimeSinceEpoch)
+		assert.Equal(t, int32(1), sync.Added)
+		assert.Equal(t, int32(1), sync.Removed)
+		assert.Equal(t, []model.CatalogSourceSyncChange{
+			{Name: "org/new", Change: "ADDED"},
+			{Name: "org/old", Change: "REMOVED"},
+		}, sync.Changes)
+		assert.False(t, sync.GetChangesTruncated())
+	})
+
+	t.Run("MCPSource", func(t *testing.T) {
+		resp, err := svc.GetSourceSyncHistory(context.Background(), "tools", "", "", "")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "tools", repo.opts.SourceID)
+		assert.Nil(t, repo.opts.Since)
+	})
+
+	t.Run("UnknownSource", func(t *testing.T) {
+		resp, _ := svc.GetSourceSyncHistory(context.Background(), "missing", "", "", "")
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+	})
+
+	t.Run("InvalidSince", func(t *testing.T) {
+		resp, err := svc.GetSourceSyncHistory(context.Background(), "hf", "yesterday", "", "")
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+
+	t.Run("NoHistory", func(t *testing.T) {
+		svc := NewModelCatalogServiceAPIService(&mockModelProvider{}, sources, nil, catalog.NewLabelCollection(), nil, nil, nil)
+		resp, _ := svc.GetSourceSyncHistory(context.Background(), "hf", "", "", "")
+		assert.Equal(t, http.StatusNotImplemented, resp.Code)
+	})
+}
+
+type mockSourceRefreshRepository struct {
+	refreshes map[int32]*models.SourceRefresh
+}
+
+func (m *mockSourceRefreshRepository) Create(sourceID string, rescan bool) (*models.SourceRefresh, error) {
+	for _, refresh := range m.refreshes {
+		if refresh.SourceID == sourceID && !refresh.IsFinished() {
+			return nil, fmt.Errorf("source %q is already being refreshed: %w", sourceID, api.ErrConflict)
+		}
+	}
+	id := int32(len(m.refreshes) + 1)
+	refresh := &models.SourceRefresh{
+		ID:                       &id,
+		SourceID:                 sourceID,
+		State:                    models.SourceRefreshStateNew,
+		Rescan:                   rescan,
+		CreateTimeSinceEpoch:     1000,
+		LastUpdateTimeSinceEpoch: 1000,
+	}
+	m.refreshes[id] = refresh
+	return refresh, nil
+}
+
+func (m *mockSourceRefreshRepository) Get(id int32) (*models.SourceRefresh, error) {
+	refresh, ok := m.refreshes[id]
+	if !ok {
+		return nil, fmt.Errorf("source refresh %d not found: %w", id, api.ErrNotFound)
+	}
+	return refresh, nil
+}
+
+func (m *mockSourceRefreshRepository) ClaimNext() (*models.SourceRefresh, error) {
+	return nil, nil
+}
+
+func (m *mockSourceRefreshRepository) Finish(id int32, state string, sourceStatus string, errorMsg string) (*models.SourceRefresh, error) {
+	refresh, err := m.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	refresh.State = state
+	refresh.SourceStatus = sourceStatus
+	refresh.Error = errorMsg
+	return refresh, nil
+}
+
+func (m *mockSourceRefreshRepository) FailRunning(errorMsg string) error {
+	return nil
+}
+
+func (m *mockSourceRefreshRepository) Prune(sourceID string, keep int) error {
+	return nil
+}
+
+func TestRefreshSource(t *testing.T) {
+	sources := catalog.NewSourceCollection()
+	require.NoError(t, sources.Merge("", map[string]catalog.ModelSource{
+		"hf":  {CatalogSource: model.CatalogSource{Id: "hf", Name: "Hugging Face"}},
+		"old": {CatalogSource: model.CatalogSource{Id: "old", Name: "Old", Enabled: apiutils.Of(false)}},
+	}))
+	mcpSources := makeMCPSources(map[string]basecatalog.MCPSource{
+		"tools": {ID: "tools", Name: "Tools"},
+	})
+
+	repo := &mockSourceRefreshRepository{refreshes: map[int32]*models.SourceRefresh{}}
+	svc := NewModelCatalogServiceAPIService(&mockModelProvider{}, sources, mcpSources, catalog.NewLabelCollection(), nil, nil, repo)
+
+	t.Run("Refresh", func(t *testing.T) {
+		resp, err := svc.RefreshSource(context.Background(), "hf", false)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusAccepted, resp.Code)
+
+		refresh, ok := resp.Body.(model.CatalogSourceRefresh)
+		require.True(t, ok)
+		assert.Equal(t, "1", refresh.Id)
+		assert.Equal(t, "hf", refresh.SourceId)
+		assert.Equal(t, models.SourceRefreshStateNew, refresh.State)
+		assert.Nil(t, refresh.SourceStatus)
+		assert.Nil(t, refresh.Rescan)
+		assert.Equal(t, "1000", refresh.CreateTimeSinceEpoch)
+	})
+
+	t.Run("AlreadyRefreshing", func(t *testing.T) {
+		resp, err := svc.RefreshSource(context.Background(), "hf", false)
+		assert.ErrorIs(t, err, api.ErrConflict)
+		assert.Equal(t, http.StatusConflict, resp.Code)
+	})
+
+	t.Run("Poll", func(t *testing.T) {
+		_, err := repo.Finish(1, models.SourceRefreshStateFailed, basecatalog.SourceStatusError, "source unreachable")
+		require.NoError(t, err)
+
+		resp, err := svc.GetSourceRefresh(context.Background(), "hf", "1")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.Code)
+
+		refresh, ok := resp.Body.(model.CatalogSourceRefresh)
+		require.True(t, ok)
+		assert.Equal(t, models.SourceRefreshStateFailed, refresh.State)
+		assert.Equal(t, model.CATALOGSOURCESTATUS_ERROR, refresh.GetSourceStatus())
+		assert.Equal(t, "source unreachable", refresh.GetError())
+	})
+
+	t.Run("RescanWhenFinished", func(t *testing.T) {
+		resp, err := svc.RefreshSource(context.Background(), "hf", true)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusAccepted, resp.Code)
+
+		refresh, ok := resp.Body.(model.CatalogSourceRefresh)
+		require.True(t, ok)
+		assert.True(t, refresh.GetRescan())
+	})
+
+	t.Run("MCPSource", func(t *testing.T) {
+		resp, err := svc.RefreshSource(context.Background(), "tools", false)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusAccepted, resp.Code)
+	})
+
+	t.Run("UnknownSource", func(t *testing.T) {
+		resp, _ := svc.RefreshSource(context.Background(), "missing", false)
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+	})
+
+	t.Run("DisabledSource", func(t *testing.T) {
+		resp, err := svc.RefreshSource(context.Background(), "old", false)
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+
+	t.Run("PollUnknownRefresh", func(t *testing.T) {
+		for _, tc := range []struct{ sourceID, refreshID string }{
+			{"hf", "99"},
+			{"hf", "abc"},
+			{"tools", "1"}, // refresh 1 belongs to hf
+		} {
+			resp, _ := svc.GetSourceRefresh(context.Background(), tc.sourceID, tc.refreshID)
+			assert.Equal(t, http.StatusNotFound, resp.Code, "%s/%s", tc.sourceID, tc.refreshID)
+		}
+	})
+
+	t.Run("NoRefreshes", func(t *testing.T) {
+		svc := NewModelCatalogServiceAPIService(&mockModelProvider{}, sources, nil, catalog.NewLabelCollection(), nil, nil, nil)
+		resp, _ := svc.RefreshSource(context.Background(), "hf", false)
+		assert.Equal(t, http.StatusNotImplemented, resp.Code)
+	})
+}
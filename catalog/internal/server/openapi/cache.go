@@ -0,0 +1,350 @@
+package openapi
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// maxCachedResponses limits the number of responses kept by a
+// ResponseCache.
+const maxCachedResponses = 1000
+
+// ResponseCache caches the responses of the catalog endpoints whose content
+// only changes when a source is loaded, and answers conditional requests
+// for them.
+//
+// It keeps a generation for each source, which is increased when the
+// content of the source changes. A response is only served from cache while
+// the generations of the sources it covers are the same as when it was
+// cached, and for at most the cache's TTL. Generations are local to the
+// process, so the TTL is what bounds how stale responses, and the ETags
+// matched by conditional requests, can get on pods that don't receive load
+// events or didn't serve a write.
+type ResponseCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu sync.Mutex
+	// all is increased by every change, for the responses that cover every
+	// source.
+	all generation
+	// epoch is increased by changes that can affect any source.
+	epoch   generation
+	sources map[string]generation
+	entries map[string]*cachedResponse
+}
+
+type generation struct {
+	n        uint64
+	modified time.Time
+}
+
+func (g *generation) increase(now time.Time) {
+	g.n++
+	g.modified = now
+}
+
+type cachedResponse struct {
+	generation string
+	header     http.Header
+	body       []byte
+	etag       string
+	modified   time.Time
+	expires    time.Time
+}
+
+// NewResponseCache creates a ResponseCache that keeps responses for at most
+// ttl. With a ttl of zero, responses aren't cached but conditional requests
+// are still answered.
+func NewResponseCache(ttl time.Duration) *ResponseCache {
+	now := time.Now()
+	return &ResponseCache{
+		ttl:     ttl,
+		now:     time.Now,
+		all:     generation{modified: now},
+		epoch:   generation{modified: now},
+		sources: map[string]generation{},
+		entries: map[string]*cachedResponse{},
+	}
+}
+
+// SourceChanged records a change to the content of a source.
+func (c *ResponseCache) SourceChanged(sourceID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	c.all.increase(now)
+	g := c.sources[sourceID]
+	g.increase(now)
+	c.sources[sourceID] = g
+}
+
+// AllChanged records a change that can affect the content of any source,
+// such as a config reload.
+func (c *ResponseCache) AllChanged() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	c.all.increase(now)
+	c.epoch.increase(now)
+	clear(c.entries)
+}
+
+// generation returns the generation of the content of sourceIDs, or of
+// every source if there are none, and when it last changed.
+func (c *ResponseCache) generation(sourceIDs []string) (string, time.Time) {
+	if len(sourceIDs) == 0 {
+		return fmt.Sprintf("*%d", c.all.n), c.all.modified
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d", c.epoch.n)
+	modified := c.epoch.modified
+	for _, id := range slices.Sorted(slices.Values(sourceIDs)) {
+		g := c.sources[id]
+		fmt.Fprintf(&sb, ",%s=%d", id, g.n)
+		if g.modified.After(modified) {
+			modified = g.modified
+		}
+	}
+	return sb.String(), modified
+}
+
+// Handler returns a handler that serves the responses of next from cache.
+// scope returns the IDs of the sources a request covers, or nil if it
+// covers every source.
+func (c *ResponseCache) Handler(scope func(r *http.Request) []string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		key := r.URL.RequestURI()
+		sourceIDs := scope(r)
+
+		c.mu.Lock()
+		gen, modified := c.generation(sourceIDs)
+		entry := c.entries[key]
+		c.mu.Unlock()
+
+		if entry == nil || entry.generation != gen || !c.now().Before(entry.expires) {
+			rec := &responseRecorder{header: http.Header{}, status: http.StatusOK}
+			next(rec, r)
+			if rec.status != http.StatusOK {
+				rec.writeTo(w)
+				return
+			}
+
+			sum := sha256.Sum256(rec.body.Bytes())
+			etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+			if entry != nil && entry.etag == etag {
+				// The content didn't change, so neither did its age.
+				modified = entry.modified
+			}
+			entry = &cachedResponse{
+				generation: gen,
+				header:     rec.header,
+				body:       rec.body.Bytes(),
+				etag:       etag,
+				modified:   modified,
+				expires:    c.now().Add(c.ttl),
+			}
+			c.store(key, sourceIDs, entry)
+		}
+
+		header := w.Header()
+		maps.Copy(header, entry.header)
+		header.Set("ETag", entry.etag)
+		header.Set("Last-Modified", entry.modified.UTC().Format(http.TimeFormat))
+		header.Set("Cache-Control", "no-cache")
+
+		if etagMatches(r.Header.Get("If-None-Match"), entry.etag) {
+			header.Del("Content-Type")
+			header.Del("Content-Length")
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(entry.body)
+	}
+}
+
+func (c *ResponseCache) store(key string, sourceIDs []string, entry *cachedResponse) {
+	if c.ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// A load may have finished while the response was computed.
+	if gen, _ := c.generation(sourceIDs); gen != entry.generation {
+		return
+	}
+
+	if len(c.entries) >= maxCachedResponses {
+		now := c.now()
+		maps.DeleteFunc(c.entries, func(_ string, e *cachedResponse) bool {
+			return !now.Before(e.expires)
+		})
+		if len(c.entries) >= maxCachedResponses {
+			clear(c.entries)
+		}
+	}
+	c.entries[key] = entry
+}
+
+// etagMatches reports whether an If-None-Match header matches etag, using
+// the weak comparison required for it.
+func etagMatches(ifNoneMatch string, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	for candidate := range strings.SplitSeq(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// responseRecorder buffers a response so that it can be cached.
+type responseRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	return r.body.Write(b)
+}
+
+func (r *responseRecorder) writeTo(w http.ResponseWriter) {
+	maps.Copy(w.Header(), r.header)
+	w.WriteHeader(r.status)
+	_, _ = w.Write(r.body.Bytes())
+}
+
+// readOnlyRoutes are the routes that use POST but don't change anything.
+var readOnlyRoutes = map[string]bool{
+	"CompareModels":        true,
+	"PreviewCatalogSource": true,
+}
+
+// NewCachingRouter wraps the routes of a model catalog router so that the
+// responses of findModels, getModel and findLabels are served from cache,
+// and changes made through the API invalidate it.
+func NewCachingRouter(router Router, cache *ResponseCache) Router {
+	return &cachingRouter{router: router, cache: cache}
+}
+
+type cachingRouter struct {
+	router Router
+	cache  *ResponseCache
+}
+
+func (c *cachingRouter) Routes() Routes {
+	routes := c.router.Routes()
+	result := make(Routes, len(routes))
+	for name, route := range routes {
+		result[name] = c.wrap(route)
+	}
+	return result
+}
+
+func (c *cachingRouter) OrderedRoutes() []Route {
+	routes := c.router.OrderedRoutes()
+	result := make([]Route, len(routes))
+	for i, route := range routes {
+		result[i] = c.wrap(route)
+	}
+	return result
+}
+
+func (c *cachingRouter) wrap(route Route) Route {
+	switch {
+	case route.Name == "FindModels":
+		route.HandlerFunc = c.cache.Handler(findModelsSources, route.HandlerFunc)
+	case route.Name == "GetModel":
+		next := route.HandlerFunc
+		cached := c.cache.Handler(getModelSources, next)
+		pattern := route.Pattern
+		route.HandlerFunc = func(w http.ResponseWriter, r *http.Request) {
+			// The wildcard also matches the model's subresources, which
+			// depend on more than the model's source.
+			if isModelSubresource(r, pattern) {
+				next(w, r)
+				return
+			}
+			cached(w, r)
+		}
+	case route.Name == "FindLabels":
+		route.HandlerFunc = c.cache.Handler(allSources, route.HandlerFunc)
+	case route.Method != http.MethodGet && !readOnlyRoutes[route.Name]:
+		// Only the pod serving a write invalidates its cache, the others
+		// keep their responses until the cache's TTL.
+		next := route.HandlerFunc
+		route.HandlerFunc = func(w http.ResponseWriter, r *http.Request) {
+			next(w, r)
+			c.cache.AllChanged()
+		}
+	}
+	return route
+}
+
+func findModelsSources(r *http.Request) []string {
+	query := r.URL.Query()
+	if query.Get("source") == "" || query.Has("sourceLabel") {
+		return nil
+	}
+	return strings.Split(query.Get("source"), ",")
+}
+
+// modelSubresources are the path segments of the subresources of a model.
+// chi routes them to their own routes, except for the models whose names
+// contain slashes, which the GetModel wildcard matches instead.
+var modelSubresources = [][]string{{"artifacts"}, {"artifacts", "performance"}, {"sizing"}, {"variants"}}
+
+// isModelSubresource returns whether r, routed to the GetModel route with
+// pattern getModelPattern, requests a subresource of a model rather than the
+// model itself, the way the GetModel handler dispatches it.
+func isModelSubresource(r *http.Request, getModelPattern string) bool {
+	rctx := chi.RouteContext(r.Context())
+	if rctx == nil || rctx.RoutePattern() != getModelPattern {
+		return true
+	}
+
+	segments := strings.Split(rctx.URLParam("*"), "/")
+	for _, subresource := range modelSubresources {
+		if len(segments) >= len(subresource) && slices.Equal(segments[len(segments)-len(subresource):], subresource) {
+			return true
+		}
+	}
+	return false
+}
+
+func getModelSources(r *http.Request) []string {
+	return []string{chi.URLParam(r, "source_id")}
+}
+
+func allSources(*http.Request) []string {
+	return nil
+}
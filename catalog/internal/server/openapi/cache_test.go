@@ -0,0 +1,231 @@
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingRouter serves a few model catalog routes, counting the calls to
+// each of them.
+type countingRouter struct {
+	calls  map[string]int
+	status int
+}
+
+func (c *countingRouter) handler(name string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		c.calls[name]++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(c.status)
+		fmt.Fprintf(w, `{"route":%q,"version":%d}`, name, c.calls["version"])
+	}
+}
+
+func (c *countingRouter) Routes() Routes {
+	routes := Routes{}
+	for _, route := range c.OrderedRoutes() {
+		routes[route.Name] = route
+	}
+	return routes
+}
+
+func (c *countingRouter) OrderedRoutes() []Route {
+	return []Route{
+		{"FindModels", http.MethodGet, "/api/model_catalog/v1alpha1/models", c.handler("FindModels")},
+		{"GetModel", http.MethodGet, "/api/model_catalog/v1alpha1/sources/{source_id}/models/*", c.handler("GetModel")},
+		{"GetModelSizing", http.MethodGet, "/api/model_catalog/v1alpha1/sources/{source_id}/models/{model_name}/sizing", c.handler("GetModelSizing")},
+		{"FindSources", http.MethodGet, "/api/model_catalog/v1alpha1/sources", c.handler("FindSources")},
+		{"CompareModels", http.MethodPost, "/api/model_catalog/v1alpha1/models:compare", c.handler("CompareModels")},
+		{"DeleteModel", http.MethodDelete, "/api/model_catalog/v1alpha1/sources/{source_id}/models/*", c.handler("DeleteModel")},
+	}
+}
+
+func newTestCachingRouter(ttl time.Duration) (http.Handler, *countingRouter, *ResponseCache) {
+	routes := &countingRouter{calls: map[string]int{}, status: http.StatusOK}
+	cache := NewResponseCache(ttl)
+	return NewRouter(NewCachingRouter(routes, cache)), routes, cache
+}
+
+func serve(t *testing.T, handler http.Handler, method string, target string, header http.Header) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, target, nil)
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestResponseCache(t *testing.T) {
+	const (
+		allModels     = "/api/model_catalog/v1alpha1/models"
+		sourceAModels = "/api/model_catalog/v1alpha1/models?source=a"
+		sourceBModel  = "/api/model_catalog/v1alpha1/sources/b/models/org%2Fmodel"
+	)
+
+	t.Run("ServesFromCache", func(t *testing.T) {
+		handler, routes, _ := newTestCachingRouter(time.Minute)
+
+		first := serve(t, handler, http.MethodGet, allModels, nil)
+		require.Equal(t, http.StatusOK, first.Code)
+		assert.NotEmpty(t, first.Header().Get("ETag"))
+		assert.NotEmpty(t, first.Header().Get("Last-Modified"))
+		assert.Equal(t, "no-cache", first.Header().Get("Cache-Control"))
+		assert.Equal(t, "application/json", first.Header().Get("Content-Type"))
+
+		second := serve(t, handler, http.MethodGet, allModels, nil)
+		assert.Equal(t, first.Body.String(), second.Body.String())
+		assert.Equal(t, first.Header().Get("ETag"), second.Header().Get("ETag"))
+		assert.Equal(t, 1, routes.calls["FindModels"])
+
+		serve(t, handler, http.MethodGet, sourceAModels, nil)
+		assert.Equal(t, 2, routes.calls["FindModels"], "other query parameters are cached separately")
+	})
+
+	t.Run("IfNoneMatch", func(t *testing.T) {
+		handler, _, _ := newTestCachingRouter(time.Minute)
+
+		etag := serve(t, handler, http.MethodGet, allModels, nil).Header().Get("ETag")
+
+		for _, ifNoneMatch := range []string{etag, "W/" + etag, `"other", ` + etag, "*"} {
+			rec := serve(t, handler, http.MethodGet, allModels, http.Header{"If-None-Match": {ifNoneMatch}})
+			assert.Equal(t, http.StatusNotModified, rec.Code, ifNoneMatch)
+			assert.Empty(t, rec.Body.String())
+			assert.Equal(t, etag, rec.Header().Get("ETag"))
+		}
+
+		rec := serve(t, handler, http.MethodGet, allModels, http.Header{"If-None-Match": {`"other"`}})
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("SourceChanged", func(t *testing.T) {
+		handler, routes, cache := newTestCachingRouter(time.Minute)
+
+		for _, target := range []string{allModels, sourceAModels, sourceBModel} {
+			serve(t, handler, http.MethodGet, target, nil)
+		}
+		cache.SourceChanged("a")
+		for _, target := range []string{allModels, sourceAModels, sourceBModel} {
+			serve(t, handler, http.MethodGet, target, nil)
+		}
+
+		assert.Equal(t, 4, routes.calls["FindModels"], "responses covering source a are computed again")
+		assert.Equal(t, 1, routes.calls["GetModel"], "responses for source b are still cached")
+	})
+
+	t.Run("AllChanged", func(t *testing.T) {
+		handler, routes, cache := newTestCachingRouter(time.Minute)
+
+		serve(t, handler, http.MethodGet, sourceBModel, nil)
+		cache.AllChanged()
+		serve(t, handler, http.MethodGet, sourceBModel, nil)
+
+		assert.Equal(t, 2, routes.calls["GetModel"])
+	})
+
+	t.Run("ChangedContent", func(t *testing.T) {
+		handler, routes, cache := newTestCachingRouter(time.Minute)
+
+		first := serve(t, handler, http.MethodGet, allModels, nil)
+		cache.SourceChanged("a")
+		second := serve(t, handler, http.MethodGet, allModels, nil)
+		assert.Equal(t, first.Header().Get("ETag"), second.Header().Get("ETag"), "same content, same ETag")
+
+		routes.calls["version"]++
+		cache.SourceChanged("a")
+		third := serve(t, handler, http.MethodGet, allModels, http.Header{"If-None-Match": {first.Header().Get("ETag")}})
+		assert.Equal(t, http.StatusOK, third.Code)
+		assert.NotEqual(t, first.Header().Get("ETag"), third.Header().Get("ETag"))
+	})
+
+	t.Run("Expires", func(t *testing.T) {
+		handler, routes, cache := newTestCachingRouter(time.Minute)
+		now := time.Now()
+		cache.now = func() time.Time { return now }
+
+		serve(t, handler, http.MethodGet, allModels, nil)
+		now = now.Add(30 * time.Second)
+		serve(t, handler, http.MethodGet, allModels, nil)
+		assert.Equal(t, 1, routes.calls["FindModels"])
+
+		now = now.Add(time.Minute)
+		serve(t, handler, http.MethodGet, allModels, nil)
+		assert.Equal(t, 2, routes.calls["FindModels"])
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		handler, routes, _ := newTestCachingRouter(0)
+
+		etag := serve(t, handler, http.MethodGet, allModels, nil).Header().Get("ETag")
+		rec := serve(t, handler, http.MethodGet, allModels, http.Header{"If-None-Match": {etag}})
+
+		assert.Equal(t, http.StatusNotModified, rec.Code, "conditional requests work without caching")
+		assert.Equal(t, 2, routes.calls["FindModels"])
+	})
+
+	t.Run("ErrorsAreNotCached", func(t *testing.T) {
+		handler, routes, _ := newTestCachingRouter(time.Minute)
+		routes.status = http.StatusNotFound
+
+		for range 2 {
+			rec := serve(t, handler, http.MethodGet, sourceBModel, nil)
+			assert.Equal(t, http.StatusNotFound, rec.Code)
+			assert.Empty(t, rec.Header().Get("ETag"))
+		}
+		assert.Equal(t, 2, routes.calls["GetModel"])
+	})
+
+	t.Run("OtherRoutes", func(t *testing.T) {
+		handler, routes, _ := newTestCachingRouter(time.Minute)
+
+		for range 2 {
+			rec := serve(t, handler, http.MethodGet, "/api/model_catalog/v1alpha1/sources", nil)
+			assert.Empty(t, rec.Header().Get("ETag"))
+		}
+		assert.Equal(t, 2, routes.calls["FindSources"])
+	})
+
+	t.Run("ModelSubresources", func(t *testing.T) {
+		handler, routes, _ := newTestCachingRouter(time.Minute)
+
+		for _, subresource := range []string{"/artifacts", "/artifacts/performance", "/sizing", "/variants"} {
+			for range 2 {
+				rec := serve(t, handler, http.MethodGet, "/api/model_catalog/v1alpha1/sources/b/models/org/model"+subresource, nil)
+				assert.Empty(t, rec.Header().Get("ETag"), subresource)
+			}
+		}
+		assert.Equal(t, 8, routes.calls["GetModel"], "subresources aren't cached")
+
+		for range 2 {
+			rec := serve(t, handler, http.MethodGet, "/api/model_catalog/v1alpha1/sources/b/models/model/sizing", nil)
+			assert.Empty(t, rec.Header().Get("ETag"))
+		}
+		assert.Equal(t, 2, routes.calls["GetModelSizing"], "subresources of their own routes aren't cached")
+
+		for range 2 {
+			rec := serve(t, handler, http.MethodGet, "/api/model_catalog/v1alpha1/sources/b/models/org/model-sizing", nil)
+			assert.NotEmpty(t, rec.Header().Get("ETag"))
+		}
+		assert.Equal(t, 9, routes.calls["GetModel"], "models named like a subresource are cached")
+	})
+
+	t.Run("WritesInvalidate", func(t *testing.T) {
+		handler, routes, _ := newTestCachingRouter(time.Minute)
+
+		serve(t, handler, http.MethodGet, sourceBModel, nil)
+		serve(t, handler, http.MethodPost, "/api/model_catalog/v1alpha1/models:compare", nil)
+		serve(t, handler, http.MethodGet, sourceBModel, nil)
+		assert.Equal(t, 1, routes.calls["GetModel"], "comparing models doesn't change anything")
+
+		serve(t, handler, http.MethodDelete, sourceBModel, nil)
+		serve(t, handler, http.MethodGet, sourceBModel, nil)
+		assert.Equal(t, 2, routes.calls["GetModel"])
+	})
+}
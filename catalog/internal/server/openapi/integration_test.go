@@ -3,10 +3,12 @@ package openapi_test
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"sort"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/go-chi/chi/v5"
@@ -15,6 +17,7 @@ import (
 	"github.com/kubeflow/hub/catalog/internal/server/openapi"
 	model "github.com/kubeflow/hub/catalog/pkg/openapi"
 	mrmodels "github.com/kubeflow/hub/internal/platform/db/entity"
+	"github.com/kubeflow/hub/pkg/api"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -404,6 +407,222 @@ func TestPerformanceArtifactsEndToEnd(t *testing.T) {
 	})
 }
 
+// TestCompareModelsEndToEnd tests the model comparison endpoint from HTTP request to response
+func TestCompareModelsEndToEnd(t *testing.T) {
+	const comparePath = "/api/model_catalog/v1alpha1/models:compare"
+
+	newProvider := func() *mockPerformanceProvider {
+		return &mockPerformanceProvider{
+			models: map[string]*model.CatalogModel{
+				"model-a": {Name: "model-a"},
+				"model-b": {Name: "model-b"},
+			},
+		}
+	}
+
+	t.Run("compares models with default profile", func(t *testing.T) {
+		provider := newProvider()
+		router, _ := setupTestServer(t, provider)
+
+		body := `{"models": [{"sourceId": "test-source", "name": "model-a"}, {"sourceId": "other-source", "name": "model-b"}]}`
+		req := httptest.NewRequest("POST", comparePath, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+		var result model.CatalogModelComparison
+		require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &result))
+		require.Len(t, result.Items, 2)
+		assert.Equal(t, "other-source", result.Items[1].SourceId)
+		assert.Equal(t, []string{"mmlu"}, result.Benchmarks)
+
+		assert.Equal(t, "ttft_p90", provider.lastCompareParams.LatencyProperty)
+		assert.Equal(t, "requests_per_second", provider.lastCompareParams.RPSProperty)
+		assert.Equal(t, "hardware_count", provider.lastCompareParams.HardwareCountProperty)
+		assert.Equal(t, "hardware_type", provider.lastCompareParams.HardwareTypeProperty)
+	})
+
+	t.Run("passes the requested profile", func(t *testing.T) {
+		provider := newProvider()
+		router, _ := setupTestServer(t, provider)
+
+		body := `{"models": [{"sourceId": "test-source", "name": "model-a"}, {"sourceId": "test-source", "name": "model-b"}], "targetRPS": 50, "hardwareType": "H100", "latencyProperty": "e2e_p95"}`
+		req := httptest.NewRequest("POST", comparePath, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.Equal(t, int32(50), provider.lastCompareParams.TargetRPS)
+		assert.Equal(t, "H100", provider.lastCompareParams.HardwareType)
+		assert.Equal(t, "e2e_p95", provider.lastCompareParams.LatencyProperty)
+	})
+
+	t.Run("unknown model", func(t *testing.T) {
+		router, _ := setupTestServer(t, newProvider())
+
+		body := `{"models": [{"sourceId": "test-source", "name": "model-a"}, {"sourceId": "test-source", "name": "missing"}]}`
+		req := httptest.NewRequest("POST", comparePath, strings.NewReader(body))
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+	})
+
+	t.Run("malformed request", func(t *testing.T) {
+		router, _ := setupTestServer(t, newProvider())
+
+		for _, body := range []string{
+			`{"models": [], "unknown": true}`,
+			`not json`,
+		} {
+			req := httptest.NewRequest("POST", comparePath, strings.NewReader(body))
+			resp := httptest.NewRecorder()
+			router.ServeHTTP(resp, req)
+
+			assert.Equal(t, http.StatusBadRequest, resp.Code, body)
+		}
+	})
+
+	t.Run("missing model name", func(t *testing.T) {
+		router, _ := setupTestServer(t, newProvider())
+
+		body := `{"models": [{"sourceId": "test-source"}, {"sourceId": "test-source", "name": "model-b"}]}`
+		req := httptest.NewRequest("POST", comparePath, strings.NewReader(body))
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+	})
+}
+
+// TestGetModelSizingEndToEnd tests the model sizing endpoint from HTTP request to response
+func TestGetModelSizingEndToEnd(t *testing.T) {
+	newProvider := func() *mockPerformanceProvider {
+		return &mockPerformanceProvider{
+			models: map[string]*model.CatalogModel{
+				"org/model-a": {Name: "org/model-a"},
+			},
+		}
+	}
+
+	t.Run("sizes with default parameters", func(t *testing.T) {
+		provider := newProvider()
+		router, _ := setupTestServer(t, provider)
+
+		req := httptest.NewRequest("GET", "/api/model_catalog/v1alpha1/sources/test-source/models/org/model-a/sizing", nil)
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+		var result model.CatalogModelSizing
+		require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &result))
+		require.Len(t, result.Items, 1)
+		assert.Equal(t, int32(2), result.Items[0].Replicas)
+
+		assert.Equal(t, int32(1), provider.lastSizingParams.TargetRPS)
+		assert.Empty(t, provider.lastSizingParams.MaxLatency)
+		assert.Equal(t, "requests_per_second", provider.lastSizingParams.RPSProperty)
+		assert.Equal(t, "hardware_count", provider.lastSizingParams.HardwareCountProperty)
+		assert.Equal(t, "hardware_type", provider.lastSizingParams.HardwareTypeProperty)
+	})
+
+	t.Run("passes the requested objectives", func(t *testing.T) {
+		provider := newProvider()
+		router, _ := setupTestServer(t, provider)
+
+		req := httptest.NewRequest("GET", "/api/model_catalog/v1alpha1/sources/test-source/models/org/model-a/sizing?targetRPS=200&maxLatency=ttft_p90:500,e2e_p90:2000&filterQuery=use_case%3D%27chatbot%27", nil)
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.Equal(t, int32(200), provider.lastSizingParams.TargetRPS)
+		assert.Equal(t, []string{"ttft_p90:500", "e2e_p90:2000"}, provider.lastSizingParams.MaxLatency)
+		assert.Equal(t, "use_case='chatbot'", provider.lastSizingParams.FilterQuery)
+	})
+
+	t.Run("invalid target RPS", func(t *testing.T) {
+		router, _ := setupTestServer(t, newProvider())
+
+		req := httptest.NewRequest("GET", "/api/model_catalog/v1alpha1/sources/test-source/models/org/model-a/sizing?targetRPS=lots", nil)
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+
+	t.Run("unknown model", func(t *testing.T) {
+		router, _ := setupTestServer(t, newProvider())
+
+		req := httptest.NewRequest("GET", "/api/model_catalog/v1alpha1/sources/test-source/models/missing/sizing", nil)
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+	})
+}
+
+func TestModelVariantsEndToEnd(t *testing.T) {
+	newProvider := func() *mockPerformanceProvider {
+		return &mockPerformanceProvider{
+			models: map[string]*model.CatalogModel{
+				"org/model-a": {Name: "org/model-a"},
+			},
+		}
+	}
+
+	t.Run("lists the variants of a model", func(t *testing.T) {
+		router, _ := setupTestServer(t, newProvider())
+
+		req := httptest.NewRequest("GET", "/api/model_catalog/v1alpha1/sources/test-source/models/org/model-a/variants", nil)
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+		var result model.CatalogModelVariantList
+		require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &result))
+		require.Len(t, result.Items, 2)
+		assert.Equal(t, "org/model-a", result.Items[0].Name)
+		assert.Equal(t, "FP8", result.Items[1].GetTensorType())
+	})
+
+	t.Run("unknown model", func(t *testing.T) {
+		router, _ := setupTestServer(t, newProvider())
+
+		req := httptest.NewRequest("GET", "/api/model_catalog/v1alpha1/sources/test-source/models/missing/variants", nil)
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+	})
+
+	t.Run("groups models by variant", func(t *testing.T) {
+		provider := newProvider()
+		router, _ := setupTestServer(t, provider)
+
+		req := httptest.NewRequest("GET", "/api/model_catalog/v1alpha1/models?source=test-source&groupBy=variant", nil)
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.True(t, provider.lastListParams.GroupByVariant)
+	})
+
+	t.Run("grouping with recommendations", func(t *testing.T) {
+		router, _ := setupTestServer(t, newProvider())
+
+		req := httptest.NewRequest("GET", "/api/model_catalog/v1alpha1/models?source=test-source&groupBy=variant&recommendations=true", nil)
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+}
+
 // setupTestServer creates a test server with the full routing and HTTP handling stack
 func setupTestServer(t *testing.T, provider catalog.APIProvider) (chi.Router, openapi.ModelCatalogServiceAPIServicer) {
 	// Create source collection
@@ -416,7 +635,7 @@ func setupTestServer(t *testing.T, provider catalog.APIProvider) (chi.Router, op
 	sourceLabels := catalog.NewLabelCollection()
 
 	// Create service and controller
-	service := openapi.NewModelCatalogServiceAPIService(provider, sources, nil, sourceLabels, nil)
+	service := openapi.NewModelCatalogServiceAPIService(provider, sources, nil, sourceLabels, nil, nil, nil)
 	controller := openapi.NewModelCatalogServiceAPIController(service)
 
 	// Create router with proper routing
@@ -427,10 +646,13 @@ func setupTestServer(t *testing.T, provider catalog.APIProvider) (chi.Router, op
 
 // mockPerformanceProvider is a mock implementation of catalog.APIProvider for testing
 type mockPerformanceProvider struct {
-	models        map[string]*model.CatalogModel
-	artifacts     map[string][]model.CatalogArtifact
-	captureParams bool
-	lastParams    catalog.ListPerformanceArtifactsParams
+	models            map[string]*model.CatalogModel
+	artifacts         map[string][]model.CatalogArtifact
+	captureParams     bool
+	lastParams        catalog.ListPerformanceArtifactsParams
+	lastCompareParams catalog.CompareModelsParams
+	lastSizingParams  catalog.ModelSizingParams
+	lastListParams    catalog.ListModelsParams
 }
 
 func (m *mockPerformanceProvider) GetModel(ctx context.Context, name string, sourceID string) (*model.CatalogModel, error) {
@@ -442,6 +664,7 @@ func (m *mockPerformanceProvider) GetModel(ctx context.Context, name string, sou
 }
 
 func (m *mockPerformanceProvider) ListModels(ctx context.Context, params catalog.ListModelsParams) (model.CatalogModelList, error) {
+	m.lastListParams = params
 	return model.CatalogModelList{}, nil
 }
 
@@ -529,11 +752,67 @@ func (m *mockPerformanceProvider) GetPerformanceArtifacts(ctx context.Context, m
 	}, nil
 }
 
-func (m *mockPerformanceProvider) GetFilterOptions(ctx context.Context) (*model.FilterOptionsList, error) {
+func (m *mockPerformanceProvider) GetFilterOptions(ctx context.Context, params catalog.FilterOptionsParams) (*model.FilterOptionsList, error) {
 	return &model.FilterOptionsList{}, nil
 }
 
-func (m *mockPerformanceProvider) FindModelsWithRecommendedLatency(ctx context.Context, pagination mrmodels.Pagination, paretoParams modelcatalog.ParetoFilteringParams, sourceIDs []string, query string) (*model.CatalogModelList, error) {
+func (m *mockPerformanceProvider) CompareModels(ctx context.Context, params catalog.CompareModelsParams) (*model.CatalogModelComparison, error) {
+	m.lastCompareParams = params
+	items := make([]model.CatalogModelComparisonItem, 0, len(params.Models))
+	for _, ref := range params.Models {
+		if _, exists := m.models[ref.Name]; !exists {
+			return nil, fmt.Errorf("no model %q found in source %q: %w", ref.Name, ref.SourceId, api.ErrNotFound)
+		}
+		items = append(items, model.CatalogModelComparisonItem{
+			SourceId: ref.SourceId,
+			Name:     ref.Name,
+			Tasks:    []string{},
+			Accuracy: map[string]float64{"mmlu": 70},
+		})
+	}
+	return &model.CatalogModelComparison{
+		Profile:    model.CatalogModelComparisonProfile{TargetRPS: params.TargetRPS, LatencyProperty: params.LatencyProperty},
+		Benchmarks: []string{"mmlu"},
+		Items:      items,
+	}, nil
+}
+
+func (m *mockPerformanceProvider) GetModelSizing(ctx context.Context, modelName string, sourceID string, params catalog.ModelSizingParams) (*model.CatalogModelSizing, error) {
+	m.lastSizingParams = params
+	if _, exists := m.models[modelName]; !exists {
+		return nil, fmt.Errorf("no models found for name=%v: %w", modelName, api.ErrNotFound)
+	}
+	return &model.CatalogModelSizing{
+		TargetRPS:  params.TargetRPS,
+		MaxLatency: map[string]float64{},
+		Items: []model.CatalogModelSizingConfiguration{
+			{
+				HardwareType:       "H100",
+				HardwareCount:      1,
+				Replicas:           2,
+				TotalHardwareCount: 2,
+				RequestsPerSecond:  60,
+				Latency:            map[string]float64{},
+			},
+		},
+	}, nil
+}
+
+func (m *mockPerformanceProvider) GetModelVariants(ctx context.Context, modelName string, sourceID string) (*model.CatalogModelVariantList, error) {
+	if _, exists := m.models[modelName]; !exists {
+		return nil, fmt.Errorf("no models found for name=%v: %w", modelName, api.ErrNotFound)
+	}
+	fp8 := "FP8"
+	return &model.CatalogModelVariantList{
+		Items: []model.CatalogModelVariant{
+			{SourceId: sourceID, Name: modelName},
+			{SourceId: sourceID, Name: modelName + "-FP8", TensorType: &fp8},
+		},
+		Size: 2,
+	}, nil
+}
+
+func (m *mockPerformanceProvider) FindModelsWithRecommendedLatency(ctx context.Context, pagination mrmodels.Pagination, paretoParams modelcatalog.ParetoFilteringParams, sourceIDs []string, query string, includeDeprecated bool) (*model.CatalogModelList, error) {
 	// Basic mock implementation - just return models sorted by name
 	var allModels []*model.CatalogModel
 	for _, mdl := range m.models {
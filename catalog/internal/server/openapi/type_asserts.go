@@ -202,8 +202,214 @@ func AssertCatalogModelArtifactRequired(obj model.CatalogModelArtifact) error {
 	return nil
 }
 
+// AssertCatalogModelCompareRequestConstraints checks if the values respects the defined constraints
+func AssertCatalogModelCompareRequestConstraints(obj model.CatalogModelCompareRequest) error {
+	for _, el := range obj.Models {
+		if err := AssertCatalogModelReferenceConstraints(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertCatalogModelCompareRequestRequired checks if the required fields are not zero-ed
+func AssertCatalogModelCompareRequestRequired(obj model.CatalogModelCompareRequest) error {
+	elements := map[string]interface{}{
+		"models": obj.Models,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	for _, el := range obj.Models {
+		if err := AssertCatalogModelReferenceRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertCatalogModelComparisonConstraints checks if the values respects the defined constraints
+func AssertCatalogModelComparisonConstraints(obj model.CatalogModelComparison) error {
+	if err := AssertCatalogModelComparisonProfileConstraints(obj.Profile); err != nil {
+		return err
+	}
+	for _, el := range obj.Items {
+		if err := AssertCatalogModelComparisonItemConstraints(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertCatalogModelComparisonItemConstraints checks if the values respects the defined constraints
+func AssertCatalogModelComparisonItemConstraints(obj model.CatalogModelComparisonItem) error {
+	if obj.Performance != nil {
+		if err := AssertCatalogModelComparisonPerformanceConstraints(*obj.Performance); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertCatalogModelComparisonItemRequired checks if the required fields are not zero-ed
+func AssertCatalogModelComparisonItemRequired(obj model.CatalogModelComparisonItem) error {
+	elements := map[string]interface{}{
+		"sourceId": obj.SourceId,
+		"name":     obj.Name,
+		"tasks":    obj.Tasks,
+		"accuracy": obj.Accuracy,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	if obj.Performance != nil {
+		if err := AssertCatalogModelComparisonPerformanceRequired(*obj.Performance); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertCatalogModelComparisonPerformanceConstraints checks if the values respects the defined constraints
+func AssertCatalogModelComparisonPerformanceConstraints(obj model.CatalogModelComparisonPerformance) error {
+	return nil
+}
+
+// AssertCatalogModelComparisonPerformanceRequired checks if the required fields are not zero-ed
+func AssertCatalogModelComparisonPerformanceRequired(obj model.CatalogModelComparisonPerformance) error {
+	elements := map[string]interface{}{
+		"hardwareType":           obj.HardwareType,
+		"hardwareCount":          obj.HardwareCount,
+		"replicas":               obj.Replicas,
+		"totalHardwareCount":     obj.TotalHardwareCount,
+		"requestsPerSecond":      obj.RequestsPerSecond,
+		"totalRequestsPerSecond": obj.TotalRequestsPerSecond,
+		"metrics":                obj.Metrics,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertCatalogModelComparisonProfileConstraints checks if the values respects the defined constraints
+func AssertCatalogModelComparisonProfileConstraints(obj model.CatalogModelComparisonProfile) error {
+	return nil
+}
+
+// AssertCatalogModelComparisonProfileRequired checks if the required fields are not zero-ed
+func AssertCatalogModelComparisonProfileRequired(obj model.CatalogModelComparisonProfile) error {
+	elements := map[string]interface{}{
+		"targetRPS":       obj.TargetRPS,
+		"latencyProperty": obj.LatencyProperty,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertCatalogModelComparisonRequired checks if the required fields are not zero-ed
+func AssertCatalogModelComparisonRequired(obj model.CatalogModelComparison) error {
+	elements := map[string]interface{}{
+		"profile":    obj.Profile,
+		"benchmarks": obj.Benchmarks,
+		"items":      obj.Items,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	if err := AssertCatalogModelComparisonProfileRequired(obj.Profile); err != nil {
+		return err
+	}
+	for _, el := range obj.Items {
+		if err := AssertCatalogModelComparisonItemRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // AssertCatalogModelConstraints checks if the values respects the defined constraints
 func AssertCatalogModelConstraints(obj model.CatalogModel) error {
+	for _, el := range obj.Variants {
+		if err := AssertCatalogModelVariantConstraints(el); err != nil {
+			return err
+		}
+	}
+	if obj.LicensePolicy != nil {
+		if err := AssertCatalogModelLicensePolicyConstraints(*obj.LicensePolicy); err != nil {
+			return err
+		}
+	}
+	if obj.SecurityScan != nil {
+		if err := AssertCatalogModelSecurityScanConstraints(*obj.SecurityScan); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertCatalogModelCreateConstraints checks if the values respects the defined constraints
+func AssertCatalogModelCreateConstraints(obj model.CatalogModelCreate) error {
+	for _, el := range obj.Artifacts {
+		if err := AssertCatalogArtifactConstraints(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertCatalogModelCreateRequired checks if the required fields are not zero-ed
+func AssertCatalogModelCreateRequired(obj model.CatalogModelCreate) error {
+	elements := map[string]interface{}{
+		"name": obj.Name,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	for _, el := range obj.Artifacts {
+		if err := AssertCatalogArtifactRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertCatalogModelLicensePolicyConstraints checks if the values respects the defined constraints
+func AssertCatalogModelLicensePolicyConstraints(obj model.CatalogModelLicensePolicy) error {
+	return nil
+}
+
+// AssertCatalogModelLicensePolicyRequired checks if the required fields are not zero-ed
+func AssertCatalogModelLicensePolicyRequired(obj model.CatalogModelLicensePolicy) error {
+	elements := map[string]interface{}{
+		"status": obj.Status,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
 	return nil
 }
 
@@ -239,6 +445,26 @@ func AssertCatalogModelListRequired(obj model.CatalogModelList) error {
 	return nil
 }
 
+// AssertCatalogModelReferenceConstraints checks if the values respects the defined constraints
+func AssertCatalogModelReferenceConstraints(obj model.CatalogModelReference) error {
+	return nil
+}
+
+// AssertCatalogModelReferenceRequired checks if the required fields are not zero-ed
+func AssertCatalogModelReferenceRequired(obj model.CatalogModelReference) error {
+	elements := map[string]interface{}{
+		"sourceId": obj.SourceId,
+		"name":     obj.Name,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
 // AssertCatalogModelRequired checks if the required fields are not zero-ed
 func AssertCatalogModelRequired(obj model.CatalogModel) error {
 	elements := map[string]interface{}{
@@ -250,6 +476,225 @@ func AssertCatalogModelRequired(obj model.CatalogModel) error {
 		}
 	}
 
+	for _, el := range obj.Variants {
+		if err := AssertCatalogModelVariantRequired(el); err != nil {
+			return err
+		}
+	}
+	if obj.LicensePolicy != nil {
+		if err := AssertCatalogModelLicensePolicyRequired(*obj.LicensePolicy); err != nil {
+			return err
+		}
+	}
+	if obj.SecurityScan != nil {
+		if err := AssertCatalogModelSecurityScanRequired(*obj.SecurityScan); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertCatalogModelSecurityScanConstraints checks if the values respects the defined constraints
+func AssertCatalogModelSecurityScanConstraints(obj model.CatalogModelSecurityScan) error {
+	return nil
+}
+
+// AssertCatalogModelSecurityScanRequired checks if the required fields are not zero-ed
+func AssertCatalogModelSecurityScanRequired(obj model.CatalogModelSecurityScan) error {
+	return nil
+}
+
+// AssertCatalogModelSizingConstraints checks if the values respects the defined constraints
+func AssertCatalogModelSizingConstraints(obj model.CatalogModelSizing) error {
+	for _, el := range obj.Items {
+		if err := AssertCatalogModelSizingConfigurationConstraints(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertCatalogModelSizingRequired checks if the required fields are not zero-ed
+func AssertCatalogModelSizingRequired(obj model.CatalogModelSizing) error {
+	elements := map[string]interface{}{
+		"targetRPS":  obj.TargetRPS,
+		"maxLatency": obj.MaxLatency,
+		"items":      obj.Items,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	for _, el := range obj.Items {
+		if err := AssertCatalogModelSizingConfigurationRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertCatalogModelSizingConfigurationConstraints checks if the values respects the defined constraints
+func AssertCatalogModelSizingConfigurationConstraints(obj model.CatalogModelSizingConfiguration) error {
+	return nil
+}
+
+// AssertCatalogModelSizingConfigurationRequired checks if the required fields are not zero-ed
+func AssertCatalogModelSizingConfigurationRequired(obj model.CatalogModelSizingConfiguration) error {
+	elements := map[string]interface{}{
+		"hardwareType":       obj.HardwareType,
+		"hardwareCount":      obj.HardwareCount,
+		"replicas":           obj.Replicas,
+		"totalHardwareCount": obj.TotalHardwareCount,
+		"requestsPerSecond":  obj.RequestsPerSecond,
+		"latency":            obj.Latency,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertCatalogModelUpdateConstraints checks if the values respects the defined constraints
+func AssertCatalogModelUpdateConstraints(obj model.CatalogModelUpdate) error {
+	for _, el := range obj.Artifacts {
+		if err := AssertCatalogArtifactConstraints(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertCatalogModelUpdateRequired checks if the required fields are not zero-ed
+func AssertCatalogModelUpdateRequired(obj model.CatalogModelUpdate) error {
+	for _, el := range obj.Artifacts {
+		if err := AssertCatalogArtifactRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertCatalogModelVariantConstraints checks if the values respects the defined constraints
+func AssertCatalogModelVariantConstraints(obj model.CatalogModelVariant) error {
+	return nil
+}
+
+// AssertCatalogModelVariantListConstraints checks if the values respects the defined constraints
+func AssertCatalogModelVariantListConstraints(obj model.CatalogModelVariantList) error {
+	for _, el := range obj.Items {
+		if err := AssertCatalogModelVariantConstraints(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertCatalogModelVariantListRequired checks if the required fields are not zero-ed
+func AssertCatalogModelVariantListRequired(obj model.CatalogModelVariantList) error {
+	elements := map[string]interface{}{
+		"items": obj.Items,
+		"size":  obj.Size,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	for _, el := range obj.Items {
+		if err := AssertCatalogModelVariantRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertCatalogModelVariantRequired checks if the required fields are not zero-ed
+func AssertCatalogModelVariantRequired(obj model.CatalogModelVariant) error {
+	elements := map[string]interface{}{
+		"sourceId": obj.SourceId,
+		"name":     obj.Name,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertCatalogNamedQueryConstraints checks if the values respects the defined constraints
+func AssertCatalogNamedQueryConstraints(obj model.CatalogNamedQuery) error {
+	return nil
+}
+
+// AssertCatalogNamedQueryRequired checks if the required fields are not zero-ed
+func AssertCatalogNamedQueryRequired(obj model.CatalogNamedQuery) error {
+	elements := map[string]interface{}{
+		"name":    obj.Name,
+		"filters": obj.Filters,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertCatalogNamedQueryListConstraints checks if the values respects the defined constraints
+func AssertCatalogNamedQueryListConstraints(obj model.CatalogNamedQueryList) error {
+	for _, el := range obj.Items {
+		if err := AssertCatalogNamedQueryConstraints(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertCatalogNamedQueryListRequired checks if the required fields are not zero-ed
+func AssertCatalogNamedQueryListRequired(obj model.CatalogNamedQueryList) error {
+	elements := map[string]interface{}{
+		"items": obj.Items,
+		"size":  obj.Size,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	for _, el := range obj.Items {
+		if err := AssertCatalogNamedQueryRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertCatalogNamedQueryUpdateConstraints checks if the values respects the defined constraints
+func AssertCatalogNamedQueryUpdateConstraints(obj model.CatalogNamedQueryUpdate) error {
+	return nil
+}
+
+// AssertCatalogNamedQueryUpdateRequired checks if the required fields are not zero-ed
+func AssertCatalogNamedQueryUpdateRequired(obj model.CatalogNamedQueryUpdate) error {
+	elements := map[string]interface{}{
+		"filters": obj.Filters,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
 	return nil
 }
 
@@ -350,6 +795,29 @@ func AssertCatalogSourcePreviewResponseRequired(obj model.CatalogSourcePreviewRe
 	return nil
 }
 
+// AssertCatalogSourceRefreshConstraints checks if the values respects the defined constraints
+func AssertCatalogSourceRefreshConstraints(obj model.CatalogSourceRefresh) error {
+	return nil
+}
+
+// AssertCatalogSourceRefreshRequired checks if the required fields are not zero-ed
+func AssertCatalogSourceRefreshRequired(obj model.CatalogSourceRefresh) error {
+	elements := map[string]interface{}{
+		"id":                       obj.Id,
+		"sourceId":                 obj.SourceId,
+		"state":                    obj.State,
+		"createTimeSinceEpoch":     obj.CreateTimeSinceEpoch,
+		"lastUpdateTimeSinceEpoch": obj.LastUpdateTimeSinceEpoch,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
 // AssertCatalogSourceRequired checks if the required fields are not zero-ed
 func AssertCatalogSourceRequired(obj model.CatalogSource) error {
 	elements := map[string]interface{}{
@@ -376,6 +844,95 @@ func AssertCatalogSourceStatusRequired(obj model.CatalogSourceStatus) error {
 	return nil
 }
 
+// AssertCatalogSourceSyncChangeConstraints checks if the values respects the defined constraints
+func AssertCatalogSourceSyncChangeConstraints(obj model.CatalogSourceSyncChange) error {
+	return nil
+}
+
+// AssertCatalogSourceSyncChangeRequired checks if the required fields are not zero-ed
+func AssertCatalogSourceSyncChangeRequired(obj model.CatalogSourceSyncChange) error {
+	elements := map[string]interface{}{
+		"name":   obj.Name,
+		"change": obj.Change,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertCatalogSourceSyncConstraints checks if the values respects the defined constraints
+func AssertCatalogSourceSyncConstraints(obj model.CatalogSourceSync) error {
+	for _, el := range obj.Changes {
+		if err := AssertCatalogSourceSyncChangeConstraints(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertCatalogSourceSyncListConstraints checks if the values respects the defined constraints
+func AssertCatalogSourceSyncListConstraints(obj model.CatalogSourceSyncList) error {
+	for _, el := range obj.Items {
+		if err := AssertCatalogSourceSyncConstraints(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertCatalogSourceSyncListRequired checks if the required fields are not zero-ed
+func AssertCatalogSourceSyncListRequired(obj model.CatalogSourceSyncList) error {
+	elements := map[string]interface{}{
+		"nextPageToken": obj.NextPageToken,
+		"pageSize":      obj.PageSize,
+		"size":          obj.Size,
+		"items":         obj.Items,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	for _, el := range obj.Items {
+		if err := AssertCatalogSourceSyncRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertCatalogSourceSyncRequired checks if the required fields are not zero-ed
+func AssertCatalogSourceSyncRequired(obj model.CatalogSourceSync) error {
+	elements := map[string]interface{}{
+		"id":                  obj.Id,
+		"sourceId":            obj.SourceId,
+		"status":              obj.Status,
+		"startTimeSinceEpoch": obj.StartTimeSinceEpoch,
+		"endTimeSinceEpoch":   obj.EndTimeSinceEpoch,
+		"added":               obj.Added,
+		"updated":             obj.Updated,
+		"removed":             obj.Removed,
+		"changes":             obj.Changes,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	for _, el := range obj.Changes {
+		if err := AssertCatalogSourceSyncChangeRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // AssertErrorConstraints checks if the values respects the defined constraints
 func AssertErrorConstraints(obj model.Error) error {
 	return nil
@@ -23,6 +23,422 @@ import (
 // MCPCatalogServiceAPIService MCPCatalogServiceAPI service
 type MCPCatalogServiceAPIService service
 
+type ApiCreateMCPNamedQueryRequest struct {
+	ctx               context.Context
+	ApiService        *MCPCatalogServiceAPIService
+	catalogNamedQuery *CatalogNamedQuery
+}
+
+// A new named query.
+func (r ApiCreateMCPNamedQueryRequest) CatalogNamedQuery(catalogNamedQuery CatalogNamedQuery) ApiCreateMCPNamedQueryRequest {
+	r.catalogNamedQuery = &catalogNamedQuery
+	return r
+}
+
+func (r ApiCreateMCPNamedQueryRequest) Execute() (*CatalogNamedQuery, *http.Response, error) {
+	return r.ApiService.CreateMCPNamedQueryExecute(r)
+}
+
+/*
+CreateMCPNamedQuery Create a named query for MCP servers.
+
+Saves a named query for MCP servers. Its filters must make a valid
+`filterQuery`.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiCreateMCPNamedQueryRequest
+*/
+func (a *MCPCatalogServiceAPIService) CreateMCPNamedQuery(ctx context.Context) ApiCreateMCPNamedQueryRequest {
+	return ApiCreateMCPNamedQueryRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CatalogNamedQuery
+func (a *MCPCatalogServiceAPIService) CreateMCPNamedQueryExecute(r ApiCreateMCPNamedQueryRequest) (*CatalogNamedQuery, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CatalogNamedQuery
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "MCPCatalogServiceAPIService.CreateMCPNamedQuery")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/mcp_catalog/v1alpha1/named_queries"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.catalogNamedQuery == nil {
+		return localVarReturnValue, nil, reportError("catalogNamedQuery is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.catalogNamedQuery
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 409 {
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiDeleteMCPNamedQueryRequest struct {
+	ctx        context.Context
+	ApiService *MCPCatalogServiceAPIService
+	queryName  string
+}
+
+func (r ApiDeleteMCPNamedQueryRequest) Execute() (*http.Response, error) {
+	return r.ApiService.DeleteMCPNamedQueryExecute(r)
+}
+
+/*
+DeleteMCPNamedQuery Delete a named query for MCP servers.
+
+Deletes a named query saved through the API. Queries defined in
+source config files can't be deleted.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param queryName The name of a named query.
+	@return ApiDeleteMCPNamedQueryRequest
+*/
+func (a *MCPCatalogServiceAPIService) DeleteMCPNamedQuery(ctx context.Context, queryName string) ApiDeleteMCPNamedQueryRequest {
+	return ApiDeleteMCPNamedQueryRequest{
+		ApiService: a,
+		ctx:        ctx,
+		queryName:  queryName,
+	}
+}
+
+// Execute executes the request
+func (a *MCPCatalogServiceAPIService) DeleteMCPNamedQueryExecute(r ApiDeleteMCPNamedQueryRequest) (*http.Response, error) {
+	var (
+		localVarHTTPMethod = http.MethodDelete
+		localVarPostBody   interface{}
+		formFiles          []formFile
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "MCPCatalogServiceAPIService.DeleteMCPNamedQuery")
+	if err != nil {
+		return nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/mcp_catalog/v1alpha1/named_queries/{query_name}"
+	localVarPath = strings.Replace(localVarPath, "{"+"query_name"+"}", url.PathEscape(parameterValueToString(r.queryName, "queryName")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 409 {
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarHTTPResponse, newErr
+	}
+
+	return localVarHTTPResponse, nil
+}
+
+type ApiFindMCPNamedQueriesRequest struct {
+	ctx        context.Context
+	ApiService *MCPCatalogServiceAPIService
+}
+
+func (r ApiFindMCPNamedQueriesRequest) Execute() (*CatalogNamedQueryList, *http.Response, error) {
+	return r.ApiService.FindMCPNamedQueriesExecute(r)
+}
+
+/*
+FindMCPNamedQueries List named queries for MCP servers.
+
+Lists the named queries that can be used with `namedQuery` when
+listing MCP servers, both the ones defined in source config files and
+the ones saved through the API. When both define a query with the
+same name, the one in the config file is used.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiFindMCPNamedQueriesRequest
+*/
+func (a *MCPCatalogServiceAPIService) FindMCPNamedQueries(ctx context.Context) ApiFindMCPNamedQueriesRequest {
+	return ApiFindMCPNamedQueriesRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CatalogNamedQueryList
+func (a *MCPCatalogServiceAPIService) FindMCPNamedQueriesExecute(r ApiFindMCPNamedQueriesRequest) (*CatalogNamedQueryList, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CatalogNamedQueryList
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "MCPCatalogServiceAPIService.FindMCPNamedQueries")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/mcp_catalog/v1alpha1/named_queries"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiFindMCPServerToolsRequest struct {
 	ctx           context.Context
 	ApiService    *MCPCatalogServiceAPIService
@@ -223,84 +639,297 @@ type ApiFindMCPServersRequest struct {
 	nextPageToken *string
 }
 
-// Filter MCP servers by server name using SQL LIKE pattern matching.
-func (r ApiFindMCPServersRequest) Name(name string) ApiFindMCPServersRequest {
-	r.name = &name
-	return r
+// Filter MCP servers by server name using SQL LIKE pattern matching.
+func (r ApiFindMCPServersRequest) Name(name string) ApiFindMCPServersRequest {
+	r.name = &name
+	return r
+}
+
+// Free-form keyword search across name, description, and provider.
+func (r ApiFindMCPServersRequest) Q(q string) ApiFindMCPServersRequest {
+	r.q = &q
+	return r
+}
+
+// Filter MCP servers by the label associated with the source. Multiple values can be separated by commas. If one of the values is the string &#x60;null&#x60;, then MCP servers from every source without a label will be returned.
+func (r ApiFindMCPServersRequest) SourceLabel(sourceLabel []string) ApiFindMCPServersRequest {
+	r.sourceLabel = &sourceLabel
+	return r
+}
+
+// A SQL-like query string to filter MCP servers.  **Supported Operators:** - Comparison: &#x60;&#x3D;&#x60;, &#x60;!&#x3D;&#x60;, &#x60;&lt;&gt;&#x60;, &#x60;&gt;&#x60;, &#x60;&lt;&#x60;, &#x60;&gt;&#x3D;&#x60;, &#x60;&lt;&#x3D;&#x60; - Pattern matching: &#x60;LIKE&#x60;, &#x60;ILIKE&#x60; (case-insensitive) - Set membership: &#x60;IN&#x60; - Logical: &#x60;AND&#x60;, &#x60;OR&#x60; - Grouping: &#x60;()&#x60; for complex expressions  **Examples:** - &#x60;license &#x3D; \&quot;Apache 2.0\&quot;&#x60; - &#x60;verifiedSource &#x3D; true&#x60; - &#x60;provider ILIKE \&quot;%local%\&quot;&#x60; - &#x60;(license &#x3D; \&quot;Apache 2.0\&quot; OR license &#x3D; \&quot;MIT\&quot;) AND verifiedSource &#x3D; true&#x60;
+func (r ApiFindMCPServersRequest) FilterQuery(filterQuery string) ApiFindMCPServersRequest {
+	r.filterQuery = &filterQuery
+	return r
+}
+
+// Name of a named query to apply. Its filters are combined with &#x60;filterQuery&#x60; using AND.
+func (r ApiFindMCPServersRequest) NamedQuery(namedQuery string) ApiFindMCPServersRequest {
+	r.namedQuery = &namedQuery
+	return r
+}
+
+// Whether to include the tools array in each MCP server result.
+func (r ApiFindMCPServersRequest) IncludeTools(includeTools bool) ApiFindMCPServersRequest {
+	r.includeTools = &includeTools
+	return r
+}
+
+// Maximum number of tools to include when includeTools is true.
+func (r ApiFindMCPServersRequest) ToolLimit(toolLimit int32) ApiFindMCPServersRequest {
+	r.toolLimit = &toolLimit
+	return r
+}
+
+// Number of entities in each page.
+func (r ApiFindMCPServersRequest) PageSize(pageSize string) ApiFindMCPServersRequest {
+	r.pageSize = &pageSize
+	return r
+}
+
+// Specifies the order by criteria for listing entities.
+func (r ApiFindMCPServersRequest) OrderBy(orderBy OrderByField) ApiFindMCPServersRequest {
+	r.orderBy = &orderBy
+	return r
+}
+
+// Specifies the sort order for listing entities, defaults to ASC.
+func (r ApiFindMCPServersRequest) SortOrder(sortOrder SortOrder) ApiFindMCPServersRequest {
+	r.sortOrder = &sortOrder
+	return r
+}
+
+// Opaque pagination token returned by a previous list call. Do not construct manually; use the value from a prior response&#39;s nextPageToken field.
+func (r ApiFindMCPServersRequest) NextPageToken(nextPageToken string) ApiFindMCPServersRequest {
+	r.nextPageToken = &nextPageToken
+	return r
+}
+
+func (r ApiFindMCPServersRequest) Execute() (*MCPServerList, *http.Response, error) {
+	return r.ApiService.FindMCPServersExecute(r)
+}
+
+/*
+FindMCPServers List MCP servers.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiFindMCPServersRequest
+*/
+func (a *MCPCatalogServiceAPIService) FindMCPServers(ctx context.Context) ApiFindMCPServersRequest {
+	return ApiFindMCPServersRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return MCPServerList
+func (a *MCPCatalogServiceAPIService) FindMCPServersExecute(r ApiFindMCPServersRequest) (*MCPServerList, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *MCPServerList
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "MCPCatalogServiceAPIService.FindMCPServers")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/mcp_catalog/v1alpha1/mcp_servers"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.name != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "name", r.name, "form", "")
+	}
+	if r.q != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "q", r.q, "form", "")
+	}
+	if r.sourceLabel != nil {
+		t := *r.sourceLabel
+		if reflect.TypeOf(t).Kind() == reflect.Slice {
+			s := reflect.ValueOf(t)
+			for i := 0; i < s.Len(); i++ {
+				parameterAddToHeaderOrQuery(localVarQueryParams, "sourceLabel", s.Index(i).Interface(), "form", "multi")
+			}
+		} else {
+			parameterAddToHeaderOrQuery(localVarQueryParams, "sourceLabel", t, "form", "multi")
+		}
+	}
+	if r.filterQuery != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "filterQuery", r.filterQuery, "form", "")
+	}
+	if r.namedQuery != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "namedQuery", r.namedQuery, "form", "")
+	}
+	if r.includeTools != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "includeTools", r.includeTools, "form", "")
+	} else {
+		var defaultValue bool = false
+		parameterAddToHeaderOrQuery(localVarQueryParams, "includeTools", defaultValue, "form", "")
+		r.includeTools = &defaultValue
+	}
+	if r.toolLimit != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "toolLimit", r.toolLimit, "form", "")
+	} else {
+		var defaultValue int32 = 10
+		parameterAddToHeaderOrQuery(localVarQueryParams, "toolLimit", defaultValue, "form", "")
+		r.toolLimit = &defaultValue
+	}
+	if r.pageSize != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "pageSize", r.pageSize, "form", "")
+	}
+	if r.orderBy != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "orderBy", r.orderBy, "form", "")
+	}
+	if r.sortOrder != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "sortOrder", r.sortOrder, "form", "")
+	}
+	if r.nextPageToken != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "nextPageToken", r.nextPageToken, "form", "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiFindMCPServersFilterOptionsRequest struct {
+	ctx         context.Context
+	ApiService  *MCPCatalogServiceAPIService
+	q           *string
+	sourceLabel *[]string
+	filterQuery *string
+	namedQuery  *string
 }
 
-// Free-form keyword search across name, description, and provider.
-func (r ApiFindMCPServersRequest) Q(q string) ApiFindMCPServersRequest {
+// Count MCP servers that match this free-form keyword search only.
+func (r ApiFindMCPServersFilterOptionsRequest) Q(q string) ApiFindMCPServersFilterOptionsRequest {
 	r.q = &q
 	return r
 }
 
-// Filter MCP servers by the label associated with the source. Multiple values can be separated by commas. If one of the values is the string &#x60;null&#x60;, then MCP servers from every source without a label will be returned.
-func (r ApiFindMCPServersRequest) SourceLabel(sourceLabel []string) ApiFindMCPServersRequest {
+// Count MCP servers from sources with these labels only. Multiple values can be separated by commas. If one of the values is the string &#x60;null&#x60;, then MCP servers from every source without a label are counted.
+func (r ApiFindMCPServersFilterOptionsRequest) SourceLabel(sourceLabel []string) ApiFindMCPServersFilterOptionsRequest {
 	r.sourceLabel = &sourceLabel
 	return r
 }
 
 // A SQL-like query string to filter MCP servers.  **Supported Operators:** - Comparison: &#x60;&#x3D;&#x60;, &#x60;!&#x3D;&#x60;, &#x60;&lt;&gt;&#x60;, &#x60;&gt;&#x60;, &#x60;&lt;&#x60;, &#x60;&gt;&#x3D;&#x60;, &#x60;&lt;&#x3D;&#x60; - Pattern matching: &#x60;LIKE&#x60;, &#x60;ILIKE&#x60; (case-insensitive) - Set membership: &#x60;IN&#x60; - Logical: &#x60;AND&#x60;, &#x60;OR&#x60; - Grouping: &#x60;()&#x60; for complex expressions  **Examples:** - &#x60;license &#x3D; \&quot;Apache 2.0\&quot;&#x60; - &#x60;verifiedSource &#x3D; true&#x60; - &#x60;provider ILIKE \&quot;%local%\&quot;&#x60; - &#x60;(license &#x3D; \&quot;Apache 2.0\&quot; OR license &#x3D; \&quot;MIT\&quot;) AND verifiedSource &#x3D; true&#x60;
-func (r ApiFindMCPServersRequest) FilterQuery(filterQuery string) ApiFindMCPServersRequest {
+func (r ApiFindMCPServersFilterOptionsRequest) FilterQuery(filterQuery string) ApiFindMCPServersFilterOptionsRequest {
 	r.filterQuery = &filterQuery
 	return r
 }
 
-// Predefined filter template name to apply when listing MCP servers.
-func (r ApiFindMCPServersRequest) NamedQuery(namedQuery string) ApiFindMCPServersRequest {
+// Name of a named query to apply. Its filters are combined with &#x60;filterQuery&#x60; using AND.
+func (r ApiFindMCPServersFilterOptionsRequest) NamedQuery(namedQuery string) ApiFindMCPServersFilterOptionsRequest {
 	r.namedQuery = &namedQuery
 	return r
 }
 
-// Whether to include the tools array in each MCP server result.
-func (r ApiFindMCPServersRequest) IncludeTools(includeTools bool) ApiFindMCPServersRequest {
-	r.includeTools = &includeTools
-	return r
-}
-
-// Maximum number of tools to include when includeTools is true.
-func (r ApiFindMCPServersRequest) ToolLimit(toolLimit int32) ApiFindMCPServersRequest {
-	r.toolLimit = &toolLimit
-	return r
-}
-
-// Number of entities in each page.
-func (r ApiFindMCPServersRequest) PageSize(pageSize string) ApiFindMCPServersRequest {
-	r.pageSize = &pageSize
-	return r
-}
-
-// Specifies the order by criteria for listing entities.
-func (r ApiFindMCPServersRequest) OrderBy(orderBy OrderByField) ApiFindMCPServersRequest {
-	r.orderBy = &orderBy
-	return r
-}
-
-// Specifies the sort order for listing entities, defaults to ASC.
-func (r ApiFindMCPServersRequest) SortOrder(sortOrder SortOrder) ApiFindMCPServersRequest {
-	r.sortOrder = &sortOrder
-	return r
-}
-
-// Opaque pagination token returned by a previous list call. Do not construct manually; use the value from a prior response&#39;s nextPageToken field.
-func (r ApiFindMCPServersRequest) NextPageToken(nextPageToken string) ApiFindMCPServersRequest {
-	r.nextPageToken = &nextPageToken
-	return r
-}
-
-func (r ApiFindMCPServersRequest) Execute() (*MCPServerList, *http.Response, error) {
-	return r.ApiService.FindMCPServersExecute(r)
+func (r ApiFindMCPServersFilterOptionsRequest) Execute() (*FilterOptionsList, *http.Response, error) {
+	return r.ApiService.FindMCPServersFilterOptionsExecute(r)
 }
 
 /*
-FindMCPServers List MCP servers.
+FindMCPServersFilterOptions Lists fields, values, and named queries that can be used in `filterQuery` on the list MCP servers endpoint.
+
+Lists the fields that MCP servers can be filtered by. String fields
+have the number of MCP servers with each value in `counts`, for the
+MCP servers that match the `q`, `sourceLabel`, `filterQuery` and
+`namedQuery` parameters, which work the same as for listing MCP
+servers.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiFindMCPServersRequest
+	@return ApiFindMCPServersFilterOptionsRequest
 */
-func (a *MCPCatalogServiceAPIService) FindMCPServers(ctx context.Context) ApiFindMCPServersRequest {
-	return ApiFindMCPServersRequest{
+func (a *MCPCatalogServiceAPIService) FindMCPServersFilterOptions(ctx context.Context) ApiFindMCPServersFilterOptionsRequest {
+	return ApiFindMCPServersFilterOptionsRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -308,29 +937,26 @@ func (a *MCPCatalogServiceAPIService) FindMCPServers(ctx context.Context) ApiFin
 
 // Execute executes the request
 //
-//	@return MCPServerList
-func (a *MCPCatalogServiceAPIService) FindMCPServersExecute(r ApiFindMCPServersRequest) (*MCPServerList, *http.Response, error) {
+//	@return FilterOptionsList
+func (a *MCPCatalogServiceAPIService) FindMCPServersFilterOptionsExecute(r ApiFindMCPServersFilterOptionsRequest) (*FilterOptionsList, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *MCPServerList
+		localVarReturnValue *FilterOptionsList
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "MCPCatalogServiceAPIService.FindMCPServers")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "MCPCatalogServiceAPIService.FindMCPServersFilterOptions")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/mcp_catalog/v1alpha1/mcp_servers"
+	localVarPath := localBasePath + "/api/mcp_catalog/v1alpha1/mcp_servers/filter_options"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.name != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "name", r.name, "form", "")
-	}
 	if r.q != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "q", r.q, "form", "")
 	}
@@ -351,32 +977,6 @@ func (a *MCPCatalogServiceAPIService) FindMCPServersExecute(r ApiFindMCPServersR
 	if r.namedQuery != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "namedQuery", r.namedQuery, "form", "")
 	}
-	if r.includeTools != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "includeTools", r.includeTools, "form", "")
-	} else {
-		var defaultValue bool = false
-		parameterAddToHeaderOrQuery(localVarQueryParams, "includeTools", defaultValue, "form", "")
-		r.includeTools = &defaultValue
-	}
-	if r.toolLimit != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "toolLimit", r.toolLimit, "form", "")
-	} else {
-		var defaultValue int32 = 10
-		parameterAddToHeaderOrQuery(localVarQueryParams, "toolLimit", defaultValue, "form", "")
-		r.toolLimit = &defaultValue
-	}
-	if r.pageSize != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "pageSize", r.pageSize, "form", "")
-	}
-	if r.orderBy != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "orderBy", r.orderBy, "form", "")
-	}
-	if r.sortOrder != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "sortOrder", r.sortOrder, "form", "")
-	}
-	if r.nextPageToken != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "nextPageToken", r.nextPageToken, "form", "")
-	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -463,45 +1063,49 @@ func (a *MCPCatalogServiceAPIService) FindMCPServersExecute(r ApiFindMCPServersR
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiFindMCPServersFilterOptionsRequest struct {
+type ApiGetMCPNamedQueryRequest struct {
 	ctx        context.Context
 	ApiService *MCPCatalogServiceAPIService
+	queryName  string
 }
 
-func (r ApiFindMCPServersFilterOptionsRequest) Execute() (*FilterOptionsList, *http.Response, error) {
-	return r.ApiService.FindMCPServersFilterOptionsExecute(r)
+func (r ApiGetMCPNamedQueryRequest) Execute() (*CatalogNamedQuery, *http.Response, error) {
+	return r.ApiService.GetMCPNamedQueryExecute(r)
 }
 
 /*
-FindMCPServersFilterOptions Lists fields, values, and named queries that can be used in `filterQuery` on the list MCP servers endpoint.
+GetMCPNamedQuery Get a named query for MCP servers.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiFindMCPServersFilterOptionsRequest
+	@param queryName The name of a named query.
+	@return ApiGetMCPNamedQueryRequest
 */
-func (a *MCPCatalogServiceAPIService) FindMCPServersFilterOptions(ctx context.Context) ApiFindMCPServersFilterOptionsRequest {
-	return ApiFindMCPServersFilterOptionsRequest{
+func (a *MCPCatalogServiceAPIService) GetMCPNamedQuery(ctx context.Context, queryName string) ApiGetMCPNamedQueryRequest {
+	return ApiGetMCPNamedQueryRequest{
 		ApiService: a,
 		ctx:        ctx,
+		queryName:  queryName,
 	}
 }
 
 // Execute executes the request
 //
-//	@return FilterOptionsList
-func (a *MCPCatalogServiceAPIService) FindMCPServersFilterOptionsExecute(r ApiFindMCPServersFilterOptionsRequest) (*FilterOptionsList, *http.Response, error) {
+//	@return CatalogNamedQuery
+func (a *MCPCatalogServiceAPIService) GetMCPNamedQueryExecute(r ApiGetMCPNamedQueryRequest) (*CatalogNamedQuery, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *FilterOptionsList
+		localVarReturnValue *CatalogNamedQuery
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "MCPCatalogServiceAPIService.FindMCPServersFilterOptions")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "MCPCatalogServiceAPIService.GetMCPNamedQuery")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/mcp_catalog/v1alpha1/mcp_servers/filter_options"
+	localVarPath := localBasePath + "/api/mcp_catalog/v1alpha1/named_queries/{query_name}"
+	localVarPath = strings.Replace(localVarPath, "{"+"query_name"+"}", url.PathEscape(parameterValueToString(r.queryName, "queryName")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
@@ -546,7 +1150,7 @@ func (a *MCPCatalogServiceAPIService) FindMCPServersFilterOptionsExecute(r ApiFi
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
 		}
-		if localVarHTTPResponse.StatusCode == 400 {
+		if localVarHTTPResponse.StatusCode == 401 {
 			var v Error
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
@@ -557,7 +1161,7 @@ func (a *MCPCatalogServiceAPIService) FindMCPServersFilterOptionsExecute(r ApiFi
 			newErr.model = v
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
-		if localVarHTTPResponse.StatusCode == 401 {
+		if localVarHTTPResponse.StatusCode == 404 {
 			var v Error
 			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 			if err != nil {
@@ -892,3 +1496,174 @@ func (a *MCPCatalogServiceAPIService) GetMCPServerToolExecute(r ApiGetMCPServerT
 
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
+
+type ApiUpdateMCPNamedQueryRequest struct {
+	ctx                     context.Context
+	ApiService              *MCPCatalogServiceAPIService
+	queryName               string
+	catalogNamedQueryUpdate *CatalogNamedQueryUpdate
+}
+
+// The new filters of the named query.
+func (r ApiUpdateMCPNamedQueryRequest) CatalogNamedQueryUpdate(catalogNamedQueryUpdate CatalogNamedQueryUpdate) ApiUpdateMCPNamedQueryRequest {
+	r.catalogNamedQueryUpdate = &catalogNamedQueryUpdate
+	return r
+}
+
+func (r ApiUpdateMCPNamedQueryRequest) Execute() (*CatalogNamedQuery, *http.Response, error) {
+	return r.ApiService.UpdateMCPNamedQueryExecute(r)
+}
+
+/*
+UpdateMCPNamedQuery Update a named query for MCP servers.
+
+Replaces the filters of a named query saved through the API. Queries
+defined in source config files can't be changed.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param queryName The name of a named query.
+	@return ApiUpdateMCPNamedQueryRequest
+*/
+func (a *MCPCatalogServiceAPIService) UpdateMCPNamedQuery(ctx context.Context, queryName string) ApiUpdateMCPNamedQueryRequest {
+	return ApiUpdateMCPNamedQueryRequest{
+		ApiService: a,
+		ctx:        ctx,
+		queryName:  queryName,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CatalogNamedQuery
+func (a *MCPCatalogServiceAPIService) UpdateMCPNamedQueryExecute(r ApiUpdateMCPNamedQueryRequest) (*CatalogNamedQuery, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPut
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CatalogNamedQuery
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "MCPCatalogServiceAPIService.UpdateMCPNamedQuery")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/mcp_catalog/v1alpha1/named_queries/{query_name}"
+	localVarPath = strings.Replace(localVarPath, "{"+"query_name"+"}", url.PathEscape(parameterValueToString(r.queryName, "queryName")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.catalogNamedQueryUpdate == nil {
+		return localVarReturnValue, nil, reportError("catalogNamedQueryUpdate is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.catalogNamedQueryUpdate
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 401 {
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 409 {
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 500 {
+			var v Error
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
@@ -52,6 +52,15 @@ type CatalogModel struct {
 	LastUpdateTimeSinceEpoch *string `json:"lastUpdateTimeSinceEpoch,omitempty"`
 	// ID of the source this model belongs to.
 	SourceId *string `json:"source_id,omitempty"`
+	// Whether the model is deprecated. Deprecated models are left out of search results unless `includeDeprecated` is set.
+	Deprecated *bool `json:"deprecated,omitempty"`
+	// The date the model was, or will be, deprecated.
+	DeprecationDate *string                `json:"deprecationDate,omitempty"`
+	ReplacedBy      *CatalogModelReference `json:"replacedBy,omitempty"`
+	// Variants of the model. Only set when models are listed with `groupBy=variant`.
+	Variants      []CatalogModelVariant      `json:"variants,omitempty"`
+	LicensePolicy *CatalogModelLicensePolicy `json:"licensePolicy,omitempty"`
+	SecurityScan  *CatalogModelSecurityScan  `json:"securityScan,omitempty"`
 }
 
 type _CatalogModel CatalogModel
@@ -610,6 +619,198 @@ func (o *CatalogModel) SetSourceId(v string) {
 	o.SourceId = &v
 }
 
+// GetDeprecated returns the Deprecated field value if set, zero value otherwise.
+func (o *CatalogModel) GetDeprecated() bool {
+	if o == nil || IsNil(o.Deprecated) {
+		var ret bool
+		return ret
+	}
+	return *o.Deprecated
+}
+
+// GetDeprecatedOk returns a tuple with the Deprecated field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CatalogModel) GetDeprecatedOk() (*bool, bool) {
+	if o == nil || IsNil(o.Deprecated) {
+		return nil, false
+	}
+	return o.Deprecated, true
+}
+
+// HasDeprecated returns a boolean if a field has been set.
+func (o *CatalogModel) HasDeprecated() bool {
+	if o != nil && !IsNil(o.Deprecated) {
+		return true
+	}
+
+	return false
+}
+
+// SetDeprecated gets a reference to the given bool and assigns it to the Deprecated field.
+func (o *CatalogModel) SetDeprecated(v bool) {
+	o.Deprecated = &v
+}
+
+// GetDeprecationDate returns the DeprecationDate field value if set, zero value otherwise.
+func (o *CatalogModel) GetDeprecationDate() string {
+	if o == nil || IsNil(o.DeprecationDate) {
+		var ret string
+		return ret
+	}
+	return *o.DeprecationDate
+}
+
+// GetDeprecationDateOk returns a tuple with the DeprecationDate field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CatalogModel) GetDeprecationDateOk() (*string, bool) {
+	if o == nil || IsNil(o.DeprecationDate) {
+		return nil, false
+	}
+	return o.DeprecationDate, true
+}
+
+// HasDeprecationDate returns a boolean if a field has been set.
+func (o *CatalogModel) HasDeprecationDate() bool {
+	if o != nil && !IsNil(o.DeprecationDate) {
+		return true
+	}
+
+	return false
+}
+
+// SetDeprecationDate gets a reference to the given string and assigns it to the DeprecationDate field.
+func (o *CatalogModel) SetDeprecationDate(v string) {
+	o.DeprecationDate = &v
+}
+
+// GetReplacedBy returns the ReplacedBy field value if set, zero value otherwise.
+func (o *CatalogModel) GetReplacedBy() CatalogModelReference {
+	if o == nil || IsNil(o.ReplacedBy) {
+		var ret CatalogModelReference
+		return ret
+	}
+	return *o.ReplacedBy
+}
+
+// GetReplacedByOk returns a tuple with the ReplacedBy field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CatalogModel) GetReplacedByOk() (*CatalogModelReference, bool) {
+	if o == nil || IsNil(o.ReplacedBy) {
+		return nil, false
+	}
+	return o.ReplacedBy, true
+}
+
+// HasReplacedBy returns a boolean if a field has been set.
+func (o *CatalogModel) HasReplacedBy() bool {
+	if o != nil && !IsNil(o.ReplacedBy) {
+		return true
+	}
+
+	return false
+}
+
+// SetReplacedBy gets a reference to the given CatalogModelReference and assigns it to the ReplacedBy field.
+func (o *CatalogModel) SetReplacedBy(v CatalogModelReference) {
+	o.ReplacedBy = &v
+}
+
+// GetVariants returns the Variants field value if set, zero value otherwise.
+func (o *CatalogModel) GetVariants() []CatalogModelVariant {
+	if o == nil || IsNil(o.Variants) {
+		var ret []CatalogModelVariant
+		return ret
+	}
+	return o.Variants
+}
+
+// GetVariantsOk returns a tuple with the Variants field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CatalogModel) GetVariantsOk() ([]CatalogModelVariant, bool) {
+	if o == nil || IsNil(o.Variants) {
+		return nil, false
+	}
+	return o.Variants, true
+}
+
+// HasVariants returns a boolean if a field has been set.
+func (o *CatalogModel) HasVariants() bool {
+	if o != nil && !IsNil(o.Variants) {
+		return true
+	}
+
+	return false
+}
+
+// SetVariants gets a reference to the given []CatalogModelVariant and assigns it to the Variants field.
+func (o *CatalogModel) SetVariants(v []CatalogModelVariant) {
+	o.Variants = v
+}
+
+// GetLicensePolicy returns the LicensePolicy field value if set, zero value otherwise.
+func (o *CatalogModel) GetLicensePolicy() CatalogModelLicensePolicy {
+	if o == nil || IsNil(o.LicensePolicy) {
+		var ret CatalogModelLicensePolicy
+		return ret
+	}
+	return *o.LicensePolicy
+}
+
+// GetLicensePolicyOk returns a tuple with the LicensePolicy field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CatalogModel) GetLicensePolicyOk() (*CatalogModelLicensePolicy, bool) {
+	if o == nil || IsNil(o.LicensePolicy) {
+		return nil, false
+	}
+	return o.LicensePolicy, true
+}
+
+// HasLicensePolicy returns a boolean if a field has been set.
+func (o *CatalogModel) HasLicensePolicy() bool {
+	if o != nil && !IsNil(o.LicensePolicy) {
+		return true
+	}
+
+	return false
+}
+
+// SetLicensePolicy gets a reference to the given CatalogModelLicensePolicy and assigns it to the LicensePolicy field.
+func (o *CatalogModel) SetLicensePolicy(v CatalogModelLicensePolicy) {
+	o.LicensePolicy = &v
+}
+
+// GetSecurityScan returns the SecurityScan field value if set, zero value otherwise.
+func (o *CatalogModel) GetSecurityScan() CatalogModelSecurityScan {
+	if o == nil || IsNil(o.SecurityScan) {
+		var ret CatalogModelSecurityScan
+		return ret
+	}
+	return *o.SecurityScan
+}
+
+// GetSecurityScanOk returns a tuple with the SecurityScan field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CatalogModel) GetSecurityScanOk() (*CatalogModelSecurityScan, bool) {
+	if o == nil || IsNil(o.SecurityScan) {
+		return nil, false
+	}
+	return o.SecurityScan, true
+}
+
+// HasSecurityScan returns a boolean if a field has been set.
+func (o *CatalogModel) HasSecurityScan() bool {
+	if o != nil && !IsNil(o.SecurityScan) {
+		return true
+	}
+
+	return false
+}
+
+// SetSecurityScan gets a reference to the given CatalogModelSecurityScan and assigns it to the SecurityScan field.
+func (o *CatalogModel) SetSecurityScan(v CatalogModelSecurityScan) {
+	o.SecurityScan = &v
+}
+
 func (o CatalogModel) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -669,6 +870,24 @@ func (o CatalogModel) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.SourceId) {
 		toSerialize["source_id"] = o.SourceId
 	}
+	if !IsNil(o.Deprecated) {
+		toSerialize["deprecated"] = o.Deprecated
+	}
+	if !IsNil(o.DeprecationDate) {
+		toSerialize["deprecationDate"] = o.DeprecationDate
+	}
+	if !IsNil(o.ReplacedBy) {
+		toSerialize["replacedBy"] = o.ReplacedBy
+	}
+	if !IsNil(o.Variants) {
+		toSerialize["variants"] = o.Variants
+	}
+	if !IsNil(o.LicensePolicy) {
+		toSerialize["licensePolicy"] = o.LicensePolicy
+	}
+	if !IsNil(o.SecurityScan) {
+		toSerialize["securityScan"] = o.SecurityScan
+	}
 	return toSerialize, nil
 }
 
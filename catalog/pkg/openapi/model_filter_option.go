@@ -22,7 +22,9 @@ type FilterOption struct {
 	// The data type of the filter option
 	Type string `json:"type"`
 	// Known values of the property for string types with a small number of possible options.
-	Values []interface{}      `json:"values,omitempty"`
+	Values []interface{} `json:"values,omitempty"`
+	// Number of entities with each of the `values`, out of the entities that match the parameters of the request. Values that no matching entity has are counted as 0.
+	Counts *map[string]int32  `json:"counts,omitempty"`
 	Range  *FilterOptionRange `json:"range,omitempty"`
 }
 
@@ -102,6 +104,38 @@ func (o *FilterOption) SetValues(v []interface{}) {
 	o.Values = v
 }
 
+// GetCounts returns the Counts field value if set, zero value otherwise.
+func (o *FilterOption) GetCounts() map[string]int32 {
+	if o == nil || IsNil(o.Counts) {
+		var ret map[string]int32
+		return ret
+	}
+	return *o.Counts
+}
+
+// GetCountsOk returns a tuple with the Counts field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *FilterOption) GetCountsOk() (*map[string]int32, bool) {
+	if o == nil || IsNil(o.Counts) {
+		return nil, false
+	}
+	return o.Counts, true
+}
+
+// HasCounts returns a boolean if a field has been set.
+func (o *FilterOption) HasCounts() bool {
+	if o != nil && !IsNil(o.Counts) {
+		return true
+	}
+
+	return false
+}
+
+// SetCounts gets a reference to the given map[string]int32 and assigns it to the Counts field.
+func (o *FilterOption) SetCounts(v map[string]int32) {
+	o.Counts = &v
+}
+
 // GetRange returns the Range field value if set, zero value otherwise.
 func (o *FilterOption) GetRange() FilterOptionRange {
 	if o == nil || IsNil(o.Range) {
@@ -148,6 +182,9 @@ func (o FilterOption) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Values) {
 		toSerialize["values"] = o.Values
 	}
+	if !IsNil(o.Counts) {
+		toSerialize["counts"] = o.Counts
+	}
 	if !IsNil(o.Range) {
 		toSerialize["range"] = o.Range
 	}
@@ -29,7 +29,11 @@ __all__ = [
     "ApiException",
     "Artifact",
     "ArtifactCreate",
+    "ArtifactDigest",
+    "ArtifactDigestAlgorithm",
+    "ArtifactFileDigest",
     "ArtifactList",
+    "ArtifactSignature",
     "ArtifactState",
     "ArtifactTypeQueryParam",
     "ArtifactUpdate",
@@ -43,6 +47,9 @@ __all__ = [
     "DataSet",
     "DataSetCreate",
     "DataSetUpdate",
+    "DeploymentEvent",
+    "DeploymentEventList",
+    "DeploymentEventType",
     "DocArtifact",
     "DocArtifactCreate",
     "DocArtifactUpdate",
@@ -101,6 +108,7 @@ __all__ = [
     "ServingEnvironment",
     "ServingEnvironmentCreate",
     "ServingEnvironmentList",
+    "ServingEnvironmentTier",
     "ServingEnvironmentUpdate",
     "SortOrder",
 ]
@@ -122,7 +130,11 @@ from mr_openapi.exceptions import ApiException as ApiException
 # import models into sdk package
 from mr_openapi.models.artifact import Artifact as Artifact
 from mr_openapi.models.artifact_create import ArtifactCreate as ArtifactCreate
+from mr_openapi.models.artifact_digest import ArtifactDigest as ArtifactDigest
+from mr_openapi.models.artifact_digest_algorithm import ArtifactDigestAlgorithm as ArtifactDigestAlgorithm
+from mr_openapi.models.artifact_file_digest import ArtifactFileDigest as ArtifactFileDigest
 from mr_openapi.models.artifact_list import ArtifactList as ArtifactList
+from mr_openapi.models.artifact_signature import ArtifactSignature as ArtifactSignature
 from mr_openapi.models.artifact_state import ArtifactState as ArtifactState
 from mr_openapi.models.artifact_type_query_param import ArtifactTypeQueryParam as ArtifactTypeQueryParam
 from mr_openapi.models.artifact_update import ArtifactUpdate as ArtifactUpdate
@@ -136,6 +148,9 @@ from mr_openapi.models.base_resource_update import BaseResourceUpdate as BaseRes
 from mr_openapi.models.data_set import DataSet as DataSet
 from mr_openapi.models.data_set_create import DataSetCreate as DataSetCreate
 from mr_openapi.models.data_set_update import DataSetUpdate as DataSetUpdate
+from mr_openapi.models.deployment_event import DeploymentEvent as DeploymentEvent
+from mr_openapi.models.deployment_event_list import DeploymentEventList as DeploymentEventList
+from mr_openapi.models.deployment_event_type import DeploymentEventType as DeploymentEventType
 from mr_openapi.models.doc_artifact import DocArtifact as DocArtifact
 from mr_openapi.models.doc_artifact_create import DocArtifactCreate as DocArtifactCreate
 from mr_openapi.models.doc_artifact_update import DocArtifactUpdate as DocArtifactUpdate
@@ -153,9 +168,14 @@ from mr_openapi.models.experiment_run_update import ExperimentRunUpdate as Exper
 from mr_openapi.models.experiment_state import ExperimentState as ExperimentState
 from mr_openapi.models.experiment_update import ExperimentUpdate as ExperimentUpdate
 from mr_openapi.models.inference_service import InferenceService as InferenceService
+from mr_openapi.models.inference_service_actual_state import InferenceServiceActualState as InferenceServiceActualState
+from mr_openapi.models.inference_service_condition import InferenceServiceCondition as InferenceServiceCondition
 from mr_openapi.models.inference_service_create import InferenceServiceCreate as InferenceServiceCreate
+from mr_openapi.models.inference_service_deployment_status import InferenceServiceDeploymentStatus as InferenceServiceDeploymentStatus
 from mr_openapi.models.inference_service_list import InferenceServiceList as InferenceServiceList
 from mr_openapi.models.inference_service_state import InferenceServiceState as InferenceServiceState
+from mr_openapi.models.inference_service_traffic_split import InferenceServiceTrafficSplit as InferenceServiceTrafficSplit
+from mr_openapi.models.inference_service_traffic_target import InferenceServiceTrafficTarget as InferenceServiceTrafficTarget
 from mr_openapi.models.inference_service_update import InferenceServiceUpdate as InferenceServiceUpdate
 from mr_openapi.models.metadata_bool_value import MetadataBoolValue as MetadataBoolValue
 from mr_openapi.models.metadata_double_value import MetadataDoubleValue as MetadataDoubleValue
@@ -194,5 +214,6 @@ from mr_openapi.models.serve_model_update import ServeModelUpdate as ServeModelU
 from mr_openapi.models.serving_environment import ServingEnvironment as ServingEnvironment
 from mr_openapi.models.serving_environment_create import ServingEnvironmentCreate as ServingEnvironmentCreate
 from mr_openapi.models.serving_environment_list import ServingEnvironmentList as ServingEnvironmentList
+from mr_openapi.models.serving_environment_tier import ServingEnvironmentTier as ServingEnvironmentTier
 from mr_openapi.models.serving_environment_update import ServingEnvironmentUpdate as ServingEnvironmentUpdate
 from mr_openapi.models.sort_order import SortOrder as SortOrder
@@ -15,7 +15,11 @@ Do not edit the class manually.
 # import models into model package
 from mr_openapi.models.artifact import Artifact
 from mr_openapi.models.artifact_create import ArtifactCreate
+from mr_openapi.models.artifact_digest import ArtifactDigest
+from mr_openapi.models.artifact_digest_algorithm import ArtifactDigestAlgorithm
+from mr_openapi.models.artifact_file_digest import ArtifactFileDigest
 from mr_openapi.models.artifact_list import ArtifactList
+from mr_openapi.models.artifact_signature import ArtifactSignature
 from mr_openapi.models.artifact_state import ArtifactState
 from mr_openapi.models.artifact_type_query_param import ArtifactTypeQueryParam
 from mr_openapi.models.artifact_update import ArtifactUpdate
@@ -29,6 +33,9 @@ from mr_openapi.models.base_resource_update import BaseResourceUpdate
 from mr_openapi.models.data_set import DataSet
 from mr_openapi.models.data_set_create import DataSetCreate
 from mr_openapi.models.data_set_update import DataSetUpdate
+from mr_openapi.models.deployment_event import DeploymentEvent
+from mr_openapi.models.deployment_event_list import DeploymentEventList
+from mr_openapi.models.deployment_event_type import DeploymentEventType
 from mr_openapi.models.doc_artifact import DocArtifact
 from mr_openapi.models.doc_artifact_create import DocArtifactCreate
 from mr_openapi.models.doc_artifact_update import DocArtifactUpdate
@@ -46,9 +53,14 @@ from mr_openapi.models.experiment_run_update import ExperimentRunUpdate
 from mr_openapi.models.experiment_state import ExperimentState
 from mr_openapi.models.experiment_update import ExperimentUpdate
 from mr_openapi.models.inference_service import InferenceService
+from mr_openapi.models.inference_service_actual_state import InferenceServiceActualState
+from mr_openapi.models.inference_service_condition import InferenceServiceCondition
 from mr_openapi.models.inference_service_create import InferenceServiceCreate
+from mr_openapi.models.inference_service_deployment_status import InferenceServiceDeploymentStatus
 from mr_openapi.models.inference_service_list import InferenceServiceList
 from mr_openapi.models.inference_service_state import InferenceServiceState
+from mr_openapi.models.inference_service_traffic_split import InferenceServiceTrafficSplit
+from mr_openapi.models.inference_service_traffic_target import InferenceServiceTrafficTarget
 from mr_openapi.models.inference_service_update import InferenceServiceUpdate
 from mr_openapi.models.metadata_bool_value import MetadataBoolValue
 from mr_openapi.models.metadata_double_value import MetadataDoubleValue
@@ -87,5 +99,6 @@ from mr_openapi.models.serve_model_update import ServeModelUpdate
 from mr_openapi.models.serving_environment import ServingEnvironment
 from mr_openapi.models.serving_environment_create import ServingEnvironmentCreate
 from mr_openapi.models.serving_environment_list import ServingEnvironmentList
+from mr_openapi.models.serving_environment_tier import ServingEnvironmentTier
 from mr_openapi.models.serving_environment_update import ServingEnvironmentUpdate
 from mr_openapi.models.sort_order import SortOrder
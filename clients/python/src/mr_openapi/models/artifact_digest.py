@@ -0,0 +1,88 @@
+"""Model Registry REST API.
+
+REST API for Model Registry to create and manage ML model metadata
+
+The version of the OpenAPI document: v1alpha3
+Generated by OpenAPI Generator (https://openapi-generator.tech)
+
+Do not edit the class manually.
+"""  # noqa: E501
+
+
+from __future__ import annotations
+
+import json
+import pprint
+import re  # noqa: F401
+from typing import Annotated, Any, ClassVar
+
+from pydantic import BaseModel, ConfigDict, Field, field_validator
+from typing_extensions import Self
+
+from mr_openapi.models.artifact_digest_algorithm import ArtifactDigestAlgorithm
+
+
+class ArtifactDigest(BaseModel):
+    """The digest of the content of an artifact made of a single file, artifacts made of several files use a manifest of `ArtifactFileDigest` instead."""  # noqa: E501
+
+    algorithm: ArtifactDigestAlgorithm
+    value: Annotated[str, Field(strict=True)] = Field(description="Hex encoded digest.")
+    __properties: ClassVar[list[str]] = ["algorithm", "value"]
+
+    @field_validator("value")
+    def value_validate_regular_expression(cls, value):
+        """Validates the regular expression."""
+        if not re.match(r"^[0-9a-fA-F]+$", value):
+            msg = r"must validate the regular expression /^[0-9a-fA-F]+$/"
+            raise ValueError(msg)
+        return value
+
+    model_config = ConfigDict(
+        populate_by_name=True,
+        validate_assignment=True,
+        protected_namespaces=(),
+    )
+
+    def to_str(self) -> str:
+        """Returns the string representation of the model using alias."""
+        return pprint.pformat(self.model_dump(by_alias=True))
+
+    def to_json(self) -> str:
+        """Returns the JSON representation of the model using alias."""
+        # TODO: pydantic v2: use .model_dump_json(by_alias=True, exclude_unset=True) instead
+        return json.dumps(self.to_dict())
+
+    @classmethod
+    def from_json(cls, json_str: str) -> Self | None:
+        """Create an instance of ArtifactDigest from a JSON string."""
+        return cls.from_dict(json.loads(json_str))
+
+    def to_dict(self) -> dict[str, Any]:
+        """Return the dictionary representation of the model using alias.
+
+        This has the following differences from calling pydantic's
+        `self.model_dump(by_alias=True)`:
+
+        * `None` is only added to the output dict for nullable fields that
+          were set at model initialization. Other fields with value `None`
+          are ignored.
+        """
+        excluded_fields: set[str] = set()
+
+        _dict = self.model_dump(
+            by_alias=True,
+            exclude=excluded_fields,
+            exclude_none=True,
+        )
+        return _dict
+
+    @classmethod
+    def from_dict(cls, obj: dict[str, Any] | None) -> Self | None:
+        """Create an instance of ArtifactDigest from a dict."""
+        if obj is None:
+            return None
+
+        if not isinstance(obj, dict):
+            return cls.model_validate(obj)
+
+        return cls.model_validate({"algorithm": obj.get("algorithm"), "value": obj.get("value")})
@@ -0,0 +1,31 @@
+"""Model Registry REST API.
+
+REST API for Model Registry to create and manage ML model metadata
+
+The version of the OpenAPI document: v1alpha3
+Generated by OpenAPI Generator (https://openapi-generator.tech)
+
+Do not edit the class manually.
+"""  # noqa: E501
+
+from __future__ import annotations
+
+import json
+from enum import Enum
+
+from typing_extensions import Self
+
+
+class ArtifactDigestAlgorithm(str, Enum):
+    """The hash algorithm of a digest."""
+
+    """
+    allowed enum values
+    """
+    SHA256 = "sha256"
+    SHA512 = "sha512"
+
+    @classmethod
+    def from_json(cls, json_str: str) -> Self:
+        """Create an instance of ArtifactDigestAlgorithm from a JSON string."""
+        return cls(json.loads(json_str))
@@ -0,0 +1,127 @@
+"""Model Registry REST API.
+
+REST API for Model Registry to create and manage ML model metadata
+
+The version of the OpenAPI document: v1alpha3
+Generated by OpenAPI Generator (https://openapi-generator.tech)
+
+Do not edit the class manually.
+"""  # noqa: E501
+
+from __future__ import annotations
+
+import json
+import pprint
+import re  # noqa: F401
+from typing import Any, ClassVar
+
+from pydantic import BaseModel, ConfigDict, Field, StrictStr
+from typing_extensions import Self
+
+from mr_openapi.models.deployment_event_type import DeploymentEventType
+
+
+class DeploymentEvent(BaseModel):
+    """A change of the deployment of a `ModelVersion` by an `InferenceService`, recorded when the `InferenceService` is created or updated."""  # noqa: E501
+
+    id: StrictStr | None = Field(default=None, description="The unique server generated id of the deployment event.")
+    create_time_since_epoch: StrictStr | None = Field(
+        default=None,
+        description="Time when the deployment changed, in milliseconds since epoch.",
+        alias="createTimeSinceEpoch",
+    )
+    type: DeploymentEventType
+    inference_service_id: StrictStr = Field(
+        description="ID of the `InferenceService` that changed.", alias="inferenceServiceId"
+    )
+    model_version_id: StrictStr = Field(
+        description="ID of the `ModelVersion` deployed by the `InferenceService`.", alias="modelVersionId"
+    )
+    registered_model_id: StrictStr = Field(
+        description="ID of the `RegisteredModel` of the `ModelVersion`.", alias="registeredModelId"
+    )
+    serving_environment_id: StrictStr = Field(
+        description="ID of the `ServingEnvironment` of the `InferenceService`.", alias="servingEnvironmentId"
+    )
+    runtime: StrictStr | None = Field(default=None, description="Model runtime of the `InferenceService`, if any.")
+    actor: StrictStr | None = Field(
+        default=None,
+        description="User that made the change, from the `kubeflow-userid` header of the request, if any.",
+    )
+    __properties: ClassVar[list[str]] = [
+        "id",
+        "createTimeSinceEpoch",
+        "type",
+        "inferenceServiceId",
+        "modelVersionId",
+        "registeredModelId",
+        "servingEnvironmentId",
+        "runtime",
+        "actor",
+    ]
+
+    model_config = ConfigDict(
+        populate_by_name=True,
+        validate_assignment=True,
+        protected_namespaces=(),
+    )
+
+    def to_str(self) -> str:
+        """Returns the string representation of the model using alias."""
+        return pprint.pformat(self.model_dump(by_alias=True))
+
+    def to_json(self) -> str:
+        """Returns the JSON representation of the model using alias."""
+        # TODO: pydantic v2: use .model_dump_json(by_alias=True, exclude_unset=True) instead
+        return json.dumps(self.to_dict())
+
+    @classmethod
+    def from_json(cls, json_str: str) -> Self | None:
+        """Create an instance of DeploymentEvent from a JSON string."""
+        return cls.from_dict(json.loads(json_str))
+
+    def to_dict(self) -> dict[str, Any]:
+        """Return the dictionary representation of the model using alias.
+
+        This has the following differences from calling pydantic's
+        `self.model_dump(by_alias=True)`:
+
+        * `None` is only added to the output dict for nullable fields that
+          were set at model initialization. Other fields with value `None`
+          are ignored.
+        * OpenAPI `readOnly` fields are excluded.
+        * OpenAPI `readOnly` fields are excluded.
+        """
+        excluded_fields: set[str] = {
+            "id",
+            "create_time_since_epoch",
+        }
+
+        return self.model_dump(
+            by_alias=True,
+            exclude=excluded_fields,
+            exclude_none=True,
+        )
+
+    @classmethod
+    def from_dict(cls, obj: dict[str, Any] | None) -> Self | None:
+        """Create an instance of DeploymentEvent from a dict."""
+        if obj is None:
+            return None
+
+        if not isinstance(obj, dict):
+            return cls.model_validate(obj)
+
+        return cls.model_validate(
+            {
+                "id": obj.get("id"),
+                "createTimeSinceEpoch": obj.get("createTimeSinceEpoch"),
+                "type": obj.get("type"),
+                "inferenceServiceId": obj.get("inferenceServiceId"),
+                "modelVersionId": obj.get("modelVersionId"),
+                "registeredModelId": obj.get("registeredModelId"),
+                "servingEnvironmentId": obj.get("servingEnvironmentId"),
+                "runtime": obj.get("runtime"),
+                "actor": obj.get("actor"),
+            }
+        )
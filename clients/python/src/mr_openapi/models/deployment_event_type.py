@@ -0,0 +1,32 @@
+"""Model Registry REST API.
+
+REST API for Model Registry to create and manage ML model metadata
+
+The version of the OpenAPI document: v1alpha3
+Generated by OpenAPI Generator (https://openapi-generator.tech)
+
+Do not edit the class manually.
+"""  # noqa: E501
+
+from __future__ import annotations
+
+import json
+from enum import Enum
+
+from typing_extensions import Self
+
+
+class DeploymentEventType(str, Enum):
+    """- DEPLOYED: The `InferenceService` started deploying the `ModelVersion`. - UPDATED: The `InferenceService` changed its model version, runtime or traffic split while deployed. - UNDEPLOYED: The `InferenceService` stopped deploying the `ModelVersion`."""
+
+    """
+    allowed enum values
+    """
+    DEPLOYED = "DEPLOYED"
+    UPDATED = "UPDATED"
+    UNDEPLOYED = "UNDEPLOYED"
+
+    @classmethod
+    def from_json(cls, json_str: str) -> Self:
+        """Create an instance of DeploymentEventType from a JSON string."""
+        return cls(json.loads(json_str))
@@ -15,10 +15,13 @@ import pprint
 import re  # noqa: F401
 from typing import Annotated, Any, ClassVar
 
-from pydantic import BaseModel, ConfigDict, Field, StrictStr, field_validator
+from pydantic import BaseModel, ConfigDict, Field, StrictBool, StrictStr, field_validator
 from typing_extensions import Self
 
+from mr_openapi.models.inference_service_actual_state import InferenceServiceActualState
+from mr_openapi.models.inference_service_deployment_status import InferenceServiceDeploymentStatus
 from mr_openapi.models.inference_service_state import InferenceServiceState
+from mr_openapi.models.inference_service_traffic_split import InferenceServiceTrafficSplit
 from mr_openapi.models.metadata_value import MetadataValue
 
 
@@ -58,6 +61,13 @@ class InferenceService(BaseModel):
     )
     runtime: StrictStr | None = Field(default=None, description="Model runtime.")
     desired_state: InferenceServiceState | None = Field(default=InferenceServiceState.DEPLOYED, alias="desiredState")
+    actual_state: InferenceServiceActualState | None = Field(default=None, alias="actualState")
+    deployment_status: InferenceServiceDeploymentStatus | None = Field(default=None, alias="deploymentStatus")
+    traffic_split: list[InferenceServiceTrafficSplit] | None = Field(
+        default=None,
+        description="Weighted set of `ModelVersions` to serve, e.g. to roll out a new version as a canary. It has at most two `ModelVersions` of the inference service's `RegisteredModel`, and the percentages must add up to 100. When set, it takes precedence over `modelVersionId`. Set it to an empty list to stop splitting the traffic.",
+        alias="trafficSplit",
+    )
     registered_model_id: Annotated[str, Field(min_length=1, strict=True)] = Field(
         description="ID of the `RegisteredModel` to serve.", alias="registeredModelId"
     )
@@ -65,6 +75,11 @@ class InferenceService(BaseModel):
         description="ID of the parent `ServingEnvironment` for this `InferenceService` entity.",
         alias="servingEnvironmentId",
     )
+    state_drift: StrictBool | None = Field(
+        default=None,
+        description="Output only. Whether the `actualState` reported for the `InferenceService` disagrees with its `desiredState`.",
+        alias="stateDrift",
+    )
     __properties: ClassVar[list[str]] = [
         "customProperties",
         "description",
@@ -76,8 +91,12 @@ class InferenceService(BaseModel):
         "modelVersionId",
         "runtime",
         "desiredState",
+        "actualState",
+        "deploymentStatus",
+        "trafficSplit",
         "registeredModelId",
         "servingEnvironmentId",
+        "stateDrift",
     ]
 
     @field_validator("model_version_id")
@@ -138,10 +157,12 @@ class InferenceService(BaseModel):
           are ignored.
         * OpenAPI `readOnly` fields are excluded.
         * OpenAPI `readOnly` fields are excluded.
+        * OpenAPI `readOnly` fields are excluded.
         """
         excluded_fields: set[str] = {
             "create_time_since_epoch",
             "last_update_time_since_epoch",
+            "state_drift",
         }
 
         _dict = self.model_dump(
@@ -156,6 +177,16 @@ class InferenceService(BaseModel):
                 if self.custom_properties[_key_custom_properties]:
                     _field_dict[_key_custom_properties] = self.custom_properties[_key_custom_properties].to_dict()
             _dict["customProperties"] = _field_dict
+        # override the default output from pydantic by calling `to_dict()` of deployment_status
+        if self.deployment_status:
+            _dict["deploymentStatus"] = self.deployment_status.to_dict()
+        # override the default output from pydantic by calling `to_dict()` of each item in traffic_split (list)
+        _items = []
+        if self.traffic_split:
+            for _item_traffic_split in self.traffic_split:
+                if _item_traffic_split:
+                    _items.append(_item_traffic_split.to_dict())
+            _dict["trafficSplit"] = _items
         return _dict
 
     @classmethod
@@ -183,7 +214,15 @@ class InferenceService(BaseModel):
                 "desiredState": obj.get("desiredState")
                 if obj.get("desiredState") is not None
                 else InferenceServiceState.DEPLOYED,
+                "actualState": obj.get("actualState"),
+                "deploymentStatus": InferenceServiceDeploymentStatus.from_dict(obj["deploymentStatus"])
+                if obj.get("deploymentStatus") is not None
+                else None,
+                "trafficSplit": [InferenceServiceTrafficSplit.from_dict(_item) for _item in obj["trafficSplit"]]
+                if obj.get("trafficSplit") is not None
+                else None,
                 "registeredModelId": obj.get("registeredModelId"),
                 "servingEnvironmentId": obj.get("servingEnvironmentId"),
+                "stateDrift": obj.get("stateDrift"),
             }
         )
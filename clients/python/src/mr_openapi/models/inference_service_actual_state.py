@@ -0,0 +1,34 @@
+"""Model Registry REST API.
+
+REST API for Model Registry to create and manage ML model metadata
+
+The version of the OpenAPI document: v1alpha3
+Generated by OpenAPI Generator (https://openapi-generator.tech)
+
+Do not edit the class manually.
+"""  # noqa: E501
+
+
+from __future__ import annotations
+
+import json
+from enum import Enum
+
+from typing_extensions import Self
+
+
+class InferenceServiceActualState(str, Enum):
+    """- DEPLOYING: The `InferenceService` is being deployed or rolled out, and isn't ready yet. - DEPLOYED: The `InferenceService` is deployed and ready. - FAILED: The deployment of the `InferenceService` failed. - UNDEPLOYED: The `InferenceService` is not deployed. The state indicates the actual state of inference service, as observed in the serving environment."""
+
+    """
+    allowed enum values
+    """
+    DEPLOYING = "DEPLOYING"
+    DEPLOYED = "DEPLOYED"
+    FAILED = "FAILED"
+    UNDEPLOYED = "UNDEPLOYED"
+
+    @classmethod
+    def from_json(cls, json_str: str) -> Self:
+        """Create an instance of InferenceServiceActualState from a JSON string."""
+        return cls(json.loads(json_str))
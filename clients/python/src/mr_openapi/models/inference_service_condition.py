@@ -0,0 +1,97 @@
+"""Model Registry REST API.
+
+REST API for Model Registry to create and manage ML model metadata
+
+The version of the OpenAPI document: v1alpha3
+Generated by OpenAPI Generator (https://openapi-generator.tech)
+
+Do not edit the class manually.
+"""  # noqa: E501
+
+
+from __future__ import annotations
+
+import json
+import pprint
+import re  # noqa: F401
+from typing import Any, ClassVar
+
+from pydantic import BaseModel, ConfigDict, Field, StrictStr
+from typing_extensions import Self
+
+
+class InferenceServiceCondition(BaseModel):
+    """A readiness condition of a deployed `InferenceService`, as reported by the serving environment."""  # noqa: E501
+
+    type: StrictStr = Field(description="Type of the condition, e.g. `Ready` or `PredictorReady`.")
+    status: StrictStr = Field(description="Status of the condition, one of `True`, `False` or `Unknown`.")
+    reason: StrictStr | None = Field(
+        default=None, description="Machine-readable reason for the last transition of the condition."
+    )
+    message: StrictStr | None = Field(
+        default=None, description="Human-readable message with details about the last transition of the condition."
+    )
+    last_transition_time_since_epoch: StrictStr | None = Field(
+        default=None,
+        description="Last time the condition transitioned from one status to another, in milliseconds since epoch.",
+        alias="lastTransitionTimeSinceEpoch",
+    )
+    __properties: ClassVar[list[str]] = ["type", "status", "reason", "message", "lastTransitionTimeSinceEpoch"]
+
+    model_config = ConfigDict(
+        populate_by_name=True,
+        validate_assignment=True,
+        protected_namespaces=(),
+    )
+
+    def to_str(self) -> str:
+        """Returns the string representation of the model using alias."""
+        return pprint.pformat(self.model_dump(by_alias=True))
+
+    def to_json(self) -> str:
+        """Returns the JSON representation of the model using alias."""
+        # TODO: pydantic v2: use .model_dump_json(by_alias=True, exclude_unset=True) instead
+        return json.dumps(self.to_dict())
+
+    @classmethod
+    def from_json(cls, json_str: str) -> Self | None:
+        """Create an instance of InferenceServiceCondition from a JSON string."""
+        return cls.from_dict(json.loads(json_str))
+
+    def to_dict(self) -> dict[str, Any]:
+        """Return the dictionary representation of the model using alias.
+
+        This has the following differences from calling pydantic's
+        `self.model_dump(by_alias=True)`:
+
+        * `None` is only added to the output dict for nullable fields that
+          were set at model initialization. Other fields with value `None`
+          are ignored.
+        """
+        excluded_fields: set[str] = set()
+
+        _dict = self.model_dump(
+            by_alias=True,
+            exclude=excluded_fields,
+            exclude_none=True,
+        )
+        return _dict
+
+    @classmethod
+    def from_dict(cls, obj: dict[str, Any] | None) -> Self | None:
+        """Create an instance of InferenceServiceCondition from a dict."""
+        if obj is None:
+            return None
+
+        if not isinstance(obj, dict):
+            return cls.model_validate(obj)
+
+        return cls.model_validate(
+            {
+                "type": obj.get("type"),
+                "status": obj.get("status"),
+                "reason": obj.get("reason"),
+                "message": obj.get("message"),
+                "lastTransitionTimeSinceEpoch": obj.get("lastTransitionTimeSinceEpoch"),
+            }
+        )
@@ -0,0 +1,116 @@
+"""Model Registry REST API.
+
+REST API for Model Registry to create and manage ML model metadata
+
+The version of the OpenAPI document: v1alpha3
+Generated by OpenAPI Generator (https://openapi-generator.tech)
+
+Do not edit the class manually.
+"""  # noqa: E501
+
+
+from __future__ import annotations
+
+import json
+import pprint
+import re  # noqa: F401
+from typing import Any, ClassVar
+
+from pydantic import BaseModel, ConfigDict, Field, StrictStr
+from typing_extensions import Self
+
+from mr_openapi.models.inference_service_condition import InferenceServiceCondition
+from mr_openapi.models.inference_service_traffic_target import InferenceServiceTrafficTarget
+
+
+class InferenceServiceDeploymentStatus(BaseModel):
+    """The live status of a deployed `InferenceService`, as reported by the serving environment."""  # noqa: E501
+
+    url: StrictStr | None = Field(default=None, description="URL of the deployed `InferenceService`.")
+    conditions: list[InferenceServiceCondition] | None = Field(
+        default=None, description="Readiness conditions of the deployed `InferenceService`."
+    )
+    traffic: list[InferenceServiceTrafficTarget] | None = Field(
+        default=None, description="How the traffic is split among the revisions serving the `InferenceService`."
+    )
+    last_transition_time_since_epoch: StrictStr | None = Field(
+        default=None,
+        description="Last time the readiness of the `InferenceService` changed, in milliseconds since epoch.",
+        alias="lastTransitionTimeSinceEpoch",
+    )
+    __properties: ClassVar[list[str]] = ["url", "conditions", "traffic", "lastTransitionTimeSinceEpoch"]
+
+    model_config = ConfigDict(
+        populate_by_name=True,
+        validate_assignment=True,
+        protected_namespaces=(),
+    )
+
+    def to_str(self) -> str:
+        """Returns the string representation of the model using alias."""
+        return pprint.pformat(self.model_dump(by_alias=True))
+
+    def to_json(self) -> str:
+        """Returns the JSON representation of the model using alias."""
+        # TODO: pydantic v2: use .model_dump_json(by_alias=True, exclude_unset=True) instead
+        return json.dumps(self.to_dict())
+
+    @classmethod
+    def from_json(cls, json_str: str) -> Self | None:
+        """Create an instance of InferenceServiceDeploymentStatus from a JSON string."""
+        return cls.from_dict(json.loads(json_str))
+
+    def to_dict(self) -> dict[str, Any]:
+        """Return the dictionary representation of the model using alias.
+
+        This has the following differences from calling pydantic's
+        `self.model_dump(by_alias=True)`:
+
+        * `None` is only added to the output dict for nullable fields that
+          were set at model initialization. Other fields with value `None`
+          are ignored.
+        """
+        excluded_fields: set[str] = set()
+
+        _dict = self.model_dump(
+            by_alias=True,
+            exclude=excluded_fields,
+            exclude_none=True,
+        )
+        # override the default output from pydantic by calling `to_dict()` of each item in conditions (list)
+        _items = []
+        if self.conditions:
+            for _item_conditions in self.conditions:
+                if _item_conditions:
+                    _items.append(_item_conditions.to_dict())
+            _dict["conditions"] = _items
+        # override the default output from pydantic by calling `to_dict()` of each item in traffic (list)
+        _items = []
+        if self.traffic:
+            for _item_traffic in self.traffic:
+                if _item_traffic:
+                    _items.append(_item_traffic.to_dict())
+            _dict["traffic"] = _items
+        return _dict
+
+    @classmethod
+    def from_dict(cls, obj: dict[str, Any] | None) -> Self | None:
+        """Create an instance of InferenceServiceDeploymentStatus from a dict."""
+        if obj is None:
+            return None
+
+        if not isinstance(obj, dict):
+            return cls.model_validate(obj)
+
+        return cls.model_validate(
+            {
+                "url": obj.get("url"),
+                "conditions": [InferenceServiceCondition.from_dict(_item) for _item in obj["conditions"]]
+                if obj.get("conditions") is not None
+                else None,
+                "traffic": [InferenceServiceTrafficTarget.from_dict(_item) for _item in obj["traffic"]]
+                if obj.get("traffic") is not None
+                else None,
+                "lastTransitionTimeSinceEpoch": obj.get("lastTransitionTimeSinceEpoch"),
+            }
+        )
@@ -17,7 +17,7 @@ from typing_extensions import Self
 
 
 class InferenceServiceState(str, Enum):
-    """- DEPLOYED: A state indicating that the `InferenceService` should be deployed. - UNDEPLOYED: A state indicating that the `InferenceService` should be un-deployed. The state indicates the desired state of inference service. See the associated `ServeModel` for the actual status of service deployment action."""
+    """- DEPLOYED: A state indicating that the `InferenceService` should be deployed. - UNDEPLOYED: A state indicating that the `InferenceService` should be un-deployed. The state indicates the desired state of inference service. See `actualState` for the state observed in the serving environment."""
 
     """
     allowed enum values
@@ -0,0 +1,110 @@
+"""Model Registry REST API.
+
+REST API for Model Registry to create and manage ML model metadata
+
+The version of the OpenAPI document: v1alpha3
+Generated by OpenAPI Generator (https://openapi-generator.tech)
+
+Do not edit the class manually.
+"""  # noqa: E501
+
+
+from __future__ import annotations
+
+import json
+import pprint
+import re  # noqa: F401
+from typing import Annotated, Any, ClassVar
+
+from pydantic import BaseModel, ConfigDict, Field, StrictBool, StrictInt, StrictStr, field_validator
+from typing_extensions import Self
+
+
+class InferenceServiceTrafficTarget(BaseModel):
+    """The share of the traffic of an `InferenceService` sent to one of its revisions."""  # noqa: E501
+
+    revision_name: StrictStr | None = Field(
+        default=None, description="Name of the revision receiving the traffic.", alias="revisionName"
+    )
+    model_version_id: Annotated[str, Field(strict=True)] | None = Field(
+        default=None, description="ID of the `ModelVersion` served by the revision, if known.", alias="modelVersionId"
+    )
+    percent: StrictInt | None = Field(default=None, description="Percentage of the traffic sent to the revision.")
+    latest_revision: StrictBool | None = Field(
+        default=None,
+        description="Whether the revision is the latest one, e.g. the canary during a rollout.",
+        alias="latestRevision",
+    )
+    tag: StrictStr | None = Field(
+        default=None, description="Tag of the revision, e.g. `latest` or `prev` during a canary rollout."
+    )
+    __properties: ClassVar[list[str]] = ["revisionName", "modelVersionId", "percent", "latestRevision", "tag"]
+
+    @field_validator("model_version_id")
+    def model_version_id_validate_regular_expression(cls, value):
+        """Validates the regular expression."""
+        if value is None:
+            return value
+
+        if not re.match(r"^[1-9][0-9]{0,8}$", value):
+            msg = r"must validate the regular expression /^[1-9][0-9]{0,8}$/"
+            raise ValueError(msg)
+        return value
+
+    model_config = ConfigDict(
+        populate_by_name=True,
+        validate_assignment=True,
+        protected_namespaces=(),
+    )
+
+    def to_str(self) -> str:
+        """Returns the string representation of the model using alias."""
+        return pprint.pformat(self.model_dump(by_alias=True))
+
+    def to_json(self) -> str:
+        """Returns the JSON representation of the model using alias."""
+        # TODO: pydantic v2: use .model_dump_json(by_alias=True, exclude_unset=True) instead
+        return json.dumps(self.to_dict())
+
+    @classmethod
+    def from_json(cls, json_str: str) -> Self | None:
+        """Create an instance of InferenceServiceTrafficTarget from a JSON string."""
+        return cls.from_dict(json.loads(json_str))
+
+    def to_dict(self) -> dict[str, Any]:
+        """Return the dictionary representation of the model using alias.
+
+        This has the following differences from calling pydantic's
+        `self.model_dump(by_alias=True)`:
+
+        * `None` is only added to the output dict for nullable fields that
+          were set at model initialization. Other fields with value `None`
+          are ignored.
+        """
+        excluded_fields: set[str] = set()
+
+        _dict = self.model_dump(
+            by_alias=True,
+            exclude=excluded_fields,
+            exclude_none=True,
+        )
+        return _dict
+
+    @classmethod
+    def from_dict(cls, obj: dict[str, Any] | None) -> Self | None:
+        """Create an instance of InferenceServiceTrafficTarget from a dict."""
+        if obj is None:
+            return None
+
+        if not isinstance(obj, dict):
+            return cls.model_validate(obj)
+
+        return cls.model_validate(
+            {
+                "revisionName": obj.get("revisionName"),
+                "modelVersionId": obj.get("modelVersionId"),
+                "percent": obj.get("percent"),
+                "latestRevision": obj.get("latestRevision"),
+                "tag": obj.get("tag"),
+            }
+        )
@@ -18,7 +18,10 @@ from typing import Annotated, Any, ClassVar
 from pydantic import BaseModel, ConfigDict, Field, StrictStr, field_validator
 from typing_extensions import Self
 
+from mr_openapi.models.inference_service_actual_state import InferenceServiceActualState
+from mr_openapi.models.inference_service_deployment_status import InferenceServiceDeploymentStatus
 from mr_openapi.models.inference_service_state import InferenceServiceState
+from mr_openapi.models.inference_service_traffic_split import InferenceServiceTrafficSplit
 from mr_openapi.models.metadata_value import MetadataValue
 
 
@@ -43,6 +46,13 @@ class InferenceServiceUpdate(BaseModel):
     )
     runtime: StrictStr | None = Field(default=None, description="Model runtime.")
     desired_state: InferenceServiceState | None = Field(default=InferenceServiceState.DEPLOYED, alias="desiredState")
+    actual_state: InferenceServiceActualState | None = Field(default=None, alias="actualState")
+    deployment_status: InferenceServiceDeploymentStatus | None = Field(default=None, alias="deploymentStatus")
+    traffic_split: list[InferenceServiceTrafficSplit] | None = Field(
+        default=None,
+        description="Weighted set of `ModelVersions` to serve, e.g. to roll out a new version as a canary. It has at most two `ModelVersions` of the inference service's `RegisteredModel`, and the percentages must add up to 100. When set, it takes precedence over `modelVersionId`. Set it to an empty list to stop splitting the traffic.",
+        alias="trafficSplit",
+    )
     __properties: ClassVar[list[str]] = [
         "customProperties",
         "description",
@@ -50,6 +60,9 @@ class InferenceServiceUpdate(BaseModel):
         "modelVersionId",
         "runtime",
         "desiredState",
+        "actualState",
+        "deploymentStatus",
+        "trafficSplit",
     ]
 
     @field_validator("model_version_id")
@@ -107,6 +120,16 @@ class InferenceServiceUpdate(BaseModel):
                 if self.custom_properties[_key_custom_properties]:
                     _field_dict[_key_custom_properties] = self.custom_properties[_key_custom_properties].to_dict()
             _dict["customProperties"] = _field_dict
+        # override the default output from pydantic by calling `to_dict()` of deployment_status
+        if self.deployment_status:
+            _dict["deploymentStatus"] = self.deployment_status.to_dict()
+        # override the default output from pydantic by calling `to_dict()` of each item in traffic_split (list)
+        _items = []
+        if self.traffic_split:
+            for _item_traffic_split in self.traffic_split:
+                if _item_traffic_split:
+                    _items.append(_item_traffic_split.to_dict())
+            _dict["trafficSplit"] = _items
         return _dict
 
     @classmethod
@@ -130,5 +153,12 @@ class InferenceServiceUpdate(BaseModel):
                 "desiredState": obj.get("desiredState")
                 if obj.get("desiredState") is not None
                 else InferenceServiceState.DEPLOYED,
+                "actualState": obj.get("actualState"),
+                "deploymentStatus": InferenceServiceDeploymentStatus.from_dict(obj["deploymentStatus"])
+                if obj.get("deploymentStatus") is not None
+                else None,
+                "trafficSplit": [InferenceServiceTrafficSplit.from_dict(_item) for _item in obj["trafficSplit"]]
+                if obj.get("trafficSplit") is not None
+                else None,
             }
         )
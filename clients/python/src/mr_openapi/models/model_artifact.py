@@ -18,6 +18,9 @@ from typing import Annotated, Any, ClassVar
 from pydantic import BaseModel, ConfigDict, Field, StrictStr, field_validator
 from typing_extensions import Self
 
+from mr_openapi.models.artifact_digest import ArtifactDigest
+from mr_openapi.models.artifact_file_digest import ArtifactFileDigest
+from mr_openapi.models.artifact_signature import ArtifactSignature
 from mr_openapi.models.artifact_state import ArtifactState
 from mr_openapi.models.metadata_value import MetadataValue
 
@@ -103,6 +106,16 @@ class ModelArtifact(BaseModel):
         description="The uniform resource identifier of the physical artifact. May be empty if there is no physical artifact.",
     )
     state: ArtifactState | None = ArtifactState.UNKNOWN
+    digest: ArtifactDigest | None = None
+    file_digests: list[ArtifactFileDigest] | None = Field(
+        default=None,
+        description="Manifest of the content of the artifact, the digest of each of its files. Set it to an empty list to clear it.",
+        alias="fileDigests",
+    )
+    signatures: list[ArtifactSignature] | None = Field(
+        default=None,
+        description="References to the signatures and attestations of the content of the artifact. Set it to an empty list to clear them.",
+    )
     __properties: ClassVar[list[str]] = [
         "customProperties",
         "description",
@@ -126,6 +139,9 @@ class ModelArtifact(BaseModel):
         "modelSourceName",
         "uri",
         "state",
+        "digest",
+        "fileDigests",
+        "signatures",
     ]
 
     @field_validator("experiment_id")
@@ -199,6 +215,23 @@ class ModelArtifact(BaseModel):
                 if self.custom_properties[_key_custom_properties]:
                     _field_dict[_key_custom_properties] = self.custom_properties[_key_custom_properties].to_dict()
             _dict["customProperties"] = _field_dict
+        # override the default output from pydantic by calling `to_dict()` of digest
+        if self.digest:
+            _dict["digest"] = self.digest.to_dict()
+        # override the default output from pydantic by calling `to_dict()` of each item in file_digests (list)
+        _items = []
+        if self.file_digests:
+            for _item_file_digests in self.file_digests:
+                if _item_file_digests:
+                    _items.append(_item_file_digests.to_dict())
+            _dict["fileDigests"] = _items
+        # override the default output from pydantic by calling `to_dict()` of each item in signatures (list)
+        _items = []
+        if self.signatures:
+            for _item_signatures in self.signatures:
+                if _item_signatures:
+                    _items.append(_item_signatures.to_dict())
+            _dict["signatures"] = _items
         return _dict
 
     @classmethod
@@ -237,5 +270,12 @@ class ModelArtifact(BaseModel):
                 "modelSourceName": obj.get("modelSourceName"),
                 "uri": obj.get("uri"),
                 "state": obj.get("state") if obj.get("state") is not None else ArtifactState.UNKNOWN,
+                "digest": ArtifactDigest.from_dict(obj["digest"]) if obj.get("digest") is not None else None,
+                "fileDigests": [ArtifactFileDigest.from_dict(_item) for _item in obj["fileDigests"]]
+                if obj.get("fileDigests") is not None
+                else None,
+                "signatures": [ArtifactSignature.from_dict(_item) for _item in obj["signatures"]]
+                if obj.get("signatures") is not None
+                else None,
             }
         )
@@ -19,6 +19,7 @@ from pydantic import BaseModel, ConfigDict, Field, StrictStr
 from typing_extensions import Self
 
 from mr_openapi.models.metadata_value import MetadataValue
+from mr_openapi.models.serving_environment_tier import ServingEnvironmentTier
 
 
 class ServingEnvironmentCreate(BaseModel):
@@ -36,7 +37,29 @@ class ServingEnvironmentCreate(BaseModel):
         alias="externalId",
     )
     name: Annotated[str, Field(min_length=1, strict=True)] = Field(description="The name of the ServingEnvironment.")
-    __properties: ClassVar[list[str]] = ["customProperties", "description", "externalId", "name"]
+    cluster_id: StrictStr | None = Field(
+        default=None, description="ID of the Kubernetes cluster hosting the serving environment.", alias="clusterId"
+    )
+    tier: ServingEnvironmentTier | None = None
+    allowed_runtimes: list[StrictStr] | None = Field(
+        default=None,
+        description="Names of the `ServingRuntimes` and `ClusterServingRuntimes` the `InferenceServices` of the serving environment can use. Any runtime is allowed when it's not set, set it to an empty list to allow any runtime again. `InferenceServices` without a runtime are always allowed, as KServe auto-selects it.",
+        alias="allowedRuntimes",
+    )
+    quotas: dict[str, StrictStr] | None = Field(
+        default=None,
+        description="Resource quotas of the serving environment, as Kubernetes quantities by resource name, e.g. `requests.nvidia.com/gpu`. Set it to an empty object to clear it.",
+    )
+    __properties: ClassVar[list[str]] = [
+        "customProperties",
+        "description",
+        "externalId",
+        "name",
+        "clusterId",
+        "tier",
+        "allowedRuntimes",
+        "quotas",
+    ]
 
     model_config = ConfigDict(
         populate_by_name=True,
@@ -101,5 +124,9 @@ class ServingEnvironmentCreate(BaseModel):
                 "description": obj.get("description"),
                 "externalId": obj.get("externalId"),
                 "name": obj.get("name"),
+                "clusterId": obj.get("clusterId"),
+                "tier": obj.get("tier"),
+                "allowedRuntimes": obj.get("allowedRuntimes"),
+                "quotas": obj.get("quotas"),
             }
         )
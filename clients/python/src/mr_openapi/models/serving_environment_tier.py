@@ -0,0 +1,32 @@
+"""Model Registry REST API.
+
+REST API for Model Registry to create and manage ML model metadata
+
+The version of the OpenAPI document: v1alpha3
+Generated by OpenAPI Generator (https://openapi-generator.tech)
+
+Do not edit the class manually.
+"""  # noqa: E501
+
+from __future__ import annotations
+
+import json
+from enum import Enum
+
+from typing_extensions import Self
+
+
+class ServingEnvironmentTier(str, Enum):
+    """- DEVELOPMENT: The serving environment is used to develop models. - STAGING: The serving environment is used to validate models before serving them in production. - PRODUCTION: The serving environment serves models in production."""  # noqa: E501
+
+    """
+    allowed enum values
+    """
+    DEVELOPMENT = "DEVELOPMENT"
+    STAGING = "STAGING"
+    PRODUCTION = "PRODUCTION"
+
+    @classmethod
+    def from_json(cls, json_str: str) -> Self:
+        """Create an instance of ServingEnvironmentTier from a JSON string."""
+        return cls(json.loads(json_str))
@@ -58,6 +58,7 @@ const (
 	ModelArtifactPath            = ModelArtifactListPath + "/:" + ModelArtifactId
 	ArtifactListPath             = ModelRegistryPath + "/artifacts"
 	ArtifactPath                 = ArtifactListPath + "/:" + ArtifactId
+	CatalogModelImportPath       = ModelRegistryPath + "/catalog_imports"
 
 	// model catalog
 	CatalogSourceId                     = "source_id"
@@ -70,6 +71,7 @@ const (
 	CatalogSourceModelCatchAllPath      = CatalogPathPrefix + "/sources/:" + CatalogSourceId + "/models/*" + CatalogModelName
 	CatalogSourceModelArtifactsCatchAll = CatalogPathPrefix + "/sources/:" + CatalogSourceId + "/artifacts/*" + CatalogModelName
 	CatalogModelPerformanceArtifacts    = CatalogPathPrefix + "/sources/:" + CatalogSourceId + "/performance_artifacts/*" + CatalogModelName
+	CatalogSourceSyncHistoryPath        = CatalogPathPrefix + "/sources/:" + CatalogSourceId + "/sync_history"
 
 	ModelCatalogSettingsPathPrefix           = SettingsPath + "/model_catalog"
 	ModelCatalogSettingsSourceConfigListPath = ModelCatalogSettingsPathPrefix + "/source_configs"
@@ -255,6 +257,7 @@ func (app *App) Routes() http.Handler {
 	apiRouter.POST(ModelVersionArtifactListPath, app.AttachNamespace(app.RequireAccessToMRService(app.AttachModelRegistryRESTClient(app.CreateModelArtifactByModelVersionHandler))))
 	apiRouter.PATCH(ModelRegistryPath, app.AttachNamespace(app.RequireAccessToMRService(app.AttachModelRegistryRESTClient(app.UpdateModelVersionHandler))))
 	apiRouter.PATCH(ModelArtifactPath, app.AttachNamespace(app.RequireAccessToMRService(app.AttachModelRegistryRESTClient(app.UpdateModelArtifactHandler))))
+	apiRouter.POST(CatalogModelImportPath, app.AttachNamespace(app.RequireAccessToMRService(app.AttachModelRegistryRESTClient(app.AttachModelCatalogRESTClient(app.ImportCatalogModelHandler)))))
 
 	// Model Transfer Jobs
 	apiRouter.GET(ModelTransferJobListPath, app.AttachNamespace(app.RequireAccessToMRService(app.handlerWithOverride(HandlerIDModelTransferJobList, func() httprouter.Handle { return app.GetAllModelTransferJobsHandler }))))
@@ -272,6 +275,7 @@ func (app *App) Routes() http.Handler {
 	apiRouter.GET(CatalogSourceModelCatchAllPath, app.AttachNamespace(app.AttachModelCatalogRESTClient(app.GetCatalogSourceModelHandler)))
 	apiRouter.GET(CatalogSourceModelArtifactsCatchAll, app.AttachNamespace(app.AttachModelCatalogRESTClient(app.GetCatalogSourceModelArtifactsHandler)))
 	apiRouter.GET(CatalogModelPerformanceArtifacts, app.AttachNamespace(app.AttachModelCatalogRESTClient(app.GetCatalogModelPerformanceArtifactsHandler)))
+	apiRouter.GET(CatalogSourceSyncHistoryPath, app.AttachNamespace(app.AttachModelCatalogRESTClient(app.GetCatalogSourceSyncHistoryHandler)))
 	// Kubernetes routes
 	apiRouter.GET(UserPath, app.UserHandler)
 	apiRouter.POST(CheckNamespaceRegistryAccessPath, app.CheckNamespaceRegistryAccessHandler)
@@ -0,0 +1,262 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/kubeflow/hub/ui/bff/internal/constants"
+	"github.com/kubeflow/hub/ui/bff/internal/integrations/httpclient"
+	"github.com/kubeflow/hub/ui/bff/internal/models"
+	"github.com/kubeflow/hub/ui/bff/internal/validation"
+	"github.com/kubeflow/model-registry/pkg/openapi"
+)
+
+type CatalogModelImportRequestEnvelope Envelope[*models.CatalogModelImportRequest, None]
+type CatalogModelImportEnvelope Envelope[*models.CatalogModelImport, None]
+
+const (
+	catalogModelArtifactType = "model-artifact"
+	catalogModelSourceKind   = "catalog"
+	// The same default version name the UI suggests when registering a catalog model.
+	defaultCatalogImportVersionName = "Version 1"
+
+	// Custom properties recording where an imported model version came from.
+	catalogSourceIdProperty    = "catalog_source_id"
+	catalogModelNameProperty   = "catalog_model_name"
+	catalogArtifactUriProperty = "catalog_artifact_uri"
+)
+
+// ImportCatalogModelHandler registers a catalog model in a model registry, creating the
+// RegisteredModel, a ModelVersion and a ModelArtifact pointing at the catalog artifact URI.
+// The registry has no transactions, so when a step fails the entities already created are
+// archived.
+func (app *App) ImportCatalogModelHandler(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	registryClient, ok := r.Context().Value(constants.ModelRegistryHttpClientKey).(httpclient.HTTPClientInterface)
+	if !ok {
+		app.serverErrorResponse(w, r, errors.New("REST client not found"))
+		return
+	}
+
+	catalogClient, ok := r.Context().Value(constants.ModelCatalogHttpClientKey).(httpclient.HTTPClientInterface)
+	if !ok {
+		app.serverErrorResponse(w, r, errors.New("catalog REST client not found"))
+		return
+	}
+
+	var envelope CatalogModelImportRequestEnvelope
+	if err := json.NewDecoder(r.Body).Decode(&envelope); err != nil {
+		app.badRequestResponse(w, r, fmt.Errorf("error decoding JSON: %v", err.Error()))
+		return
+	}
+
+	if envelope.Data == nil {
+		app.badRequestResponse(w, r, errors.New("missing data in request body"))
+		return
+	}
+	data := *envelope.Data
+
+	if err := validation.ValidateCatalogModelImport(data); err != nil {
+		app.badRequestResponse(w, r, fmt.Errorf("validation error:: %v", err.Error()))
+		return
+	}
+
+	modelName := url.PathEscape(strings.TrimPrefix(data.ModelName, "/"))
+
+	catalogModel, err := app.repositories.ModelCatalogClient.GetCatalogSourceModel(catalogClient, data.SourceId, modelName)
+	if err != nil {
+		app.clientErrorResponse(w, r, err)
+		return
+	}
+
+	artifactValues := url.Values{}
+	artifactValues.Set("artifactType", catalogModelArtifactType)
+	catalogArtifacts, err := app.repositories.ModelCatalogClient.GetCatalogSourceModelArtifacts(catalogClient, data.SourceId, modelName, artifactValues)
+	if err != nil {
+		app.clientErrorResponse(w, r, err)
+		return
+	}
+
+	artifactUri, err := selectCatalogArtifactUri(catalogArtifacts.Items, data.ArtifactUri)
+	if err != nil {
+		app.badRequestResponse(w, r, err)
+		return
+	}
+
+	registeredModelName := data.RegisteredModelName
+	if registeredModelName == "" {
+		registeredModelName = catalogModel.Name
+	}
+	versionName := data.VersionName
+	if versionName == "" {
+		versionName = defaultCatalogImportVersionName
+	}
+
+	jsonData, err := json.Marshal(newRegisteredModelFromCatalog(catalogModel, registeredModelName))
+	if err != nil {
+		app.serverErrorResponse(w, r, fmt.Errorf("error marshaling model to JSON: %w", err))
+		return
+	}
+	createdModel, err := app.repositories.ModelRegistryClient.CreateRegisteredModel(registryClient, jsonData)
+	if err != nil {
+		app.clientErrorResponse(w, r, err)
+		return
+	}
+	if createdModel == nil || createdModel.Id == nil {
+		app.serverErrorResponse(w, r, fmt.Errorf("created model is nil"))
+		return
+	}
+
+	version := newModelVersionFromCatalog(catalogModel, data, versionName, artifactUri, *createdModel.Id)
+	jsonData, err = json.Marshal(version)
+	if err != nil {
+		app.serverErrorResponse(w, r, fmt.Errorf("error marshaling model version to JSON: %w", err))
+		return
+	}
+	createdVersion, err := app.repositories.ModelRegistryClient.CreateModelVersionForRegisteredModel(registryClient, *createdModel.Id, jsonData)
+	if err != nil {
+		app.rollbackCatalogImport(r, registryClient, *createdModel.Id, "")
+		app.clientErrorResponse(w, r, err)
+		return
+	}
+	if createdVersion == nil || createdVersion.Id == nil {
+		app.rollbackCatalogImport(r, registryClient, *createdModel.Id, "")
+		app.serverErrorResponse(w, r, fmt.Errorf("created model version is nil"))
+		return
+	}
+
+	jsonData, err = json.Marshal(newModelArtifactFromCatalog(data.SourceId, catalogModel.Name, versionName, artifactUri))
+	if err != nil {
+		app.rollbackCatalogImport(r, registryClient, *createdModel.Id, *createdVersion.Id)
+		app.serverErrorResponse(w, r, fmt.Errorf("error marshaling model artifact to JSON: %w", err))
+		return
+	}
+	createdArtifact, err := app.repositories.ModelRegistryClient.CreateModelArtifactByModelVersion(registryClient, *createdVersion.Id, jsonData)
+	if err != nil {
+		app.rollbackCatalogImport(r, registryClient, *createdModel.Id, *createdVersion.Id)
+		app.clientErrorResponse(w, r, err)
+		return
+	}
+
+	response := CatalogModelImportEnvelope{
+		Data: &models.CatalogModelImport{
+			RegisteredModel: createdModel,
+			ModelVersion:    createdVersion,
+			ModelArtifact:   createdArtifact,
+		},
+	}
+
+	w.Header().Set("Location", r.URL.JoinPath("..", "registered_models", *createdModel.Id).String())
+	err = app.WriteJSON(w, http.StatusCreated, response, nil)
+	if err != nil {
+		app.serverErrorResponse(w, r, fmt.Errorf("error writing JSON"))
+		return
+	}
+}
+
+// rollbackCatalogImport archives the model version, if any, and the registered model created
+// by a failed import, so it doesn't leave a model without artifacts behind. Failures are only
+// logged, the import error is what gets reported.
+func (app *App) rollbackCatalogImport(r *http.Request, client httpclient.HTTPClientInterface, registeredModelId string, modelVersionId string) {
+	if modelVersionId != "" {
+		jsonData, err := json.Marshal(openapi.ModelVersionUpdate{State: openapi.MODELVERSIONSTATE_ARCHIVED.Ptr()})
+		if err == nil {
+			_, err = app.repositories.ModelRegistryClient.UpdateModelVersion(client, modelVersionId, jsonData)
+		}
+		if err != nil {
+			app.logger.Error("failed to archive the model version of a failed catalog import", "modelVersionId", modelVersionId, "error", err, "uri", r.URL.RequestURI())
+		}
+	}
+
+	jsonData, err := json.Marshal(openapi.RegisteredModelUpdate{State: openapi.REGISTEREDMODELSTATE_ARCHIVED.Ptr()})
+	if err == nil {
+		_, err = app.repositories.ModelRegistryClient.UpdateRegisteredModel(client, registeredModelId, jsonData)
+	}
+	if err != nil {
+		app.logger.Error("failed to archive the registered model of a failed catalog import", "registeredModelId", registeredModelId, "error", err, "uri", r.URL.RequestURI())
+	}
+}
+
+// clientErrorResponse forwards errors returned by an upstream service and treats anything else as a server error.
+func (app *App) clientErrorResponse(w http.ResponseWriter, r *http.Request, err error) {
+	var httpErr *httpclient.HTTPError
+	if errors.As(err, &httpErr) {
+		app.errorResponse(w, r, httpErr)
+		return
+	}
+	app.serverErrorResponse(w, r, err)
+}
+
+// selectCatalogArtifactUri returns the URI of the requested catalog model artifact, or of the
+// first model artifact when no URI is requested.
+func selectCatalogArtifactUri(artifacts []models.CatalogArtifact, requested string) (string, error) {
+	for _, artifact := range artifacts {
+		if artifact.ArtifactType != catalogModelArtifactType || artifact.Uri == nil || *artifact.Uri == "" {
+			continue
+		}
+		if requested == "" || *artifact.Uri == requested {
+			return *artifact.Uri, nil
+		}
+	}
+
+	if requested != "" {
+		return "", fmt.Errorf("catalog model has no artifact with uri %q", requested)
+	}
+	return "", errors.New("catalog model has no model artifacts")
+}
+
+func newRegisteredModelFromCatalog(catalogModel *models.CatalogModel, name string) openapi.RegisteredModel {
+	return openapi.RegisteredModel{
+		Name:        name,
+		Description: catalogModel.Description,
+		Readme:      catalogModel.Readme,
+		Maturity:    catalogModel.Maturity,
+		Language:    catalogModel.Language,
+		Tasks:       catalogModel.Tasks,
+		Provider:    catalogModel.Provider,
+		Logo:        catalogModel.Logo,
+		License:     catalogModel.License,
+		LicenseLink: catalogModel.LicenseLink,
+		LibraryName: catalogModel.LibraryName,
+	}
+}
+
+func newModelVersionFromCatalog(catalogModel *models.CatalogModel, data models.CatalogModelImportRequest, versionName string, artifactUri string, registeredModelId string) openapi.ModelVersion {
+	// Only the provenance is recorded: the catalog custom properties describe the catalog
+	// model and may change or go away, they don't belong to the registered version.
+	customProperties := map[string]openapi.MetadataValue{
+		catalogSourceIdProperty:    stringMetadataValue(data.SourceId),
+		catalogModelNameProperty:   stringMetadataValue(catalogModel.Name),
+		catalogArtifactUriProperty: stringMetadataValue(artifactUri),
+	}
+
+	version := openapi.ModelVersion{
+		Name:              versionName,
+		Description:       catalogModel.Description,
+		RegisteredModelId: registeredModelId,
+		CustomProperties:  &customProperties,
+	}
+	if data.Author != "" {
+		version.Author = openapi.PtrString(data.Author)
+	}
+	return version
+}
+
+func newModelArtifactFromCatalog(sourceId string, modelName string, versionName string, artifactUri string) openapi.ModelArtifact {
+	return openapi.ModelArtifact{
+		Name:             openapi.PtrString(versionName),
+		Uri:              openapi.PtrString(artifactUri),
+		ArtifactType:     openapi.PtrString(catalogModelArtifactType),
+		ModelSourceKind:  openapi.PtrString(catalogModelSourceKind),
+		ModelSourceClass: openapi.PtrString(sourceId),
+		ModelSourceName:  openapi.PtrString(modelName),
+	}
+}
+
+func stringMetadataValue(value string) openapi.MetadataValue {
+	return openapi.MetadataStringValueAsMetadataValue(openapi.NewMetadataStringValue(value, "MetadataStringValue"))
+}
@@ -0,0 +1,113 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/kubeflow/hub/ui/bff/internal/integrations/kubernetes"
+	"github.com/kubeflow/hub/ui/bff/internal/mocks"
+	"github.com/kubeflow/hub/ui/bff/internal/models"
+	"github.com/kubeflow/model-registry/pkg/openapi"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ImportCatalogModelHandler", func() {
+	Context("testing catalog model import", Ordered, func() {
+		requestIdentity := kubernetes.RequestIdentity{
+			UserID: "user@example.com",
+		}
+
+		It("should create the registered model, version and artifact", func() {
+			By("posting a catalog model import")
+			body := CatalogModelImportRequestEnvelope{Data: &models.CatalogModelImportRequest{
+				SourceId:  "sample-source",
+				ModelName: "repo1/granite-8b-code-instruct",
+			}}
+			actual, rs, err := setupApiTest[CatalogModelImportEnvelope](http.MethodPost, "/api/v1/model_registry/model-registry/catalog_imports?namespace=kubeflow", body, kubernetesMockedStaticClientFactory, requestIdentity, "kubeflow")
+			Expect(err).NotTo(HaveOccurred())
+
+			By("returning the created entities")
+			Expect(rs.StatusCode).To(Equal(http.StatusCreated))
+			Expect(actual.Data.RegisteredModel.Name).To(Equal(mocks.GetRegisteredModelMocks()[0].Name))
+			Expect(actual.Data.ModelVersion.Name).To(Equal(mocks.GetModelVersionMocks()[0].Name))
+			Expect(actual.Data.ModelArtifact).NotTo(BeNil())
+			Expect(rs.Header.Get("location")).To(Equal("/api/v1/model_registry/model-registry/registered_models/1?namespace=kubeflow"))
+		})
+
+		It("should reject a request without a model name", func() {
+			body := CatalogModelImportRequestEnvelope{Data: &models.CatalogModelImportRequest{
+				SourceId: "sample-source",
+			}}
+			_, rs, err := setupApiTest[CatalogModelImportEnvelope](http.MethodPost, "/api/v1/model_registry/model-registry/catalog_imports?namespace=kubeflow", body, kubernetesMockedStaticClientFactory, requestIdentity, "kubeflow")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rs.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should reject an artifact uri the catalog model does not have", func() {
+			body := CatalogModelImportRequestEnvelope{Data: &models.CatalogModelImportRequest{
+				SourceId:    "sample-source",
+				ModelName:   "repo1/granite-8b-code-instruct",
+				ArtifactUri: "oci://registry.sample.io/unknown:1.0",
+			}}
+			_, rs, err := setupApiTest[CatalogModelImportEnvelope](http.MethodPost, "/api/v1/model_registry/model-registry/catalog_imports?namespace=kubeflow", body, kubernetesMockedStaticClientFactory, requestIdentity, "kubeflow")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rs.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Context("building registry entities from a catalog model", func() {
+		catalogModel := &models.CatalogModel{
+			Name:        "repo1/granite-8b-code-instruct",
+			Provider:    stringPtr("IBM"),
+			License:     stringPtr("Apache 2.0"),
+			Tasks:       []string{"text-generation"},
+			Language:    []string{"en"},
+			LibraryName: stringPtr("transformers"),
+		}
+
+		It("should copy the base model fields to the registered model", func() {
+			registeredModel := newRegisteredModelFromCatalog(catalogModel, "granite")
+			Expect(registeredModel.Name).To(Equal("granite"))
+			Expect(registeredModel.GetProvider()).To(Equal("IBM"))
+			Expect(registeredModel.GetLicense()).To(Equal("Apache 2.0"))
+			Expect(registeredModel.Tasks).To(Equal([]string{"text-generation"}))
+			Expect(registeredModel.Language).To(Equal([]string{"en"}))
+			Expect(registeredModel.GetLibraryName()).To(Equal("transformers"))
+		})
+
+		It("should record catalog provenance on the model version", func() {
+			request := models.CatalogModelImportRequest{SourceId: "sample-source", ModelName: catalogModel.Name}
+			version := newModelVersionFromCatalog(catalogModel, request, "Version 1", "oci://registry.sample.io/granite:1.0", "1")
+			Expect(version.RegisteredModelId).To(Equal("1"))
+			properties := version.GetCustomProperties()
+			Expect(properties[catalogSourceIdProperty].MetadataStringValue.StringValue).To(Equal("sample-source"))
+			Expect(properties[catalogModelNameProperty].MetadataStringValue.StringValue).To(Equal(catalogModel.Name))
+			Expect(properties[catalogArtifactUriProperty].MetadataStringValue.StringValue).To(Equal("oci://registry.sample.io/granite:1.0"))
+		})
+
+		It("should not copy the catalog custom properties to the model version", func() {
+			withProperties := *catalogModel
+			withProperties.CustomProperties = &map[string]openapi.MetadataValue{
+				"validated_on": stringMetadataValue("rhoai-2.20"),
+			}
+			request := models.CatalogModelImportRequest{SourceId: "sample-source", ModelName: catalogModel.Name}
+			version := newModelVersionFromCatalog(&withProperties, request, "Version 1", "oci://registry.sample.io/granite:1.0", "1")
+			Expect(version.GetCustomProperties()).To(HaveLen(3))
+			Expect(version.GetCustomProperties()).NotTo(HaveKey("validated_on"))
+		})
+
+		It("should point the model artifact at the catalog uri", func() {
+			artifact := newModelArtifactFromCatalog("sample-source", catalogModel.Name, "Version 1", "oci://registry.sample.io/granite:1.0")
+			Expect(artifact.GetUri()).To(Equal("oci://registry.sample.io/granite:1.0"))
+			Expect(artifact.GetModelSourceKind()).To(Equal("catalog"))
+			Expect(artifact.GetModelSourceClass()).To(Equal("sample-source"))
+			Expect(artifact.GetModelSourceName()).To(Equal(catalogModel.Name))
+		})
+
+		It("should pick the first model artifact when no uri is requested", func() {
+			uri, err := selectCatalogArtifactUri(mocks.GetCatalogModelArtifactListMock().Items, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(uri).To(Equal("oci://registry.sample.io/repo1/modelcar-granite-7b-starter:1.4.0"))
+		})
+	})
+})
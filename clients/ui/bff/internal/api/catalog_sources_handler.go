@@ -16,6 +16,7 @@ type CatalogSourceListEnvelope Envelope[*models.CatalogSourceList, None]
 type CatalogModelEnvelope Envelope[*models.CatalogModel, None]
 type catalogModelArtifactsListEnvelope Envelope[*models.CatalogModelArtifactList, None]
 type CatalogLabelListEnvelope Envelope[*models.CatalogLabelList, None]
+type CatalogSourceSyncListEnvelope Envelope[*models.CatalogSourceSyncList, None]
 
 func (app *App) GetAllCatalogSourcesHandler(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 	client, ok := r.Context().Value(constants.ModelCatalogHttpClientKey).(httpclient.HTTPClientInterface)
@@ -150,3 +151,27 @@ func (app *App) GetCatalogLabelsHandler(w http.ResponseWriter, r *http.Request,
 		app.serverErrorResponse(w, r, err)
 	}
 }
+
+func (app *App) GetCatalogSourceSyncHistoryHandler(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	client, ok := r.Context().Value(constants.ModelCatalogHttpClientKey).(httpclient.HTTPClientInterface)
+	if !ok {
+		app.serverErrorResponse(w, r, errors.New("catalog REST client not found"))
+		return
+	}
+
+	syncHistory, err := app.repositories.ModelCatalogClient.GetCatalogSourceSyncHistory(client, ps.ByName(CatalogSourceId), r.URL.Query())
+
+	if err != nil {
+		app.serverErrorResponse(w, r, err)
+		return
+	}
+
+	syncList := CatalogSourceSyncListEnvelope{
+		Data: syncHistory,
+	}
+
+	err = app.WriteJSON(w, http.StatusOK, syncList, nil)
+	if err != nil {
+		app.serverErrorResponse(w, r, err)
+	}
+}
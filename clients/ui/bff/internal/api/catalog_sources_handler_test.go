@@ -215,3 +215,27 @@ var _ = Describe("TestGetCatalogLabelsHandler", func() {
 
 	})
 })
+
+var _ = Describe("TestGetCatalogSourceSyncHistoryHandler", func() {
+	Context("testing Catalog Source Sync History Handler", Ordered, func() {
+
+		It("should retrieve the sync history of a source", func() {
+			By("fetching the sync history")
+			data := mocks.GetCatalogSourceSyncListMock("hugging_face_source")
+			requestIdentity := kubernetes.RequestIdentity{
+				UserID: "user@example.com",
+			}
+
+			expected := CatalogSourceSyncListEnvelope{Data: &data}
+			actual, rs, err := setupApiTest[CatalogSourceSyncListEnvelope](http.MethodGet, "/api/v1/model_catalog/sources/hugging_face_source/sync_history?namespace=kubeflow&since=1739894400000", nil, kubernetesMockedStaticClientFactory, requestIdentity, "kubeflow")
+			Expect(err).NotTo(HaveOccurred())
+
+			By("should match the expected sync history")
+			Expect(rs.StatusCode).To(Equal(http.StatusOK))
+			Expect(actual.Data.Size).To(Equal(expected.Data.Size))
+			Expect(actual.Data.PageSize).To(Equal(expected.Data.PageSize))
+			Expect(actual.Data.NextPageToken).To(Equal(expected.Data.NextPageToken))
+			Expect(actual.Data.Items).To(Equal(expected.Data.Items))
+		})
+	})
+})
@@ -204,19 +204,7 @@ func (app *App) AttachModelRegistryRESTClient(next func(http.ResponseWriter, *ht
 			}
 		}
 
-		// Prepare headers for the REST client
-		headers := http.Header{}
-
-		// If using user token authentication, extract and forward the authorization header
-		if app.config.AuthMethod == config.AuthMethodUser {
-			identity, ok := r.Context().Value(constants.RequestIdentityKey).(*kubernetes.RequestIdentity)
-			if ok && identity != nil && identity.Token != "" {
-				// Always send as "Authorization: Bearer <token>" regardless of incoming header format
-				// The identity.Token already has any prefix removed by ExtractRequestIdentity
-				authHeaderValue := "Bearer " + identity.Token
-				headers.Set("Authorization", authHeaderValue)
-			}
-		}
+		headers := app.modelRegistryHeaders(r, client)
 
 		restHttpClient, err := httpclient.NewHTTPClient(restClientLogger, modelRegistryBaseURL, headers, app.config.InsecureSkipVerify, app.rootCAs)
 		if err != nil {
@@ -228,6 +216,38 @@ func (app *App) AttachModelRegistryRESTClient(next func(http.ResponseWriter, *ht
 	}
 }
 
+// modelRegistryHeaders returns the headers the REST client sends to the model registry.
+// Besides the user token, it forwards the user as kubeflow-userid so the server records
+// who made a change; the server cannot derive the user from the token itself.
+func (app *App) modelRegistryHeaders(r *http.Request, client kubernetes.KubernetesClientInterface) http.Header {
+	headers := http.Header{}
+
+	identity, ok := r.Context().Value(constants.RequestIdentityKey).(*kubernetes.RequestIdentity)
+	if !ok || identity == nil {
+		return headers
+	}
+
+	// If using user token authentication, extract and forward the authorization header
+	if app.config.AuthMethod == config.AuthMethodUser && identity.Token != "" {
+		// Always send as "Authorization: Bearer <token>" regardless of incoming header format
+		// The identity.Token already has any prefix removed by ExtractRequestIdentity
+		headers.Set("Authorization", "Bearer "+identity.Token)
+	}
+
+	userID, err := client.GetUser(identity)
+	if err != nil {
+		if app.logger != nil {
+			app.logger.Warn("failed to resolve the user to forward to the model registry", "error", err)
+		}
+		return headers
+	}
+	if userID != "" {
+		headers.Set(constants.KubeflowUserIDHeader, userID)
+	}
+
+	return headers
+}
+
 func (app *App) AttachNamespace(next func(http.ResponseWriter, *http.Request, httprouter.Params)) httprouter.Handle {
 	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		namespace := r.URL.Query().Get(string(constants.NamespaceHeaderParameterKey))
@@ -0,0 +1,69 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kubeflow/hub/ui/bff/internal/config"
+	"github.com/kubeflow/hub/ui/bff/internal/constants"
+	"github.com/kubeflow/hub/ui/bff/internal/integrations/httpclient"
+	"github.com/kubeflow/hub/ui/bff/internal/integrations/kubernetes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// userClient only resolves the user of a request, like GetUser of the
+// internal and token Kubernetes clients.
+type userClient struct {
+	kubernetes.KubernetesClientInterface
+	user string
+	err  error
+}
+
+func (c userClient) GetUser(_ *kubernetes.RequestIdentity) (string, error) {
+	return c.user, c.err
+}
+
+func TestModelRegistryHeadersForwardUser(t *testing.T) {
+	var actor, authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actor = r.Header.Get(constants.KubeflowUserIDHeader)
+		authorization = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	app := App{config: config.EnvConfig{AuthMethod: config.AuthMethodUser}}
+	identity := &kubernetes.RequestIdentity{Token: "user-token"}
+	req := httptest.NewRequest(http.MethodPatch, "/api/v1/model_registry/demo/registered_models/1", nil)
+	req = req.WithContext(context.WithValue(req.Context(), constants.RequestIdentityKey, identity))
+
+	headers := app.modelRegistryHeaders(req, userClient{user: "user@example.com"})
+
+	client, err := httpclient.NewHTTPClient(slog.New(slog.NewTextHandler(io.Discard, nil)), server.URL, headers, false, nil)
+	require.NoError(t, err)
+	_, err = client.PATCH("/registered_models/1", strings.NewReader("{}"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "user@example.com", actor)
+	assert.Equal(t, "Bearer user-token", authorization)
+}
+
+func TestModelRegistryHeadersWithoutUser(t *testing.T) {
+	app := App{config: config.EnvConfig{AuthMethod: config.AuthMethodInternal}}
+	identity := &kubernetes.RequestIdentity{UserID: "user@example.com"}
+	req := httptest.NewRequest(http.MethodPatch, "/api/v1/model_registry/demo/registered_models/1", nil)
+	req = req.WithContext(context.WithValue(req.Context(), constants.RequestIdentityKey, identity))
+
+	headers := app.modelRegistryHeaders(req, userClient{err: errors.New("no username found in token")})
+
+	assert.Empty(t, headers.Get(constants.KubeflowUserIDHeader))
+	assert.Empty(t, headers.Get("Authorization"))
+}
@@ -268,6 +268,12 @@ func (m *ModelCatalogClientMock) GetCatalogLabels(client httpclient.HTTPClientIn
 	return &labels, nil
 }
 
+func (m *ModelCatalogClientMock) GetCatalogSourceSyncHistory(client httpclient.HTTPClientInterface, sourceId string, pageValues url.Values) (*models.CatalogSourceSyncList, error) {
+	syncHistory := GetCatalogSourceSyncListMock(sourceId)
+
+	return &syncHistory, nil
+}
+
 func (m *ModelCatalogClientMock) CreateCatalogSourcePreview(client httpclient.HTTPClientInterface, sourcePreviewPayload models.CatalogSourcePreviewRequest, pageValues url.Values) (*models.CatalogSourcePreviewResult, error) {
 	filterStatus := pageValues.Get("filterStatus")
 	if filterStatus == "" {
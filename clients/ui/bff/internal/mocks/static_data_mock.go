@@ -982,6 +982,51 @@ func GetCatalogSourceListMock() models.CatalogSourceList {
 	}
 }
 
+func GetCatalogSourceSyncListMock(sourceId string) models.CatalogSourceSyncList {
+	modelsAssetType := "models"
+	partialError := "Failed to load 1 model(s): [org/broken-model]"
+
+	syncs := []models.CatalogSourceSync{
+		{
+			Id:                  "2",
+			SourceId:            sourceId,
+			AssetType:           &modelsAssetType,
+			Status:              "partially-available",
+			Error:               &partialError,
+			StartTimeSinceEpoch: "1739980800000",
+			EndTimeSinceEpoch:   "1739980815000",
+			Added:               1,
+			Updated:             1,
+			Removed:             1,
+			Changes: []models.CatalogSourceSyncChange{
+				{Name: "org/new-model", Change: "ADDED"},
+				{Name: "org/updated-model", Change: "UPDATED"},
+				{Name: "org/retired-model", Change: "REMOVED"},
+			},
+		},
+		{
+			Id:                  "1",
+			SourceId:            sourceId,
+			AssetType:           &modelsAssetType,
+			Status:              "available",
+			StartTimeSinceEpoch: "1739894400000",
+			EndTimeSinceEpoch:   "1739894410000",
+			Added:               2,
+			Changes: []models.CatalogSourceSyncChange{
+				{Name: "org/updated-model", Change: "ADDED"},
+				{Name: "org/retired-model", Change: "ADDED"},
+			},
+		},
+	}
+
+	return models.CatalogSourceSyncList{
+		Items:         syncs,
+		Size:          int32(len(syncs)),
+		PageSize:      int32(10),
+		NextPageToken: "",
+	}
+}
+
 func GetCatalogLabelListMock() models.CatalogLabelList {
 	redHatAI := "Red Hat AI"
 	redHatAIValidated := "Red Hat AI Validated"
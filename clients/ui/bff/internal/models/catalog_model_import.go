@@ -0,0 +1,21 @@
+package models
+
+import "github.com/kubeflow/model-registry/pkg/openapi"
+
+// CatalogModelImportRequest identifies the catalog model and artifact to register in a model registry.
+type CatalogModelImportRequest struct {
+	SourceId  string `json:"sourceId"`
+	ModelName string `json:"modelName"`
+	// ArtifactUri selects one of the catalog model artifacts, the first one is used when empty.
+	ArtifactUri         string `json:"artifactUri,omitempty"`
+	RegisteredModelName string `json:"registeredModelName,omitempty"`
+	VersionName         string `json:"versionName,omitempty"`
+	Author              string `json:"author,omitempty"`
+}
+
+// CatalogModelImport holds the model registry entities created from a catalog model.
+type CatalogModelImport struct {
+	RegisteredModel *openapi.RegisteredModel `json:"registeredModel"`
+	ModelVersion    *openapi.ModelVersion    `json:"modelVersion"`
+	ModelArtifact   *openapi.ModelArtifact   `json:"modelArtifact"`
+}
@@ -0,0 +1,28 @@
+package models
+
+type CatalogSourceSyncChange struct {
+	Name   string `json:"name"`
+	Change string `json:"change"`
+}
+
+type CatalogSourceSync struct {
+	Id                  string                    `json:"id"`
+	SourceId            string                    `json:"sourceId"`
+	AssetType           *string                   `json:"assetType,omitempty"`
+	Status              string                    `json:"status"`
+	Error               *string                   `json:"error,omitempty"`
+	StartTimeSinceEpoch string                    `json:"startTimeSinceEpoch"`
+	EndTimeSinceEpoch   string                    `json:"endTimeSinceEpoch"`
+	Added               int32                     `json:"added"`
+	Updated             int32                     `json:"updated"`
+	Removed             int32                     `json:"removed"`
+	Changes             []CatalogSourceSyncChange `json:"changes"`
+	ChangesTruncated    *bool                     `json:"changesTruncated,omitempty"`
+}
+
+type CatalogSourceSyncList struct {
+	NextPageToken string              `json:"nextPageToken"`
+	PageSize      int32               `json:"pageSize"`
+	Size          int32               `json:"size"`
+	Items         []CatalogSourceSync `json:"items,omitempty"`
+}
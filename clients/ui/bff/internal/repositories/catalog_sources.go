@@ -20,6 +20,7 @@ type CatalogSourcesInterface interface {
 	GetCatalogFilterOptions(client httpclient.HTTPClientInterface) (*models.FilterOptionsList, error)
 	GetCatalogModelPerformanceArtifacts(client httpclient.HTTPClientInterface, sourceId string, modelName string, pageValues url.Values) (*models.CatalogModelArtifactList, error)
 	GetCatalogLabels(client httpclient.HTTPClientInterface, pageValues url.Values) (*models.CatalogLabelList, error)
+	GetCatalogSourceSyncHistory(client httpclient.HTTPClientInterface, sourceId string, pageValues url.Values) (*models.CatalogSourceSyncList, error)
 }
 
 type CatalogSources struct {
@@ -127,3 +128,22 @@ func (a CatalogSources) GetCatalogLabels(client httpclient.HTTPClientInterface,
 
 	return &labels, nil
 }
+
+func (a CatalogSources) GetCatalogSourceSyncHistory(client httpclient.HTTPClientInterface, sourceId string, pageValues url.Values) (*models.CatalogSourceSyncList, error) {
+	path, err := url.JoinPath(sourcesPath, sourceId, "sync_history")
+	if err != nil {
+		return nil, err
+	}
+	responseData, err := client.GET(UrlWithPageParams(path, pageValues))
+	if err != nil {
+		return nil, fmt.Errorf("error fetching sync history: %w", err)
+	}
+
+	var syncs models.CatalogSourceSyncList
+
+	if err := json.Unmarshal(responseData, &syncs); err != nil {
+		return nil, fmt.Errorf("error decoding response data: %w", err)
+	}
+
+	return &syncs, nil
+}
@@ -3,6 +3,7 @@ package validation
 import (
 	"errors"
 
+	"github.com/kubeflow/hub/ui/bff/internal/models"
 	"github.com/kubeflow/model-registry/pkg/openapi"
 )
 
@@ -29,3 +30,13 @@ func ValidateModelArtifact(input openapi.ModelArtifact) error {
 	// Add more field validations as required
 	return nil
 }
+
+func ValidateCatalogModelImport(input models.CatalogModelImportRequest) error {
+	if input.SourceId == "" {
+		return errors.New("sourceId cannot be empty")
+	}
+	if input.ModelName == "" {
+		return errors.New("modelName cannot be empty")
+	}
+	return nil
+}
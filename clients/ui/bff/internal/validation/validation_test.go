@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"github.com/kubeflow/hub/ui/bff/internal/models"
 	"github.com/kubeflow/model-registry/pkg/openapi"
 	"testing"
 )
@@ -55,3 +56,25 @@ func TestValidateModel(t *testing.T) {
 
 	validateTestSpecs(t, specs, ValidateModelArtifact)
 }
+
+func TestValidateCatalogModelImport(t *testing.T) {
+	specs := []testSpec[models.CatalogModelImportRequest]{
+		{
+			name:    "Empty source",
+			input:   models.CatalogModelImportRequest{ModelName: "repo1/granite-8b-code-instruct"},
+			wantErr: true,
+		},
+		{
+			name:    "Empty model name",
+			input:   models.CatalogModelImportRequest{SourceId: "sample-source"},
+			wantErr: true,
+		},
+		{
+			name:    "Valid request",
+			input:   models.CatalogModelImportRequest{SourceId: "sample-source", ModelName: "repo1/granite-8b-code-instruct"},
+			wantErr: false,
+		},
+	}
+
+	validateTestSpecs(t, specs, ValidateCatalogModelImport)
+}
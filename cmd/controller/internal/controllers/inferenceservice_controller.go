@@ -19,16 +19,14 @@ type InferenceServiceReconciler struct {
 	InferenceServiceController *infrctrl.InferenceServiceController
 }
 
-// +kubebuilder:rbac:groups=serving.kserve.io,resources=inferenceservices,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=serving.kserve.io,resources=inferenceservices,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=serving.kserve.io,resources=inferenceservices/finalizers,verbs=get;list;watch;update;create;patch;delete
 // +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
-// move the current state of the cluster closer to the desired state.
-// TODO(user): Modify the Reconcile function to compare the state specified by
-// the InferenceService object against the actual cluster state, and then
-// perform operations to make the cluster state reflect the state specified by
-// the user.
+// move the current state of the cluster closer to the desired state. It
+// mirrors the InferenceService in the model registry, the reverse direction
+// is handled by the DeploymentController.
 //
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.19.1/pkg/reconcile
@@ -0,0 +1,74 @@
+package controllers
+
+import (
+	"context"
+
+	kservev1alpha1 "github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrctrl "github.com/kubeflow/hub/pkg/inferenceservice-controller"
+)
+
+// ServingEnvironmentReconciler reconciles a Namespace object
+type ServingEnvironmentReconciler struct {
+	client.Client
+	Scheme                       *runtime.Scheme
+	ServingEnvironmentController *infrctrl.ServingEnvironmentController
+}
+
+// +kubebuilder:rbac:groups="",resources=namespaces;resourcequotas,verbs=get;list;watch
+// +kubebuilder:rbac:groups=serving.kserve.io,resources=servingruntimes;clusterservingruntimes,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state. It
+// syncs the ServingEnvironment of a labelled Namespace in the model
+// registries.
+//
+// For more details, check Reconcile and its Result here:
+// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.19.1/pkg/reconcile
+func (r *ServingEnvironmentReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	_ = log.FromContext(ctx)
+
+	return r.ServingEnvironmentController.Reconcile(ctx, req)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ServingEnvironmentReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	labelled := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return obj.GetLabels()[infrctrl.ServingEnvironmentLabel] == "true"
+	})
+	namespaceOf := handler.EnqueueRequestsFromMapFunc(func(_ context.Context, obj client.Object) []reconcile.Request {
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: obj.GetNamespace()}}}
+	})
+	allLabelled := handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, _ client.Object) []reconcile.Request {
+		nsList := &corev1.NamespaceList{}
+		if err := r.List(ctx, nsList, client.MatchingLabels{infrctrl.ServingEnvironmentLabel: "true"}); err != nil {
+			log.FromContext(ctx).Error(err, "Unable to list the labelled Namespaces")
+			return nil
+		}
+
+		requests := make([]reconcile.Request, 0, len(nsList.Items))
+		for _, ns := range nsList.Items {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: ns.Name}})
+		}
+		return requests
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.Namespace{}, builder.WithPredicates(labelled)).
+		Watches(&kservev1alpha1.ServingRuntime{}, namespaceOf).
+		Watches(&kservev1alpha1.ClusterServingRuntime{}, allLabelled).
+		Watches(&corev1.ResourceQuota{}, namespaceOf).
+		Named("servingenvironment").
+		Complete(r)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -14,10 +15,16 @@ import (
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 	"k8s.io/client-go/rest"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -26,6 +33,7 @@ import (
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
+	kservev1alpha1 "github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
 	kservev1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
 	infrctrl "github.com/kubeflow/hub/pkg/inferenceservice-controller"
 	// +kubebuilder:scaffold:imports
@@ -108,7 +116,16 @@ func main() {
 		// this setup is not recommended for production.
 	}
 
+	// The registries namespace scopes the Secret cache, so it must be known
+	// before the manager is created.
+	registriesNamespace, err := getEnvOrFail("REGISTRIES_NAMESPACE")
+	if err != nil {
+		setupLog.Error(err, "unable to start manager")
+		os.Exit(1)
+	}
+
 	utilruntime.Must(kservev1beta1.AddToScheme(scheme))
+	utilruntime.Must(kservev1alpha1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
@@ -118,6 +135,17 @@ func main() {
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "a7d60e25.kubeflow.org",
+		// The connection profile Secrets are only read in the registries
+		// namespace, where the controller is granted access to them.
+		Cache: cache.Options{
+			ByObject: map[client.Object]cache.ByObject{
+				&corev1.Secret{}: {
+					Namespaces: map[string]cache.Config{
+						registriesNamespace: {},
+					},
+				},
+			},
+		},
 		// LeaderElectionReleaseOnCancel defines if the leader should step down voluntarily
 		// when the Manager ends. This requires the binary to immediately end when the
 		// Manager is stopped, otherwise, this setting is unsafe. Setting this significantly
@@ -140,6 +168,7 @@ func main() {
 			context.Background(),
 			mgr,
 			ctrl.GetConfigOrDie(),
+			registriesNamespace,
 		)
 		if err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "InferenceService")
@@ -157,6 +186,71 @@ func main() {
 		// +kubebuilder:scaffold:builder
 	}
 
+	if os.Getenv("DEPLOYMENT_CONTROLLER") == "managed" {
+		deploymentController, err := setupDeploymentController(
+			context.Background(),
+			mgr,
+			ctrl.GetConfigOrDie(),
+			registriesNamespace,
+		)
+		if err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Deployment")
+			os.Exit(1)
+		}
+
+		if err = deploymentController.SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Deployment")
+			os.Exit(1)
+		}
+	}
+
+	if os.Getenv("SERVING_ENVIRONMENT_CONTROLLER") == "managed" {
+		servingEnvironmentController, err := setupServingEnvironmentController(
+			context.Background(),
+			mgr,
+			ctrl.GetConfigOrDie(),
+			registriesNamespace,
+		)
+		if err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ServingEnvironment")
+			os.Exit(1)
+		}
+
+		if err = (&controllers.ServingEnvironmentReconciler{
+			Client:                       mgr.GetClient(),
+			Scheme:                       mgr.GetScheme(),
+			ServingEnvironmentController: servingEnvironmentController,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ServingEnvironment")
+			os.Exit(1)
+		}
+	}
+
+	if os.Getenv("DRIFT_CONTROLLER") == "managed" {
+		driftController, err := setupDriftController(
+			context.Background(),
+			mgr,
+			ctrl.GetConfigOrDie(),
+			registriesNamespace,
+		)
+		if err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Drift")
+			os.Exit(1)
+		}
+
+		if err = driftController.SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Drift")
+			os.Exit(1)
+		}
+
+		// The dry-run report is served next to the metrics, behind the same
+		// authentication and authorization.
+		if err = mgr.AddMetricsServerExtraHandler("/drift", driftController); err != nil {
+			setupLog.Error(err, "unable to serve the drift report", "controller", "Drift")
+			os.Exit(1)
+		}
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
@@ -173,7 +267,7 @@ func main() {
 	}
 }
 
-func setupInferenceServiceController(ctx context.Context, mgr manager.Manager, cfg *rest.Config) (*infrctrl.InferenceServiceController, error) {
+func setupInferenceServiceController(ctx context.Context, mgr manager.Manager, cfg *rest.Config, registriesNamespace string) (*infrctrl.InferenceServiceController, error) {
 	namespaceLabel, err := getEnvOrFail("NAMESPACE_LABEL")
 	if err != nil {
 		return nil, err
@@ -214,16 +308,76 @@ func setupInferenceServiceController(ctx context.Context, mgr manager.Manager, c
 		return nil, err
 	}
 
-	registriesNamespace, err := getEnvOrFail("REGISTRIES_NAMESPACE")
+	skipTLSVerify := getEnvAsBool("SKIP_TLS_VERIFY", false)
+
+	return infrctrl.NewInferenceServiceController(
+		mgr.GetClient(),
+		log.FromContext(ctx).WithName("controllers").WithName("ModelRegistryInferenceService"),
+		skipTLSVerify,
+		cfg.BearerToken,
+		inferenceServiceIDLabel,
+		registeredModelIdLabel,
+		modelVersionIDLabel,
+		namespaceLabel,
+		nameLabel,
+		urlAnnotation,
+		finalizer,
+		serviceAnnotation,
+		registriesNamespace,
+	), nil
+}
+
+func setupDeploymentController(ctx context.Context, mgr manager.Manager, cfg *rest.Config, registriesNamespace string) (*infrctrl.DeploymentController, error) {
+	namespaceLabel, err := getEnvOrFail("NAMESPACE_LABEL")
+	if err != nil {
+		return nil, err
+	}
+
+	nameLabel, err := getEnvOrFail("NAME_LABEL")
+	if err != nil {
+		return nil, err
+	}
+
+	inferenceServiceIDLabel, err := getEnvOrFail("INFERENCE_SERVICE_ID_LABEL")
+	if err != nil {
+		return nil, err
+	}
+
+	modelVersionIDLabel, err := getEnvOrFail("MODEL_VERSION_ID_LABEL")
+	if err != nil {
+		return nil, err
+	}
+
+	registeredModelIdLabel, err := getEnvOrFail("REGISTERED_MODEL_ID_LABEL")
+	if err != nil {
+		return nil, err
+	}
+
+	serviceAnnotation, err := getEnvOrFail("SERVICE_ANNOTATION")
 	if err != nil {
 		return nil, err
 	}
 
 	skipTLSVerify := getEnvAsBool("SKIP_TLS_VERIFY", false)
 
-	return infrctrl.NewInferenceServiceController(
+	syncPeriod, err := getEnvAsDuration("DEPLOYMENT_SYNC_PERIOD", 30*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	undeployPolicy := infrctrl.UndeployPolicy(os.Getenv("UNDEPLOY_POLICY"))
+
+	switch undeployPolicy {
+	case "":
+		undeployPolicy = infrctrl.UndeployPolicyDelete
+	case infrctrl.UndeployPolicyDelete, infrctrl.UndeployPolicyScaleToZero:
+	default:
+		return nil, fmt.Errorf("invalid UNDEPLOY_POLICY %q, must be %q or %q", undeployPolicy, infrctrl.UndeployPolicyDelete, infrctrl.UndeployPolicyScaleToZero)
+	}
+
+	return infrctrl.NewDeploymentController(
 		mgr.GetClient(),
-		log.FromContext(ctx).WithName("controllers").WithName("ModelRegistryInferenceService"),
+		log.FromContext(ctx).WithName("controllers").WithName("ModelRegistryDeployment"),
 		skipTLSVerify,
 		cfg.BearerToken,
 		inferenceServiceIDLabel,
@@ -231,10 +385,112 @@ func setupInferenceServiceController(ctx context.Context, mgr manager.Manager, c
 		modelVersionIDLabel,
 		namespaceLabel,
 		nameLabel,
+		serviceAnnotation,
+		registriesNamespace,
+		syncPeriod,
+		undeployPolicy,
+	), nil
+}
+
+func setupServingEnvironmentController(ctx context.Context, mgr manager.Manager, cfg *rest.Config, registriesNamespace string) (*infrctrl.ServingEnvironmentController, error) {
+	serviceAnnotation, err := getEnvOrFail("SERVICE_ANNOTATION")
+	if err != nil {
+		return nil, err
+	}
+
+	skipTLSVerify := getEnvAsBool("SKIP_TLS_VERIFY", false)
+
+	syncPeriod, err := getEnvAsDuration("SERVING_ENVIRONMENT_SYNC_PERIOD", 5*time.Minute)
+	if err != nil {
+		return nil, err
+	}
+
+	// Clusters have no ID, the UID of the kube-system namespace is the usual
+	// stand-in as it lives as long as the cluster.
+	clusterID := os.Getenv("CLUSTER_ID")
+	if clusterID == "" {
+		kubeSystem := &corev1.Namespace{}
+		if err := mgr.GetAPIReader().Get(ctx, types.NamespacedName{Name: metav1.NamespaceSystem}, kubeSystem); err != nil {
+			return nil, fmt.Errorf("unable to read the cluster ID from the %s namespace, set CLUSTER_ID: %w", metav1.NamespaceSystem, err)
+		}
+
+		clusterID = string(kubeSystem.UID)
+	}
+
+	return infrctrl.NewServingEnvironmentController(
+		mgr.GetClient(),
+		log.FromContext(ctx).WithName("controllers").WithName("ModelRegistryServingEnvironment"),
+		skipTLSVerify,
+		cfg.BearerToken,
+		clusterID,
+		serviceAnnotation,
+		registriesNamespace,
+		syncPeriod,
+	), nil
+}
+
+func setupDriftController(ctx context.Context, mgr manager.Manager, cfg *rest.Config, registriesNamespace string) (*infrctrl.DriftController, error) {
+	namespaceLabel, err := getEnvOrFail("NAMESPACE_LABEL")
+	if err != nil {
+		return nil, err
+	}
+
+	nameLabel, err := getEnvOrFail("NAME_LABEL")
+	if err != nil {
+		return nil, err
+	}
+
+	urlAnnotation, err := getEnvOrFail("URL_ANNOTATION")
+	if err != nil {
+		return nil, err
+	}
+
+	inferenceServiceIDLabel, err := getEnvOrFail("INFERENCE_SERVICE_ID_LABEL")
+	if err != nil {
+		return nil, err
+	}
+
+	registeredModelIdLabel, err := getEnvOrFail("REGISTERED_MODEL_ID_LABEL")
+	if err != nil {
+		return nil, err
+	}
+
+	serviceAnnotation, err := getEnvOrFail("SERVICE_ANNOTATION")
+	if err != nil {
+		return nil, err
+	}
+
+	skipTLSVerify := getEnvAsBool("SKIP_TLS_VERIFY", false)
+
+	syncPeriod, err := getEnvAsDuration("DRIFT_SYNC_PERIOD", 10*time.Minute)
+	if err != nil {
+		return nil, err
+	}
+
+	driftPolicy := infrctrl.DriftPolicy(os.Getenv("DRIFT_POLICY"))
+
+	switch driftPolicy {
+	case "":
+		driftPolicy = infrctrl.DriftPolicyReport
+	case infrctrl.DriftPolicyReport, infrctrl.DriftPolicyFix:
+	default:
+		return nil, fmt.Errorf("invalid DRIFT_POLICY %q, must be %q or %q", driftPolicy, infrctrl.DriftPolicyReport, infrctrl.DriftPolicyFix)
+	}
+
+	return infrctrl.NewDriftController(
+		mgr.GetClient(),
+		log.FromContext(ctx).WithName("controllers").WithName("ModelRegistryDrift"),
+		skipTLSVerify,
+		cfg.BearerToken,
+		inferenceServiceIDLabel,
+		registeredModelIdLabel,
+		namespaceLabel,
+		nameLabel,
 		urlAnnotation,
-		finalizer,
 		serviceAnnotation,
 		registriesNamespace,
+		syncPeriod,
+		driftPolicy,
 	), nil
 }
 
@@ -257,3 +513,18 @@ func getEnvAsBool(name string, defaultValue bool) bool {
 
 	return defaultValue
 }
+
+func getEnvAsDuration(name string, defaultValue time.Duration) (time.Duration, error) {
+	valStr := os.Getenv(name)
+
+	if valStr == "" {
+		return defaultValue, nil
+	}
+
+	val, err := time.ParseDuration(valStr)
+	if err != nil {
+		return 0, fmt.Errorf("environment variable %s is not a valid duration: %w", name, err)
+	}
+
+	return val, nil
+}
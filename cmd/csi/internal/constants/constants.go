@@ -2,4 +2,17 @@ package constants
 
 import kserve "github.com/kserve/kserve/pkg/agent/storage"
 
-const MR kserve.Protocol = "model-registry://"
+const (
+	MR  kserve.Protocol = "model-registry://"
+	OCI kserve.Protocol = "oci://"
+	HF  kserve.Protocol = "hf://"
+	PVC kserve.Protocol = "pvc://"
+)
+
+// Annotations recording on the KServe InferenceService the model registry
+// entities its model-registry storage URI resolved to.
+const (
+	RegisteredModelIDAnnotation = "modelregistry.kubeflow.org/resolved-registered-model-id"
+	ModelVersionIDAnnotation    = "modelregistry.kubeflow.org/resolved-model-version-id"
+	ModelArtifactIDAnnotation   = "modelregistry.kubeflow.org/resolved-model-artifact-id"
+)
@@ -0,0 +1,156 @@
+package inferenceservice
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	inferenceServiceNameEnv      = "INFERENCE_SERVICE_NAME"
+	inferenceServiceNamespaceEnv = "INFERENCE_SERVICE_NAMESPACE"
+	kubernetesServiceHostEnv     = "KUBERNETES_SERVICE_HOST"
+	kubernetesServicePortEnv     = "KUBERNETES_SERVICE_PORT"
+	serviceAccountDir            = "/var/run/secrets/kubernetes.io/serviceaccount"
+)
+
+var ErrRecordingAnnotations = errors.New("error recording annotations on InferenceService")
+
+// Recorder records annotations on the KServe InferenceService whose model is
+// downloaded by the storage initializer, through the Kubernetes API.
+type Recorder struct {
+	Client *http.Client
+	// Host is the URL of the Kubernetes API server.
+	Host      string
+	Token     string
+	Namespace string
+	Name      string
+}
+
+// NewRecorderFromEnv returns a Recorder of the InferenceService named by the
+// INFERENCE_SERVICE_NAME and INFERENCE_SERVICE_NAMESPACE env variables,
+// authenticated with the service account of the pod, or nil if they aren't
+// set.
+func NewRecorderFromEnv() (*Recorder, error) {
+	name := os.Getenv(inferenceServiceNameEnv)
+	namespace := os.Getenv(inferenceServiceNamespaceEnv)
+
+	if name == "" || namespace == "" {
+		return nil, nil
+	}
+
+	host, port := os.Getenv(kubernetesServiceHostEnv), os.Getenv(kubernetesServicePortEnv)
+	if host == "" || port == "" {
+		return nil, fmt.Errorf("%w: not running in a cluster, %s and %s are not set", ErrRecordingAnnotations, kubernetesServiceHostEnv, kubernetesServicePortEnv)
+	}
+
+	token, err := os.ReadFile(filepath.Join(serviceAccountDir, "token"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRecordingAnnotations, err)
+	}
+
+	ca, err := os.ReadFile(filepath.Join(serviceAccountDir, "ca.crt"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRecordingAnnotations, err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("%w: no valid certificate in the service account CA", ErrRecordingAnnotations)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+
+	return &Recorder{
+		Client:    &http.Client{Transport: transport},
+		Host:      "https://" + net.JoinHostPort(host, port),
+		Token:     strings.TrimSpace(string(token)),
+		Namespace: namespace,
+		Name:      name,
+	}, nil
+}
+
+// inferenceServiceMetadata is the part of an InferenceService the Recorder
+// reads and patches.
+type inferenceServiceMetadata struct {
+	Metadata struct {
+		Annotations map[string]string `json:"annotations"`
+	} `json:"metadata"`
+}
+
+// Record sets annotations on the InferenceService, unless it already has
+// them, so that it's not updated again by the next pods.
+func (r *Recorder) Record(ctx context.Context, annotations map[string]string) error {
+	current := inferenceServiceMetadata{}
+	if err := r.do(ctx, http.MethodGet, nil, &current); err != nil {
+		return err
+	}
+
+	changed := false
+	for key, value := range annotations {
+		if current.Metadata.Annotations[key] != value {
+			changed = true
+			break
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	patch := inferenceServiceMetadata{}
+	patch.Metadata.Annotations = maps.Clone(annotations)
+
+	body, err := json.Marshal(patch)
+	if err != nil {
+		return err
+	}
+
+	return r.do(ctx, http.MethodPatch, body, nil)
+}
+
+func (r *Recorder) do(ctx context.Context, method string, body []byte, result any) error {
+	target := fmt.Sprintf("%s/apis/serving.kserve.io/v1beta1/namespaces/%s/inferenceservices/%s",
+		strings.TrimSuffix(r.Host, "/"), url.PathEscape(r.Namespace), url.PathEscape(r.Name))
+
+	req, err := http.NewRequestWithContext(ctx, method, target, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/merge-patch+json")
+	}
+	if r.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+r.Token)
+	}
+
+	resp, err := r.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w %s/%s: %w", ErrRecordingAnnotations, r.Namespace, r.Name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w %s/%s: %s %s returned a %d response code", ErrRecordingAnnotations, r.Namespace, r.Name, method, target, resp.StatusCode)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	return json.NewDecoder(resp.Body).Decode(result)
+}
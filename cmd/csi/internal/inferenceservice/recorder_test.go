@@ -0,0 +1,82 @@
+package inferenceservice
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorderRecord(t *testing.T) {
+	annotations := map[string]string{"kept": "value"}
+	patches := []string{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/apis/serving.kserve.io/v1beta1/namespaces/kserve-test/inferenceservices/iris", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer sa-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			isvc := inferenceServiceMetadata{}
+			isvc.Metadata.Annotations = annotations
+			require.NoError(t, json.NewEncoder(w).Encode(isvc))
+		case http.MethodPatch:
+			assert.Equal(t, "application/merge-patch+json", r.Header.Get("Content-Type"))
+
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			patches = append(patches, string(body))
+
+			patch := inferenceServiceMetadata{}
+			require.NoError(t, json.Unmarshal(body, &patch))
+			for key, value := range patch.Metadata.Annotations {
+				annotations[key] = value
+			}
+
+			w.Write([]byte("{}"))
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	recorder := &Recorder{
+		Client:    server.Client(),
+		Host:      server.URL,
+		Token:     "sa-token",
+		Namespace: "kserve-test",
+		Name:      "iris",
+	}
+
+	resolved := map[string]string{"modelregistry.kubeflow.org/resolved-model-version-id": "2"}
+
+	require.NoError(t, recorder.Record(context.Background(), resolved))
+	assert.Equal(t, []string{`{"metadata":{"annotations":{"modelregistry.kubeflow.org/resolved-model-version-id":"2"}}}`}, patches)
+	assert.Equal(t, map[string]string{"kept": "value", "modelregistry.kubeflow.org/resolved-model-version-id": "2"}, annotations)
+
+	// The InferenceService isn't patched again when already annotated.
+	require.NoError(t, recorder.Record(context.Background(), resolved))
+	assert.Len(t, patches, 1)
+
+	recorder.Name = "missing"
+	assert.ErrorIs(t, recorder.Record(context.Background(), resolved), ErrRecordingAnnotations)
+}
+
+func TestNewRecorderFromEnvNotConfigured(t *testing.T) {
+	t.Setenv(inferenceServiceNameEnv, "")
+	t.Setenv(inferenceServiceNamespaceEnv, "kserve-test")
+
+	recorder, err := NewRecorderFromEnv()
+	assert.NoError(t, err)
+	assert.Nil(t, recorder)
+}
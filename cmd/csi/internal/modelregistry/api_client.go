@@ -16,9 +16,12 @@ import (
 func NewAPIClient(cfg *openapi.Configuration, storageUri string, serviceName string, clusterDomain string) *openapi.APIClient {
 	client := openapi.NewAPIClient(cfg)
 
-	u, err := url.Parse(storageUri)
+	// The URI isn't parsed as a whole, as its path may hold semver ranges
+	_, rawQuery, _ := strings.Cut(storageUri, "?")
+
+	query, err := url.ParseQuery(rawQuery)
 	if err == nil {
-		ns := u.Query().Get("namespace")
+		ns := query.Get("namespace")
 		if ns != "" {
 			if !regexp.MustCompile(`^[a-zA-Z0-9_-]+$`).MatchString(ns) {
 				log.Printf("Invalid namespace parameter: %s", ns)
@@ -46,7 +49,7 @@ func NewAPIClient(cfg *openapi.Configuration, storageUri string, serviceName str
 	}
 
 	// Parse the URI to retrieve the needed information to query model registry (modelArtifact)
-	mrUri := strings.TrimPrefix(storageUri, string(constants.MR))
+	mrUri, _, _ := strings.Cut(strings.TrimPrefix(storageUri, string(constants.MR)), "?")
 
 	tokens := strings.SplitN(mrUri, "/", 3)
 
@@ -0,0 +1,208 @@
+package storage
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"log"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/kubeflow/hub/pkg/openapi"
+	"github.com/kubeflow/hub/pkg/openapi/digests"
+)
+
+const (
+	// ChecksumProperty is the custom property of a ModelArtifact recording
+	// the checksum of its only file, like sha256:{hex}.
+	ChecksumProperty = "checksum"
+	// ChecksumsProperty is the custom property of a ModelArtifact recording
+	// the checksums of its files, as a JSON object mapping their paths in the
+	// model directory to their checksums.
+	ChecksumsProperty = "checksums"
+)
+
+var (
+	ErrInvalidChecksum  = errors.New("invalid checksum recorded on model artifact")
+	ErrChecksumMismatch = errors.New("checksum mismatch")
+)
+
+// verifyChecksums verifies the files downloaded to modelDir against the
+// digests recorded on their ModelArtifact or, for artifacts registered without
+// them, the checksums recorded in its custom properties, if any.
+func verifyChecksums(modelDir string, artifact *openapi.ModelArtifact) error {
+	if digests.Recorded(artifact) {
+		return verifyDigests(modelDir, artifact)
+	}
+
+	checksums, err := artifactChecksums(modelDir, artifact)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(checksums)) {
+		log.Printf("Verifying checksum: file=%s, checksum=%s", name, checksums[name])
+
+		if err := verifyChecksum(modelDir, name, checksums[name]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func artifactChecksums(modelDir string, artifact *openapi.ModelArtifact) (map[string]string, error) {
+	properties := artifact.GetCustomProperties()
+	checksums := map[string]string{}
+
+	if value, ok := properties[ChecksumsProperty]; ok {
+		if value.MetadataStringValue == nil {
+			return nil, fmt.Errorf("%w: %s must be a string", ErrInvalidChecksum, ChecksumsProperty)
+		}
+
+		if err := json.Unmarshal([]byte(value.MetadataStringValue.StringValue), &checksums); err != nil {
+			return nil, fmt.Errorf("%w: %s must be a JSON object of checksums by path: %w", ErrInvalidChecksum, ChecksumsProperty, err)
+		}
+	}
+
+	if value, ok := properties[ChecksumProperty]; ok {
+		if value.MetadataStringValue == nil {
+			return nil, fmt.Errorf("%w: %s must be a string", ErrInvalidChecksum, ChecksumProperty)
+		}
+
+		files, err := listFiles(modelDir)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(files) != 1 {
+			return nil, fmt.Errorf("%w: %s is recorded for a single file but %d were downloaded, use %s instead", ErrInvalidChecksum, ChecksumProperty, len(files), ChecksumsProperty)
+		}
+
+		checksums[files[0]] = value.MetadataStringValue.StringValue
+	}
+
+	return checksums, nil
+}
+
+// verifyDigests verifies the files downloaded to modelDir against the digests
+// recorded on artifact, hashing each file with the algorithm of its recorded
+// digest, or sha256 for the files missing from its manifest.
+func verifyDigests(modelDir string, artifact *openapi.ModelArtifact) error {
+	files, err := listFiles(modelDir)
+	if err != nil {
+		return err
+	}
+
+	algorithms := map[string]openapi.ArtifactDigestAlgorithm{}
+	for _, digest := range artifact.FileDigests {
+		algorithms[digest.Path] = digest.Algorithm
+	}
+
+	manifest := make([]openapi.ArtifactFileDigest, 0, len(files))
+	for _, name := range files {
+		algorithm, ok := algorithms[name]
+		switch {
+		case ok:
+		case len(artifact.FileDigests) == 0:
+			algorithm = artifact.Digest.Algorithm
+		default:
+			algorithm = openapi.ARTIFACTDIGESTALGORITHM_SHA256
+		}
+
+		log.Printf("Verifying digest: file=%s, algorithm=%s", name, algorithm)
+
+		value, err := fileChecksum(modelDir, name, string(algorithm))
+		if err != nil {
+			return err
+		}
+
+		manifest = append(manifest, *openapi.NewArtifactFileDigest(name, algorithm, value))
+	}
+
+	if err := digests.Verify(artifact, manifest); err != nil {
+		return fmt.Errorf("%w: %w", ErrChecksumMismatch, err)
+	}
+
+	return nil
+}
+
+// verifyChecksum verifies the slash separated path name relative to modelDir
+// against a checksum like {algorithm}:{hex}.
+func verifyChecksum(modelDir string, name string, checksum string) error {
+	algorithm, expected, _ := strings.Cut(checksum, ":")
+
+	actual, err := fileChecksum(modelDir, name, algorithm)
+	if err != nil {
+		return err
+	}
+
+	if !strings.EqualFold(actual, expected) {
+		return fmt.Errorf("%w for %s: expected %s, got %s:%s", ErrChecksumMismatch, name, checksum, strings.ToLower(algorithm), actual)
+	}
+
+	return nil
+}
+
+// fileChecksum returns the hex encoded checksum of the slash separated path
+// name relative to modelDir, computed with algorithm, sha256 or sha512.
+func fileChecksum(modelDir string, name string, algorithm string) (string, error) {
+	var h hash.Hash
+
+	switch strings.ToLower(algorithm) {
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	default:
+		return "", fmt.Errorf("%w: unsupported %q checksum of %s, use sha256:{hex} or sha512:{hex}", ErrInvalidChecksum, algorithm, name)
+	}
+
+	path, err := joinPath(modelDir, name)
+	if err != nil {
+		return "", err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to verify the checksum of %s: %w", name, err)
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(h, file); err != nil {
+		return "", fmt.Errorf("unable to verify the checksum of %s: %w", name, err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// listFiles returns the slash separated paths of the files in dir.
+func listFiles(dir string) ([]string, error) {
+	files := []string{}
+
+	err := filepath.WalkDir(dir, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil || !entry.Type().IsRegular() {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, file)
+		if err != nil {
+			return err
+		}
+
+		files = append(files, filepath.ToSlash(rel))
+
+		return nil
+	})
+
+	return files, err
+}
@@ -0,0 +1,154 @@
+package storage
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/kubeflow/hub/pkg/openapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyChecksums(t *testing.T) {
+	// configSha is the checksum of "{}".
+	const configSha = "sha256:44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a"
+
+	stringValue := func(value string) openapi.MetadataValue {
+		return openapi.MetadataStringValueAsMetadataValue(openapi.NewMetadataStringValue(value, "MetadataStringValue"))
+	}
+
+	tests := []struct {
+		name             string
+		files            map[string]string
+		customProperties map[string]openapi.MetadataValue
+		digest           *openapi.ArtifactDigest
+		fileDigests      []openapi.ArtifactFileDigest
+		expectError      bool
+		expectedError    error
+	}{
+		{
+			name:  "no checksum",
+			files: map[string]string{"model.onnx": "onnx"},
+		},
+		{
+			name:             "checksum of the file",
+			files:            map[string]string{"model.onnx": "{}"},
+			customProperties: map[string]openapi.MetadataValue{ChecksumProperty: stringValue(configSha)},
+		},
+		{
+			name:  "sha512 checksum of the file",
+			files: map[string]string{"config.json": "{}"},
+			customProperties: map[string]openapi.MetadataValue{
+				ChecksumProperty: stringValue("sha512:27C74670ADB75075FAD058D5CEAF7B20C4E7786C83BAE8A32F626F9782AF34C9A33C2046EF60FD2A7878D378E29FEC851806BBD9A67878F3A9F1CDA4830763FD"),
+			},
+		},
+		{
+			name:             "checksum mismatch",
+			files:            map[string]string{"model.onnx": "onnx"},
+			customProperties: map[string]openapi.MetadataValue{ChecksumProperty: stringValue(configSha)},
+			expectedError:    ErrChecksumMismatch,
+		},
+		{
+			name:             "checksum of several files",
+			files:            map[string]string{"model.onnx": "onnx", "config.json": "{}"},
+			customProperties: map[string]openapi.MetadataValue{ChecksumProperty: stringValue(configSha)},
+			expectedError:    ErrInvalidChecksum,
+		},
+		{
+			name:  "checksums of the files",
+			files: map[string]string{"model.onnx": "onnx", "1/config.json": "{}"},
+			customProperties: map[string]openapi.MetadataValue{
+				ChecksumsProperty: stringValue(`{"1/config.json":"` + configSha + `"}`),
+			},
+		},
+		{
+			name:  "checksums of a missing file",
+			files: map[string]string{"model.onnx": "onnx"},
+			customProperties: map[string]openapi.MetadataValue{
+				ChecksumsProperty: stringValue(`{"config.json":"` + configSha + `"}`),
+			},
+			expectError: true,
+		},
+		{
+			name:  "invalid checksums",
+			files: map[string]string{"model.onnx": "onnx"},
+			customProperties: map[string]openapi.MetadataValue{
+				ChecksumsProperty: stringValue(`["` + configSha + `"]`),
+			},
+			expectedError: ErrInvalidChecksum,
+		},
+		{
+			name:             "unsupported algorithm",
+			files:            map[string]string{"model.onnx": "onnx"},
+			customProperties: map[string]openapi.MetadataValue{ChecksumProperty: stringValue("md5:abcd")},
+			expectedError:    ErrInvalidChecksum,
+		},
+		{
+			name:   "digest of the file",
+			files:  map[string]string{"model.onnx": "{}"},
+			digest: openapi.NewArtifactDigest(openapi.ARTIFACTDIGESTALGORITHM_SHA256, configSha[len("sha256:"):]),
+		},
+		{
+			name:          "digest mismatch",
+			files:         map[string]string{"model.onnx": "onnx"},
+			digest:        openapi.NewArtifactDigest(openapi.ARTIFACTDIGESTALGORITHM_SHA256, configSha[len("sha256:"):]),
+			expectedError: ErrChecksumMismatch,
+		},
+		{
+			name:          "digest of several files",
+			files:         map[string]string{"model.onnx": "onnx", "config.json": "{}"},
+			digest:        openapi.NewArtifactDigest(openapi.ARTIFACTDIGESTALGORITHM_SHA256, configSha[len("sha256:"):]),
+			expectedError: ErrChecksumMismatch,
+		},
+		{
+			name:  "file digests of the files",
+			files: map[string]string{"1/config.json": "{}"},
+			fileDigests: []openapi.ArtifactFileDigest{
+				*openapi.NewArtifactFileDigest("1/config.json", openapi.ARTIFACTDIGESTALGORITHM_SHA256, configSha[len("sha256:"):]),
+			},
+			// The digests take precedence over the checksums custom property.
+			customProperties: map[string]openapi.MetadataValue{ChecksumProperty: stringValue("md5:abcd")},
+		},
+		{
+			name:  "file digests missing a downloaded file",
+			files: map[string]string{"model.onnx": "onnx", "config.json": "{}"},
+			fileDigests: []openapi.ArtifactFileDigest{
+				*openapi.NewArtifactFileDigest("config.json", openapi.ARTIFACTDIGESTALGORITHM_SHA256, configSha[len("sha256:"):]),
+			},
+			expectedError: ErrChecksumMismatch,
+		},
+		{
+			name:  "file digests mismatch",
+			files: map[string]string{"config.json": "[]"},
+			fileDigests: []openapi.ArtifactFileDigest{
+				*openapi.NewArtifactFileDigest("config.json", openapi.ARTIFACTDIGESTALGORITHM_SHA256, configSha[len("sha256:"):]),
+			},
+			expectedError: ErrChecksumMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			modelDir := t.TempDir()
+			for name, content := range tt.files {
+				writeTestFile(t, filepath.Join(modelDir, filepath.FromSlash(name)), content)
+			}
+
+			artifact := openapi.NewModelArtifactWithDefaults()
+			if tt.customProperties != nil {
+				artifact.SetCustomProperties(tt.customProperties)
+			}
+			artifact.Digest = tt.digest
+			artifact.FileDigests = tt.fileDigests
+
+			err := verifyChecksums(modelDir, artifact)
+			switch {
+			case tt.expectedError != nil:
+				assert.ErrorIs(t, err, tt.expectedError)
+			case tt.expectError:
+				assert.Error(t, err)
+			default:
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
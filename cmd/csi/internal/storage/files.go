@@ -0,0 +1,60 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	kserve "github.com/kserve/kserve/pkg/agent/storage"
+)
+
+var ErrPathOutsideModelDir = errors.New("path is outside of the model directory")
+
+// joinPath joins the slash separated path name to dir, refusing paths that
+// escape dir.
+func joinPath(dir string, name string) (string, error) {
+	path := filepath.Join(dir, filepath.FromSlash(name))
+
+	rel, err := filepath.Rel(dir, path)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%w: %s", ErrPathOutsideModelDir, name)
+	}
+
+	return path, nil
+}
+
+// writeFile writes the content of r to the slash separated path name relative
+// to modelDir.
+func writeFile(modelDir string, name string, r io.Reader) error {
+	path, err := joinPath(modelDir, name)
+	if err != nil {
+		return err
+	}
+
+	file, err := kserve.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(file, r); err != nil {
+		file.Close()
+		return fmt.Errorf("unable to write %s: %w", path, err)
+	}
+
+	return file.Close()
+}
+
+// copyFile copies the file at src to the slash separated path name relative to
+// modelDir.
+func copyFile(modelDir string, name string, src string) error {
+	file, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return writeFile(modelDir, name, file)
+}
@@ -0,0 +1,149 @@
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	kserve "github.com/kserve/kserve/pkg/agent/storage"
+	"github.com/kubeflow/hub/cmd/csi/internal/constants"
+)
+
+const (
+	hfEndpointEnv     = "HF_ENDPOINT"
+	hfTokenEnv        = "HF_TOKEN"
+	hfEndpointDefault = "https://huggingface.co"
+	hfRevisionDefault = "main"
+)
+
+var (
+	_               kserve.Provider = (*HFProvider)(nil)
+	ErrInvalidHFURI                 = errors.New("invalid Hugging Face URI, use like hf://{repoId}@{revision}")
+	ErrHFRequest                    = errors.New("error querying Hugging Face")
+)
+
+// HFProvider downloads the files of Hugging Face model repositories.
+type HFProvider struct {
+	Client *http.Client
+	// Endpoint is the base URL of the Hugging Face Hub, or of a mirror of it.
+	Endpoint string
+	Token    string
+}
+
+func NewHFProvider() *HFProvider {
+	endpoint, ok := os.LookupEnv(hfEndpointEnv)
+	if !ok || endpoint == "" {
+		endpoint = hfEndpointDefault
+	}
+
+	return &HFProvider{
+		Client:   http.DefaultClient,
+		Endpoint: endpoint,
+		Token:    os.Getenv(hfTokenEnv),
+	}
+}
+
+// hfModelInfo is the part of the model info returned by the Hugging Face Hub
+// API that's needed to download a repository.
+type hfModelInfo struct {
+	Sha      string `json:"sha"`
+	Siblings []struct {
+		RFilename string `json:"rfilename"`
+	} `json:"siblings"`
+}
+
+// storageUri formatted like hf://{repoId}@{revision}, where the revision is a
+// branch, a tag or a commit and defaults to main. The revision is resolved to
+// its commit first, so that all the files come from the same one.
+func (p *HFProvider) DownloadModel(modelDir string, modelName string, storageUri string) error {
+	repoID, revision, err := parseHFURI(storageUri)
+	if err != nil {
+		return err
+	}
+
+	info := hfModelInfo{}
+	infoURL := fmt.Sprintf("%s/api/models/%s/revision/%s", strings.TrimSuffix(p.Endpoint, "/"), repoID, url.PathEscape(revision))
+
+	if err := p.get(infoURL, func(body io.Reader) error {
+		return json.NewDecoder(body).Decode(&info)
+	}); err != nil {
+		return err
+	}
+
+	if info.Sha == "" {
+		return fmt.Errorf("%w: no commit found for %s@%s", ErrHFRequest, repoID, revision)
+	}
+
+	modelDir = filepath.Join(modelDir, modelName)
+
+	log.Printf("Downloading Hugging Face repository: repoId=%s, revision=%s, commit=%s, files=%d", repoID, revision, info.Sha, len(info.Siblings))
+
+	for _, sibling := range info.Siblings {
+		fileURL := fmt.Sprintf("%s/%s/resolve/%s/%s", strings.TrimSuffix(p.Endpoint, "/"), repoID, info.Sha, escapePath(sibling.RFilename))
+
+		if err := p.get(fileURL, func(body io.Reader) error {
+			return writeFile(modelDir, sibling.RFilename, body)
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *HFProvider) get(rawURL string, read func(io.Reader) error) error {
+	req, err := http.NewRequest(http.MethodGet, rawURL, nil)
+	if err != nil {
+		return err
+	}
+
+	if p.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+p.Token)
+	}
+
+	resp, err := p.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrHFRequest, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: %s returned a %d response code", ErrHFRequest, rawURL, resp.StatusCode)
+	}
+
+	return read(resp.Body)
+}
+
+func parseHFURI(storageUri string) (string, string, error) {
+	repoID, revision, found := strings.Cut(strings.TrimPrefix(storageUri, string(constants.HF)), "@")
+	if !found {
+		revision = hfRevisionDefault
+	}
+
+	if repoID == "" || revision == "" || strings.Count(repoID, "/") > 1 || strings.HasPrefix(repoID, "/") || strings.HasSuffix(repoID, "/") {
+		return "", "", fmt.Errorf("%w: %s", ErrInvalidHFURI, storageUri)
+	}
+
+	return repoID, revision, nil
+}
+
+// escapePath escapes each segment of a slash separated path.
+func escapePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	return strings.Join(segments, "/")
+}
+
+func (p *HFProvider) UploadObject(bucket string, key string, object []byte) error {
+	return fmt.Errorf("uploading objects is not supported when using the Hugging Face protocol")
+}
@@ -0,0 +1,127 @@
+package storage
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHFURI(t *testing.T) {
+	tests := []struct {
+		name             string
+		storageUri       string
+		expectedRepoID   string
+		expectedRevision string
+		expectError      bool
+	}{
+		{
+			name:             "repository",
+			storageUri:       "hf://org/model",
+			expectedRepoID:   "org/model",
+			expectedRevision: "main",
+		},
+		{
+			name:             "repository at a revision",
+			storageUri:       "hf://org/model@v1.0",
+			expectedRepoID:   "org/model",
+			expectedRevision: "v1.0",
+		},
+		{
+			name:             "repository without namespace",
+			storageUri:       "hf://gpt2@e7da7f2",
+			expectedRepoID:   "gpt2",
+			expectedRevision: "e7da7f2",
+		},
+		{
+			name:        "empty revision",
+			storageUri:  "hf://org/model@",
+			expectError: true,
+		},
+		{
+			name:        "path in repository",
+			storageUri:  "hf://org/model/file",
+			expectError: true,
+		},
+		{
+			name:        "no repository",
+			storageUri:  "hf://",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoID, revision, err := parseHFURI(tt.storageUri)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedRepoID, repoID)
+			assert.Equal(t, tt.expectedRevision, revision)
+		})
+	}
+}
+
+func TestHFProviderDownloadModel(t *testing.T) {
+	const commit = "0123456789abcdef"
+
+	files := map[string]string{
+		"config.json":             "{}",
+		"onnx/model weights.onnx": "onnx",
+	}
+
+	var authorizations []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/models/org/model/revision/{revision}", func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+
+		if r.PathValue("revision") != "v1" {
+			http.NotFound(w, r)
+			return
+		}
+
+		fmt.Fprintf(w, `{"id":"org/model","sha":%q,"siblings":[{"rfilename":"config.json"},{"rfilename":"onnx/model weights.onnx"}]}`, commit)
+	})
+	mux.HandleFunc("GET /org/model/resolve/{revision}/{file...}", func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+
+		content, ok := files[r.PathValue("file")]
+		if r.PathValue("revision") != commit || !ok {
+			http.NotFound(w, r)
+			return
+		}
+
+		fmt.Fprint(w, content)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	provider := &HFProvider{
+		Client:   server.Client(),
+		Endpoint: server.URL,
+		Token:    "hf_token",
+	}
+
+	t.Run("download at the commit of the revision", func(t *testing.T) {
+		authorizations = nil
+		modelDir := t.TempDir()
+
+		require.NoError(t, provider.DownloadModel(modelDir, "", "hf://org/model@v1"))
+
+		assertFiles(t, modelDir, files)
+		assert.Equal(t, []string{"Bearer hf_token", "Bearer hf_token", "Bearer hf_token"}, authorizations)
+	})
+
+	t.Run("unknown revision", func(t *testing.T) {
+		err := provider.DownloadModel(t.TempDir(), "", "hf://org/model@v2")
+		assert.ErrorIs(t, err, ErrHFRequest)
+	})
+}
@@ -5,7 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/url"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 
 	kserve "github.com/kserve/kserve/pkg/agent/storage"
@@ -25,21 +28,69 @@ var (
 	ErrProtocolNotSupported                   = errors.New("protocol not supported for storageUri")
 	ErrFetchingModelVersion                   = errors.New("error fetching model version")
 	ErrFetchingModelVersions                  = errors.New("error fetching model versions")
+	ErrInvalidVersionSelector                 = errors.New("invalid model version selector")
+	ErrNoVersionMatching                      = errors.New("no model version matching the selector of registered model")
 )
 
+// supportedProtocols are the protocols of the model artifact URIs, the KServe
+// ones and the ones of the providers of this package.
+var supportedProtocols = slices.Concat(kserve.SupportedProtocols, []kserve.Protocol{constants.OCI, constants.HF, constants.PVC})
+
 type ModelRegistryProvider struct {
 	Client    *openapi.APIClient
 	Providers map[kserve.Protocol]kserve.Provider
+	// Resolution is the model registry entities the last downloaded URI
+	// resolved to.
+	Resolution *Resolution
+}
+
+// modelSelector selects the model artifact to download from a model-registry
+// URI.
+type modelSelector struct {
+	registeredModelName string
+	// versionName selects a model version by name, otherwise the newest
+	// version matching the other selectors is.
+	versionName *string
+	// versionRange selects the highest version whose name is a semantic
+	// version in the range.
+	versionRange string
+	state        string
+	filterQuery  string
+	// artifactName selects a model artifact of the version by name, otherwise
+	// the newest one is.
+	artifactName string
+}
+
+// Resolution is the model registry entities a model-registry URI resolved to.
+type Resolution struct {
+	RegisteredModelId string
+	ModelVersionId    string
+	ModelArtifactId   string
+}
+
+// Annotations returns the annotations recording the resolution on the KServe
+// InferenceService.
+func (r *Resolution) Annotations() map[string]string {
+	return map[string]string{
+		constants.RegisteredModelIDAnnotation: r.RegisteredModelId,
+		constants.ModelVersionIDAnnotation:    r.ModelVersionId,
+		constants.ModelArtifactIDAnnotation:   r.ModelArtifactId,
+	}
 }
 
 func NewModelRegistryProvider(client *openapi.APIClient) (*ModelRegistryProvider, error) {
 	return &ModelRegistryProvider{
-		Client:    client,
-		Providers: map[kserve.Protocol]kserve.Provider{},
+		Client: client,
+		Providers: map[kserve.Protocol]kserve.Provider{
+			constants.OCI: NewOCIProvider(),
+			constants.HF:  NewHFProvider(),
+			constants.PVC: NewPVCProvider(),
+		},
 	}, nil
 }
 
-// storageUri formatted like model-registry://{modelRegistryUrl}/{registeredModelName}/{versionName}
+// storageUri formatted like model-registry://{modelRegistryUrl}/{registeredModelName}/{versionName},
+// see parseModelVersion for the selectors.
 func (p *ModelRegistryProvider) DownloadModel(modelDir string, modelName string, storageUri string) error {
 	log.Printf("Download model indexed in model registry: modelName=%s, storageUri=%s, modelDir=%s",
 		modelName,
@@ -47,54 +98,46 @@ func (p *ModelRegistryProvider) DownloadModel(modelDir string, modelName string,
 		modelDir,
 	)
 
-	registeredModelName, versionName, err := p.parseModelVersion(storageUri)
+	selector, err := p.parseModelVersion(storageUri)
 	if err != nil {
 		return err
 	}
 
-	log.Printf("Parsed storageUri=%s as: modelRegistryUrl=%s, registeredModelName=%s, versionName=%v",
+	log.Printf("Parsed storageUri=%s as: modelRegistryUrl=%s, registeredModelName=%s, versionName=%v, versionRange=%s, state=%s, filterQuery=%s, artifactName=%s",
 		storageUri,
 		p.Client.GetConfig().Host,
-		registeredModelName,
-		safeString(versionName),
+		selector.registeredModelName,
+		safeString(selector.versionName),
+		selector.versionRange,
+		selector.state,
+		selector.filterQuery,
+		selector.artifactName,
 	)
 
-	log.Printf("Fetching model: registeredModelName=%s, versionName=%v", registeredModelName, safeString(versionName))
+	log.Printf("Fetching model: registeredModelName=%s", selector.registeredModelName)
 
 	// Fetch the registered model
-	model, _, err := p.Client.ModelRegistryServiceAPI.FindRegisteredModel(context.Background()).Name(registeredModelName).Execute()
+	model, _, err := p.Client.ModelRegistryServiceAPI.FindRegisteredModel(context.Background()).Name(selector.registeredModelName).Execute()
 	if err != nil {
 		return err
 	}
 
 	log.Printf("Fetching model version: model=%v", model)
 
-	// Fetch model version by name or latest if not specified
-	version, err := p.fetchModelVersion(versionName, registeredModelName, model)
+	// Fetch model version by name or the newest one matching the selectors
+	version, err := p.fetchModelVersion(selector, model)
 	if err != nil {
 		return err
 	}
 
 	log.Printf("Fetching model artifacts: version=%v", version)
 
-	artifacts, _, err := p.Client.ModelRegistryServiceAPI.GetModelVersionArtifacts(context.Background(), *version.Id).
-		OrderBy(openapi.ORDERBYFIELD_CREATE_TIME).
-		SortOrder(openapi.SORTORDER_DESC).
-		Execute()
+	modelArtifact, err := p.fetchModelArtifact(selector, version)
 	if err != nil {
 		return err
 	}
 
-	if artifacts.Size == 0 {
-		return fmt.Errorf("%w %s", ErrNoArtifactAssociated, *version.Id)
-	}
-
-	modelArtifact := artifacts.Items[0].ModelArtifact
-	if modelArtifact == nil {
-		return fmt.Errorf("%w %s", ErrNoModelArtifact, *version.Id)
-	}
-
-	// Call appropriate kserve provider based on the indexed model artifact URI
+	// Call appropriate provider based on the indexed model artifact URI
 	if modelArtifact.Uri == nil {
 		return fmt.Errorf("%w %s", ErrModelArtifactEmptyURI, *modelArtifact.Id)
 	}
@@ -105,14 +148,28 @@ func (p *ModelRegistryProvider) DownloadModel(modelDir string, modelName string,
 		return err
 	}
 
-	log.Printf("Getting KServe provider for protocol: %s", protocol)
+	log.Printf("Getting provider for protocol: %s", protocol)
 	provider, err := kserve.GetProvider(p.Providers, protocol)
 	if err != nil {
 		return err
 	}
 
-	log.Printf("Delegating to KServe provider to download model with: modelDir=%s, storageUri=%s", modelDir, safeString(modelArtifact.Uri))
-	return provider.DownloadModel(modelDir, "", *modelArtifact.Uri)
+	log.Printf("Delegating to provider to download model with: modelDir=%s, storageUri=%s", modelDir, safeString(modelArtifact.Uri))
+	if err := provider.DownloadModel(modelDir, "", *modelArtifact.Uri); err != nil {
+		return err
+	}
+
+	if err := verifyChecksums(modelDir, modelArtifact); err != nil {
+		return err
+	}
+
+	p.Resolution = &Resolution{
+		RegisteredModelId: *model.Id,
+		ModelVersionId:    *version.Id,
+		ModelArtifactId:   *modelArtifact.Id,
+	}
+
+	return nil
 }
 
 // Possible URIs:
@@ -120,11 +177,26 @@ func (p *ModelRegistryProvider) DownloadModel(modelDir string, modelName string,
 // (2) model-registry://{modelName}/{modelVersion}
 // (3) model-registry://{modelRegistryUrl}/{modelName}
 // (4) model-registry://{modelRegistryUrl}/{modelName}/{modelVersion}
-func (p *ModelRegistryProvider) parseModelVersion(storageUri string) (string, *string, error) {
-	var versionName *string
-
-	// Strip query parameters
-	storageUri, _, _ = strings.Cut(storageUri, "?")
+//
+// Instead of a version name, the model name can be followed by a semver range
+// like model-registry://{modelName}@^1.2, selecting the highest version in the
+// range. Only the text after the last '@' is taken as the range, and only if
+// it is one, so model names can contain '@'. The query parameters select among the versions and artifacts:
+//   - state: the state of the version, LIVE or ARCHIVED
+//   - filterQuery: a filter query the version must match
+//   - artifactName: the name of the model artifact of the version
+//
+// Without version name, the newest version matching the selectors is used.
+func (p *ModelRegistryProvider) parseModelVersion(storageUri string) (*modelSelector, error) {
+	selector := &modelSelector{}
+
+	// Split the query parameters
+	storageUri, rawQuery, _ := strings.Cut(storageUri, "?")
+
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidMRURI, err)
+	}
 
 	// Parse the URI to retrieve the needed information to query model registry (modelArtifact)
 	mrUri := strings.TrimPrefix(storageUri, string(constants.MR))
@@ -132,7 +204,7 @@ func (p *ModelRegistryProvider) parseModelVersion(storageUri string) (string, *s
 	tokens := strings.SplitN(mrUri, "/", 3)
 
 	if len(tokens) == 0 || len(tokens) > 3 {
-		return "", nil, ErrInvalidMRURI
+		return nil, ErrInvalidMRURI
 	}
 
 	// Check if the first token is the host and remove it so that we reduce cases (3) and (4) to (1) and (2)
@@ -140,46 +212,238 @@ func (p *ModelRegistryProvider) parseModelVersion(storageUri string) (string, *s
 		tokens = tokens[1:]
 	}
 
-	registeredModelName := tokens[0]
+	// The names are path escaped, like the DeploymentController does.
+	for i, token := range tokens {
+		unescaped, err := url.PathUnescape(token)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidMRURI, err)
+		}
+
+		tokens[i] = unescaped
+	}
+
+	selector.registeredModelName = tokens[0]
 
 	if len(tokens) == 2 {
-		versionName = &tokens[1]
+		selector.versionName = &tokens[1]
+	}
+
+	name, versionRange, err := splitVersionRange(selector.registeredModelName)
+	if err != nil {
+		return nil, err
+	}
+
+	if versionRange != "" {
+		if selector.versionName != nil {
+			return nil, fmt.Errorf("%w: both a version name and a semver range are set", ErrInvalidVersionSelector)
+		}
+
+		selector.registeredModelName = name
+		selector.versionRange = versionRange
+	}
+
+	if selector.registeredModelName == "" || (selector.versionName != nil && *selector.versionName == "") {
+		return nil, ErrInvalidMRURI
+	}
+
+	if state := query.Get("state"); state != "" {
+		if _, err := openapi.NewModelVersionStateFromValue(state); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidVersionSelector, err)
+		}
+
+		selector.state = state
+	}
+
+	selector.filterQuery = query.Get("filterQuery")
+	selector.artifactName = query.Get("artifactName")
+
+	if selector.versionName != nil && selector.filterQuery != "" {
+		return nil, fmt.Errorf("%w: a filter query selects among the versions, not with a version name", ErrInvalidVersionSelector)
+	}
+
+	return selector, nil
+}
+
+// splitVersionRange splits a model name followed by a semver range on the
+// last '@'. Model names can contain '@' too, so the name is returned whole
+// when what follows the last '@' isn't a range. It is an error only when it
+// starts like one, e.g. iris@^one.
+func splitVersionRange(name string) (string, string, error) {
+	i := strings.LastIndex(name, "@")
+	if i < 0 {
+		return name, "", nil
+	}
+
+	versionRange := name[i+1:]
+	if _, err := parseSemRange(versionRange); err != nil || strings.TrimSpace(versionRange) == "" {
+		if strings.IndexAny(versionRange, "^~<>=") == 0 {
+			return "", "", fmt.Errorf("%w: invalid semver range %q", ErrInvalidVersionSelector, versionRange)
+		}
+
+		return name, "", nil
 	}
 
-	return registeredModelName, versionName, nil
+	return name[:i], versionRange, nil
 }
 
-func (p *ModelRegistryProvider) fetchModelVersion(
-	versionName *string,
-	registeredModelName string,
-	model *openapi.RegisteredModel,
-) (*openapi.ModelVersion, error) {
-	if versionName != nil {
+// fetchModelArtifact returns the newest model artifact of a version, or the
+// one named by the selector. The other types of artifacts are ignored.
+func (p *ModelRegistryProvider) fetchModelArtifact(selector *modelSelector, version *openapi.ModelVersion) (*openapi.ModelArtifact, error) {
+	request := p.Client.ModelRegistryServiceAPI.GetModelVersionArtifacts(context.Background(), *version.Id).
+		ArtifactType(openapi.ARTIFACTTYPEQUERYPARAM_MODEL_ARTIFACT).
+		OrderBy(openapi.ORDERBYFIELD_CREATE_TIME).
+		SortOrder(openapi.SORTORDER_DESC)
+	if selector.artifactName != "" {
+		request = request.Name(selector.artifactName)
+	}
+
+	artifacts, _, err := request.Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	if artifacts.Size == 0 {
+		if selector.artifactName != "" {
+			return nil, fmt.Errorf("%w %s named %s", ErrNoArtifactAssociated, *version.Id, selector.artifactName)
+		}
+
+		return nil, fmt.Errorf("%w %s", ErrNoArtifactAssociated, *version.Id)
+	}
+
+	modelArtifact := artifacts.Items[0].ModelArtifact
+	if modelArtifact == nil {
+		return nil, fmt.Errorf("%w %s", ErrNoModelArtifact, *version.Id)
+	}
+
+	return modelArtifact, nil
+}
+
+func (p *ModelRegistryProvider) fetchModelVersion(selector *modelSelector, model *openapi.RegisteredModel) (*openapi.ModelVersion, error) {
+	if selector.versionName != nil {
 		version, _, err := p.Client.ModelRegistryServiceAPI.
 			FindModelVersion(context.Background()).
-			Name(*versionName).
+			Name(*selector.versionName).
 			ParentResourceId(*model.Id).
 			Execute()
 		if err != nil {
 			return nil, fmt.Errorf("%w: %w", ErrFetchingModelVersion, err)
 		}
 
+		if selector.state != "" && string(version.GetState()) != selector.state {
+			return nil, fmt.Errorf("%w %s: version %s is %s", ErrNoVersionMatching, selector.registeredModelName, *selector.versionName, version.GetState())
+		}
+
 		return version, nil
 	}
 
-	versions, _, err := p.Client.ModelRegistryServiceAPI.GetRegisteredModelVersions(context.Background(), *model.Id).
-		// OrderBy(openapi.ORDERBYFIELD_CREATE_TIME). not supported
-		SortOrder(openapi.SORTORDER_DESC).
-		Execute()
+	versions, err := p.listModelVersions(*model.Id, selector.versionFilterQuery())
+	if err != nil {
+		return nil, err
+	}
+
+	var best *openapi.ModelVersion
+	var bestSemVersion semVersion
+
+	if selector.versionRange != "" {
+		versionRange, err := parseSemRange(selector.versionRange)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range versions {
+			v, ok := parseSemVersion(versions[i].Name)
+			if !ok || !versionRange.matches(v) {
+				continue
+			}
+
+			// Prefer the highest version, then the newest one.
+			if best == nil || v.compare(bestSemVersion) > 0 ||
+				(v.compare(bestSemVersion) == 0 && isNewerVersion(&versions[i], best)) {
+				best = &versions[i]
+				bestSemVersion = v
+			}
+		}
+	} else {
+		for i := range versions {
+			if best == nil || isNewerVersion(&versions[i], best) {
+				best = &versions[i]
+			}
+		}
+	}
+
+	if best == nil {
+		if selector.versionRange == "" && selector.versionFilterQuery() == "" {
+			return nil, fmt.Errorf("%w %s", ErrNoVersionAssociated, selector.registeredModelName)
+		}
+
+		return nil, fmt.Errorf("%w %s", ErrNoVersionMatching, selector.registeredModelName)
+	}
+
+	return best, nil
+}
+
+// versionFilterQuery returns the filter query matching the versions selected
+// by the state and filter query selectors.
+func (s *modelSelector) versionFilterQuery() string {
+	conditions := []string{}
+
+	if s.state != "" {
+		conditions = append(conditions, fmt.Sprintf("state='%s'", s.state))
+	}
+
+	if s.filterQuery != "" {
+		conditions = append(conditions, "("+s.filterQuery+")")
+	}
+
+	return strings.Join(conditions, " AND ")
+}
+
+// listModelVersions returns all the versions of a registered model matching
+// filterQuery.
+func (p *ModelRegistryProvider) listModelVersions(registeredModelId string, filterQuery string) ([]openapi.ModelVersion, error) {
+	versions := []openapi.ModelVersion{}
+	nextPageToken := ""
+
+	for {
+		request := p.Client.ModelRegistryServiceAPI.GetRegisteredModelVersions(context.Background(), registeredModelId).
+			// OrderBy(openapi.ORDERBYFIELD_CREATE_TIME). not supported
+			SortOrder(openapi.SORTORDER_DESC)
+		if filterQuery != "" {
+			request = request.FilterQuery(filterQuery)
+		}
+		if nextPageToken != "" {
+			request = request.NextPageToken(nextPageToken)
+		}
+
+		page, _, err := request.Execute()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrFetchingModelVersions, err)
+		}
+
+		versions = append(versions, page.Items...)
+
+		if page.NextPageToken == "" || len(page.Items) == 0 {
+			return versions, nil
+		}
+
+		nextPageToken = page.NextPageToken
+	}
+}
+
+// isNewerVersion returns whether version was created after other, versions
+// listed first winning ties.
+func isNewerVersion(version *openapi.ModelVersion, other *openapi.ModelVersion) bool {
+	created, err := strconv.ParseInt(version.GetCreateTimeSinceEpoch(), 10, 64)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %w", ErrFetchingModelVersions, err)
+		return false
 	}
 
-	if versions.Size == 0 {
-		return nil, fmt.Errorf("%w %s", ErrNoVersionAssociated, registeredModelName)
+	otherCreated, err := strconv.ParseInt(other.GetCreateTimeSinceEpoch(), 10, 64)
+	if err != nil {
+		return true
 	}
 
-	return &versions.Items[0], nil
+	return created > otherCreated
 }
 
 func (*ModelRegistryProvider) extractProtocol(storageURI string) (kserve.Protocol, error) {
@@ -191,7 +455,7 @@ func (*ModelRegistryProvider) extractProtocol(storageURI string) (kserve.Protoco
 		return "", ErrNoProtocolInSTorageURI
 	}
 
-	for _, prefix := range kserve.SupportedProtocols {
+	for _, prefix := range supportedProtocols {
 		if strings.HasPrefix(storageURI, string(prefix)) {
 			return prefix, nil
 		}
@@ -1,10 +1,16 @@
 package storage
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/kubeflow/hub/pkg/openapi"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestParseModelVersion(t *testing.T) {
@@ -17,78 +23,370 @@ func TestParseModelVersion(t *testing.T) {
 	}
 
 	tests := []struct {
-		name            string
-		storageUri      string
-		expectedModel   string
-		expectedVersion *string
-		expectError     bool
+		name             string
+		storageUri       string
+		expectedSelector *modelSelector
+		expectError      bool
 	}{
 		{
-			name:            "basic model",
-			storageUri:      "model-registry://iris",
-			expectedModel:   "iris",
-			expectedVersion: nil,
-			expectError:     false,
+			name:             "basic model",
+			storageUri:       "model-registry://iris",
+			expectedSelector: &modelSelector{registeredModelName: "iris"},
 		},
 		{
-			name:            "model and version",
-			storageUri:      "model-registry://iris/v1",
-			expectedModel:   "iris",
-			expectedVersion: stringPtr("v1"),
-			expectError:     false,
+			name:             "model and version",
+			storageUri:       "model-registry://iris/v1",
+			expectedSelector: &modelSelector{registeredModelName: "iris", versionName: stringPtr("v1")},
 		},
 		{
-			name:            "embedded host with model",
-			storageUri:      "model-registry://localhost:8080/iris",
-			expectedModel:   "iris",
-			expectedVersion: nil,
-			expectError:     false,
+			name:             "embedded host with model",
+			storageUri:       "model-registry://localhost:8080/iris",
+			expectedSelector: &modelSelector{registeredModelName: "iris"},
 		},
 		{
-			name:            "embedded host with model and version",
-			storageUri:      "model-registry://localhost:8080/iris/v1",
-			expectedModel:   "iris",
-			expectedVersion: stringPtr("v1"),
-			expectError:     false,
+			name:             "embedded host with model and version",
+			storageUri:       "model-registry://localhost:8080/iris/v1",
+			expectedSelector: &modelSelector{registeredModelName: "iris", versionName: stringPtr("v1")},
 		},
 		{
-			name:            "namespace query param model",
-			storageUri:      "model-registry://iris?namespace=profile-alpha",
-			expectedModel:   "iris",
-			expectedVersion: nil,
-			expectError:     false,
+			name:             "namespace query param model",
+			storageUri:       "model-registry://iris?namespace=profile-alpha",
+			expectedSelector: &modelSelector{registeredModelName: "iris"},
 		},
 		{
-			name:            "namespace query param model and version",
-			storageUri:      "model-registry://iris/v1?namespace=profile-alpha",
-			expectedModel:   "iris",
-			expectedVersion: stringPtr("v1"),
-			expectError:     false,
+			name:             "namespace query param model and version",
+			storageUri:       "model-registry://iris/v1?namespace=profile-alpha",
+			expectedSelector: &modelSelector{registeredModelName: "iris", versionName: stringPtr("v1")},
 		},
 		{
-			name:            "namespace query param with embedded host",
-			storageUri:      "model-registry://localhost:8080/iris/v1?namespace=profile-alpha",
-			expectedModel:   "iris",
-			expectedVersion: stringPtr("v1"),
-			expectError:     false,
+			name:             "namespace query param with embedded host",
+			storageUri:       "model-registry://localhost:8080/iris/v1?namespace=profile-alpha",
+			expectedSelector: &modelSelector{registeredModelName: "iris", versionName: stringPtr("v1")},
+		},
+		{
+			name:             "escaped model and version",
+			storageUri:       "model-registry://localhost:8080/Iris%20Classifier/v1%2Frc%201",
+			expectedSelector: &modelSelector{registeredModelName: "Iris Classifier", versionName: stringPtr("v1/rc 1")},
+		},
+		{
+			name:             "semver range",
+			storageUri:       "model-registry://iris@^1.2",
+			expectedSelector: &modelSelector{registeredModelName: "iris", versionRange: "^1.2"},
+		},
+		{
+			name:             "semver range with embedded host",
+			storageUri:       "model-registry://localhost:8080/iris@>=1.0.0 <2?namespace=profile-alpha",
+			expectedSelector: &modelSelector{registeredModelName: "iris", versionRange: ">=1.0.0 <2"},
+		},
+		{
+			name:       "selectors",
+			storageUri: "model-registry://iris?state=LIVE&filterQuery=framework%3D%27onnx%27&artifactName=model.onnx",
+			expectedSelector: &modelSelector{
+				registeredModelName: "iris",
+				state:               "LIVE",
+				filterQuery:         "framework='onnx'",
+				artifactName:        "model.onnx",
+			},
+		},
+		{
+			name:             "version and state",
+			storageUri:       "model-registry://iris/v1?state=ARCHIVED",
+			expectedSelector: &modelSelector{registeredModelName: "iris", versionName: stringPtr("v1"), state: "ARCHIVED"},
+		},
+		{
+			name:        "version and semver range",
+			storageUri:  "model-registry://iris@^1/v1",
+			expectError: true,
+		},
+		{
+			name:        "version and filter query",
+			storageUri:  "model-registry://iris/v1?filterQuery=state%3D%27LIVE%27",
+			expectError: true,
+		},
+		{
+			name:        "invalid semver range",
+			storageUri:  "model-registry://iris@^one",
+			expectError: true,
+		},
+		{
+			name:        "invalid state",
+			storageUri:  "model-registry://iris?state=DEPLOYED",
+			expectError: true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			model, version, err := provider.parseModelVersion(tt.storageUri)
+			selector, err := provider.parseModelVersion(tt.storageUri)
 			if tt.expectError {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tt.expectedModel, model)
-				if tt.expectedVersion == nil {
-					assert.Nil(t, version)
-				} else {
-					assert.NotNil(t, version)
-					assert.Equal(t, *tt.expectedVersion, *version)
-				}
+				assert.Equal(t, tt.expectedSelector, selector)
+			}
+		})
+	}
+}
+
+func TestFetchModelVersion(t *testing.T) {
+	newVersion := func(id string, name string, state openapi.ModelVersionState, createTime string) openapi.ModelVersion {
+		version := openapi.NewModelVersionWithDefaults()
+		version.SetId(id)
+		version.SetName(name)
+		version.SetState(state)
+		version.SetCreateTimeSinceEpoch(createTime)
+
+		return *version
+	}
+
+	// Listed like the model registry does, by descending ID.
+	versions := []openapi.ModelVersion{
+		newVersion("6", "experiment", openapi.MODELVERSIONSTATE_LIVE, "600"),
+		newVersion("5", "2.0.0-rc.1", openapi.MODELVERSIONSTATE_LIVE, "500"),
+		newVersion("4", "v1.10.0", openapi.MODELVERSIONSTATE_ARCHIVED, "400"),
+		newVersion("3", "1.3.0", openapi.MODELVERSIONSTATE_LIVE, "300"),
+		newVersion("2", "1.2.5", openapi.MODELVERSIONSTATE_LIVE, "200"),
+		newVersion("1", "1.2.0", openapi.MODELVERSIONSTATE_LIVE, "100"),
+	}
+
+	var filterQueries []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/model_registry/v1alpha3/registered_models/1/versions", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		filterQuery := r.URL.Query().Get("filterQuery")
+		filterQueries = append(filterQueries, filterQuery)
+
+		// Only filter on the state, pages of 2 versions.
+		matching := []openapi.ModelVersion{}
+		for _, version := range versions {
+			if !strings.Contains(filterQuery, "state='") || strings.Contains(filterQuery, "state='"+string(version.GetState())+"'") {
+				matching = append(matching, version)
+			}
+		}
+
+		start, _ := strconv.Atoi(r.URL.Query().Get("nextPageToken"))
+		end := min(start+2, len(matching))
+
+		list := openapi.ModelVersionList{Items: matching[start:end], Size: int32(end - start), PageSize: 2}
+		if end < len(matching) {
+			list.NextPageToken = strconv.Itoa(end)
+		}
+
+		require.NoError(t, json.NewEncoder(w).Encode(list))
+	})
+	mux.HandleFunc("GET /api/model_registry/v1alpha3/model_version", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		for _, version := range versions {
+			if version.Name == r.URL.Query().Get("name") {
+				require.NoError(t, json.NewEncoder(w).Encode(version))
+				return
 			}
+		}
+
+		http.NotFound(w, r)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	cfg := openapi.NewConfiguration()
+	cfg.Host = strings.TrimPrefix(server.URL, "http://")
+	cfg.Scheme = "http"
+
+	provider := &ModelRegistryProvider{
+		Client: openapi.NewAPIClient(cfg),
+	}
+
+	model := openapi.NewRegisteredModelWithDefaults()
+	model.SetId("1")
+	model.SetName("iris")
+
+	tests := []struct {
+		name                string
+		selector            *modelSelector
+		expectedVersionId   string
+		expectedFilterQuery string
+		expectedError       error
+	}{
+		{
+			name:              "newest version",
+			selector:          &modelSelector{},
+			expectedVersionId: "6",
+		},
+		{
+			name:              "version name",
+			selector:          &modelSelector{versionName: stringPtr("1.2.5")},
+			expectedVersionId: "2",
+		},
+		{
+			name:          "version name in another state",
+			selector:      &modelSelector{versionName: stringPtr("v1.10.0"), state: "LIVE"},
+			expectedError: ErrNoVersionMatching,
+		},
+		{
+			name:                "newest version in a state",
+			selector:            &modelSelector{state: "ARCHIVED"},
+			expectedVersionId:   "4",
+			expectedFilterQuery: "state='ARCHIVED'",
+		},
+		{
+			name:                "newest version matching a filter query",
+			selector:            &modelSelector{state: "LIVE", filterQuery: "name LIKE '1.%'"},
+			expectedVersionId:   "6",
+			expectedFilterQuery: "state='LIVE' AND (name LIKE '1.%')",
+		},
+		{
+			name:              "caret range",
+			selector:          &modelSelector{versionRange: "^1.2"},
+			expectedVersionId: "4",
+		},
+		{
+			name:                "caret range in a state",
+			selector:            &modelSelector{versionRange: "^1.2", state: "LIVE"},
+			expectedVersionId:   "3",
+			expectedFilterQuery: "state='LIVE'",
+		},
+		{
+			name:              "tilde range",
+			selector:          &modelSelector{versionRange: "~1.2.0"},
+			expectedVersionId: "2",
+		},
+		{
+			name:              "pre-release range",
+			selector:          &modelSelector{versionRange: ">=2.0.0-rc.0"},
+			expectedVersionId: "5",
+		},
+		{
+			name:          "no version in range",
+			selector:      &modelSelector{versionRange: "^3"},
+			expectedError: ErrNoVersionMatching,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filterQueries = nil
+			tt.selector.registeredModelName = "iris"
+
+			version, err := provider.fetchModelVersion(tt.selector, model)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedVersionId, version.GetId())
+
+			for _, filterQuery := range filterQueries {
+				assert.Equal(t, tt.expectedFilterQuery, filterQuery)
+			}
+		})
+	}
+}
+
+func TestFetchModelArtifact(t *testing.T) {
+	newModelArtifact := func(id string, name string) openapi.Artifact {
+		artifact := openapi.NewModelArtifactWithDefaults()
+		artifact.SetId(id)
+		artifact.SetName(name)
+		artifact.SetUri("s3://bucket/" + name)
+
+		return openapi.ModelArtifactAsArtifact(artifact)
+	}
+
+	newDocArtifact := func(id string, name string) openapi.Artifact {
+		artifact := openapi.NewDocArtifactWithDefaults()
+		artifact.SetId(id)
+		artifact.SetName(name)
+
+		return openapi.DocArtifactAsArtifact(artifact)
+	}
+
+	// Listed newest first, the doc artifact being newer than the model
+	// artifacts.
+	artifacts := []openapi.Artifact{
+		newDocArtifact("3", "README.md"),
+		newModelArtifact("2", "model.onnx"),
+		newModelArtifact("1", "model.pt"),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/model_registry/v1alpha3/model_versions/1/artifacts", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		matching := []openapi.Artifact{}
+		for _, artifact := range artifacts {
+			if r.URL.Query().Get("artifactType") == string(openapi.ARTIFACTTYPEQUERYPARAM_MODEL_ARTIFACT) && artifact.ModelArtifact == nil {
+				continue
+			}
+
+			var name string
+			if artifact.ModelArtifact != nil {
+				name = artifact.ModelArtifact.GetName()
+			} else {
+				name = artifact.DocArtifact.GetName()
+			}
+
+			if r.URL.Query().Get("name") != "" && r.URL.Query().Get("name") != name {
+				continue
+			}
+
+			matching = append(matching, artifact)
+		}
+
+		require.NoError(t, json.NewEncoder(w).Encode(openapi.ArtifactList{Items: matching, Size: int32(len(matching))}))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	cfg := openapi.NewConfiguration()
+	cfg.Host = strings.TrimPrefix(server.URL, "http://")
+	cfg.Scheme = "http"
+
+	provider := &ModelRegistryProvider{
+		Client: openapi.NewAPIClient(cfg),
+	}
+
+	version := openapi.NewModelVersionWithDefaults()
+	version.SetId("1")
+
+	tests := []struct {
+		name               string
+		selector           *modelSelector
+		expectedArtifactId string
+		expectedError      error
+	}{
+		{
+			name:               "newest model artifact",
+			selector:           &modelSelector{},
+			expectedArtifactId: "2",
+		},
+		{
+			name:               "artifact name",
+			selector:           &modelSelector{artifactName: "model.pt"},
+			expectedArtifactId: "1",
+		},
+		{
+			name:          "name of another type of artifact",
+			selector:      &modelSelector{artifactName: "README.md"},
+			expectedError: ErrNoArtifactAssociated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			artifact, err := provider.fetchModelArtifact(tt.selector, version)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedArtifactId, artifact.GetId())
 		})
 	}
 }
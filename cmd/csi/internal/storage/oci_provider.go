@@ -0,0 +1,510 @@
+package storage
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	kserve "github.com/kserve/kserve/pkg/agent/storage"
+	"github.com/kubeflow/hub/cmd/csi/internal/constants"
+)
+
+const (
+	ociUsernameEnv = "OCI_USERNAME"
+	ociPasswordEnv = "OCI_PASSWORD"
+
+	ociIndexMediaType           = "application/vnd.oci.image.index.v1+json"
+	ociManifestMediaType        = "application/vnd.oci.image.manifest.v1+json"
+	dockerManifestListMediaType = "application/vnd.docker.distribution.manifest.list.v2+json"
+	dockerManifestMediaType     = "application/vnd.docker.distribution.manifest.v2+json"
+
+	// modelcarModelsDir is the directory of a modelcar image holding the
+	// model, as expected by KServe.
+	modelcarModelsDir = "models/"
+
+	whiteoutPrefix    = ".wh."
+	whiteoutOpaqueDir = ".wh..wh..opq"
+)
+
+var (
+	_                      kserve.Provider = (*OCIProvider)(nil)
+	ErrInvalidOCIURI                       = errors.New("invalid OCI URI, use like oci://{registry}/{repository}:{tag} or oci://{registry}/{repository}@{digest}")
+	ErrOCIRequest                          = errors.New("error querying OCI registry")
+	ErrLayerDigestMismatch                 = errors.New("image layer doesn't match its digest")
+	ErrNoModelInImage                      = errors.New("no model found in the modelcar image under /" + modelcarModelsDir)
+)
+
+// OCIProvider downloads the models packaged in modelcar images, by pulling the
+// layers of the image from its registry and extracting the files under
+// /models.
+type OCIProvider struct {
+	Client *http.Client
+	// Username and Password authenticate to the registries, if set.
+	Username string
+	Password string
+}
+
+func NewOCIProvider() *OCIProvider {
+	return &OCIProvider{
+		Client:   http.DefaultClient,
+		Username: os.Getenv(ociUsernameEnv),
+		Password: os.Getenv(ociPasswordEnv),
+	}
+}
+
+type ociReference struct {
+	registry   string
+	repository string
+	// reference is either a tag or a digest.
+	reference string
+}
+
+type ociDescriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+	Platform  *struct {
+		OS           string `json:"os"`
+		Architecture string `json:"architecture"`
+	} `json:"platform,omitempty"`
+}
+
+// ociManifest is either an image manifest or an image index.
+type ociManifest struct {
+	MediaType string          `json:"mediaType"`
+	Manifests []ociDescriptor `json:"manifests"`
+	Layers    []ociDescriptor `json:"layers"`
+}
+
+// ociSession pulls an image, keeping the authorization granted by the
+// registry.
+type ociSession struct {
+	provider      *OCIProvider
+	ref           ociReference
+	authorization string
+}
+
+// storageUri formatted like oci://{registry}/{repository}:{tag} or
+// oci://{registry}/{repository}@{digest}, the tag defaulting to latest.
+func (p *OCIProvider) DownloadModel(modelDir string, modelName string, storageUri string) error {
+	ref, err := parseOCIURI(storageUri)
+	if err != nil {
+		return err
+	}
+
+	session := &ociSession{provider: p, ref: ref}
+
+	manifest, err := session.fetchManifest(ref.reference)
+	if err != nil {
+		return err
+	}
+
+	if len(manifest.Manifests) > 0 {
+		descriptor, err := selectManifest(manifest.Manifests)
+		if err != nil {
+			return err
+		}
+
+		if manifest, err = session.fetchManifest(descriptor.Digest); err != nil {
+			return err
+		}
+	}
+
+	modelDir = filepath.Join(modelDir, modelName)
+
+	log.Printf("Pulling modelcar image: registry=%s, repository=%s, reference=%s, layers=%d", ref.registry, ref.repository, ref.reference, len(manifest.Layers))
+
+	if err := os.MkdirAll(modelDir, 0o755); err != nil {
+		return err
+	}
+
+	// The layers are extracted to a temporary directory while they stream, and
+	// only moved to modelDir once the digests of all of them match.
+	extractDir, err := os.MkdirTemp(modelDir, ".modelcar-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(extractDir)
+
+	files := 0
+
+	for _, layer := range manifest.Layers {
+		extracted, err := session.extractLayer(layer, extractDir)
+		if err != nil {
+			return err
+		}
+
+		files += extracted
+	}
+
+	if files == 0 {
+		return fmt.Errorf("%w: %s", ErrNoModelInImage, storageUri)
+	}
+
+	return moveDirContent(extractDir, modelDir)
+}
+
+// moveDirContent moves the content of the directory src to dst, replacing
+// the entries of dst with the same names.
+func moveDirContent(src string, dst string) error {
+	entries, err := os.ReadDir(src)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		target := filepath.Join(dst, entry.Name())
+
+		if err := os.RemoveAll(target); err != nil {
+			return err
+		}
+
+		if err := os.Rename(filepath.Join(src, entry.Name()), target); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func parseOCIURI(storageUri string) (ociReference, error) {
+	registry, repository, _ := strings.Cut(strings.TrimPrefix(storageUri, string(constants.OCI)), "/")
+
+	ref := ociReference{registry: registry, repository: repository, reference: "latest"}
+
+	if name, digest, found := strings.Cut(repository, "@"); found {
+		ref.repository = name
+		ref.reference = digest
+	} else if i := strings.LastIndex(repository, ":"); i > strings.LastIndex(repository, "/") {
+		ref.repository = repository[:i]
+		ref.reference = repository[i+1:]
+	}
+
+	if ref.registry == "" || ref.repository == "" || ref.reference == "" {
+		return ociReference{}, fmt.Errorf("%w: %s", ErrInvalidOCIURI, storageUri)
+	}
+
+	// Docker Hub is served by another host than its name, and its official
+	// images by the library namespace.
+	if ref.registry == "docker.io" {
+		ref.registry = "registry-1.docker.io"
+
+		if !strings.Contains(ref.repository, "/") {
+			ref.repository = "library/" + ref.repository
+		}
+	}
+
+	return ref, nil
+}
+
+// selectManifest returns the manifest of an image index for the platform of
+// the storage initializer, or the only one of the index.
+func selectManifest(manifests []ociDescriptor) (ociDescriptor, error) {
+	for _, manifest := range manifests {
+		if manifest.Platform != nil && manifest.Platform.OS == "linux" && manifest.Platform.Architecture == runtime.GOARCH {
+			return manifest, nil
+		}
+	}
+
+	if len(manifests) == 1 {
+		return manifests[0], nil
+	}
+
+	return ociDescriptor{}, fmt.Errorf("%w: no manifest for linux/%s in the image index", ErrOCIRequest, runtime.GOARCH)
+}
+
+func (s *ociSession) fetchManifest(reference string) (ociManifest, error) {
+	resp, err := s.get("/manifests/"+reference, ociIndexMediaType, ociManifestMediaType, dockerManifestListMediaType, dockerManifestMediaType)
+	if err != nil {
+		return ociManifest{}, err
+	}
+	defer resp.Body.Close()
+
+	manifest := ociManifest{}
+	if err := json.NewDecoder(resp.Body).Decode(&manifest); err != nil {
+		return ociManifest{}, fmt.Errorf("%w: invalid manifest %s: %w", ErrOCIRequest, reference, err)
+	}
+
+	return manifest, nil
+}
+
+// extractLayer extracts the model files of a layer to modelDir, returning
+// their number.
+func (s *ociSession) extractLayer(layer ociDescriptor, modelDir string) (int, error) {
+	algorithm, expected, _ := strings.Cut(layer.Digest, ":")
+	if algorithm != "sha256" {
+		return 0, fmt.Errorf("%w: unsupported layer digest %s", ErrOCIRequest, layer.Digest)
+	}
+
+	resp, err := s.get("/blobs/" + layer.Digest)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	hash := sha256.New()
+	blob := io.TeeReader(resp.Body, hash)
+	archive := blob
+
+	switch {
+	case strings.HasSuffix(layer.MediaType, "gzip"):
+		gz, err := gzip.NewReader(blob)
+		if err != nil {
+			return 0, fmt.Errorf("unable to decompress image layer %s: %w", layer.Digest, err)
+		}
+		defer gz.Close()
+
+		archive = gz
+	case strings.HasSuffix(layer.MediaType, "zstd"):
+		return 0, fmt.Errorf("%w: unsupported layer media type %s", ErrOCIRequest, layer.MediaType)
+	}
+
+	files, err := extractModelcarLayer(archive, modelDir)
+	if err != nil {
+		return 0, fmt.Errorf("unable to extract image layer %s: %w", layer.Digest, err)
+	}
+
+	// Read what's left after the end of the archive, to hash the whole blob.
+	if _, err := io.Copy(io.Discard, blob); err != nil {
+		return 0, fmt.Errorf("unable to read image layer %s: %w", layer.Digest, err)
+	}
+
+	if hex.EncodeToString(hash.Sum(nil)) != expected {
+		return 0, fmt.Errorf("%w %s", ErrLayerDigestMismatch, layer.Digest)
+	}
+
+	return files, nil
+}
+
+// extractModelcarLayer extracts the files under the models directory of a
+// tar layer to modelDir, applying the whiteouts of the layer.
+func extractModelcarLayer(r io.Reader, modelDir string) (int, error) {
+	files := 0
+	tr := tar.NewReader(r)
+
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return files, err
+		}
+
+		name, ok := strings.CutPrefix(strings.TrimPrefix(path.Clean("/"+header.Name), "/"), modelcarModelsDir)
+		if !ok {
+			continue
+		}
+
+		dir, base := path.Split(name)
+
+		switch {
+		case base == whiteoutOpaqueDir:
+			if err := clearDir(modelDir, dir); err != nil {
+				return files, err
+			}
+		case strings.HasPrefix(base, whiteoutPrefix):
+			target, err := joinPath(modelDir, dir+strings.TrimPrefix(base, whiteoutPrefix))
+			if err != nil {
+				return files, err
+			}
+
+			if err := os.RemoveAll(target); err != nil {
+				return files, err
+			}
+		case header.Typeflag == tar.TypeReg:
+			if err := writeFile(modelDir, name, tr); err != nil {
+				return files, err
+			}
+
+			files++
+		case header.Typeflag == tar.TypeDir:
+			// Directories are created along with their files.
+		default:
+			log.Printf("Skipping %s of type %c in image layer", header.Name, header.Typeflag)
+		}
+	}
+}
+
+// clearDir removes the content of the slash separated directory dir relative
+// to modelDir.
+func clearDir(modelDir string, dir string) error {
+	target := modelDir
+
+	if dir = strings.TrimSuffix(dir, "/"); dir != "" {
+		var err error
+		if target, err = joinPath(modelDir, dir); err != nil {
+			return err
+		}
+	}
+
+	entries, err := os.ReadDir(target)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	for _, entry := range entries {
+		if err := os.RemoveAll(filepath.Join(target, entry.Name())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// get requests a resource of the repository, authenticating to the registry
+// when challenged to.
+func (s *ociSession) get(resource string, accept ...string) (*http.Response, error) {
+	target := fmt.Sprintf("https://%s/v2/%s%s", s.ref.registry, s.ref.repository, resource)
+
+	for authenticated := false; ; authenticated = true {
+		req, err := http.NewRequest(http.MethodGet, target, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(accept) > 0 {
+			req.Header.Set("Accept", strings.Join(accept, ", "))
+		}
+
+		if s.authorization != "" {
+			req.Header.Set("Authorization", s.authorization)
+		}
+
+		resp, err := s.provider.Client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrOCIRequest, err)
+		}
+
+		if resp.StatusCode == http.StatusUnauthorized && !authenticated {
+			resp.Body.Close()
+
+			if err := s.authenticate(resp.Header.Get("WWW-Authenticate")); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("%w: %s returned a %d response code", ErrOCIRequest, target, resp.StatusCode)
+		}
+
+		return resp, nil
+	}
+}
+
+// authenticate answers the challenge of the registry, either with basic
+// authentication or by getting a bearer token from its token server.
+func (s *ociSession) authenticate(challenge string) error {
+	scheme, params := parseChallenge(challenge)
+
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if s.provider.Username == "" {
+			return fmt.Errorf("%w: registry %s requires credentials", ErrOCIRequest, s.ref.registry)
+		}
+
+		s.authorization = "Basic " + base64.StdEncoding.EncodeToString([]byte(s.provider.Username+":"+s.provider.Password))
+
+		return nil
+	case "bearer":
+		realm, err := url.Parse(params["realm"])
+		if err != nil || params["realm"] == "" {
+			return fmt.Errorf("%w: invalid token realm %q", ErrOCIRequest, params["realm"])
+		}
+
+		scope := params["scope"]
+		if scope == "" {
+			scope = "repository:" + s.ref.repository + ":pull"
+		}
+
+		query := realm.Query()
+		query.Set("scope", scope)
+		if service, ok := params["service"]; ok {
+			query.Set("service", service)
+		}
+		realm.RawQuery = query.Encode()
+
+		req, err := http.NewRequest(http.MethodGet, realm.String(), nil)
+		if err != nil {
+			return err
+		}
+
+		if s.provider.Username != "" {
+			req.SetBasicAuth(s.provider.Username, s.provider.Password)
+		}
+
+		resp, err := s.provider.Client.Do(req)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrOCIRequest, err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("%w: token server %s returned a %d response code", ErrOCIRequest, realm.Host, resp.StatusCode)
+		}
+
+		token := struct {
+			Token       string `json:"token"`
+			AccessToken string `json:"access_token"`
+		}{}
+		if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+			return fmt.Errorf("%w: invalid token response: %w", ErrOCIRequest, err)
+		}
+
+		if token.Token == "" {
+			token.Token = token.AccessToken
+		}
+
+		s.authorization = "Bearer " + token.Token
+
+		return nil
+	default:
+		return fmt.Errorf("%w: unsupported authentication challenge %q from registry %s", ErrOCIRequest, challenge, s.ref.registry)
+	}
+}
+
+// parseChallenge parses a WWW-Authenticate header like
+// Bearer realm="https://auth.example.com/token",service="registry.example.com".
+func parseChallenge(challenge string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(challenge), " ")
+	params := map[string]string{}
+
+	for rest = strings.TrimSpace(rest); rest != ""; rest = strings.TrimLeft(rest, ", ") {
+		key, value, found := strings.Cut(rest, "=")
+		if !found {
+			break
+		}
+
+		key = strings.ToLower(strings.TrimSpace(key))
+
+		if quoted, ok := strings.CutPrefix(value, `"`); ok {
+			value, rest, _ = strings.Cut(quoted, `"`)
+		} else {
+			value, rest, _ = strings.Cut(value, ",")
+		}
+
+		params[key] = strings.TrimSpace(value)
+	}
+
+	return scheme, params
+}
+
+func (p *OCIProvider) UploadObject(bucket string, key string, object []byte) error {
+	return fmt.Errorf("uploading objects is not supported when using the OCI protocol")
+}
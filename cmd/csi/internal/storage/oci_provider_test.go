@@ -0,0 +1,317 @@
+package storage
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOCIURI(t *testing.T) {
+	tests := []struct {
+		name        string
+		storageUri  string
+		expected    ociReference
+		expectError bool
+	}{
+		{
+			name:       "tag",
+			storageUri: "oci://quay.io/org/model:v1",
+			expected:   ociReference{registry: "quay.io", repository: "org/model", reference: "v1"},
+		},
+		{
+			name:       "default tag",
+			storageUri: "oci://registry.example.com:5000/org/model",
+			expected:   ociReference{registry: "registry.example.com:5000", repository: "org/model", reference: "latest"},
+		},
+		{
+			name:       "digest",
+			storageUri: "oci://quay.io/org/model@sha256:abcd",
+			expected:   ociReference{registry: "quay.io", repository: "org/model", reference: "sha256:abcd"},
+		},
+		{
+			name:       "docker hub official image",
+			storageUri: "oci://docker.io/model:v1",
+			expected:   ociReference{registry: "registry-1.docker.io", repository: "library/model", reference: "v1"},
+		},
+		{
+			name:        "no repository",
+			storageUri:  "oci://quay.io",
+			expectError: true,
+		},
+		{
+			name:        "empty tag",
+			storageUri:  "oci://quay.io/org/model:",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref, err := parseOCIURI(tt.storageUri)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, ref)
+		})
+	}
+}
+
+func TestParseChallenge(t *testing.T) {
+	scheme, params := parseChallenge(`Bearer realm="https://auth.example.com/token",service="registry.example.com",scope="repository:org/model:pull,push"`)
+
+	assert.Equal(t, "Bearer", scheme)
+	assert.Equal(t, map[string]string{
+		"realm":   "https://auth.example.com/token",
+		"service": "registry.example.com",
+		"scope":   "repository:org/model:pull,push",
+	}, params)
+}
+
+// fakeRegistry serves the images of org/model, requiring a bearer token.
+type fakeRegistry struct {
+	*httptest.Server
+	blobs     map[string][]byte
+	manifests map[string][]byte
+}
+
+func newFakeRegistry(t *testing.T) *fakeRegistry {
+	registry := &fakeRegistry{
+		blobs:     map[string][]byte{},
+		manifests: map[string][]byte{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /token", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("scope") != "repository:org/model:pull" || r.URL.Query().Get("service") != "fake" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		fmt.Fprint(w, `{"token":"registry-token"}`)
+	})
+	mux.HandleFunc("GET /v2/org/model/{kind}/{reference}", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer registry-token" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="fake"`, registry.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		var content []byte
+		var ok bool
+
+		switch r.PathValue("kind") {
+		case "manifests":
+			content, ok = registry.manifests[r.PathValue("reference")]
+		case "blobs":
+			content, ok = registry.blobs[r.PathValue("reference")]
+		}
+
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+
+		w.Write(content)
+	})
+
+	registry.Server = httptest.NewTLSServer(mux)
+	t.Cleanup(registry.Close)
+
+	return registry
+}
+
+func (r *fakeRegistry) addBlob(content []byte) string {
+	digest := sha256.Sum256(content)
+	r.blobs["sha256:"+hex.EncodeToString(digest[:])] = content
+
+	return "sha256:" + hex.EncodeToString(digest[:])
+}
+
+func (r *fakeRegistry) addManifest(t *testing.T, reference string, manifest any) string {
+	content, err := json.Marshal(manifest)
+	require.NoError(t, err)
+
+	digest := sha256.Sum256(content)
+	r.manifests[reference] = content
+	r.manifests["sha256:"+hex.EncodeToString(digest[:])] = content
+
+	return "sha256:" + hex.EncodeToString(digest[:])
+}
+
+func (r *fakeRegistry) host() string {
+	return strings.TrimPrefix(r.URL, "https://")
+}
+
+// tarLayer returns a tar archive of files by name, the ones with a trailing
+// slash being directories.
+func tarLayer(t *testing.T, files map[string]string, compress bool) []byte {
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+
+	for name, content := range files {
+		header := &tar.Header{Name: name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg}
+		if strings.HasSuffix(name, "/") {
+			header = &tar.Header{Name: name, Mode: 0o755, Typeflag: tar.TypeDir}
+		}
+
+		require.NoError(t, tw.WriteHeader(header))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, tw.Close())
+
+	if !compress {
+		return buf.Bytes()
+	}
+
+	compressed := &bytes.Buffer{}
+	gz := gzip.NewWriter(compressed)
+	_, err := gz.Write(buf.Bytes())
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	return compressed.Bytes()
+}
+
+func TestOCIProviderDownloadModel(t *testing.T) {
+	registry := newFakeRegistry(t)
+
+	base := registry.addBlob(tarLayer(t, map[string]string{
+		"bin/":   "",
+		"bin/sh": "#!",
+	}, true))
+	model := registry.addBlob(tarLayer(t, map[string]string{
+		"models/":                "",
+		"models/model.onnx":      "onnx",
+		"models/1/config.json":   "{}",
+		"models/stale/old.bin":   "old",
+		"./models/tokenizer.txt": "tokens",
+	}, true))
+	update := registry.addBlob(tarLayer(t, map[string]string{
+		"models/.wh.stale":  "",
+		"models/model.onnx": "onnx v2",
+	}, false))
+
+	layers := []map[string]string{
+		{"mediaType": "application/vnd.oci.image.layer.v1.tar+gzip", "digest": base},
+		{"mediaType": "application/vnd.oci.image.layer.v1.tar+gzip", "digest": model},
+		{"mediaType": "application/vnd.oci.image.layer.v1.tar", "digest": update},
+	}
+
+	registry.addManifest(t, "v1", map[string]any{
+		"schemaVersion": 2,
+		"mediaType":     ociManifestMediaType,
+		"layers":        layers,
+	})
+
+	platformManifest := registry.addManifest(t, "platform", map[string]any{
+		"schemaVersion": 2,
+		"mediaType":     ociManifestMediaType,
+		"layers":        layers,
+	})
+	registry.addManifest(t, "index", map[string]any{
+		"schemaVersion": 2,
+		"mediaType":     ociIndexMediaType,
+		"manifests": []map[string]any{
+			{"mediaType": ociManifestMediaType, "digest": "sha256:other", "platform": map[string]string{"os": "linux", "architecture": "other"}},
+			{"mediaType": ociManifestMediaType, "digest": platformManifest, "platform": map[string]string{"os": "linux", "architecture": runtime.GOARCH}},
+		},
+	})
+
+	tampered := registry.addBlob(tarLayer(t, map[string]string{"models/model.onnx": "onnx"}, false))
+	registry.blobs[tampered] = tarLayer(t, map[string]string{"models/model.onnx": "evil"}, false)
+	registry.addManifest(t, "tampered", map[string]any{
+		"schemaVersion": 2,
+		"mediaType":     ociManifestMediaType,
+		"layers":        []map[string]string{{"mediaType": "application/vnd.oci.image.layer.v1.tar", "digest": tampered}},
+	})
+
+	registry.addManifest(t, "empty", map[string]any{
+		"schemaVersion": 2,
+		"mediaType":     ociManifestMediaType,
+		"layers":        layers[:1],
+	})
+
+	provider := &OCIProvider{Client: registry.Client()}
+
+	expectedFiles := map[string]string{
+		"model.onnx":    "onnx v2",
+		"1/config.json": "{}",
+		"tokenizer.txt": "tokens",
+	}
+
+	tests := []struct {
+		name          string
+		reference     string
+		expectedFiles map[string]string
+		expectedError error
+	}{
+		{
+			name:          "image manifest",
+			reference:     ":v1",
+			expectedFiles: expectedFiles,
+		},
+		{
+			name:          "image index",
+			reference:     ":index",
+			expectedFiles: expectedFiles,
+		},
+		{
+			name:          "digest",
+			reference:     "@" + platformManifest,
+			expectedFiles: expectedFiles,
+		},
+		{
+			name:          "tampered layer",
+			reference:     ":tampered",
+			expectedError: ErrLayerDigestMismatch,
+		},
+		{
+			name:          "no model",
+			reference:     ":empty",
+			expectedError: ErrNoModelInImage,
+		},
+		{
+			name:          "unknown tag",
+			reference:     ":unknown",
+			expectedError: ErrOCIRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			modelDir := t.TempDir()
+
+			err := provider.DownloadModel(modelDir, "", "oci://"+registry.host()+"/org/model"+tt.reference)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+
+				// Nothing is left behind, e.g. the files of a tampered layer.
+				entries, err := os.ReadDir(modelDir)
+				require.NoError(t, err)
+				assert.Empty(t, entries)
+				return
+			}
+
+			require.NoError(t, err)
+			assertFiles(t, modelDir, tt.expectedFiles)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	kserve "github.com/kserve/kserve/pkg/agent/storage"
+	"github.com/kubeflow/hub/cmd/csi/internal/constants"
+)
+
+const (
+	pvcMountRootEnv     = "PVC_MOUNT_ROOT"
+	pvcMountRootDefault = "/mnt/pvc"
+)
+
+var (
+	_                kserve.Provider = (*PVCProvider)(nil)
+	ErrInvalidPVCURI                 = errors.New("invalid PVC URI, use like pvc://{pvcName}/{path}")
+)
+
+// PVCProvider copies models from PersistentVolumeClaims mounted in the
+// storage initializer container, each of them under MountRoot/{pvcName}.
+type PVCProvider struct {
+	MountRoot string
+}
+
+func NewPVCProvider() *PVCProvider {
+	mountRoot, ok := os.LookupEnv(pvcMountRootEnv)
+	if !ok || mountRoot == "" {
+		mountRoot = pvcMountRootDefault
+	}
+
+	return &PVCProvider{
+		MountRoot: mountRoot,
+	}
+}
+
+// storageUri formatted like pvc://{pvcName}/{path}, the path being either a
+// file or a directory whose content is copied.
+func (p *PVCProvider) DownloadModel(modelDir string, modelName string, storageUri string) error {
+	pvcName, path, _ := strings.Cut(strings.TrimPrefix(storageUri, string(constants.PVC)), "/")
+	if pvcName == "" {
+		return fmt.Errorf("%w: %s", ErrInvalidPVCURI, storageUri)
+	}
+
+	claimRoot, err := joinPath(p.MountRoot, pvcName)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidPVCURI, storageUri)
+	}
+
+	src := claimRoot
+	if path != "" {
+		if src, err = joinPath(claimRoot, path); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidPVCURI, storageUri)
+		}
+	}
+
+	info, err := os.Stat(src)
+	if err != nil {
+		return fmt.Errorf("unable to read %s, is the PVC %s mounted under %s: %w", path, pvcName, p.MountRoot, err)
+	}
+
+	modelDir = filepath.Join(modelDir, modelName)
+
+	log.Printf("Copying model from PVC: pvcName=%s, path=%s, modelDir=%s", pvcName, path, modelDir)
+
+	if !info.IsDir() {
+		return copyFile(modelDir, info.Name(), src)
+	}
+
+	return filepath.WalkDir(src, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.Type()&fs.ModeSymlink != 0 {
+			// Follow the links to files, like the ones of a Hugging Face cache.
+			info, err := os.Stat(file)
+			if err != nil {
+				return err
+			}
+
+			if !info.Mode().IsRegular() {
+				return nil
+			}
+		} else if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(src, file)
+		if err != nil {
+			return err
+		}
+
+		return copyFile(modelDir, filepath.ToSlash(rel), file)
+	})
+}
+
+func (p *PVCProvider) UploadObject(bucket string, key string, object []byte) error {
+	return fmt.Errorf("uploading objects is not supported when using the PVC protocol")
+}
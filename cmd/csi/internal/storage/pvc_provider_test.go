@@ -0,0 +1,107 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPVCProviderDownloadModel(t *testing.T) {
+	mountRoot := t.TempDir()
+	writeTestFile(t, filepath.Join(mountRoot, "models-pvc", "iris", "model.onnx"), "onnx")
+	writeTestFile(t, filepath.Join(mountRoot, "models-pvc", "iris", "1", "config.json"), "{}")
+	writeTestFile(t, filepath.Join(mountRoot, "models-pvc", "blobs", "weights"), "weights")
+	require.NoError(t, os.MkdirAll(filepath.Join(mountRoot, "models-pvc", "linked"), 0o755))
+	require.NoError(t, os.Symlink(filepath.Join("..", "blobs", "weights"), filepath.Join(mountRoot, "models-pvc", "linked", "weights.bin")))
+
+	provider := &PVCProvider{MountRoot: mountRoot}
+
+	tests := []struct {
+		name          string
+		storageUri    string
+		expectedFiles map[string]string
+		expectError   bool
+	}{
+		{
+			name:       "directory",
+			storageUri: "pvc://models-pvc/iris",
+			expectedFiles: map[string]string{
+				"model.onnx":    "onnx",
+				"1/config.json": "{}",
+			},
+		},
+		{
+			name:       "file",
+			storageUri: "pvc://models-pvc/iris/model.onnx",
+			expectedFiles: map[string]string{
+				"model.onnx": "onnx",
+			},
+		},
+		{
+			name:       "symlinked file",
+			storageUri: "pvc://models-pvc/linked",
+			expectedFiles: map[string]string{
+				"weights.bin": "weights",
+			},
+		},
+		{
+			name:        "missing path",
+			storageUri:  "pvc://models-pvc/missing",
+			expectError: true,
+		},
+		{
+			name:        "path outside of the PVC",
+			storageUri:  "pvc://models-pvc/../other-pvc",
+			expectError: true,
+		},
+		{
+			name:        "no PVC name",
+			storageUri:  "pvc://",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			modelDir := t.TempDir()
+
+			err := provider.DownloadModel(modelDir, "", tt.storageUri)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assertFiles(t, modelDir, tt.expectedFiles)
+		})
+	}
+}
+
+func writeTestFile(t *testing.T, path string, content string) {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+// assertFiles asserts that dir holds exactly the expected files, by slash
+// separated path.
+func assertFiles(t *testing.T, dir string, expected map[string]string) {
+	t.Helper()
+
+	files, err := listFiles(dir)
+	require.NoError(t, err)
+
+	actual := map[string]string{}
+	for _, file := range files {
+		content, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(file)))
+		require.NoError(t, err)
+
+		actual[file] = string(content)
+	}
+
+	assert.Equal(t, expected, actual)
+}
@@ -0,0 +1,276 @@
+package storage
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidVersionRange = errors.New("invalid semver range")
+
+// semVersion is a semantic version, see https://semver.org.
+type semVersion struct {
+	major, minor, patch uint64
+	prerelease          []string
+}
+
+// parseSemVersion parses a version name like 1.2.3 or v1.2.3-rc.1, missing
+// minor and patch numbers defaulting to 0.
+func parseSemVersion(name string) (semVersion, bool) {
+	partial, err := parsePartialVersion(name)
+	if err != nil || partial.wildcard {
+		return semVersion{}, false
+	}
+
+	return partial.semVersion, true
+}
+
+func (v semVersion) compare(other semVersion) int {
+	if c := cmp.Compare(v.major, other.major); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(v.minor, other.minor); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(v.patch, other.patch); c != 0 {
+		return c
+	}
+
+	// A version without pre-release has a higher precedence.
+	if len(v.prerelease) == 0 || len(other.prerelease) == 0 {
+		return -cmp.Compare(len(v.prerelease), len(other.prerelease))
+	}
+
+	for i := 0; i < len(v.prerelease) && i < len(other.prerelease); i++ {
+		a, aErr := strconv.ParseUint(v.prerelease[i], 10, 64)
+		b, bErr := strconv.ParseUint(other.prerelease[i], 10, 64)
+
+		var c int
+		switch {
+		case aErr == nil && bErr == nil:
+			c = cmp.Compare(a, b)
+		case aErr == nil:
+			// Numeric identifiers have a lower precedence.
+			c = -1
+		case bErr == nil:
+			c = 1
+		default:
+			c = strings.Compare(v.prerelease[i], other.prerelease[i])
+		}
+
+		if c != 0 {
+			return c
+		}
+	}
+
+	return cmp.Compare(len(v.prerelease), len(other.prerelease))
+}
+
+func (v semVersion) sameCore(other semVersion) bool {
+	return v.major == other.major && v.minor == other.minor && v.patch == other.patch
+}
+
+// partialVersion is a version of a range, whose minor and patch numbers may
+// be missing or wildcards.
+type partialVersion struct {
+	semVersion
+	// parts is the number of numbers set, from 0 to 3.
+	parts    int
+	wildcard bool
+}
+
+func parsePartialVersion(s string) (partialVersion, error) {
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "v"), "=")
+	s, _, _ = strings.Cut(s, "+")
+
+	core, prerelease, hasPrerelease := strings.Cut(s, "-")
+	numbers := strings.Split(core, ".")
+
+	if core == "" || len(numbers) > 3 {
+		return partialVersion{}, fmt.Errorf("%w: invalid version %q", ErrInvalidVersionRange, s)
+	}
+
+	partial := partialVersion{}
+	fields := []*uint64{&partial.major, &partial.minor, &partial.patch}
+
+	for i, number := range numbers {
+		if number == "x" || number == "X" || number == "*" {
+			partial.wildcard = true
+			break
+		}
+
+		value, err := strconv.ParseUint(number, 10, 64)
+		if err != nil {
+			return partialVersion{}, fmt.Errorf("%w: invalid version %q", ErrInvalidVersionRange, s)
+		}
+
+		*fields[i] = value
+		partial.parts = i + 1
+	}
+
+	if hasPrerelease {
+		if partial.parts < 3 || prerelease == "" {
+			return partialVersion{}, fmt.Errorf("%w: invalid version %q", ErrInvalidVersionRange, s)
+		}
+
+		partial.prerelease = strings.Split(prerelease, ".")
+	}
+
+	return partial, nil
+}
+
+// next returns the lowest version above all the ones matching the partial
+// version, at its least significant number set.
+func (p partialVersion) next() semVersion {
+	switch p.parts {
+	case 1:
+		return semVersion{major: p.major + 1}
+	case 2:
+		return semVersion{major: p.major, minor: p.minor + 1}
+	default:
+		return semVersion{major: p.major, minor: p.minor, patch: p.patch + 1}
+	}
+}
+
+type semComparator struct {
+	operator string
+	version  semVersion
+}
+
+func (c semComparator) matches(v semVersion) bool {
+	result := v.compare(c.version)
+
+	switch c.operator {
+	case ">":
+		return result > 0
+	case ">=":
+		return result >= 0
+	case "<":
+		return result < 0
+	case "<=":
+		return result <= 0
+	default:
+		return result == 0
+	}
+}
+
+// semRange is a range of versions like the ones of npm, e.g., ^1.2,
+// ~1.2.3, >=1.0.0 <2.0.0, 1.x or 1.2.3 || ^2. It's a union of sets of
+// comparators, all of the comparators of a set matching a version.
+type semRange [][]semComparator
+
+func parseSemRange(s string) (semRange, error) {
+	r := semRange{}
+
+	for _, alternative := range strings.Split(s, "||") {
+		set := []semComparator{}
+
+		for _, token := range strings.FieldsFunc(alternative, func(c rune) bool { return c == ' ' || c == ',' }) {
+			comparators, err := parseSemComparators(token)
+			if err != nil {
+				return nil, err
+			}
+
+			set = append(set, comparators...)
+		}
+
+		r = append(r, set)
+	}
+
+	return r, nil
+}
+
+func parseSemComparators(token string) ([]semComparator, error) {
+	operator := ""
+	for _, prefix := range []string{">=", "<=", ">", "<", "^", "~", "="} {
+		if strings.HasPrefix(token, prefix) {
+			operator = prefix
+			break
+		}
+	}
+
+	if token == "*" || token == "x" || token == "X" {
+		return nil, nil
+	}
+
+	partial, err := parsePartialVersion(strings.TrimPrefix(token, operator))
+	if err != nil {
+		return nil, err
+	}
+
+	lower := semComparator{">=", partial.semVersion}
+
+	if partial.parts == 0 {
+		// Any version.
+		if operator == "<" || operator == ">" {
+			return []semComparator{{"<", semVersion{}}}, nil
+		}
+
+		return nil, nil
+	}
+
+	switch operator {
+	case "^":
+		// Allow the changes that don't modify the left-most non-zero number.
+		upper := partial.next()
+		switch {
+		case partial.major > 0 || partial.parts == 1:
+			upper = semVersion{major: partial.major + 1}
+		case partial.minor > 0 || partial.parts == 2:
+			upper = semVersion{minor: partial.minor + 1}
+		}
+
+		return []semComparator{lower, {"<", upper}}, nil
+	case "~":
+		// Allow the patch changes, or the minor ones if only the major is set.
+		if partial.parts == 1 {
+			return []semComparator{lower, {"<", partial.next()}}, nil
+		}
+
+		return []semComparator{lower, {"<", semVersion{major: partial.major, minor: partial.minor + 1}}}, nil
+	case ">":
+		if partial.parts < 3 {
+			return []semComparator{{">=", partial.next()}}, nil
+		}
+
+		return []semComparator{{">", partial.semVersion}}, nil
+	case ">=":
+		return []semComparator{lower}, nil
+	case "<":
+		return []semComparator{{"<", partial.semVersion}}, nil
+	case "<=":
+		if partial.parts < 3 {
+			return []semComparator{{"<", partial.next()}}, nil
+		}
+
+		return []semComparator{{"<=", partial.semVersion}}, nil
+	default:
+		if partial.parts < 3 {
+			return []semComparator{lower, {"<", partial.next()}}, nil
+		}
+
+		return []semComparator{{"=", partial.semVersion}}, nil
+	}
+}
+
+// matches returns whether v is in the range. Like npm, pre-release versions
+// only match a set of comparators having a pre-release of the same version.
+func (r semRange) matches(v semVersion) bool {
+	for _, set := range r {
+		matches := true
+		for _, comparator := range set {
+			matches = matches && comparator.matches(v)
+		}
+
+		if matches && (len(v.prerelease) == 0 || slices.ContainsFunc(set, func(c semComparator) bool {
+			return len(c.version.prerelease) > 0 && c.version.sameCore(v)
+		})) {
+			return true
+		}
+	}
+
+	return false
+}
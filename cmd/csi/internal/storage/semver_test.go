@@ -0,0 +1,105 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSemRange(t *testing.T) {
+	tests := []struct {
+		versionRange string
+		matching     []string
+		notMatching  []string
+	}{
+		{
+			versionRange: "^1.2",
+			matching:     []string{"1.2.0", "v1.2.7", "1.9.0"},
+			notMatching:  []string{"1.1.9", "2.0.0", "1.3.0-rc.1"},
+		},
+		{
+			versionRange: "^0.2.3",
+			matching:     []string{"0.2.3", "0.2.9"},
+			notMatching:  []string{"0.2.2", "0.3.0"},
+		},
+		{
+			versionRange: "^0.0.3",
+			matching:     []string{"0.0.3"},
+			notMatching:  []string{"0.0.4"},
+		},
+		{
+			versionRange: "~1.2.3",
+			matching:     []string{"1.2.3", "1.2.9"},
+			notMatching:  []string{"1.2.2", "1.3.0"},
+		},
+		{
+			versionRange: "~1",
+			matching:     []string{"1.0.0", "1.9.9"},
+			notMatching:  []string{"2.0.0"},
+		},
+		{
+			versionRange: ">=1.0.0 <2",
+			matching:     []string{"1.0.0", "1.99.0"},
+			notMatching:  []string{"0.9.0", "2.0.0"},
+		},
+		{
+			versionRange: ">1.2, <=1.4",
+			matching:     []string{"1.3.0", "1.4.5"},
+			notMatching:  []string{"1.2.9", "1.5.0"},
+		},
+		{
+			versionRange: "1.2.x || 2",
+			matching:     []string{"1.2.0", "1.2.4", "2.3.0"},
+			notMatching:  []string{"1.3.0", "3.0.0"},
+		},
+		{
+			versionRange: "1.2.3",
+			matching:     []string{"1.2.3", "v1.2.3+build.1"},
+			notMatching:  []string{"1.2.4"},
+		},
+		{
+			versionRange: "*",
+			matching:     []string{"0.0.1", "10.0.0"},
+			notMatching:  []string{"1.0.0-alpha"},
+		},
+		{
+			versionRange: ">=1.0.0-beta.2 <1.0.0",
+			matching:     []string{"1.0.0-beta.2", "1.0.0-beta.11", "1.0.0-rc.1"},
+			notMatching:  []string{"1.0.0-beta.1", "1.0.0-alpha", "1.0.0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.versionRange, func(t *testing.T) {
+			versionRange, err := parseSemRange(tt.versionRange)
+			require.NoError(t, err)
+
+			for _, name := range tt.matching {
+				version, ok := parseSemVersion(name)
+				require.True(t, ok, name)
+				assert.True(t, versionRange.matches(version), "%s should match", name)
+			}
+
+			for _, name := range tt.notMatching {
+				version, ok := parseSemVersion(name)
+				require.True(t, ok, name)
+				assert.False(t, versionRange.matches(version), "%s should not match", name)
+			}
+		})
+	}
+}
+
+func TestParseSemRangeErrors(t *testing.T) {
+	for _, versionRange := range []string{"^one", "1.2.3.4", ">=", "1.2-rc.1", "~v"} {
+		_, err := parseSemRange(versionRange)
+		assert.ErrorIs(t, err, ErrInvalidVersionRange, versionRange)
+	}
+}
+
+func TestParseSemVersion(t *testing.T) {
+	for _, name := range []string{"experiment", "1.x", "", "v"} {
+		_, ok := parseSemVersion(name)
+		assert.False(t, ok, name)
+	}
+}
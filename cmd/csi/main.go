@@ -1,9 +1,11 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
 
+	"github.com/kubeflow/hub/cmd/csi/internal/inferenceservice"
 	"github.com/kubeflow/hub/cmd/csi/internal/modelregistry"
 	"github.com/kubeflow/hub/cmd/csi/internal/storage"
 	"github.com/kubeflow/hub/pkg/openapi"
@@ -64,4 +66,27 @@ func main() {
 	if err := provider.DownloadModel(destPath, "", sourceUri); err != nil {
 		log.Fatalf("Error downloading the model: %s", err.Error())
 	}
+
+	recordResolution(provider.Resolution)
+}
+
+// recordResolution records the model registry entities the storage URI
+// resolved to on the InferenceService, if configured to. Failing to doesn't
+// prevent the model from being served.
+func recordResolution(resolution *storage.Resolution) {
+	recorder, err := inferenceservice.NewRecorderFromEnv()
+	if err != nil {
+		log.Printf("Unable to record the resolved model on the InferenceService: %v", err)
+		return
+	}
+
+	if recorder == nil || resolution == nil {
+		return
+	}
+
+	log.Printf("Recording the resolved model on InferenceService %s/%s: %v", recorder.Namespace, recorder.Name, resolution.Annotations())
+
+	if err := recorder.Record(context.Background(), resolution.Annotations()); err != nil {
+		log.Printf("Unable to record the resolved model on the InferenceService: %v", err)
+	}
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/kubeflow/hub/internal/datastore/embedmd"
 	"github.com/kubeflow/hub/internal/db/models"
 	"github.com/kubeflow/hub/internal/db/service"
+	"github.com/kubeflow/hub/internal/licensepolicy"
 	platformproxy "github.com/kubeflow/hub/internal/platform/proxy"
 	"github.com/kubeflow/hub/internal/proxy"
 	"github.com/kubeflow/hub/internal/server/middleware"
@@ -25,6 +26,12 @@ import (
 type ProxyConfig struct {
 	EmbedMD       embedmd.EmbedMDConfig
 	DatastoreType string
+	// LicensePolicyPath is an optional license policy file checked when
+	// registered models are created.
+	LicensePolicyPath string
+	// CatalogURL is an optional model catalog checked for deprecated models
+	// when model versions are created from catalog models.
+	CatalogURL string
 }
 
 const (
@@ -211,6 +218,7 @@ func newModelRegistryService(ds datastore.Connector) (api.ModelRegistryApi, erro
 		getRepo[models.ServingEnvironmentRepository](repoSet),
 		getRepo[models.InferenceServiceRepository](repoSet),
 		getRepo[models.ServeModelRepository](repoSet),
+		getRepo[models.DeploymentEventRepository](repoSet),
 		getRepo[models.ExperimentRepository](repoSet),
 		getRepo[models.ExperimentRunRepository](repoSet),
 		getRepo[models.DataSetRepository](repoSet),
@@ -220,6 +228,23 @@ func newModelRegistryService(ds datastore.Connector) (api.ModelRegistryApi, erro
 		repoSet.TypeMap(),
 	)
 
+	if proxyCfg.LicensePolicyPath != "" {
+		policy, err := licensepolicy.Read(proxyCfg.LicensePolicyPath)
+		if err != nil {
+			return nil, fmt.Errorf("error reading license policy: %w", err)
+		}
+		modelRegistryService.SetLicensePolicy(policy)
+		glog.Infof("License policy loaded from %s", proxyCfg.LicensePolicyPath)
+	}
+
+	if proxyCfg.CatalogURL != "" {
+		catalog, err := core.NewCatalogClient(proxyCfg.CatalogURL)
+		if err != nil {
+			return nil, err
+		}
+		modelRegistryService.SetCatalog(catalog)
+	}
+
 	glog.Infof("EmbedMD service connected")
 
 	return modelRegistryService, nil
@@ -250,4 +275,6 @@ func init() {
 	proxyCmd.Flags().BoolVar(&proxyCfg.EmbedMD.TLSConfig.VerifyServerCert, "embedmd-database-ssl-verify-server-cert", false, "EmbedMD SSL verify server cert")
 
 	proxyCmd.Flags().StringVar(&proxyCfg.DatastoreType, "datastore-type", proxyCfg.DatastoreType, "Datastore type")
+	proxyCmd.Flags().StringVar(&proxyCfg.LicensePolicyPath, "license-policy-path", "", "Path to a license policy file checked when registered models are created")
+	proxyCmd.Flags().StringVar(&proxyCfg.CatalogURL, "catalog-url", "", "URL of a model catalog checked for deprecated models when model versions are created from catalog models")
 }
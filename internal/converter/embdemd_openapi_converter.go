@@ -56,6 +56,9 @@ type EmbedMDToOpenAPIConverter interface {
 	// goverter:map Properties ModelSourceGroup | MapEmbedMDPropertyModelSourceGroup
 	// goverter:map Properties ModelSourceId | MapEmbedMDPropertyModelSourceId
 	// goverter:map Properties ModelSourceName | MapEmbedMDPropertyModelSourceName
+	// goverter:map Properties Digest | MapEmbedMDPropertyDigestModelArtifact
+	// goverter:map Properties FileDigests | MapEmbedMDPropertyFileDigestsModelArtifact
+	// goverter:map Properties Signatures | MapEmbedMDPropertySignaturesModelArtifact
 	// goverter:map Attributes ExternalId | MapEmbedMDExternalIDModelArtifact
 	// goverter:map Attributes Name | MapEmbedMDNameModelArtifact
 	// goverter:map Attributes Uri | MapEmbedMDURIModelArtifact
@@ -84,11 +87,19 @@ type EmbedMDToOpenAPIConverter interface {
 	// goverter:map Attributes Name | MapEmbedMDNameServingEnvironment
 	// goverter:map Attributes CreateTimeSinceEpoch | MapEmbedMDCreateTimeSinceEpochServingEnvironment
 	// goverter:map Attributes LastUpdateTimeSinceEpoch | MapEmbedMDLastUpdateTimeSinceEpochServingEnvironment
+	// goverter:map Properties ClusterId | MapEmbedMDPropertyClusterIdServingEnvironment
+	// goverter:map Properties Tier | MapEmbedMDPropertyTierServingEnvironment
+	// goverter:map Properties AllowedRuntimes | MapEmbedMDPropertyAllowedRuntimesServingEnvironment
+	// goverter:map Properties Quotas | MapEmbedMDPropertyQuotasServingEnvironment
 	ConvertServingEnvironment(source *models.ServingEnvironmentImpl) (*openapi.ServingEnvironment, error)
 
 	// goverter:map Properties Description | MapEmbedMDDescription
 	// goverter:map Properties Runtime | MapEmbedMDPropertyRuntime
 	// goverter:map Properties DesiredState | MapEmbedMDPropertyDesiredStateInferenceService
+	// goverter:map Properties ActualState | MapEmbedMDPropertyActualStateInferenceService
+	// goverter:map Properties DeploymentStatus | MapEmbedMDPropertyDeploymentStatusInferenceService
+	// goverter:map Properties TrafficSplit | MapEmbedMDPropertyTrafficSplitInferenceService
+	// goverter:map Properties StateDrift | MapEmbedMDPropertyStateDriftInferenceService
 	// goverter:map Properties ModelVersionId | MapEmbedMDPropertyModelVersionId
 	// goverter:map Properties RegisteredModelId | MapEmbedMDPropertyRegisteredModelId
 	// goverter:map Properties ServingEnvironmentId | MapEmbedMDPropertyServingEnvironmentId
@@ -107,6 +118,16 @@ type EmbedMDToOpenAPIConverter interface {
 	// goverter:map Attributes LastUpdateTimeSinceEpoch | MapEmbedMDLastUpdateTimeSinceEpochServeModel
 	ConvertServeModel(source *models.ServeModelImpl) (*openapi.ServeModel, error)
 
+	// goverter:map Properties Type | MapEmbedMDPropertyEventTypeDeploymentEvent
+	// goverter:map Properties InferenceServiceId | MapEmbedMDPropertyInferenceServiceId
+	// goverter:map Properties ModelVersionId | MapEmbedMDPropertyModelVersionIdServeModel
+	// goverter:map Properties RegisteredModelId | MapEmbedMDPropertyRegisteredModelId
+	// goverter:map Properties ServingEnvironmentId | MapEmbedMDPropertyServingEnvironmentId
+	// goverter:map Properties Runtime | MapEmbedMDPropertyRuntime
+	// goverter:map Properties Actor | MapEmbedMDPropertyActorDeploymentEvent
+	// goverter:map Attributes CreateTimeSinceEpoch | MapEmbedMDCreateTimeSinceEpochDeploymentEvent
+	ConvertDeploymentEvent(source *models.DeploymentEventImpl) (*openapi.DeploymentEvent, error)
+
 	// goverter:map Properties Description | MapEmbedMDDescription
 	// goverter:map Properties Owner | MapEmbedMDOwner
 	// goverter:map Properties State | MapEmbedMDStateExperiment
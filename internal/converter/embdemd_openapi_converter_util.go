@@ -338,6 +338,66 @@ func MapEmbedMDLastUpdateTimeSinceEpochServingEnvironment(source *models.Serving
 	return Int64ToString(source.LastUpdateTimeSinceEpoch)
 }
 
+func MapEmbedMDPropertyClusterIdServingEnvironment(source *[]models.Properties) *string {
+	for _, v := range *source {
+		if v.Name == "cluster_id" {
+			return v.StringValue
+		}
+	}
+
+	return nil
+}
+
+func MapEmbedMDPropertyTierServingEnvironment(source *[]models.Properties) (*openapi.ServingEnvironmentTier, error) {
+	for _, v := range *source {
+		if v.Name == "tier" && v.StringValue != nil {
+			return openapi.NewServingEnvironmentTierFromValue(*v.StringValue)
+		}
+	}
+
+	return nil, nil
+}
+
+func MapEmbedMDPropertyAllowedRuntimesServingEnvironment(source *[]models.Properties) ([]string, error) {
+	for _, v := range *source {
+		if v.Name == "allowed_runtimes" && v.StringValue != nil {
+			var allowedRuntimes []string
+			if err := json.Unmarshal([]byte(*v.StringValue), &allowedRuntimes); err != nil {
+				return nil, fmt.Errorf("invalid allowed_runtimes: %w", err)
+			}
+
+			// An empty list is stored to lift a previous restriction.
+			if len(allowedRuntimes) == 0 {
+				return nil, nil
+			}
+
+			return allowedRuntimes, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func MapEmbedMDPropertyQuotasServingEnvironment(source *[]models.Properties) (map[string]string, error) {
+	for _, v := range *source {
+		if v.Name == "quotas" && v.StringValue != nil {
+			var quotas map[string]string
+			if err := json.Unmarshal([]byte(*v.StringValue), &quotas); err != nil {
+				return nil, fmt.Errorf("invalid quotas: %w", err)
+			}
+
+			// An empty object is stored to clear previous quotas.
+			if len(quotas) == 0 {
+				return nil, nil
+			}
+
+			return quotas, nil
+		}
+	}
+
+	return nil, nil
+}
+
 func MapEmbedMDPropertyRuntime(source *[]models.Properties) *string {
 	for _, v := range *source {
 		if v.Name == "runtime" {
@@ -371,6 +431,61 @@ func MapEmbedMDPropertyDesiredStateInferenceService(source *[]models.Properties)
 	return nil, nil
 }
 
+func MapEmbedMDPropertyActualStateInferenceService(source *[]models.Properties) (*openapi.InferenceServiceActualState, error) {
+	for _, v := range *source {
+		if v.Name == "actual_state" && v.StringValue != nil {
+			return openapi.NewInferenceServiceActualStateFromValue(*v.StringValue)
+		}
+	}
+
+	return nil, nil
+}
+
+func MapEmbedMDPropertyDeploymentStatusInferenceService(source *[]models.Properties) (*openapi.InferenceServiceDeploymentStatus, error) {
+	for _, v := range *source {
+		if v.Name == "deployment_status" && v.StringValue != nil {
+			var deploymentStatus openapi.InferenceServiceDeploymentStatus
+			if err := json.Unmarshal([]byte(*v.StringValue), &deploymentStatus); err != nil {
+				return nil, fmt.Errorf("invalid deployment_status: %w", err)
+			}
+
+			return &deploymentStatus, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func MapEmbedMDPropertyTrafficSplitInferenceService(source *[]models.Properties) ([]openapi.InferenceServiceTrafficSplit, error) {
+	for _, v := range *source {
+		if v.Name == "traffic_split" && v.StringValue != nil {
+			var trafficSplit []openapi.InferenceServiceTrafficSplit
+			if err := json.Unmarshal([]byte(*v.StringValue), &trafficSplit); err != nil {
+				return nil, fmt.Errorf("invalid traffic_split: %w", err)
+			}
+
+			// An empty list is stored to clear a previous traffic split.
+			if len(trafficSplit) == 0 {
+				return nil, nil
+			}
+
+			return trafficSplit, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func MapEmbedMDPropertyStateDriftInferenceService(source *[]models.Properties) *bool {
+	for _, v := range *source {
+		if v.Name == "state_drift" {
+			return v.BoolValue
+		}
+	}
+
+	return nil
+}
+
 func MapEmbedMDPropertyModelVersionId(source *[]models.Properties) *string {
 	for _, v := range *source {
 		if v.Name == "model_version_id" {
@@ -533,6 +648,61 @@ func MapEmbedMDPropertyModelSourceName(source *[]models.Properties) *string {
 	return nil
 }
 
+func MapEmbedMDPropertyDigestModelArtifact(source *[]models.Properties) (*openapi.ArtifactDigest, error) {
+	for _, v := range *source {
+		if v.Name == "digest" && v.StringValue != nil {
+			var digest openapi.ArtifactDigest
+			if err := json.Unmarshal([]byte(*v.StringValue), &digest); err != nil {
+				return nil, fmt.Errorf("invalid digest: %w", err)
+			}
+
+			return &digest, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func MapEmbedMDPropertyFileDigestsModelArtifact(source *[]models.Properties) ([]openapi.ArtifactFileDigest, error) {
+	for _, v := range *source {
+		if v.Name == "file_digests" && v.StringValue != nil {
+			var fileDigests []openapi.ArtifactFileDigest
+			if err := json.Unmarshal([]byte(*v.StringValue), &fileDigests); err != nil {
+				return nil, fmt.Errorf("invalid file_digests: %w", err)
+			}
+
+			// An empty list is stored to clear a previous manifest.
+			if len(fileDigests) == 0 {
+				return nil, nil
+			}
+
+			return fileDigests, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func MapEmbedMDPropertySignaturesModelArtifact(source *[]models.Properties) ([]openapi.ArtifactSignature, error) {
+	for _, v := range *source {
+		if v.Name == "signatures" && v.StringValue != nil {
+			var signatures []openapi.ArtifactSignature
+			if err := json.Unmarshal([]byte(*v.StringValue), &signatures); err != nil {
+				return nil, fmt.Errorf("invalid signatures: %w", err)
+			}
+
+			// An empty list is stored to clear previous signatures.
+			if len(signatures) == 0 {
+				return nil, nil
+			}
+
+			return signatures, nil
+		}
+	}
+
+	return nil, nil
+}
+
 func MapEmbedMDExternalIDModelArtifact(source *models.ModelArtifactAttributes) *string {
 	return source.ExternalID
 }
@@ -619,6 +789,50 @@ func MapEmbedMDPropertyModelVersionIdServeModel(source *[]models.Properties) (st
 	return *modelVersionId, nil
 }
 
+// DeploymentEvent mapping functions
+func MapEmbedMDPropertyEventTypeDeploymentEvent(source *[]models.Properties) (openapi.DeploymentEventType, error) {
+	for _, v := range *source {
+		if v.Name == "event_type" && v.StringValue != nil {
+			eventType, err := openapi.NewDeploymentEventTypeFromValue(*v.StringValue)
+			if err != nil {
+				return "", err
+			}
+
+			return *eventType, nil
+		}
+	}
+
+	return "", fmt.Errorf("event type is required")
+}
+
+func MapEmbedMDPropertyInferenceServiceId(source *[]models.Properties) string {
+	for _, v := range *source {
+		if v.Name == "inference_service_id" {
+			result := Int32ToString(v.IntValue)
+			if result == nil {
+				return ""
+			}
+			return *result
+		}
+	}
+
+	return ""
+}
+
+func MapEmbedMDPropertyActorDeploymentEvent(source *[]models.Properties) *string {
+	for _, v := range *source {
+		if v.Name == "actor" {
+			return v.StringValue
+		}
+	}
+
+	return nil
+}
+
+func MapEmbedMDCreateTimeSinceEpochDeploymentEvent(source *models.DeploymentEventAttributes) *string {
+	return Int64ToString(source.CreateTimeSinceEpoch)
+}
+
 // Experiment mapping functions
 func MapEmbedMDStateExperiment(source *[]models.Properties) (*openapi.ExperimentState, error) {
 	for _, v := range *source {
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/kubeflow/hub/internal/db/models"
+	"github.com/kubeflow/hub/internal/platform/apiutils"
 	"github.com/kubeflow/hub/pkg/openapi"
 	"github.com/stretchr/testify/assert"
 )
@@ -1057,6 +1058,168 @@ func TestMapEmbedMDCreateTimeSinceEpochServingEnvironment(t *testing.T) {
 	}
 }
 
+func TestMapEmbedMDPropertyTierServingEnvironment(t *testing.T) {
+	testCases := []struct {
+		name     string
+		source   *[]models.Properties
+		expected *openapi.ServingEnvironmentTier
+		wantErr  bool
+	}{
+		{
+			name: "test tier with invalid value",
+			source: &[]models.Properties{
+				{
+					Name:        "tier",
+					StringValue: apiutils.Of("QA"),
+				},
+			},
+			expected: nil,
+			wantErr:  true,
+		},
+		{
+			name: "test tier with valid value",
+			source: &[]models.Properties{
+				{
+					Name:        "tier",
+					StringValue: apiutils.Of("PRODUCTION"),
+				},
+			},
+			expected: openapi.SERVINGENVIRONMENTTIER_PRODUCTION.Ptr(),
+			wantErr:  false,
+		},
+		{
+			name:     "test tier not set",
+			source:   &[]models.Properties{},
+			expected: nil,
+			wantErr:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := MapEmbedMDPropertyTierServingEnvironment(tc.source)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestMapEmbedMDPropertyAllowedRuntimesServingEnvironment(t *testing.T) {
+	testCases := []struct {
+		name     string
+		source   *[]models.Properties
+		expected []string
+		wantErr  bool
+	}{
+		{
+			name: "test allowed runtimes with invalid value",
+			source: &[]models.Properties{
+				{
+					Name:        "allowed_runtimes",
+					StringValue: apiutils.Of("["),
+				},
+			},
+			expected: nil,
+			wantErr:  true,
+		},
+		{
+			name: "test allowed runtimes cleared",
+			source: &[]models.Properties{
+				{
+					Name:        "allowed_runtimes",
+					StringValue: apiutils.Of("[]"),
+				},
+			},
+			expected: nil,
+			wantErr:  false,
+		},
+		{
+			name: "test allowed runtimes with valid value",
+			source: &[]models.Properties{
+				{
+					Name:        "allowed_runtimes",
+					StringValue: apiutils.Of(`["kserve-ovms","vllm-runtime"]`),
+				},
+			},
+			expected: []string{"kserve-ovms", "vllm-runtime"},
+			wantErr:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := MapEmbedMDPropertyAllowedRuntimesServingEnvironment(tc.source)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestMapEmbedMDPropertyQuotasServingEnvironment(t *testing.T) {
+	testCases := []struct {
+		name     string
+		source   *[]models.Properties
+		expected map[string]string
+		wantErr  bool
+	}{
+		{
+			name: "test quotas with invalid value",
+			source: &[]models.Properties{
+				{
+					Name:        "quotas",
+					StringValue: apiutils.Of("{"),
+				},
+			},
+			expected: nil,
+			wantErr:  true,
+		},
+		{
+			name: "test quotas cleared",
+			source: &[]models.Properties{
+				{
+					Name:        "quotas",
+					StringValue: apiutils.Of("{}"),
+				},
+			},
+			expected: nil,
+			wantErr:  false,
+		},
+		{
+			name: "test quotas with valid value",
+			source: &[]models.Properties{
+				{
+					Name:        "quotas",
+					StringValue: apiutils.Of(`{"limits.memory":"64Gi","requests.nvidia.com/gpu":"4"}`),
+				},
+			},
+			expected: map[string]string{
+				"limits.memory":           "64Gi",
+				"requests.nvidia.com/gpu": "4",
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := MapEmbedMDPropertyQuotasServingEnvironment(tc.source)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, actual)
+			}
+		})
+	}
+}
 func TestMapEmbedMDPropertyRuntime(t *testing.T) {
 	stringValue := "test"
 
@@ -1166,6 +1329,346 @@ func TestMapEmbedMDPropertyDesiredStateInferenceService(t *testing.T) {
 	}
 }
 
+func TestMapEmbedMDPropertyActualStateInferenceService(t *testing.T) {
+	invalidState := "test"
+	validState := openapi.INFERENCESERVICEACTUALSTATE_FAILED.Ptr()
+
+	testCases := []struct {
+		name     string
+		source   *[]models.Properties
+		expected *openapi.InferenceServiceActualState
+		wantErr  bool
+	}{
+		{
+			name: "test actual state with invalid value",
+			source: &[]models.Properties{
+				{
+					Name:        "actual_state",
+					StringValue: &invalidState,
+				},
+			},
+			expected: nil,
+			wantErr:  true,
+		},
+		{
+			name:     "test actual state not set",
+			source:   &[]models.Properties{},
+			expected: nil,
+			wantErr:  false,
+		},
+		{
+			name: "test actual state with valid value",
+			source: &[]models.Properties{
+				{
+					Name:        "actual_state",
+					StringValue: (*string)(validState),
+				},
+			},
+			expected: validState,
+			wantErr:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := MapEmbedMDPropertyActualStateInferenceService(tc.source)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestMapEmbedMDPropertyDeploymentStatusInferenceService(t *testing.T) {
+	testCases := []struct {
+		name     string
+		source   *[]models.Properties
+		expected *openapi.InferenceServiceDeploymentStatus
+		wantErr  bool
+	}{
+		{
+			name: "test deployment status with invalid value",
+			source: &[]models.Properties{
+				{
+					Name:        "deployment_status",
+					StringValue: apiutils.Of("{"),
+				},
+			},
+			expected: nil,
+			wantErr:  true,
+		},
+		{
+			name:     "test deployment status not set",
+			source:   &[]models.Properties{},
+			expected: nil,
+			wantErr:  false,
+		},
+		{
+			name: "test deployment status with valid value",
+			source: &[]models.Properties{
+				{
+					Name:        "deployment_status",
+					StringValue: apiutils.Of(`{"url":"http://example.com","traffic":[{"revisionName":"rev-1","modelVersionId":"2","percent":100}]}`),
+				},
+			},
+			expected: &openapi.InferenceServiceDeploymentStatus{
+				Url: apiutils.Of("http://example.com"),
+				Traffic: []openapi.InferenceServiceTrafficTarget{
+					{RevisionName: apiutils.Of("rev-1"), ModelVersionId: apiutils.Of("2"), Percent: apiutils.Of(int32(100))},
+				},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := MapEmbedMDPropertyDeploymentStatusInferenceService(tc.source)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestMapEmbedMDPropertyTrafficSplitInferenceService(t *testing.T) {
+	testCases := []struct {
+		name     string
+		source   *[]models.Properties
+		expected []openapi.InferenceServiceTrafficSplit
+		wantErr  bool
+	}{
+		{
+			name: "test traffic split with invalid value",
+			source: &[]models.Properties{
+				{
+					Name:        "traffic_split",
+					StringValue: apiutils.Of("["),
+				},
+			},
+			expected: nil,
+			wantErr:  true,
+		},
+		{
+			name:     "test traffic split not set",
+			source:   &[]models.Properties{},
+			expected: nil,
+			wantErr:  false,
+		},
+		{
+			name: "test traffic split cleared",
+			source: &[]models.Properties{
+				{
+					Name:        "traffic_split",
+					StringValue: apiutils.Of("[]"),
+				},
+			},
+			expected: nil,
+			wantErr:  false,
+		},
+		{
+			name: "test traffic split with valid value",
+			source: &[]models.Properties{
+				{
+					Name:        "traffic_split",
+					StringValue: apiutils.Of(`[{"modelVersionId":"1","percent":80},{"modelVersionId":"2","percent":20}]`),
+				},
+			},
+			expected: []openapi.InferenceServiceTrafficSplit{
+				{ModelVersionId: "1", Percent: 80},
+				{ModelVersionId: "2", Percent: 20},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := MapEmbedMDPropertyTrafficSplitInferenceService(tc.source)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestMapEmbedMDPropertyDigestModelArtifact(t *testing.T) {
+	testCases := []struct {
+		name     string
+		source   *[]models.Properties
+		expected *openapi.ArtifactDigest
+		wantErr  bool
+	}{
+		{
+			name: "test digest with invalid value",
+			source: &[]models.Properties{
+				{
+					Name:        "digest",
+					StringValue: apiutils.Of("{"),
+				},
+			},
+			expected: nil,
+			wantErr:  true,
+		},
+		{
+			name:     "test digest not set",
+			source:   &[]models.Properties{},
+			expected: nil,
+			wantErr:  false,
+		},
+		{
+			name: "test digest with valid value",
+			source: &[]models.Properties{
+				{
+					Name:        "digest",
+					StringValue: apiutils.Of(`{"algorithm":"sha512","value":"abc123"}`),
+				},
+			},
+			expected: &openapi.ArtifactDigest{
+				Algorithm: openapi.ARTIFACTDIGESTALGORITHM_SHA512,
+				Value:     "abc123",
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := MapEmbedMDPropertyDigestModelArtifact(tc.source)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestMapEmbedMDPropertyFileDigestsModelArtifact(t *testing.T) {
+	testCases := []struct {
+		name     string
+		source   *[]models.Properties
+		expected []openapi.ArtifactFileDigest
+		wantErr  bool
+	}{
+		{
+			name: "test file digests with invalid value",
+			source: &[]models.Properties{
+				{
+					Name:        "file_digests",
+					StringValue: apiutils.Of("["),
+				},
+			},
+			expected: nil,
+			wantErr:  true,
+		},
+		{
+			name: "test file digests cleared",
+			source: &[]models.Properties{
+				{
+					Name:        "file_digests",
+					StringValue: apiutils.Of("[]"),
+				},
+			},
+			expected: nil,
+			wantErr:  false,
+		},
+		{
+			name: "test file digests with valid value",
+			source: &[]models.Properties{
+				{
+					Name:        "file_digests",
+					StringValue: apiutils.Of(`[{"path":"config.json","algorithm":"sha256","value":"abc"},{"path":"model.bin","algorithm":"sha256","value":"def"}]`),
+				},
+			},
+			expected: []openapi.ArtifactFileDigest{
+				{Path: "config.json", Algorithm: openapi.ARTIFACTDIGESTALGORITHM_SHA256, Value: "abc"},
+				{Path: "model.bin", Algorithm: openapi.ARTIFACTDIGESTALGORITHM_SHA256, Value: "def"},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := MapEmbedMDPropertyFileDigestsModelArtifact(tc.source)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestMapEmbedMDPropertySignaturesModelArtifact(t *testing.T) {
+	testCases := []struct {
+		name     string
+		source   *[]models.Properties
+		expected []openapi.ArtifactSignature
+		wantErr  bool
+	}{
+		{
+			name: "test signatures with invalid value",
+			source: &[]models.Properties{
+				{
+					Name:        "signatures",
+					StringValue: apiutils.Of("["),
+				},
+			},
+			expected: nil,
+			wantErr:  true,
+		},
+		{
+			name: "test signatures cleared",
+			source: &[]models.Properties{
+				{
+					Name:        "signatures",
+					StringValue: apiutils.Of("[]"),
+				},
+			},
+			expected: nil,
+			wantErr:  false,
+		},
+		{
+			name: "test signatures with valid value",
+			source: &[]models.Properties{
+				{
+					Name:        "signatures",
+					StringValue: apiutils.Of(`[{"type":"sigstore-bundle","uri":"oci://quay.io/org/model:sha256-abc.sig"}]`),
+				},
+			},
+			expected: []openapi.ArtifactSignature{
+				{Type: "sigstore-bundle", Uri: "oci://quay.io/org/model:sha256-abc.sig"},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := MapEmbedMDPropertySignaturesModelArtifact(tc.source)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, actual)
+			}
+		})
+	}
+}
+
 func TestMapEmbedMDPropertyModelVersionId(t *testing.T) {
 	intValue := int32(1)
 
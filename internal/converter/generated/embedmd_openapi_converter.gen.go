@@ -48,6 +48,31 @@ func (c *EmbedMDToOpenAPIConverterImpl) ConvertDataSet(source *entity.BaseEntity
 	}
 	return pOpenapiDataSet, nil
 }
+func (c *EmbedMDToOpenAPIConverterImpl) ConvertDeploymentEvent(source *entity.BaseEntity[models.DeploymentEventAttributes]) (*openapi.DeploymentEvent, error) {
+	var pOpenapiDeploymentEvent *openapi.DeploymentEvent
+	if source != nil {
+		var openapiDeploymentEvent openapi.DeploymentEvent
+		openapiDeploymentEvent.Id = converter.Int32ToString((*source).ID)
+		openapiDeploymentEvent.CreateTimeSinceEpoch = converter.MapEmbedMDCreateTimeSinceEpochDeploymentEvent((*source).Attributes)
+		openapiDeploymentEventType, err := converter.MapEmbedMDPropertyEventTypeDeploymentEvent((*source).Properties)
+		if err != nil {
+			return nil, fmt.Errorf("error setting field Type: %w", err)
+		}
+		openapiDeploymentEvent.Type = openapiDeploymentEventType
+		openapiDeploymentEvent.InferenceServiceId = converter.MapEmbedMDPropertyInferenceServiceId((*source).Properties)
+		xstring, err := converter.MapEmbedMDPropertyModelVersionIdServeModel((*source).Properties)
+		if err != nil {
+			return nil, fmt.Errorf("error setting field ModelVersionId: %w", err)
+		}
+		openapiDeploymentEvent.ModelVersionId = xstring
+		openapiDeploymentEvent.RegisteredModelId = converter.MapEmbedMDPropertyRegisteredModelId((*source).Properties)
+		openapiDeploymentEvent.ServingEnvironmentId = converter.MapEmbedMDPropertyServingEnvironmentId((*source).Properties)
+		openapiDeploymentEvent.Runtime = converter.MapEmbedMDPropertyRuntime((*source).Properties)
+		openapiDeploymentEvent.Actor = converter.MapEmbedMDPropertyActorDeploymentEvent((*source).Properties)
+		pOpenapiDeploymentEvent = &openapiDeploymentEvent
+	}
+	return pOpenapiDeploymentEvent, nil
+}
 func (c *EmbedMDToOpenAPIConverterImpl) ConvertDocArtifact(source *entity.BaseEntity[models.DocArtifactAttributes]) (*openapi.DocArtifact, error) {
 	var pOpenapiDocArtifact *openapi.DocArtifact
 	if source != nil {
@@ -168,8 +193,24 @@ func (c *EmbedMDToOpenAPIConverterImpl) ConvertInferenceService(source *entity.B
 			return nil, fmt.Errorf("error setting field DesiredState: %w", err)
 		}
 		openapiInferenceService.DesiredState = pOpenapiInferenceServiceState
+		pOpenapiInferenceServiceActualState, err := converter.MapEmbedMDPropertyActualStateInferenceService((*source).Properties)
+		if err != nil {
+			return nil, err
+		}
+		openapiInferenceService.ActualState = pOpenapiInferenceServiceActualState
+		pOpenapiInferenceServiceDeploymentStatus, err := converter.MapEmbedMDPropertyDeploymentStatusInferenceService((*source).Properties)
+		if err != nil {
+			return nil, err
+		}
+		openapiInferenceService.DeploymentStatus = pOpenapiInferenceServiceDeploymentStatus
+		openapiInferenceServiceTrafficSplitList, err := converter.MapEmbedMDPropertyTrafficSplitInferenceService((*source).Properties)
+		if err != nil {
+			return nil, err
+		}
+		openapiInferenceService.TrafficSplit = openapiInferenceServiceTrafficSplitList
 		openapiInferenceService.RegisteredModelId = converter.MapEmbedMDPropertyRegisteredModelId((*source).Properties)
 		openapiInferenceService.ServingEnvironmentId = converter.MapEmbedMDPropertyServingEnvironmentId((*source).Properties)
+		openapiInferenceService.StateDrift = converter.MapEmbedMDPropertyStateDriftInferenceService((*source).Properties)
 		pOpenapiInferenceService = &openapiInferenceService
 	}
 	return pOpenapiInferenceService, nil
@@ -242,6 +283,21 @@ func (c *EmbedMDToOpenAPIConverterImpl) ConvertModelArtifact(source *entity.Base
 			return nil, fmt.Errorf("error setting field State: %w", err)
 		}
 		openapiModelArtifact.State = pOpenapiArtifactState
+		pOpenapiArtifactDigest, err := converter.MapEmbedMDPropertyDigestModelArtifact((*source).Properties)
+		if err != nil {
+			return nil, fmt.Errorf("error setting field Digest: %w", err)
+		}
+		openapiModelArtifact.Digest = pOpenapiArtifactDigest
+		openapiArtifactFileDigestList, err := converter.MapEmbedMDPropertyFileDigestsModelArtifact((*source).Properties)
+		if err != nil {
+			return nil, fmt.Errorf("error setting field FileDigests: %w", err)
+		}
+		openapiModelArtifact.FileDigests = openapiArtifactFileDigestList
+		openapiArtifactSignatureList, err := converter.MapEmbedMDPropertySignaturesModelArtifact((*source).Properties)
+		if err != nil {
+			return nil, fmt.Errorf("error setting field Signatures: %w", err)
+		}
+		openapiModelArtifact.Signatures = openapiArtifactSignatureList
 		pOpenapiModelArtifact = &openapiModelArtifact
 	}
 	return pOpenapiModelArtifact, nil
@@ -393,6 +449,22 @@ func (c *EmbedMDToOpenAPIConverterImpl) ConvertServingEnvironment(source *entity
 		openapiServingEnvironment.Id = converter.Int32ToString((*source).ID)
 		openapiServingEnvironment.CreateTimeSinceEpoch = converter.MapEmbedMDCreateTimeSinceEpochServingEnvironment((*source).Attributes)
 		openapiServingEnvironment.LastUpdateTimeSinceEpoch = converter.MapEmbedMDLastUpdateTimeSinceEpochServingEnvironment((*source).Attributes)
+		openapiServingEnvironment.ClusterId = converter.MapEmbedMDPropertyClusterIdServingEnvironment((*source).Properties)
+		pOpenapiServingEnvironmentTier, err := converter.MapEmbedMDPropertyTierServingEnvironment((*source).Properties)
+		if err != nil {
+			return nil, fmt.Errorf("error setting field Tier: %w", err)
+		}
+		openapiServingEnvironment.Tier = pOpenapiServingEnvironmentTier
+		stringList, err := converter.MapEmbedMDPropertyAllowedRuntimesServingEnvironment((*source).Properties)
+		if err != nil {
+			return nil, fmt.Errorf("error setting field AllowedRuntimes: %w", err)
+		}
+		openapiServingEnvironment.AllowedRuntimes = stringList
+		mapStringString, err := converter.MapEmbedMDPropertyQuotasServingEnvironment((*source).Properties)
+		if err != nil {
+			return nil, fmt.Errorf("error setting field Quotas: %w", err)
+		}
+		openapiServingEnvironment.Quotas = mapStringString
 		pOpenapiServingEnvironment = &openapiServingEnvironment
 	}
 	return pOpenapiServingEnvironment, nil
@@ -458,6 +458,20 @@ func (c *OpenAPIConverterImpl) ConvertInferenceServiceCreate(source *openapi.Inf
 			}
 			openapiInferenceService.DesiredState = &openapiInferenceServiceState
 		}
+		if (*source).ActualState != nil {
+			openapiInferenceServiceActualState, err := c.openapiInferenceServiceActualStateToOpenapiInferenceServiceActualState(*(*source).ActualState)
+			if err != nil {
+				return nil, fmt.Errorf("error setting field ActualState: %w", err)
+			}
+			openapiInferenceService.ActualState = &openapiInferenceServiceActualState
+		}
+		openapiInferenceService.DeploymentStatus = c.pOpenapiInferenceServiceDeploymentStatusToPOpenapiInferenceServiceDeploymentStatus((*source).DeploymentStatus)
+		if (*source).TrafficSplit != nil {
+			openapiInferenceService.TrafficSplit = make([]openapi.InferenceServiceTrafficSplit, len((*source).TrafficSplit))
+			for i := 0; i < len((*source).TrafficSplit); i++ {
+				openapiInferenceService.TrafficSplit[i] = c.openapiInferenceServiceTrafficSplitToOpenapiInferenceServiceTrafficSplit((*source).TrafficSplit[i])
+			}
+		}
 		openapiInferenceService.RegisteredModelId = (*source).RegisteredModelId
 		openapiInferenceService.ServingEnvironmentId = (*source).ServingEnvironmentId
 		pOpenapiInferenceService = &openapiInferenceService
@@ -497,6 +511,20 @@ func (c *OpenAPIConverterImpl) ConvertInferenceServiceUpdate(source *openapi.Inf
 			}
 			openapiInferenceService.DesiredState = &openapiInferenceServiceState
 		}
+		if (*source).ActualState != nil {
+			openapiInferenceServiceActualState, err := c.openapiInferenceServiceActualStateToOpenapiInferenceServiceActualState(*(*source).ActualState)
+			if err != nil {
+				return nil, fmt.Errorf("error setting field ActualState: %w", err)
+			}
+			openapiInferenceService.ActualState = &openapiInferenceServiceActualState
+		}
+		openapiInferenceService.DeploymentStatus = c.pOpenapiInferenceServiceDeploymentStatusToPOpenapiInferenceServiceDeploymentStatus((*source).DeploymentStatus)
+		if (*source).TrafficSplit != nil {
+			openapiInferenceService.TrafficSplit = make([]openapi.InferenceServiceTrafficSplit, len((*source).TrafficSplit))
+			for i := 0; i < len((*source).TrafficSplit); i++ {
+				openapiInferenceService.TrafficSplit[i] = c.openapiInferenceServiceTrafficSplitToOpenapiInferenceServiceTrafficSplit((*source).TrafficSplit[i])
+			}
+		}
 		pOpenapiInferenceService = &openapiInferenceService
 	}
 	return pOpenapiInferenceService, nil
@@ -660,6 +688,27 @@ func (c *OpenAPIConverterImpl) ConvertModelArtifactCreate(source *openapi.ModelA
 			}
 			openapiModelArtifact.State = &openapiArtifactState
 		}
+		pOpenapiArtifactDigest, err := c.pOpenapiArtifactDigestToPOpenapiArtifactDigest((*source).Digest)
+		if err != nil {
+			return nil, fmt.Errorf("error setting field Digest: %w", err)
+		}
+		openapiModelArtifact.Digest = pOpenapiArtifactDigest
+		if (*source).FileDigests != nil {
+			openapiModelArtifact.FileDigests = make([]openapi.ArtifactFileDigest, len((*source).FileDigests))
+			for i := 0; i < len((*source).FileDigests); i++ {
+				openapiArtifactFileDigest, err := c.openapiArtifactFileDigestToOpenapiArtifactFileDigest((*source).FileDigests[i])
+				if err != nil {
+					return nil, fmt.Errorf("error setting field FileDigests: %w", err)
+				}
+				openapiModelArtifact.FileDigests[i] = openapiArtifactFileDigest
+			}
+		}
+		if (*source).Signatures != nil {
+			openapiModelArtifact.Signatures = make([]openapi.ArtifactSignature, len((*source).Signatures))
+			for j := 0; j < len((*source).Signatures); j++ {
+				openapiModelArtifact.Signatures[j] = c.openapiArtifactSignatureToOpenapiArtifactSignature((*source).Signatures[j])
+			}
+		}
 		pOpenapiModelArtifact = &openapiModelArtifact
 	}
 	return pOpenapiModelArtifact, nil
@@ -733,6 +782,27 @@ func (c *OpenAPIConverterImpl) ConvertModelArtifactUpdate(source *openapi.ModelA
 			}
 			openapiModelArtifact.State = &openapiArtifactState
 		}
+		pOpenapiArtifactDigest, err := c.pOpenapiArtifactDigestToPOpenapiArtifactDigest((*source).Digest)
+		if err != nil {
+			return nil, fmt.Errorf("error setting field Digest: %w", err)
+		}
+		openapiModelArtifact.Digest = pOpenapiArtifactDigest
+		if (*source).FileDigests != nil {
+			openapiModelArtifact.FileDigests = make([]openapi.ArtifactFileDigest, len((*source).FileDigests))
+			for i := 0; i < len((*source).FileDigests); i++ {
+				openapiArtifactFileDigest, err := c.openapiArtifactFileDigestToOpenapiArtifactFileDigest((*source).FileDigests[i])
+				if err != nil {
+					return nil, fmt.Errorf("error setting field FileDigests: %w", err)
+				}
+				openapiModelArtifact.FileDigests[i] = openapiArtifactFileDigest
+			}
+		}
+		if (*source).Signatures != nil {
+			openapiModelArtifact.Signatures = make([]openapi.ArtifactSignature, len((*source).Signatures))
+			for j := 0; j < len((*source).Signatures); j++ {
+				openapiModelArtifact.Signatures[j] = c.openapiArtifactSignatureToOpenapiArtifactSignature((*source).Signatures[j])
+			}
+		}
 		pOpenapiModelArtifact = &openapiModelArtifact
 	}
 	return pOpenapiModelArtifact, nil
@@ -1118,6 +1188,29 @@ func (c *OpenAPIConverterImpl) ConvertServingEnvironmentCreate(source *openapi.S
 			openapiServingEnvironment.ExternalId = &xstring2
 		}
 		openapiServingEnvironment.Name = (*source).Name
+		if (*source).ClusterId != nil {
+			xstring3 := *(*source).ClusterId
+			openapiServingEnvironment.ClusterId = &xstring3
+		}
+		if (*source).Tier != nil {
+			openapiServingEnvironmentTier, err := c.openapiServingEnvironmentTierToOpenapiServingEnvironmentTier(*(*source).Tier)
+			if err != nil {
+				return nil, fmt.Errorf("error setting field Tier: %w", err)
+			}
+			openapiServingEnvironment.Tier = &openapiServingEnvironmentTier
+		}
+		if (*source).AllowedRuntimes != nil {
+			openapiServingEnvironment.AllowedRuntimes = make([]string, len((*source).AllowedRuntimes))
+			for i := 0; i < len((*source).AllowedRuntimes); i++ {
+				openapiServingEnvironment.AllowedRuntimes[i] = (*source).AllowedRuntimes[i]
+			}
+		}
+		if (*source).Quotas != nil {
+			openapiServingEnvironment.Quotas = make(map[string]string, len((*source).Quotas))
+			for key2, value2 := range (*source).Quotas {
+				openapiServingEnvironment.Quotas[key2] = value2
+			}
+		}
 		pOpenapiServingEnvironment = &openapiServingEnvironment
 	}
 	return pOpenapiServingEnvironment, nil
@@ -1140,6 +1233,29 @@ func (c *OpenAPIConverterImpl) ConvertServingEnvironmentUpdate(source *openapi.S
 			xstring2 := *(*source).ExternalId
 			openapiServingEnvironment.ExternalId = &xstring2
 		}
+		if (*source).ClusterId != nil {
+			xstring3 := *(*source).ClusterId
+			openapiServingEnvironment.ClusterId = &xstring3
+		}
+		if (*source).Tier != nil {
+			openapiServingEnvironmentTier, err := c.openapiServingEnvironmentTierToOpenapiServingEnvironmentTier(*(*source).Tier)
+			if err != nil {
+				return nil, fmt.Errorf("error setting field Tier: %w", err)
+			}
+			openapiServingEnvironment.Tier = &openapiServingEnvironmentTier
+		}
+		if (*source).AllowedRuntimes != nil {
+			openapiServingEnvironment.AllowedRuntimes = make([]string, len((*source).AllowedRuntimes))
+			for i := 0; i < len((*source).AllowedRuntimes); i++ {
+				openapiServingEnvironment.AllowedRuntimes[i] = (*source).AllowedRuntimes[i]
+			}
+		}
+		if (*source).Quotas != nil {
+			openapiServingEnvironment.Quotas = make(map[string]string, len((*source).Quotas))
+			for key2, value2 := range (*source).Quotas {
+				openapiServingEnvironment.Quotas[key2] = value2
+			}
+		}
 		pOpenapiServingEnvironment = &openapiServingEnvironment
 	}
 	return pOpenapiServingEnvironment, nil
@@ -1428,6 +1544,39 @@ func (c *OpenAPIConverterImpl) OverrideNotEditableForServingEnvironment(source c
 	}
 	return openapiServingEnvironment, nil
 }
+func (c *OpenAPIConverterImpl) openapiArtifactDigestAlgorithmToOpenapiArtifactDigestAlgorithm(source openapi.ArtifactDigestAlgorithm) (openapi.ArtifactDigestAlgorithm, error) {
+	var openapiArtifactDigestAlgorithm openapi.ArtifactDigestAlgorithm
+	switch source {
+	case openapi.ARTIFACTDIGESTALGORITHM_SHA256:
+		openapiArtifactDigestAlgorithm = openapi.ARTIFACTDIGESTALGORITHM_SHA256
+	case openapi.ARTIFACTDIGESTALGORITHM_SHA512:
+		openapiArtifactDigestAlgorithm = openapi.ARTIFACTDIGESTALGORITHM_SHA512
+	default:
+		return openapiArtifactDigestAlgorithm, fmt.Errorf("unexpected enum element: %v", source)
+	}
+	return openapiArtifactDigestAlgorithm, nil
+}
+func (c *OpenAPIConverterImpl) openapiArtifactFileDigestToOpenapiArtifactFileDigest(source openapi.ArtifactFileDigest) (openapi.ArtifactFileDigest, error) {
+	var openapiArtifactFileDigest openapi.ArtifactFileDigest
+	openapiArtifactFileDigest.Path = source.Path
+	openapiArtifactDigestAlgorithm, err := c.openapiArtifactDigestAlgorithmToOpenapiArtifactDigestAlgorithm(source.Algorithm)
+	if err != nil {
+		return openapiArtifactFileDigest, fmt.Errorf("error setting field Algorithm: %w", err)
+	}
+	openapiArtifactFileDigest.Algorithm = openapiArtifactDigestAlgorithm
+	openapiArtifactFileDigest.Value = source.Value
+	return openapiArtifactFileDigest, nil
+}
+func (c *OpenAPIConverterImpl) openapiArtifactSignatureToOpenapiArtifactSignature(source openapi.ArtifactSignature) openapi.ArtifactSignature {
+	var openapiArtifactSignature openapi.ArtifactSignature
+	openapiArtifactSignature.Type = source.Type
+	openapiArtifactSignature.Uri = source.Uri
+	if source.Signer != nil {
+		xstring := *source.Signer
+		openapiArtifactSignature.Signer = &xstring
+	}
+	return openapiArtifactSignature
+}
 func (c *OpenAPIConverterImpl) openapiArtifactStateToOpenapiArtifactState(source openapi.ArtifactState) (openapi.ArtifactState, error) {
 	var openapiArtifactState openapi.ArtifactState
 	switch source {
@@ -1514,6 +1663,40 @@ func (c *OpenAPIConverterImpl) openapiExperimentStateToOpenapiExperimentState(so
 	}
 	return openapiExperimentState, nil
 }
+func (c *OpenAPIConverterImpl) openapiInferenceServiceActualStateToOpenapiInferenceServiceActualState(source openapi.InferenceServiceActualState) (openapi.InferenceServiceActualState, error) {
+	var openapiInferenceServiceActualState openapi.InferenceServiceActualState
+	switch source {
+	case openapi.INFERENCESERVICEACTUALSTATE_DEPLOYED:
+		openapiInferenceServiceActualState = openapi.INFERENCESERVICEACTUALSTATE_DEPLOYED
+	case openapi.INFERENCESERVICEACTUALSTATE_DEPLOYING:
+		openapiInferenceServiceActualState = openapi.INFERENCESERVICEACTUALSTATE_DEPLOYING
+	case openapi.INFERENCESERVICEACTUALSTATE_FAILED:
+		openapiInferenceServiceActualState = openapi.INFERENCESERVICEACTUALSTATE_FAILED
+	case openapi.INFERENCESERVICEACTUALSTATE_UNDEPLOYED:
+		openapiInferenceServiceActualState = openapi.INFERENCESERVICEACTUALSTATE_UNDEPLOYED
+	default:
+		return openapiInferenceServiceActualState, fmt.Errorf("unexpected enum element: %v", source)
+	}
+	return openapiInferenceServiceActualState, nil
+}
+func (c *OpenAPIConverterImpl) openapiInferenceServiceConditionToOpenapiInferenceServiceCondition(source openapi.InferenceServiceCondition) openapi.InferenceServiceCondition {
+	var openapiInferenceServiceCondition openapi.InferenceServiceCondition
+	openapiInferenceServiceCondition.Type = source.Type
+	openapiInferenceServiceCondition.Status = source.Status
+	if source.Reason != nil {
+		xstring := *source.Reason
+		openapiInferenceServiceCondition.Reason = &xstring
+	}
+	if source.Message != nil {
+		xstring2 := *source.Message
+		openapiInferenceServiceCondition.Message = &xstring2
+	}
+	if source.LastTransitionTimeSinceEpoch != nil {
+		xstring3 := *source.LastTransitionTimeSinceEpoch
+		openapiInferenceServiceCondition.LastTransitionTimeSinceEpoch = &xstring3
+	}
+	return openapiInferenceServiceCondition
+}
 func (c *OpenAPIConverterImpl) openapiInferenceServiceStateToOpenapiInferenceServiceState(source openapi.InferenceServiceState) (openapi.InferenceServiceState, error) {
 	var openapiInferenceServiceState openapi.InferenceServiceState
 	switch source {
@@ -1526,6 +1709,36 @@ func (c *OpenAPIConverterImpl) openapiInferenceServiceStateToOpenapiInferenceSer
 	}
 	return openapiInferenceServiceState, nil
 }
+func (c *OpenAPIConverterImpl) openapiInferenceServiceTrafficSplitToOpenapiInferenceServiceTrafficSplit(source openapi.InferenceServiceTrafficSplit) openapi.InferenceServiceTrafficSplit {
+	var openapiInferenceServiceTrafficSplit openapi.InferenceServiceTrafficSplit
+	openapiInferenceServiceTrafficSplit.ModelVersionId = source.ModelVersionId
+	openapiInferenceServiceTrafficSplit.Percent = source.Percent
+	return openapiInferenceServiceTrafficSplit
+}
+func (c *OpenAPIConverterImpl) openapiInferenceServiceTrafficTargetToOpenapiInferenceServiceTrafficTarget(source openapi.InferenceServiceTrafficTarget) openapi.InferenceServiceTrafficTarget {
+	var openapiInferenceServiceTrafficTarget openapi.InferenceServiceTrafficTarget
+	if source.RevisionName != nil {
+		xstring := *source.RevisionName
+		openapiInferenceServiceTrafficTarget.RevisionName = &xstring
+	}
+	if source.ModelVersionId != nil {
+		xstring2 := *source.ModelVersionId
+		openapiInferenceServiceTrafficTarget.ModelVersionId = &xstring2
+	}
+	if source.Percent != nil {
+		xint32 := *source.Percent
+		openapiInferenceServiceTrafficTarget.Percent = &xint32
+	}
+	if source.LatestRevision != nil {
+		xbool := *source.LatestRevision
+		openapiInferenceServiceTrafficTarget.LatestRevision = &xbool
+	}
+	if source.Tag != nil {
+		xstring3 := *source.Tag
+		openapiInferenceServiceTrafficTarget.Tag = &xstring3
+	}
+	return openapiInferenceServiceTrafficTarget
+}
 func (c *OpenAPIConverterImpl) openapiMetadataValueToOpenapiMetadataValue(source openapi.MetadataValue) openapi.MetadataValue {
 	var openapiMetadataValue openapi.MetadataValue
 	openapiMetadataValue.MetadataBoolValue = c.pOpenapiMetadataBoolValueToPOpenapiMetadataBoolValue(source.MetadataBoolValue)
@@ -1576,6 +1789,62 @@ func (c *OpenAPIConverterImpl) openapiRegisteredModelStateToOpenapiRegisteredMod
 	}
 	return openapiRegisteredModelState, nil
 }
+func (c *OpenAPIConverterImpl) openapiServingEnvironmentTierToOpenapiServingEnvironmentTier(source openapi.ServingEnvironmentTier) (openapi.ServingEnvironmentTier, error) {
+	var openapiServingEnvironmentTier openapi.ServingEnvironmentTier
+	switch source {
+	case openapi.SERVINGENVIRONMENTTIER_DEVELOPMENT:
+		openapiServingEnvironmentTier = openapi.SERVINGENVIRONMENTTIER_DEVELOPMENT
+	case openapi.SERVINGENVIRONMENTTIER_PRODUCTION:
+		openapiServingEnvironmentTier = openapi.SERVINGENVIRONMENTTIER_PRODUCTION
+	case openapi.SERVINGENVIRONMENTTIER_STAGING:
+		openapiServingEnvironmentTier = openapi.SERVINGENVIRONMENTTIER_STAGING
+	default:
+		return openapiServingEnvironmentTier, fmt.Errorf("unexpected enum element: %v", source)
+	}
+	return openapiServingEnvironmentTier, nil
+}
+func (c *OpenAPIConverterImpl) pOpenapiArtifactDigestToPOpenapiArtifactDigest(source *openapi.ArtifactDigest) (*openapi.ArtifactDigest, error) {
+	var pOpenapiArtifactDigest *openapi.ArtifactDigest
+	if source != nil {
+		var openapiArtifactDigest openapi.ArtifactDigest
+		openapiArtifactDigestAlgorithm, err := c.openapiArtifactDigestAlgorithmToOpenapiArtifactDigestAlgorithm((*source).Algorithm)
+		if err != nil {
+			return nil, fmt.Errorf("error setting field Algorithm: %w", err)
+		}
+		openapiArtifactDigest.Algorithm = openapiArtifactDigestAlgorithm
+		openapiArtifactDigest.Value = (*source).Value
+		pOpenapiArtifactDigest = &openapiArtifactDigest
+	}
+	return pOpenapiArtifactDigest, nil
+}
+func (c *OpenAPIConverterImpl) pOpenapiInferenceServiceDeploymentStatusToPOpenapiInferenceServiceDeploymentStatus(source *openapi.InferenceServiceDeploymentStatus) *openapi.InferenceServiceDeploymentStatus {
+	var pOpenapiInferenceServiceDeploymentStatus *openapi.InferenceServiceDeploymentStatus
+	if source != nil {
+		var openapiInferenceServiceDeploymentStatus openapi.InferenceServiceDeploymentStatus
+		if (*source).Url != nil {
+			xstring := *(*source).Url
+			openapiInferenceServiceDeploymentStatus.Url = &xstring
+		}
+		if (*source).Conditions != nil {
+			openapiInferenceServiceDeploymentStatus.Conditions = make([]openapi.InferenceServiceCondition, len((*source).Conditions))
+			for i := 0; i < len((*source).Conditions); i++ {
+				openapiInferenceServiceDeploymentStatus.Conditions[i] = c.openapiInferenceServiceConditionToOpenapiInferenceServiceCondition((*source).Conditions[i])
+			}
+		}
+		if (*source).Traffic != nil {
+			openapiInferenceServiceDeploymentStatus.Traffic = make([]openapi.InferenceServiceTrafficTarget, len((*source).Traffic))
+			for j := 0; j < len((*source).Traffic); j++ {
+				openapiInferenceServiceDeploymentStatus.Traffic[j] = c.openapiInferenceServiceTrafficTargetToOpenapiInferenceServiceTrafficTarget((*source).Traffic[j])
+			}
+		}
+		if (*source).LastTransitionTimeSinceEpoch != nil {
+			xstring2 := *(*source).LastTransitionTimeSinceEpoch
+			openapiInferenceServiceDeploymentStatus.LastTransitionTimeSinceEpoch = &xstring2
+		}
+		pOpenapiInferenceServiceDeploymentStatus = &openapiInferenceServiceDeploymentStatus
+	}
+	return pOpenapiInferenceServiceDeploymentStatus
+}
 func (c *OpenAPIConverterImpl) pOpenapiMetadataBoolValueToPOpenapiMetadataBoolValue(source *openapi.MetadataBoolValue) *openapi.MetadataBoolValue {
 	var pOpenapiMetadataBoolValue *openapi.MetadataBoolValue
 	if source != nil {
@@ -371,6 +371,36 @@ func (c *OpenAPIReconcilerImpl) UpdateExistingInferenceService(source converter.
 		}
 		openapiInferenceService.DesiredState = &openapiInferenceServiceState
 	}
+	var pOpenapiInferenceServiceActualState *openapi.InferenceServiceActualState
+	if source.Update != nil {
+		pOpenapiInferenceServiceActualState = source.Update.ActualState
+	}
+	if pOpenapiInferenceServiceActualState != nil {
+		openapiInferenceServiceActualState, err := c.openapiInferenceServiceActualStateToOpenapiInferenceServiceActualState(*pOpenapiInferenceServiceActualState)
+		if err != nil {
+			return openapiInferenceService, fmt.Errorf("error setting field ActualState: %w", err)
+		}
+		openapiInferenceService.ActualState = &openapiInferenceServiceActualState
+	}
+	var pOpenapiInferenceServiceDeploymentStatus *openapi.InferenceServiceDeploymentStatus
+	if source.Update != nil {
+		pOpenapiInferenceServiceDeploymentStatus = source.Update.DeploymentStatus
+	}
+	if pOpenapiInferenceServiceDeploymentStatus != nil {
+		openapiInferenceService.DeploymentStatus = c.pOpenapiInferenceServiceDeploymentStatusToPOpenapiInferenceServiceDeploymentStatus(pOpenapiInferenceServiceDeploymentStatus)
+	}
+	var pOpenapiInferenceServiceTrafficSplitList *[]openapi.InferenceServiceTrafficSplit
+	if source.Update != nil {
+		pOpenapiInferenceServiceTrafficSplitList = &source.Update.TrafficSplit
+	}
+	if pOpenapiInferenceServiceTrafficSplitList != nil {
+		if (*pOpenapiInferenceServiceTrafficSplitList) != nil {
+			openapiInferenceService.TrafficSplit = make([]openapi.InferenceServiceTrafficSplit, len((*pOpenapiInferenceServiceTrafficSplitList)))
+			for i := 0; i < len((*pOpenapiInferenceServiceTrafficSplitList)); i++ {
+				openapiInferenceService.TrafficSplit[i] = c.openapiInferenceServiceTrafficSplitToOpenapiInferenceServiceTrafficSplit((*pOpenapiInferenceServiceTrafficSplitList)[i])
+			}
+		}
+	}
 	return openapiInferenceService, nil
 }
 func (c *OpenAPIReconcilerImpl) UpdateExistingMetric(source converter.OpenapiUpdateWrapper[openapi.Metric]) (openapi.Metric, error) {
@@ -601,6 +631,45 @@ func (c *OpenAPIReconcilerImpl) UpdateExistingModelArtifact(source converter.Ope
 		}
 		openapiModelArtifact.State = &openapiArtifactState
 	}
+	var pOpenapiArtifactDigest *openapi.ArtifactDigest
+	if source.Update != nil {
+		pOpenapiArtifactDigest = source.Update.Digest
+	}
+	if pOpenapiArtifactDigest != nil {
+		pOpenapiArtifactDigest2, err := c.pOpenapiArtifactDigestToPOpenapiArtifactDigest(pOpenapiArtifactDigest)
+		if err != nil {
+			return openapiModelArtifact, fmt.Errorf("error setting field Digest: %w", err)
+		}
+		openapiModelArtifact.Digest = pOpenapiArtifactDigest2
+	}
+	var pOpenapiArtifactFileDigestList *[]openapi.ArtifactFileDigest
+	if source.Update != nil {
+		pOpenapiArtifactFileDigestList = &source.Update.FileDigests
+	}
+	if pOpenapiArtifactFileDigestList != nil {
+		if (*pOpenapiArtifactFileDigestList) != nil {
+			openapiModelArtifact.FileDigests = make([]openapi.ArtifactFileDigest, len((*pOpenapiArtifactFileDigestList)))
+			for i := 0; i < len((*pOpenapiArtifactFileDigestList)); i++ {
+				openapiArtifactFileDigest, err := c.openapiArtifactFileDigestToOpenapiArtifactFileDigest((*pOpenapiArtifactFileDigestList)[i])
+				if err != nil {
+					return openapiModelArtifact, fmt.Errorf("error setting field FileDigests: %w", err)
+				}
+				openapiModelArtifact.FileDigests[i] = openapiArtifactFileDigest
+			}
+		}
+	}
+	var pOpenapiArtifactSignatureList *[]openapi.ArtifactSignature
+	if source.Update != nil {
+		pOpenapiArtifactSignatureList = &source.Update.Signatures
+	}
+	if pOpenapiArtifactSignatureList != nil {
+		if (*pOpenapiArtifactSignatureList) != nil {
+			openapiModelArtifact.Signatures = make([]openapi.ArtifactSignature, len((*pOpenapiArtifactSignatureList)))
+			for j := 0; j < len((*pOpenapiArtifactSignatureList)); j++ {
+				openapiModelArtifact.Signatures[j] = c.openapiArtifactSignatureToOpenapiArtifactSignature((*pOpenapiArtifactSignatureList)[j])
+			}
+		}
+	}
 	return openapiModelArtifact, nil
 }
 func (c *OpenAPIReconcilerImpl) UpdateExistingModelVersion(source converter.OpenapiUpdateWrapper[openapi.ModelVersion]) (openapi.ModelVersion, error) {
@@ -936,8 +1005,84 @@ func (c *OpenAPIReconcilerImpl) UpdateExistingServingEnvironment(source converte
 		xstring2 := *pString2
 		openapiServingEnvironment.ExternalId = &xstring2
 	}
+	var pString3 *string
+	if source.Update != nil {
+		pString3 = source.Update.ClusterId
+	}
+	if pString3 != nil {
+		xstring3 := *pString3
+		openapiServingEnvironment.ClusterId = &xstring3
+	}
+	var pOpenapiServingEnvironmentTier *openapi.ServingEnvironmentTier
+	if source.Update != nil {
+		pOpenapiServingEnvironmentTier = source.Update.Tier
+	}
+	if pOpenapiServingEnvironmentTier != nil {
+		openapiServingEnvironmentTier, err := c.openapiServingEnvironmentTierToOpenapiServingEnvironmentTier(*pOpenapiServingEnvironmentTier)
+		if err != nil {
+			return openapiServingEnvironment, fmt.Errorf("error setting field Tier: %w", err)
+		}
+		openapiServingEnvironment.Tier = &openapiServingEnvironmentTier
+	}
+	var pStringList *[]string
+	if source.Update != nil {
+		pStringList = &source.Update.AllowedRuntimes
+	}
+	if pStringList != nil {
+		if (*pStringList) != nil {
+			openapiServingEnvironment.AllowedRuntimes = make([]string, len((*pStringList)))
+			for i := 0; i < len((*pStringList)); i++ {
+				openapiServingEnvironment.AllowedRuntimes[i] = (*pStringList)[i]
+			}
+		}
+	}
+	var pMapStringString *map[string]string
+	if source.Update != nil {
+		pMapStringString = &source.Update.Quotas
+	}
+	if pMapStringString != nil {
+		if (*pMapStringString) != nil {
+			openapiServingEnvironment.Quotas = make(map[string]string, len((*pMapStringString)))
+			for key2, value2 := range *pMapStringString {
+				openapiServingEnvironment.Quotas[key2] = value2
+			}
+		}
+	}
 	return openapiServingEnvironment, nil
 }
+func (c *OpenAPIReconcilerImpl) openapiArtifactDigestAlgorithmToOpenapiArtifactDigestAlgorithm(source openapi.ArtifactDigestAlgorithm) (openapi.ArtifactDigestAlgorithm, error) {
+	var openapiArtifactDigestAlgorithm openapi.ArtifactDigestAlgorithm
+	switch source {
+	case openapi.ARTIFACTDIGESTALGORITHM_SHA256:
+		openapiArtifactDigestAlgorithm = openapi.ARTIFACTDIGESTALGORITHM_SHA256
+	case openapi.ARTIFACTDIGESTALGORITHM_SHA512:
+		openapiArtifactDigestAlgorithm = openapi.ARTIFACTDIGESTALGORITHM_SHA512
+	default:
+		return openapiArtifactDigestAlgorithm, fmt.Errorf("unexpected enum element: %v", source)
+	}
+	return openapiArtifactDigestAlgorithm, nil
+}
+func (c *OpenAPIReconcilerImpl) openapiArtifactFileDigestToOpenapiArtifactFileDigest(source openapi.ArtifactFileDigest) (openapi.ArtifactFileDigest, error) {
+	var openapiArtifactFileDigest openapi.ArtifactFileDigest
+	openapiArtifactFileDigest.Path = source.Path
+	openapiArtifactDigestAlgorithm, err := c.openapiArtifactDigestAlgorithmToOpenapiArtifactDigestAlgorithm(source.Algorithm)
+	if err != nil {
+		return openapiArtifactFileDigest, fmt.Errorf("error setting field Algorithm: %w", err)
+	}
+	openapiArtifactFileDigest.Algorithm = openapiArtifactDigestAlgorithm
+	openapiArtifactFileDigest.Value = source.Value
+	return openapiArtifactFileDigest, nil
+}
+func (c *OpenAPIReconcilerImpl) openapiArtifactSignatureToOpenapiArtifactSignature(source openapi.ArtifactSignature) openapi.ArtifactSignature {
+	var openapiArtifactSignature openapi.ArtifactSignature
+	openapiArtifactSignature.Type = source.Type
+	openapiArtifactSignature.Uri = source.Uri
+	if source.Signer != nil {
+		xstring := *source.Signer
+		openapiArtifactSignature.Signer = &xstring
+	}
+	return openapiArtifactSignature
+}
 func (c *OpenAPIReconcilerImpl) openapiArtifactStateToOpenapiArtifactState(source openapi.ArtifactState) (openapi.ArtifactState, error) {
 	var openapiArtifactState openapi.ArtifactState
 	switch source {
@@ -1024,6 +1169,40 @@ func (c *OpenAPIReconcilerImpl) openapiExperimentStateToOpenapiExperimentState(s
 	}
 	return openapiExperimentState, nil
 }
+func (c *OpenAPIReconcilerImpl) openapiInferenceServiceActualStateToOpenapiInferenceServiceActualState(source openapi.InferenceServiceActualState) (openapi.InferenceServiceActualState, error) {
+	var openapiInferenceServiceActualState openapi.InferenceServiceActualState
+	switch source {
+	case openapi.INFERENCESERVICEACTUALSTATE_DEPLOYED:
+		openapiInferenceServiceActualState = openapi.INFERENCESERVICEACTUALSTATE_DEPLOYED
+	case openapi.INFERENCESERVICEACTUALSTATE_DEPLOYING:
+		openapiInferenceServiceActualState = openapi.INFERENCESERVICEACTUALSTATE_DEPLOYING
+	case openapi.INFERENCESERVICEACTUALSTATE_FAILED:
+		openapiInferenceServiceActualState = openapi.INFERENCESERVICEACTUALSTATE_FAILED
+	case openapi.INFERENCESERVICEACTUALSTATE_UNDEPLOYED:
+		openapiInferenceServiceActualState = openapi.INFERENCESERVICEACTUALSTATE_UNDEPLOYED
+	default:
+		return openapiInferenceServiceActualState, fmt.Errorf("unexpected enum element: %v", source)
+	}
+	return openapiInferenceServiceActualState, nil
+}
+func (c *OpenAPIReconcilerImpl) openapiInferenceServiceConditionToOpenapiInferenceServiceCondition(source openapi.InferenceServiceCondition) openapi.InferenceServiceCondition {
+	var openapiInferenceServiceCondition openapi.InferenceServiceCondition
+	openapiInferenceServiceCondition.Type = source.Type
+	openapiInferenceServiceCondition.Status = source.Status
+	if source.Reason != nil {
+		xstring := *source.Reason
+		openapiInferenceServiceCondition.Reason = &xstring
+	}
+	if source.Message != nil {
+		xstring2 := *source.Message
+		openapiInferenceServiceCondition.Message = &xstring2
+	}
+	if source.LastTransitionTimeSinceEpoch != nil {
+		xstring3 := *source.LastTransitionTimeSinceEpoch
+		openapiInferenceServiceCondition.LastTransitionTimeSinceEpoch = &xstring3
+	}
+	return openapiInferenceServiceCondition
+}
 func (c *OpenAPIReconcilerImpl) openapiInferenceServiceStateToOpenapiInferenceServiceState(source openapi.InferenceServiceState) (openapi.InferenceServiceState, error) {
 	var openapiInferenceServiceState openapi.InferenceServiceState
 	switch source {
@@ -1036,6 +1215,36 @@ func (c *OpenAPIReconcilerImpl) openapiInferenceServiceStateToOpenapiInferenceSe
 	}
 	return openapiInferenceServiceState, nil
 }
+func (c *OpenAPIReconcilerImpl) openapiInferenceServiceTrafficSplitToOpenapiInferenceServiceTrafficSplit(source openapi.InferenceServiceTrafficSplit) openapi.InferenceServiceTrafficSplit {
+	var openapiInferenceServiceTrafficSplit openapi.InferenceServiceTrafficSplit
+	openapiInferenceServiceTrafficSplit.ModelVersionId = source.ModelVersionId
+	openapiInferenceServiceTrafficSplit.Percent = source.Percent
+	return openapiInferenceServiceTrafficSplit
+}
+func (c *OpenAPIReconcilerImpl) openapiInferenceServiceTrafficTargetToOpenapiInferenceServiceTrafficTarget(source openapi.InferenceServiceTrafficTarget) openapi.InferenceServiceTrafficTarget {
+	var openapiInferenceServiceTrafficTarget openapi.InferenceServiceTrafficTarget
+	if source.RevisionName != nil {
+		xstring := *source.RevisionName
+		openapiInferenceServiceTrafficTarget.RevisionName = &xstring
+	}
+	if source.ModelVersionId != nil {
+		xstring2 := *source.ModelVersionId
+		openapiInferenceServiceTrafficTarget.ModelVersionId = &xstring2
+	}
+	if source.Percent != nil {
+		xint32 := *source.Percent
+		openapiInferenceServiceTrafficTarget.Percent = &xint32
+	}
+	if source.LatestRevision != nil {
+		xbool := *source.LatestRevision
+		openapiInferenceServiceTrafficTarget.LatestRevision = &xbool
+	}
+	if source.Tag != nil {
+		xstring3 := *source.Tag
+		openapiInferenceServiceTrafficTarget.Tag = &xstring3
+	}
+	return openapiInferenceServiceTrafficTarget
+}
 func (c *OpenAPIReconcilerImpl) openapiMetadataValueToOpenapiMetadataValue(source openapi.MetadataValue) openapi.MetadataValue {
 	var openapiMetadataValue openapi.MetadataValue
 	openapiMetadataValue.MetadataBoolValue = c.pOpenapiMetadataBoolValueToPOpenapiMetadataBoolValue(source.MetadataBoolValue)
@@ -1086,6 +1295,62 @@ func (c *OpenAPIReconcilerImpl) openapiRegisteredModelStateToOpenapiRegisteredMo
 	}
 	return openapiRegisteredModelState, nil
 }
+func (c *OpenAPIReconcilerImpl) openapiServingEnvironmentTierToOpenapiServingEnvironmentTier(source openapi.ServingEnvironmentTier) (openapi.ServingEnvironmentTier, error) {
+	var openapiServingEnvironmentTier openapi.ServingEnvironmentTier
+	switch source {
+	case openapi.SERVINGENVIRONMENTTIER_DEVELOPMENT:
+		openapiServingEnvironmentTier = openapi.SERVINGENVIRONMENTTIER_DEVELOPMENT
+	case openapi.SERVINGENVIRONMENTTIER_PRODUCTION:
+		openapiServingEnvironmentTier = openapi.SERVINGENVIRONMENTTIER_PRODUCTION
+	case openapi.SERVINGENVIRONMENTTIER_STAGING:
+		openapiServingEnvironmentTier = openapi.SERVINGENVIRONMENTTIER_STAGING
+	default:
+		return openapiServingEnvironmentTier, fmt.Errorf("unexpected enum element: %v", source)
+	}
+	return openapiServingEnvironmentTier, nil
+}
+func (c *OpenAPIReconcilerImpl) pOpenapiArtifactDigestToPOpenapiArtifactDigest(source *openapi.ArtifactDigest) (*openapi.ArtifactDigest, error) {
+	var pOpenapiArtifactDigest *openapi.ArtifactDigest
+	if source != nil {
+		var openapiArtifactDigest openapi.ArtifactDigest
+		openapiArtifactDigestAlgorithm, err := c.openapiArtifactDigestAlgorithmToOpenapiArtifactDigestAlgorithm((*source).Algorithm)
+		if err != nil {
+			return nil, fmt.Errorf("error setting field Algorithm: %w", err)
+		}
+		openapiArtifactDigest.Algorithm = openapiArtifactDigestAlgorithm
+		openapiArtifactDigest.Value = (*source).Value
+		pOpenapiArtifactDigest = &openapiArtifactDigest
+	}
+	return pOpenapiArtifactDigest, nil
+}
+func (c *OpenAPIReconcilerImpl) pOpenapiInferenceServiceDeploymentStatusToPOpenapiInferenceServiceDeploymentStatus(source *openapi.InferenceServiceDeploymentStatus) *openapi.InferenceServiceDeploymentStatus {
+	var pOpenapiInferenceServiceDeploymentStatus *openapi.InferenceServiceDeploymentStatus
+	if source != nil {
+		var openapiInferenceServiceDeploymentStatus openapi.InferenceServiceDeploymentStatus
+		if (*source).Url != nil {
+			xstring := *(*source).Url
+			openapiInferenceServiceDeploymentStatus.Url = &xstring
+		}
+		if (*source).Conditions != nil {
+			openapiInferenceServiceDeploymentStatus.Conditions = make([]openapi.InferenceServiceCondition, len((*source).Conditions))
+			for i := 0; i < len((*source).Conditions); i++ {
+				openapiInferenceServiceDeploymentStatus.Conditions[i] = c.openapiInferenceServiceConditionToOpenapiInferenceServiceCondition((*source).Conditions[i])
+			}
+		}
+		if (*source).Traffic != nil {
+			openapiInferenceServiceDeploymentStatus.Traffic = make([]openapi.InferenceServiceTrafficTarget, len((*source).Traffic))
+			for j := 0; j < len((*source).Traffic); j++ {
+				openapiInferenceServiceDeploymentStatus.Traffic[j] = c.openapiInferenceServiceTrafficTargetToOpenapiInferenceServiceTrafficTarget((*source).Traffic[j])
+			}
+		}
+		if (*source).LastTransitionTimeSinceEpoch != nil {
+			xstring2 := *(*source).LastTransitionTimeSinceEpoch
+			openapiInferenceServiceDeploymentStatus.LastTransitionTimeSinceEpoch = &xstring2
+		}
+		pOpenapiInferenceServiceDeploymentStatus = &openapiInferenceServiceDeploymentStatus
+	}
+	return pOpenapiInferenceServiceDeploymentStatus
+}
 func (c *OpenAPIReconcilerImpl) pOpenapiMetadataBoolValueToPOpenapiMetadataBoolValue(source *openapi.MetadataBoolValue) *openapi.MetadataBoolValue {
 	var pOpenapiMetadataBoolValue *openapi.MetadataBoolValue
 	if source != nil {
@@ -350,6 +350,42 @@ func MapServingEnvironmentPropertiesEmbedMD(source *openapi.ServingEnvironment)
 				StringValue:      source.Description,
 			})
 		}
+		if source.ClusterId != nil {
+			props = append(props, models.Properties{
+				Name:             "cluster_id",
+				IsCustomProperty: false,
+				StringValue:      source.ClusterId,
+			})
+		}
+		if source.Tier != nil {
+			props = append(props, models.Properties{
+				Name:             "tier",
+				IsCustomProperty: false,
+				StringValue:      apiutils.Of(string(*source.Tier)),
+			})
+		}
+		if source.AllowedRuntimes != nil {
+			allowedRuntimes, err := json.Marshal(source.AllowedRuntimes)
+			if err != nil {
+				return nil, fmt.Errorf("invalid allowedRuntimes: %w", err)
+			}
+			props = append(props, models.Properties{
+				Name:             "allowed_runtimes",
+				IsCustomProperty: false,
+				StringValue:      apiutils.Of(string(allowedRuntimes)),
+			})
+		}
+		if source.Quotas != nil {
+			quotas, err := json.Marshal(source.Quotas)
+			if err != nil {
+				return nil, fmt.Errorf("invalid quotas: %w", err)
+			}
+			props = append(props, models.Properties{
+				Name:             "quotas",
+				IsCustomProperty: false,
+				StringValue:      apiutils.Of(string(quotas)),
+			})
+		}
 	}
 
 	return &props, nil
@@ -412,6 +448,46 @@ func MapInferenceServicePropertiesEmbedMD(source *openapi.InferenceService) (*[]
 			})
 		}
 
+		if source.ActualState != nil {
+			props = append(props, models.Properties{
+				Name:             "actual_state",
+				IsCustomProperty: false,
+				StringValue:      apiutils.Of(string(*source.ActualState)),
+			})
+		}
+
+		if source.DeploymentStatus != nil {
+			deploymentStatus, err := json.Marshal(source.DeploymentStatus)
+			if err != nil {
+				return nil, fmt.Errorf("invalid deploymentStatus: %w", err)
+			}
+			props = append(props, models.Properties{
+				Name:             "deployment_status",
+				IsCustomProperty: false,
+				StringValue:      apiutils.Of(string(deploymentStatus)),
+			})
+		}
+
+		if source.TrafficSplit != nil {
+			trafficSplit, err := json.Marshal(source.TrafficSplit)
+			if err != nil {
+				return nil, fmt.Errorf("invalid trafficSplit: %w", err)
+			}
+			props = append(props, models.Properties{
+				Name:             "traffic_split",
+				IsCustomProperty: false,
+				StringValue:      apiutils.Of(string(trafficSplit)),
+			})
+		}
+
+		if source.StateDrift != nil {
+			props = append(props, models.Properties{
+				Name:             "state_drift",
+				IsCustomProperty: false,
+				BoolValue:        source.StateDrift,
+			})
+		}
+
 		if source.RegisteredModelId != "" {
 			registeredModelId, err := StringToInt32(source.RegisteredModelId)
 			if err != nil {
@@ -565,6 +641,39 @@ func MapModelArtifactPropertiesEmbedMD(source *openapi.ModelArtifact) (*[]models
 				StringValue:      source.ModelSourceName,
 			})
 		}
+		if source.Digest != nil {
+			digest, err := json.Marshal(source.Digest)
+			if err != nil {
+				return nil, fmt.Errorf("invalid digest: %w", err)
+			}
+			props = append(props, models.Properties{
+				Name:             "digest",
+				IsCustomProperty: false,
+				StringValue:      apiutils.Of(string(digest)),
+			})
+		}
+		if source.FileDigests != nil {
+			fileDigests, err := json.Marshal(source.FileDigests)
+			if err != nil {
+				return nil, fmt.Errorf("invalid fileDigests: %w", err)
+			}
+			props = append(props, models.Properties{
+				Name:             "file_digests",
+				IsCustomProperty: false,
+				StringValue:      apiutils.Of(string(fileDigests)),
+			})
+		}
+		if source.Signatures != nil {
+			signatures, err := json.Marshal(source.Signatures)
+			if err != nil {
+				return nil, fmt.Errorf("invalid signatures: %w", err)
+			}
+			props = append(props, models.Properties{
+				Name:             "signatures",
+				IsCustomProperty: false,
+				StringValue:      apiutils.Of(string(signatures)),
+			})
+		}
 
 	}
 
@@ -556,6 +556,61 @@ func TestMapServingEnvironmentPropertiesEmbedMD(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "test serving environment metadata properties",
+			source: &openapi.ServingEnvironment{
+				ClusterId:       apiutils.Of("cluster-1"),
+				Tier:            openapi.SERVINGENVIRONMENTTIER_STAGING.Ptr(),
+				AllowedRuntimes: []string{"kserve-ovms", "vllm-runtime"},
+				Quotas: map[string]string{
+					"requests.nvidia.com/gpu": "4",
+					"limits.memory":           "64Gi",
+				},
+			},
+			expected: &[]models.Properties{
+				{
+					Name:             "cluster_id",
+					StringValue:      apiutils.Of("cluster-1"),
+					IsCustomProperty: false,
+				},
+				{
+					Name:             "tier",
+					StringValue:      apiutils.Of("STAGING"),
+					IsCustomProperty: false,
+				},
+				{
+					Name:             "allowed_runtimes",
+					StringValue:      apiutils.Of(`["kserve-ovms","vllm-runtime"]`),
+					IsCustomProperty: false,
+				},
+				{
+					Name:             "quotas",
+					StringValue:      apiutils.Of(`{"limits.memory":"64Gi","requests.nvidia.com/gpu":"4"}`),
+					IsCustomProperty: false,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "test serving environment with restrictions cleared",
+			source: &openapi.ServingEnvironment{
+				AllowedRuntimes: []string{},
+				Quotas:          map[string]string{},
+			},
+			expected: &[]models.Properties{
+				{
+					Name:             "allowed_runtimes",
+					StringValue:      apiutils.Of("[]"),
+					IsCustomProperty: false,
+				},
+				{
+					Name:             "quotas",
+					StringValue:      apiutils.Of("{}"),
+					IsCustomProperty: false,
+				},
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -698,6 +753,102 @@ func TestMapInferenceServicePropertiesEmbedMD(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "test inference service properties with deployment status",
+			source: &openapi.InferenceService{
+				ActualState: openapi.INFERENCESERVICEACTUALSTATE_DEPLOYED.Ptr(),
+				DeploymentStatus: &openapi.InferenceServiceDeploymentStatus{
+					Url:        apiutils.Of("http://example.com"),
+					Conditions: []openapi.InferenceServiceCondition{{Type: "Ready", Status: "True"}},
+				},
+				StateDrift:           apiutils.Of(false),
+				RegisteredModelId:    strconv.Itoa(int(registeredModelId)),
+				ServingEnvironmentId: strconv.Itoa(int(servingEnvironmentId)),
+			},
+			expected: &[]models.Properties{
+				{
+					Name:             "actual_state",
+					StringValue:      apiutils.Of("DEPLOYED"),
+					IsCustomProperty: false,
+				},
+				{
+					Name:             "deployment_status",
+					StringValue:      apiutils.Of(`{"conditions":[{"status":"True","type":"Ready"}],"url":"http://example.com"}`),
+					IsCustomProperty: false,
+				},
+				{
+					Name:             "state_drift",
+					BoolValue:        apiutils.Of(false),
+					IsCustomProperty: false,
+				},
+				{
+					Name:             "registered_model_id",
+					IntValue:         &registeredModelId,
+					IsCustomProperty: false,
+				},
+				{
+					Name:             "serving_environment_id",
+					IntValue:         &servingEnvironmentId,
+					IsCustomProperty: false,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "test inference service properties with traffic split",
+			source: &openapi.InferenceService{
+				TrafficSplit: []openapi.InferenceServiceTrafficSplit{
+					{ModelVersionId: "1", Percent: 90},
+					{ModelVersionId: "2", Percent: 10},
+				},
+				RegisteredModelId:    strconv.Itoa(int(registeredModelId)),
+				ServingEnvironmentId: strconv.Itoa(int(servingEnvironmentId)),
+			},
+			expected: &[]models.Properties{
+				{
+					Name:             "traffic_split",
+					StringValue:      apiutils.Of(`[{"modelVersionId":"1","percent":90},{"modelVersionId":"2","percent":10}]`),
+					IsCustomProperty: false,
+				},
+				{
+					Name:             "registered_model_id",
+					IntValue:         &registeredModelId,
+					IsCustomProperty: false,
+				},
+				{
+					Name:             "serving_environment_id",
+					IntValue:         &servingEnvironmentId,
+					IsCustomProperty: false,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "test inference service properties with cleared traffic split",
+			source: &openapi.InferenceService{
+				TrafficSplit:         []openapi.InferenceServiceTrafficSplit{},
+				RegisteredModelId:    strconv.Itoa(int(registeredModelId)),
+				ServingEnvironmentId: strconv.Itoa(int(servingEnvironmentId)),
+			},
+			expected: &[]models.Properties{
+				{
+					Name:             "traffic_split",
+					StringValue:      apiutils.Of(`[]`),
+					IsCustomProperty: false,
+				},
+				{
+					Name:             "registered_model_id",
+					IntValue:         &registeredModelId,
+					IsCustomProperty: false,
+				},
+				{
+					Name:             "serving_environment_id",
+					IntValue:         &servingEnvironmentId,
+					IsCustomProperty: false,
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "test inference service properties with missing registered model id",
 			source: &openapi.InferenceService{
@@ -908,6 +1059,59 @@ func TestMapModelArtifactPropertiesEmbedMD(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "test model artifact properties with digests and signatures",
+			source: &openapi.ModelArtifact{
+				Digest: &openapi.ArtifactDigest{
+					Algorithm: openapi.ARTIFACTDIGESTALGORITHM_SHA256,
+					Value:     "abc123",
+				},
+				FileDigests: []openapi.ArtifactFileDigest{
+					{Path: "model.onnx", Algorithm: openapi.ARTIFACTDIGESTALGORITHM_SHA256, Value: "abc123"},
+				},
+				Signatures: []openapi.ArtifactSignature{
+					{Type: "sigstore-bundle", Uri: "s3://bucket/model.sigstore.json", Signer: apiutils.Of("ci@example.com")},
+				},
+			},
+			expected: &[]models.Properties{
+				{
+					Name:             "digest",
+					StringValue:      apiutils.Of(`{"algorithm":"sha256","value":"abc123"}`),
+					IsCustomProperty: false,
+				},
+				{
+					Name:             "file_digests",
+					StringValue:      apiutils.Of(`[{"algorithm":"sha256","path":"model.onnx","value":"abc123"}]`),
+					IsCustomProperty: false,
+				},
+				{
+					Name:             "signatures",
+					StringValue:      apiutils.Of(`[{"signer":"ci@example.com","type":"sigstore-bundle","uri":"s3://bucket/model.sigstore.json"}]`),
+					IsCustomProperty: false,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "test model artifact properties with cleared file digests and signatures",
+			source: &openapi.ModelArtifact{
+				FileDigests: []openapi.ArtifactFileDigest{},
+				Signatures:  []openapi.ArtifactSignature{},
+			},
+			expected: &[]models.Properties{
+				{
+					Name:             "file_digests",
+					StringValue:      apiutils.Of(`[]`),
+					IsCustomProperty: false,
+				},
+				{
+					Name:             "signatures",
+					StringValue:      apiutils.Of(`[]`),
+					IsCustomProperty: false,
+				},
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tc := range testCases {
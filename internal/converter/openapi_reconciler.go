@@ -63,7 +63,7 @@ type OpenAPIReconciler interface {
 	// Ignore all fields that can't be updated
 	// goverter:default InitWithExisting
 	// goverter:autoMap Update
-	// goverter:ignore Id CreateTimeSinceEpoch LastUpdateTimeSinceEpoch Name RegisteredModelId ServingEnvironmentId
+	// goverter:ignore Id CreateTimeSinceEpoch LastUpdateTimeSinceEpoch Name RegisteredModelId ServingEnvironmentId StateDrift
 	UpdateExistingInferenceService(source OpenapiUpdateWrapper[openapi.InferenceService]) (openapi.InferenceService, error)
 
 	// Ignore all fields that can't be updated
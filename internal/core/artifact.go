@@ -3,12 +3,15 @@ package core
 import (
 	"errors"
 	"fmt"
+	"path"
+	"strings"
 
 	"github.com/kubeflow/hub/internal/platform/apiutils"
 	"github.com/kubeflow/hub/internal/converter"
 	"github.com/kubeflow/hub/internal/db/models"
 	"github.com/kubeflow/hub/pkg/api"
 	"github.com/kubeflow/hub/pkg/openapi"
+	"github.com/kubeflow/hub/pkg/openapi/digests"
 	"gorm.io/gorm"
 )
 
@@ -94,6 +97,10 @@ func (b *ModelRegistryService) upsertArtifact(artifact *openapi.Artifact, parent
 			ma = &withNotEditable
 		}
 
+		if err := validateModelArtifactIntegrity(ma); err != nil {
+			return nil, err
+		}
+
 		modelArtifact, err := b.mapper.MapFromModelArtifact(ma, parentResourceId)
 		if err != nil {
 			return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
@@ -671,3 +678,88 @@ func (b *ModelRegistryService) setExperimentPropertiesOnArtifact(artifact *opena
 		setProperties(&artifact.Parameter.ExperimentId, &artifact.Parameter.ExperimentRunId, &artifact.Parameter.CustomProperties)
 	}
 }
+
+// VerifyModelArtifactDigests checks a manifest of the content of a model artifact, e.g. computed
+// after downloading it, against the digests recorded on the model artifact.
+func (b *ModelRegistryService) VerifyModelArtifactDigests(id string, manifest []openapi.ArtifactFileDigest) error {
+	if err := validateFileDigests(manifest); err != nil {
+		return fmt.Errorf("invalid manifest: %w", err)
+	}
+
+	modelArtifact, err := b.GetModelArtifactById(id)
+	if err != nil {
+		return err
+	}
+
+	if !digests.Recorded(modelArtifact) {
+		return fmt.Errorf("model artifact %s has no digest to verify against: %w", id, api.ErrBadRequest)
+	}
+
+	if err := digests.Verify(modelArtifact, manifest); err != nil {
+		return fmt.Errorf("model artifact %s doesn't match the manifest: %w: %w", id, api.ErrDigestMismatch, err)
+	}
+
+	return nil
+}
+
+// digestLengths is the length of the hex encoded digests of each supported algorithm.
+var digestLengths = map[openapi.ArtifactDigestAlgorithm]int{
+	openapi.ARTIFACTDIGESTALGORITHM_SHA256: 64,
+	openapi.ARTIFACTDIGESTALGORITHM_SHA512: 128,
+}
+
+// validateModelArtifactIntegrity checks the digests and signatures of a model artifact, if any.
+func validateModelArtifactIntegrity(modelArtifact *openapi.ModelArtifact) error {
+	if modelArtifact.Digest != nil {
+		if err := validateDigest(modelArtifact.Digest.Algorithm, modelArtifact.Digest.Value); err != nil {
+			return fmt.Errorf("invalid digest: %w", err)
+		}
+	}
+
+	if err := validateFileDigests(modelArtifact.FileDigests); err != nil {
+		return fmt.Errorf("invalid file digests: %w", err)
+	}
+
+	for _, signature := range modelArtifact.Signatures {
+		if signature.Type == "" || signature.Uri == "" {
+			return fmt.Errorf("invalid signature, type and uri are required: %w", api.ErrBadRequest)
+		}
+	}
+
+	return nil
+}
+
+// validateFileDigests checks that a manifest holds valid digests of distinct files, with paths
+// relative to the root of the artifact.
+func validateFileDigests(fileDigests []openapi.ArtifactFileDigest) error {
+	seen := map[string]bool{}
+	for _, file := range fileDigests {
+		if file.Path == "" || path.IsAbs(file.Path) || path.Clean(file.Path) != file.Path ||
+			file.Path == "." || file.Path == ".." || strings.HasPrefix(file.Path, "../") {
+			return fmt.Errorf("path %q must be a clean path relative to the root of the artifact: %w", file.Path, api.ErrBadRequest)
+		}
+		if seen[file.Path] {
+			return fmt.Errorf("path %s appears more than once: %w", file.Path, api.ErrBadRequest)
+		}
+		seen[file.Path] = true
+
+		if err := validateDigest(file.Algorithm, file.Value); err != nil {
+			return fmt.Errorf("%s: %w", file.Path, err)
+		}
+	}
+
+	return nil
+}
+
+func validateDigest(algorithm openapi.ArtifactDigestAlgorithm, value string) error {
+	length, ok := digestLengths[algorithm]
+	if !ok {
+		return fmt.Errorf("unsupported digest algorithm %q: %w", algorithm, api.ErrBadRequest)
+	}
+
+	if len(value) != length || strings.Trim(value, "0123456789abcdefABCDEF") != "" {
+		return fmt.Errorf("%s digest must be %d hex characters: %w", algorithm, length, api.ErrBadRequest)
+	}
+
+	return nil
+}
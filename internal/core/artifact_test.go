@@ -3472,3 +3472,143 @@ func TestArtifactTypeMismatchOnUpdate(t *testing.T) {
 		assert.Contains(t, err.Error(), "is not a model artifact")
 	})
 }
+
+func TestModelArtifactDigests(t *testing.T) {
+	_service, cleanup := SetupModelRegistryService(t)
+	defer cleanup()
+
+	sha256Of := func(c string) string { return strings.Repeat(c, 64) }
+
+	t.Run("digests and signatures round trip", func(t *testing.T) {
+		created, err := _service.UpsertModelArtifact(&openapi.ModelArtifact{
+			Name: apiutils.Of("digest-round-trip"),
+			Uri:  apiutils.Of("s3://bucket/model"),
+			FileDigests: []openapi.ArtifactFileDigest{
+				{Path: "config.json", Algorithm: openapi.ARTIFACTDIGESTALGORITHM_SHA256, Value: sha256Of("a")},
+				{Path: "weights/model.bin", Algorithm: openapi.ARTIFACTDIGESTALGORITHM_SHA256, Value: sha256Of("b")},
+			},
+			Signatures: []openapi.ArtifactSignature{
+				{Type: "sigstore-bundle", Uri: "s3://bucket/model.sigstore.json", Signer: apiutils.Of("ci@example.com")},
+			},
+		})
+		require.NoError(t, err)
+		assert.Len(t, created.FileDigests, 2)
+		assert.Len(t, created.Signatures, 1)
+
+		// An update without digests keeps the existing ones
+		updated, err := _service.UpsertModelArtifact(&openapi.ModelArtifact{
+			Id:          created.Id,
+			Description: apiutils.Of("updated"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, created.FileDigests, updated.FileDigests)
+		assert.Equal(t, created.Signatures, updated.Signatures)
+
+		// Empty lists clear them
+		updated, err = _service.UpsertModelArtifact(&openapi.ModelArtifact{
+			Id:          created.Id,
+			FileDigests: []openapi.ArtifactFileDigest{},
+			Signatures:  []openapi.ArtifactSignature{},
+		})
+		require.NoError(t, err)
+		assert.Nil(t, updated.FileDigests)
+		assert.Nil(t, updated.Signatures)
+	})
+
+	t.Run("invalid digests", func(t *testing.T) {
+		invalid := map[string]*openapi.ModelArtifact{
+			"digest too short": {
+				Digest: &openapi.ArtifactDigest{Algorithm: openapi.ARTIFACTDIGESTALGORITHM_SHA512, Value: sha256Of("a")},
+			},
+			"digest not hex": {
+				Digest: &openapi.ArtifactDigest{Algorithm: openapi.ARTIFACTDIGESTALGORITHM_SHA256, Value: sha256Of("z")},
+			},
+			"absolute path": {
+				FileDigests: []openapi.ArtifactFileDigest{
+					{Path: "/model.bin", Algorithm: openapi.ARTIFACTDIGESTALGORITHM_SHA256, Value: sha256Of("a")},
+				},
+			},
+			"path outside the artifact": {
+				FileDigests: []openapi.ArtifactFileDigest{
+					{Path: "../model.bin", Algorithm: openapi.ARTIFACTDIGESTALGORITHM_SHA256, Value: sha256Of("a")},
+				},
+			},
+			"duplicate path": {
+				FileDigests: []openapi.ArtifactFileDigest{
+					{Path: "model.bin", Algorithm: openapi.ARTIFACTDIGESTALGORITHM_SHA256, Value: sha256Of("a")},
+					{Path: "model.bin", Algorithm: openapi.ARTIFACTDIGESTALGORITHM_SHA256, Value: sha256Of("b")},
+				},
+			},
+			"signature without uri": {
+				Signatures: []openapi.ArtifactSignature{{Type: "sigstore-bundle"}},
+			},
+		}
+		for name, modelArtifact := range invalid {
+			_, err := _service.UpsertModelArtifact(modelArtifact)
+			assert.ErrorIs(t, err, api.ErrBadRequest, name)
+		}
+	})
+
+	t.Run("verify manifest against file digests", func(t *testing.T) {
+		created, err := _service.UpsertModelArtifact(&openapi.ModelArtifact{
+			Name: apiutils.Of("digest-verify-manifest"),
+			FileDigests: []openapi.ArtifactFileDigest{
+				{Path: "config.json", Algorithm: openapi.ARTIFACTDIGESTALGORITHM_SHA256, Value: sha256Of("a")},
+				{Path: "model.bin", Algorithm: openapi.ARTIFACTDIGESTALGORITHM_SHA256, Value: sha256Of("b")},
+			},
+		})
+		require.NoError(t, err)
+
+		err = _service.VerifyModelArtifactDigests(*created.Id, []openapi.ArtifactFileDigest{
+			{Path: "model.bin", Algorithm: openapi.ARTIFACTDIGESTALGORITHM_SHA256, Value: strings.ToUpper(sha256Of("b"))},
+			{Path: "config.json", Algorithm: openapi.ARTIFACTDIGESTALGORITHM_SHA256, Value: sha256Of("a")},
+		})
+		assert.NoError(t, err)
+
+		err = _service.VerifyModelArtifactDigests(*created.Id, []openapi.ArtifactFileDigest{
+			{Path: "model.bin", Algorithm: openapi.ARTIFACTDIGESTALGORITHM_SHA256, Value: sha256Of("c")},
+			{Path: "extra.txt", Algorithm: openapi.ARTIFACTDIGESTALGORITHM_SHA256, Value: sha256Of("d")},
+		})
+		require.ErrorIs(t, err, api.ErrDigestMismatch)
+		assert.Contains(t, err.Error(), "config.json is missing")
+		assert.Contains(t, err.Error(), "extra.txt is unexpected")
+		assert.Contains(t, err.Error(), "model.bin has sha256 digest")
+
+		err = _service.VerifyModelArtifactDigests(*created.Id, []openapi.ArtifactFileDigest{
+			{Path: "model.bin", Algorithm: openapi.ARTIFACTDIGESTALGORITHM_SHA256, Value: sha256Of("b")},
+			{Path: "model.bin", Algorithm: openapi.ARTIFACTDIGESTALGORITHM_SHA256, Value: sha256Of("b")},
+		})
+		assert.ErrorIs(t, err, api.ErrBadRequest)
+	})
+
+	t.Run("verify manifest against single file digest", func(t *testing.T) {
+		created, err := _service.UpsertModelArtifact(&openapi.ModelArtifact{
+			Name:   apiutils.Of("digest-verify-single-file"),
+			Digest: &openapi.ArtifactDigest{Algorithm: openapi.ARTIFACTDIGESTALGORITHM_SHA256, Value: sha256Of("a")},
+		})
+		require.NoError(t, err)
+
+		err = _service.VerifyModelArtifactDigests(*created.Id, []openapi.ArtifactFileDigest{
+			{Path: "model.onnx", Algorithm: openapi.ARTIFACTDIGESTALGORITHM_SHA256, Value: sha256Of("a")},
+		})
+		assert.NoError(t, err)
+
+		err = _service.VerifyModelArtifactDigests(*created.Id, []openapi.ArtifactFileDigest{
+			{Path: "model.onnx", Algorithm: openapi.ARTIFACTDIGESTALGORITHM_SHA256, Value: sha256Of("a")},
+			{Path: "other.onnx", Algorithm: openapi.ARTIFACTDIGESTALGORITHM_SHA256, Value: sha256Of("b")},
+		})
+		assert.ErrorIs(t, err, api.ErrDigestMismatch)
+	})
+
+	t.Run("verify manifest without recorded digests", func(t *testing.T) {
+		created, err := _service.UpsertModelArtifact(&openapi.ModelArtifact{
+			Name: apiutils.Of("digest-verify-none"),
+		})
+		require.NoError(t, err)
+
+		err = _service.VerifyModelArtifactDigests(*created.Id, []openapi.ArtifactFileDigest{
+			{Path: "model.onnx", Algorithm: openapi.ARTIFACTDIGESTALGORITHM_SHA256, Value: sha256Of("a")},
+		})
+		assert.ErrorIs(t, err, api.ErrBadRequest)
+	})
+}
@@ -0,0 +1,134 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	catalogopenapi "github.com/kubeflow/hub/catalog/pkg/openapi"
+	"github.com/kubeflow/hub/pkg/openapi"
+)
+
+const (
+	// Custom properties recording which catalog model a model version was
+	// created from, set when catalog models are imported.
+	catalogSourceIDProperty  = "catalog_source_id"
+	catalogModelNameProperty = "catalog_model_name"
+
+	// Custom properties set on model versions created from deprecated
+	// catalog models.
+	catalogModelDeprecatedProperty = "catalog_model_deprecated"
+	catalogModelReplacedByProperty = "catalog_model_replaced_by"
+
+	catalogTimeout = 5 * time.Second
+)
+
+// CatalogModelGetter gets models from a model catalog.
+type CatalogModelGetter interface {
+	GetCatalogModel(ctx context.Context, sourceID string, name string) (*catalogopenapi.CatalogModel, error)
+}
+
+type catalogClient struct {
+	api *catalogopenapi.APIClient
+}
+
+// NewCatalogClient returns a CatalogModelGetter for the model catalog
+// served at baseURL, for example http://model-catalog:8080.
+func NewCatalogClient(baseURL string) (CatalogModelGetter, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid catalog URL %q", baseURL)
+	}
+
+	cfg := catalogopenapi.NewConfiguration()
+	cfg.Servers = catalogopenapi.ServerConfigurations{{URL: strings.TrimSuffix(baseURL, "/")}}
+	cfg.HTTPClient = &http.Client{Timeout: catalogTimeout}
+
+	return &catalogClient{api: catalogopenapi.NewAPIClient(cfg)}, nil
+}
+
+func (c *catalogClient) GetCatalogModel(ctx context.Context, sourceID string, name string) (*catalogopenapi.CatalogModel, error) {
+	model, _, err := c.api.ModelCatalogServiceAPI.GetModel(ctx, sourceID, name).Execute()
+	return model, err
+}
+
+// SetCatalog sets the model catalog checked for deprecated models when
+// model versions are created from catalog models. A nil catalog disables
+// the check.
+func (b *ModelRegistryService) SetCatalog(catalog CatalogModelGetter) {
+	b.catalog = catalog
+}
+
+// checkCatalogDeprecation records in the catalog_model_deprecated and
+// catalog_model_replaced_by custom properties that a new model version is
+// created from a deprecated catalog model, see CatalogDeprecationWarning. The
+// version is still created, even if the catalog can't be reached.
+func (b *ModelRegistryService) checkCatalogDeprecation(modelVersion *openapi.ModelVersion) {
+	if b.catalog == nil {
+		return
+	}
+
+	sourceID := stringCustomProperty(modelVersion.GetCustomProperties(), catalogSourceIDProperty)
+	name := stringCustomProperty(modelVersion.GetCustomProperties(), catalogModelNameProperty)
+	if sourceID == "" || name == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), catalogTimeout)
+	defer cancel()
+
+	catalogModel, err := b.catalog.GetCatalogModel(ctx, sourceID, name)
+	if err != nil {
+		glog.Warningf("Unable to check whether catalog model %s/%s is deprecated: %v", sourceID, name, err)
+		return
+	}
+	if !catalogModel.GetDeprecated() {
+		return
+	}
+
+	customProperties := make(map[string]openapi.MetadataValue, len(modelVersion.GetCustomProperties())+2)
+	for key, value := range modelVersion.GetCustomProperties() {
+		customProperties[key] = value
+	}
+	customProperties[catalogModelDeprecatedProperty] = openapi.MetadataBoolValueAsMetadataValue(
+		openapi.NewMetadataBoolValue(true, "MetadataBoolValue"))
+
+	if replacedBy, ok := catalogModel.GetReplacedByOk(); ok {
+		customProperties[catalogModelReplacedByProperty] = openapi.MetadataStringValueAsMetadataValue(
+			openapi.NewMetadataStringValue(replacedBy.SourceId+":"+replacedBy.Name, "MetadataStringValue"))
+	}
+	modelVersion.CustomProperties = customProperties
+
+	glog.Warning(CatalogDeprecationWarning(modelVersion))
+}
+
+// CatalogDeprecationWarning returns the warning for a model version recorded
+// as created from a deprecated catalog model, naming its replacement if any,
+// or an empty string otherwise. The API returns it to the caller creating
+// the version.
+func CatalogDeprecationWarning(modelVersion *openapi.ModelVersion) string {
+	customProperties := modelVersion.GetCustomProperties()
+	deprecated, ok := customProperties[catalogModelDeprecatedProperty]
+	if !ok || deprecated.MetadataBoolValue == nil || !deprecated.MetadataBoolValue.BoolValue {
+		return ""
+	}
+
+	warning := fmt.Sprintf("model version %q is created from deprecated catalog model %s/%s", modelVersion.Name,
+		stringCustomProperty(customProperties, catalogSourceIDProperty), stringCustomProperty(customProperties, catalogModelNameProperty))
+	if sourceID, name, ok := strings.Cut(stringCustomProperty(customProperties, catalogModelReplacedByProperty), ":"); ok {
+		warning += fmt.Sprintf(", use %s/%s instead", sourceID, name)
+	}
+	return warning
+}
+
+func stringCustomProperty(customProperties map[string]openapi.MetadataValue, name string) string {
+	value, ok := customProperties[name]
+	if !ok || value.MetadataStringValue == nil {
+		return ""
+	}
+	return value.MetadataStringValue.StringValue
+}
@@ -84,6 +84,7 @@ func createModelRegistryService(t *testing.T, db *gorm.DB) *core.ModelRegistrySe
 	servingEnvironmentRepo := service.NewServingEnvironmentRepository(db, typesMap[defaults.ServingEnvironmentTypeName])
 	inferenceServiceRepo := service.NewInferenceServiceRepository(db, typesMap[defaults.InferenceServiceTypeName])
 	serveModelRepo := service.NewServeModelRepository(db, typesMap[defaults.ServeModelTypeName])
+	deploymentEventRepo := service.NewDeploymentEventRepository(db, typesMap[defaults.DeploymentEventTypeName])
 	experimentRepo := service.NewExperimentRepository(db, typesMap[defaults.ExperimentTypeName])
 	experimentRunRepo := service.NewExperimentRunRepository(db, typesMap[defaults.ExperimentRunTypeName])
 	dataSetRepo := service.NewDataSetRepository(db, typesMap[defaults.DataSetTypeName])
@@ -101,6 +102,7 @@ func createModelRegistryService(t *testing.T, db *gorm.DB) *core.ModelRegistrySe
 		servingEnvironmentRepo,
 		inferenceServiceRepo,
 		serveModelRepo,
+		deploymentEventRepo,
 		experimentRepo,
 		experimentRunRepo,
 		dataSetRepo,
@@ -0,0 +1,210 @@
+package core
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/hub/internal/db/models"
+	"github.com/kubeflow/hub/internal/defaults"
+	"github.com/kubeflow/hub/internal/platform/apiutils"
+	"github.com/kubeflow/hub/pkg/api"
+	"github.com/kubeflow/hub/pkg/openapi"
+)
+
+// recordDeploymentEvents records the changes of the model versions deployed by an inference
+// service in its deployment history, comparing the saved inference service with the existing one,
+// nil when it was just created:
+//   - DEPLOYED for each model version it started deploying;
+//   - UNDEPLOYED for each model version it stopped deploying;
+//   - UPDATED for each model version it still deploys with another runtime or traffic percentage.
+//
+// The deployment history is best effort, failing to record it doesn't fail the upsert.
+func (b *ModelRegistryService) recordDeploymentEvents(existing, saved *openapi.InferenceService, actor string) {
+	latestModelVersionId := ""
+	if (existing != nil && existing.ModelVersionId == nil) || saved.ModelVersionId == nil {
+		latestModelVersionId = b.latestModelVersionId(saved.RegisteredModelId)
+	}
+
+	before := deployedModelVersions(existing, latestModelVersionId)
+	after := deployedModelVersions(saved, latestModelVersionId)
+
+	runtimeChanged := existing != nil && apiutils.ZeroIfNil(existing.Runtime) != apiutils.ZeroIfNil(saved.Runtime)
+
+	// Undeployments are recorded before deployments, so that switching model versions reads in order.
+	events := map[openapi.DeploymentEventType][]string{}
+	for modelVersionId, percentBefore := range before {
+		percentAfter, ok := after[modelVersionId]
+		switch {
+		case !ok:
+			events[openapi.DEPLOYMENTEVENTTYPE_UNDEPLOYED] = append(events[openapi.DEPLOYMENTEVENTTYPE_UNDEPLOYED], modelVersionId)
+		case runtimeChanged || percentBefore != percentAfter:
+			events[openapi.DEPLOYMENTEVENTTYPE_UPDATED] = append(events[openapi.DEPLOYMENTEVENTTYPE_UPDATED], modelVersionId)
+		}
+	}
+	for modelVersionId := range after {
+		if _, ok := before[modelVersionId]; !ok {
+			events[openapi.DEPLOYMENTEVENTTYPE_DEPLOYED] = append(events[openapi.DEPLOYMENTEVENTTYPE_DEPLOYED], modelVersionId)
+		}
+	}
+
+	for _, eventType := range []openapi.DeploymentEventType{
+		openapi.DEPLOYMENTEVENTTYPE_UNDEPLOYED,
+		openapi.DEPLOYMENTEVENTTYPE_UPDATED,
+		openapi.DEPLOYMENTEVENTTYPE_DEPLOYED,
+	} {
+		modelVersionIds := events[eventType]
+		slices.Sort(modelVersionIds)
+
+		for _, modelVersionId := range modelVersionIds {
+			if err := b.saveDeploymentEvent(saved, modelVersionId, eventType, actor); err != nil {
+				glog.Errorf("Unable to record %s deployment event of model version %s for inference service %s: %v", eventType, modelVersionId, saved.GetId(), err)
+			}
+		}
+	}
+}
+
+// deployedModelVersions returns the model versions deployed by an inference service, with the
+// percentage of the traffic they receive. An inference service without a model version deploys the
+// latest model version of its registered model.
+func deployedModelVersions(inferenceService *openapi.InferenceService, latestModelVersionId string) map[string]int32 {
+	modelVersions := map[string]int32{}
+
+	if inferenceService == nil || inferenceService.GetDesiredState() == openapi.INFERENCESERVICESTATE_UNDEPLOYED {
+		return modelVersions
+	}
+
+	switch {
+	case len(inferenceService.TrafficSplit) > 0:
+		for _, split := range inferenceService.TrafficSplit {
+			modelVersions[split.ModelVersionId] = split.Percent
+		}
+	case inferenceService.ModelVersionId != nil:
+		modelVersions[*inferenceService.ModelVersionId] = 100
+	case latestModelVersionId != "":
+		modelVersions[latestModelVersionId] = 100
+	}
+
+	return modelVersions
+}
+
+// latestModelVersionId returns the ID of the latest model version of a registered model, or an
+// empty string if it has none.
+func (b *ModelRegistryService) latestModelVersionId(registeredModelId string) string {
+	orderByCreateTime := "CREATE_TIME"
+	sortOrderDesc := "DESC"
+	pageSize := int32(1)
+
+	versions, err := b.GetModelVersions(api.ListOptions{PageSize: &pageSize, OrderBy: &orderByCreateTime, SortOrder: &sortOrderDesc}, &registeredModelId)
+	if err != nil || len(versions.Items) == 0 {
+		return ""
+	}
+
+	return versions.Items[0].GetId()
+}
+
+func (b *ModelRegistryService) saveDeploymentEvent(inferenceService *openapi.InferenceService, modelVersionId string, eventType openapi.DeploymentEventType, actor string) error {
+	inferenceServiceID, err := apiutils.ValidateIDAsInt32(inferenceService.GetId(), "inference service")
+	if err != nil {
+		return err
+	}
+
+	modelVersionID, err := apiutils.ValidateIDAsInt32(modelVersionId, "model version")
+	if err != nil {
+		return err
+	}
+
+	registeredModelID, err := apiutils.ValidateIDAsInt32(inferenceService.RegisteredModelId, "registered model")
+	if err != nil {
+		return err
+	}
+
+	servingEnvironmentID, err := apiutils.ValidateIDAsInt32(inferenceService.ServingEnvironmentId, "serving environment")
+	if err != nil {
+		return err
+	}
+
+	properties := []models.Properties{
+		models.NewStringProperty("event_type", string(eventType), false),
+		models.NewIntProperty("inference_service_id", inferenceServiceID, false),
+		models.NewIntProperty("model_version_id", modelVersionID, false),
+		models.NewIntProperty("registered_model_id", registeredModelID, false),
+		models.NewIntProperty("serving_environment_id", servingEnvironmentID, false),
+	}
+
+	if inferenceService.Runtime != nil {
+		properties = append(properties, models.NewStringProperty("runtime", *inferenceService.Runtime, false))
+	}
+
+	if actor != "" {
+		properties = append(properties, models.NewStringProperty("actor", actor, false))
+	}
+
+	deploymentEvent := &models.DeploymentEventImpl{
+		TypeID:     apiutils.Of(b.typesMap[defaults.DeploymentEventTypeName]),
+		Attributes: &models.DeploymentEventAttributes{},
+		Properties: &properties,
+	}
+
+	if _, err := b.deploymentEventRepository.Save(deploymentEvent, &inferenceServiceID); err != nil {
+		return fmt.Errorf("failed to insert deployment event: %w", err)
+	}
+
+	return nil
+}
+
+func (b *ModelRegistryService) GetDeploymentEvents(listOptions api.ListOptions, modelVersionId *string, inferenceServiceId *string) (*openapi.DeploymentEventList, error) {
+	modelVersionID, err := apiutils.ValidateIDAsInt32Ptr(modelVersionId, "model version")
+	if err != nil {
+		return nil, err
+	}
+
+	inferenceServiceID, err := apiutils.ValidateIDAsInt32Ptr(inferenceServiceId, "inference service")
+	if err != nil {
+		return nil, err
+	}
+
+	if modelVersionId != nil {
+		if _, err := b.GetModelVersionById(*modelVersionId); err != nil {
+			return nil, err
+		}
+	}
+
+	if inferenceServiceId != nil {
+		if _, err := b.GetInferenceServiceById(*inferenceServiceId); err != nil {
+			return nil, err
+		}
+	}
+
+	deploymentEvents, err := b.deploymentEventRepository.List(models.DeploymentEventListOptions{
+		Pagination: models.Pagination{
+			PageSize:      listOptions.PageSize,
+			OrderBy:       listOptions.OrderBy,
+			SortOrder:     listOptions.SortOrder,
+			NextPageToken: listOptions.NextPageToken,
+		},
+		InferenceServiceID: inferenceServiceID,
+		ModelVersionID:     modelVersionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deploymentEventList := &openapi.DeploymentEventList{
+		Items: []openapi.DeploymentEvent{},
+	}
+
+	for _, deploymentEvent := range deploymentEvents.Items {
+		deploymentEvent, err := b.mapper.MapToDeploymentEvent(deploymentEvent)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
+		}
+		deploymentEventList.Items = append(deploymentEventList.Items, *deploymentEvent)
+	}
+
+	deploymentEventList.NextPageToken = deploymentEvents.NextPageToken
+	deploymentEventList.PageSize = deploymentEvents.PageSize
+	deploymentEventList.Size = int32(deploymentEvents.Size)
+
+	return deploymentEventList, nil
+}
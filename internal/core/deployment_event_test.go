@@ -0,0 +1,168 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/kubeflow/hub/internal/platform/apiutils"
+	"github.com/kubeflow/hub/pkg/api"
+	"github.com/kubeflow/hub/pkg/openapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDeploymentEvents(t *testing.T) {
+	_service, cleanup := SetupModelRegistryService(t)
+	defer cleanup()
+
+	createdModel, err := _service.UpsertRegisteredModel(&openapi.RegisteredModel{
+		Name: "deployment-events-model",
+	})
+	require.NoError(t, err)
+
+	createdEnv, err := _service.UpsertServingEnvironment(&openapi.ServingEnvironment{
+		Name: "deployment-events-env",
+	})
+	require.NoError(t, err)
+
+	version1, err := _service.UpsertModelVersion(&openapi.ModelVersion{
+		Name:              "v1",
+		RegisteredModelId: *createdModel.Id,
+	}, createdModel.Id)
+	require.NoError(t, err)
+
+	version2, err := _service.UpsertModelVersion(&openapi.ModelVersion{
+		Name:              "v2",
+		RegisteredModelId: *createdModel.Id,
+	}, createdModel.Id)
+	require.NoError(t, err)
+
+	eventTypes := func(events *openapi.DeploymentEventList) []openapi.DeploymentEventType {
+		types := []openapi.DeploymentEventType{}
+		for _, event := range events.Items {
+			types = append(types, event.Type)
+		}
+		return types
+	}
+
+	t.Run("records the deployment history", func(t *testing.T) {
+		created, err := _service.UpsertInferenceServiceWithActor(&openapi.InferenceService{
+			Name:                 apiutils.Of("deployment-events-isvc"),
+			ServingEnvironmentId: *createdEnv.Id,
+			RegisteredModelId:    *createdModel.Id,
+			ModelVersionId:       version1.Id,
+			Runtime:              apiutils.Of("vllm"),
+		}, "alice@example.com")
+		require.NoError(t, err)
+
+		// Changing the runtime updates the deployment of v1
+		created.Runtime = apiutils.Of("kserve-ovms")
+		updated, err := _service.UpsertInferenceServiceWithActor(created, "bob@example.com")
+		require.NoError(t, err)
+
+		// Changing the description isn't recorded
+		updated.Description = apiutils.Of("new description")
+		updated, err = _service.UpsertInferenceService(updated)
+		require.NoError(t, err)
+
+		// Switching to v2 undeploys v1
+		updated.ModelVersionId = version2.Id
+		updated, err = _service.UpsertInferenceService(updated)
+		require.NoError(t, err)
+
+		updated.DesiredState = apiutils.Of(openapi.INFERENCESERVICESTATE_UNDEPLOYED)
+		_, err = _service.UpsertInferenceService(updated)
+		require.NoError(t, err)
+
+		events, err := _service.GetDeploymentEvents(api.ListOptions{}, nil, created.Id)
+		require.NoError(t, err)
+		require.Equal(t, int32(5), events.Size)
+		assert.Equal(t, []openapi.DeploymentEventType{
+			openapi.DEPLOYMENTEVENTTYPE_DEPLOYED,
+			openapi.DEPLOYMENTEVENTTYPE_UPDATED,
+			openapi.DEPLOYMENTEVENTTYPE_UNDEPLOYED,
+			openapi.DEPLOYMENTEVENTTYPE_DEPLOYED,
+			openapi.DEPLOYMENTEVENTTYPE_UNDEPLOYED,
+		}, eventTypes(events))
+
+		first := events.Items[0]
+		assert.Equal(t, *created.Id, first.InferenceServiceId)
+		assert.Equal(t, *version1.Id, first.ModelVersionId)
+		assert.Equal(t, *createdModel.Id, first.RegisteredModelId)
+		assert.Equal(t, *createdEnv.Id, first.ServingEnvironmentId)
+		assert.Equal(t, "vllm", first.GetRuntime())
+		assert.Equal(t, "alice@example.com", first.GetActor())
+		assert.NotNil(t, first.CreateTimeSinceEpoch)
+
+		assert.Equal(t, "kserve-ovms", events.Items[1].GetRuntime())
+		assert.Equal(t, "bob@example.com", events.Items[1].GetActor())
+		assert.Nil(t, events.Items[2].Actor)
+
+		v1Events, err := _service.GetDeploymentEvents(api.ListOptions{}, version1.Id, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []openapi.DeploymentEventType{
+			openapi.DEPLOYMENTEVENTTYPE_DEPLOYED,
+			openapi.DEPLOYMENTEVENTTYPE_UPDATED,
+			openapi.DEPLOYMENTEVENTTYPE_UNDEPLOYED,
+		}, eventTypes(v1Events))
+
+		v2Events, err := _service.GetDeploymentEvents(api.ListOptions{}, version2.Id, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []openapi.DeploymentEventType{
+			openapi.DEPLOYMENTEVENTTYPE_DEPLOYED,
+			openapi.DEPLOYMENTEVENTTYPE_UNDEPLOYED,
+		}, eventTypes(v2Events))
+	})
+
+	t.Run("records traffic split changes", func(t *testing.T) {
+		created, err := _service.UpsertInferenceService(&openapi.InferenceService{
+			Name:                 apiutils.Of("deployment-events-split"),
+			ServingEnvironmentId: *createdEnv.Id,
+			RegisteredModelId:    *createdModel.Id,
+			TrafficSplit: []openapi.InferenceServiceTrafficSplit{
+				{ModelVersionId: *version1.Id, Percent: 100},
+			},
+		})
+		require.NoError(t, err)
+
+		created.TrafficSplit = []openapi.InferenceServiceTrafficSplit{
+			{ModelVersionId: *version1.Id, Percent: 90},
+			{ModelVersionId: *version2.Id, Percent: 10},
+		}
+		_, err = _service.UpsertInferenceService(created)
+		require.NoError(t, err)
+
+		events, err := _service.GetDeploymentEvents(api.ListOptions{}, nil, created.Id)
+		require.NoError(t, err)
+		require.Equal(t, int32(3), events.Size)
+		assert.Equal(t, *version1.Id, events.Items[0].ModelVersionId)
+		assert.Equal(t, openapi.DEPLOYMENTEVENTTYPE_DEPLOYED, events.Items[0].Type)
+		assert.Equal(t, *version1.Id, events.Items[1].ModelVersionId)
+		assert.Equal(t, openapi.DEPLOYMENTEVENTTYPE_UPDATED, events.Items[1].Type)
+		assert.Equal(t, *version2.Id, events.Items[2].ModelVersionId)
+		assert.Equal(t, openapi.DEPLOYMENTEVENTTYPE_DEPLOYED, events.Items[2].Type)
+	})
+
+	t.Run("records the latest version when none is set", func(t *testing.T) {
+		created, err := _service.UpsertInferenceService(&openapi.InferenceService{
+			Name:                 apiutils.Of("deployment-events-latest"),
+			ServingEnvironmentId: *createdEnv.Id,
+			RegisteredModelId:    *createdModel.Id,
+		})
+		require.NoError(t, err)
+
+		events, err := _service.GetDeploymentEvents(api.ListOptions{}, nil, created.Id)
+		require.NoError(t, err)
+		require.Equal(t, int32(1), events.Size)
+		assert.Equal(t, *version2.Id, events.Items[0].ModelVersionId)
+	})
+
+	t.Run("unknown model version", func(t *testing.T) {
+		_, err := _service.GetDeploymentEvents(api.ListOptions{}, apiutils.Of("999999"), nil)
+		assert.ErrorIs(t, err, api.ErrNotFound)
+	})
+
+	t.Run("invalid inference service id", func(t *testing.T) {
+		_, err := _service.GetDeploymentEvents(api.ListOptions{}, nil, apiutils.Of("invalid"))
+		assert.ErrorIs(t, err, api.ErrBadRequest)
+	})
+}
@@ -3,6 +3,7 @@ package core
 import (
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/golang/glog"
 	"github.com/kubeflow/hub/internal/platform/apiutils"
@@ -14,12 +15,21 @@ import (
 )
 
 func (b *ModelRegistryService) UpsertInferenceService(inferenceService *openapi.InferenceService) (*openapi.InferenceService, error) {
+	return b.UpsertInferenceServiceWithActor(inferenceService, "")
+}
+
+func (b *ModelRegistryService) UpsertInferenceServiceWithActor(inferenceService *openapi.InferenceService, actor string) (*openapi.InferenceService, error) {
 	if inferenceService == nil {
 		return nil, fmt.Errorf("invalid inference service pointer, cannot be nil: %w", api.ErrBadRequest)
 	}
 
+	// The allowed runtimes are only enforced when the runtime is chosen, so that existing inference
+	// services can still be updated after their serving environment stops allowing their runtime.
+	checkRuntime := true
+	var existing *openapi.InferenceService
 	if inferenceService.Id != nil {
-		existing, err := b.GetInferenceServiceById(*inferenceService.Id)
+		var err error
+		existing, err = b.GetInferenceServiceById(*inferenceService.Id)
 		if err != nil {
 			return nil, err
 		}
@@ -29,13 +39,26 @@ func (b *ModelRegistryService) UpsertInferenceService(inferenceService *openapi.
 			return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 		}
 		inferenceService = &withNotEditable
+		checkRuntime = apiutils.ZeroIfNil(existing.Runtime) != apiutils.ZeroIfNil(inferenceService.Runtime)
 	}
 
-	_, err := b.GetServingEnvironmentById(inferenceService.ServingEnvironmentId)
+	inferenceService.StateDrift = inferenceServiceStateDrift(inferenceService)
+
+	servingEnvironment, err := b.GetServingEnvironmentById(inferenceService.ServingEnvironmentId)
 	if err != nil {
 		return nil, fmt.Errorf("no serving environment found for id %s: %w", inferenceService.ServingEnvironmentId, api.ErrNotFound)
 	}
 
+	if checkRuntime {
+		if err := validateRuntime(inferenceService, servingEnvironment); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := b.validateTrafficSplit(inferenceService); err != nil {
+		return nil, err
+	}
+
 	infSvc, err := b.mapper.MapFromInferenceService(inferenceService, inferenceService.ServingEnvironmentId)
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
@@ -55,9 +78,95 @@ func (b *ModelRegistryService) UpsertInferenceService(inferenceService *openapi.
 		return nil, fmt.Errorf("%v: %w", err, api.ErrBadRequest)
 	}
 
+	b.recordDeploymentEvents(existing, toReturn, actor)
+
 	return toReturn, nil
 }
 
+// inferenceServiceStateDrift reports whether the actual state of an inference service disagrees
+// with its desired state, or nil if no actual state was reported yet. An inference service that is
+// still being deployed isn't considered drifted.
+func inferenceServiceStateDrift(inferenceService *openapi.InferenceService) *bool {
+	if inferenceService.ActualState == nil {
+		return nil
+	}
+
+	desiredState := inferenceService.GetDesiredState()
+	if inferenceService.DesiredState == nil {
+		desiredState = openapi.INFERENCESERVICESTATE_DEPLOYED
+	}
+
+	switch *inferenceService.ActualState {
+	case openapi.INFERENCESERVICEACTUALSTATE_DEPLOYING:
+		return apiutils.Of(false)
+	case openapi.INFERENCESERVICEACTUALSTATE_DEPLOYED:
+		return apiutils.Of(desiredState != openapi.INFERENCESERVICESTATE_DEPLOYED)
+	case openapi.INFERENCESERVICEACTUALSTATE_UNDEPLOYED:
+		return apiutils.Of(desiredState != openapi.INFERENCESERVICESTATE_UNDEPLOYED)
+	default:
+		return apiutils.Of(true)
+	}
+}
+
+// validateRuntime checks that an inference service uses one of the runtimes allowed by its serving
+// environment, if the serving environment restricts them. An inference service without a runtime
+// is allowed, as KServe auto-selects one of the runtimes available in the namespace.
+func validateRuntime(inferenceService *openapi.InferenceService, servingEnvironment *openapi.ServingEnvironment) error {
+	runtime := apiutils.ZeroIfNil(inferenceService.Runtime)
+	if len(servingEnvironment.AllowedRuntimes) == 0 || runtime == "" {
+		return nil
+	}
+
+	if !slices.Contains(servingEnvironment.AllowedRuntimes, runtime) {
+		return fmt.Errorf("runtime %s isn't allowed in serving environment %s, must be one of %v: %w", runtime, servingEnvironment.Name, servingEnvironment.AllowedRuntimes, api.ErrBadRequest)
+	}
+
+	return nil
+}
+
+// maxTrafficSplitVersions is the number of model versions a traffic split can have: KServe
+// canary rollouts only split the traffic between the latest and the previous revision.
+const maxTrafficSplitVersions = 2
+
+// validateTrafficSplit checks that the traffic split of an inference service, if any, sends all of
+// the traffic to at most two distinct versions of its registered model.
+func (b *ModelRegistryService) validateTrafficSplit(inferenceService *openapi.InferenceService) error {
+	if len(inferenceService.TrafficSplit) == 0 {
+		return nil
+	}
+
+	if len(inferenceService.TrafficSplit) > maxTrafficSplitVersions {
+		return fmt.Errorf("traffic split has %d model versions, at most %d are supported: %w", len(inferenceService.TrafficSplit), maxTrafficSplitVersions, api.ErrBadRequest)
+	}
+
+	total := int32(0)
+	seen := map[string]bool{}
+	for _, split := range inferenceService.TrafficSplit {
+		if split.Percent < 1 || split.Percent > 100 {
+			return fmt.Errorf("invalid traffic split percent %d for model version %s, must be between 1 and 100: %w", split.Percent, split.ModelVersionId, api.ErrBadRequest)
+		}
+		if seen[split.ModelVersionId] {
+			return fmt.Errorf("model version %s appears more than once in the traffic split: %w", split.ModelVersionId, api.ErrBadRequest)
+		}
+		seen[split.ModelVersionId] = true
+		total += split.Percent
+
+		modelVersion, err := b.GetModelVersionById(split.ModelVersionId)
+		if err != nil {
+			return fmt.Errorf("invalid model version %s in the traffic split: %v: %w", split.ModelVersionId, err, api.ErrBadRequest)
+		}
+		if modelVersion.RegisteredModelId != inferenceService.RegisteredModelId {
+			return fmt.Errorf("model version %s in the traffic split doesn't belong to registered model %s: %w", split.ModelVersionId, inferenceService.RegisteredModelId, api.ErrBadRequest)
+		}
+	}
+
+	if total != 100 {
+		return fmt.Errorf("traffic split percentages must add up to 100, got %d: %w", total, api.ErrBadRequest)
+	}
+
+	return nil
+}
+
 func (b *ModelRegistryService) GetInferenceServiceById(id string) (*openapi.InferenceService, error) {
 	glog.Infof("Getting InferenceService by id %s", id)
 
@@ -481,6 +481,269 @@ func TestUpsertInferenceService(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("deployment status write-back", func(t *testing.T) {
+		createdModel, err := _service.UpsertRegisteredModel(&openapi.RegisteredModel{
+			Name: "status-test-registered-model",
+		})
+		require.NoError(t, err)
+
+		createdEnv, err := _service.UpsertServingEnvironment(&openapi.ServingEnvironment{
+			Name: "status-test-serving-env",
+		})
+		require.NoError(t, err)
+
+		created, err := _service.UpsertInferenceService(&openapi.InferenceService{
+			Name:                 apiutils.Of("status-test-inference-service"),
+			ServingEnvironmentId: *createdEnv.Id,
+			RegisteredModelId:    *createdModel.Id,
+			DesiredState:         openapi.INFERENCESERVICESTATE_DEPLOYED.Ptr(),
+		})
+		require.NoError(t, err)
+		assert.Nil(t, created.ActualState)
+		assert.Nil(t, created.StateDrift)
+
+		// The serving environment reports the inference service as deployed
+		update := &openapi.InferenceService{
+			Id:                   created.Id,
+			ServingEnvironmentId: *createdEnv.Id,
+			RegisteredModelId:    *createdModel.Id,
+			ActualState:          openapi.INFERENCESERVICEACTUALSTATE_DEPLOYED.Ptr(),
+			DeploymentStatus: &openapi.InferenceServiceDeploymentStatus{
+				Url: apiutils.Of("http://status-test.example.com"),
+				Conditions: []openapi.InferenceServiceCondition{
+					{Type: "Ready", Status: "True"},
+				},
+				Traffic: []openapi.InferenceServiceTrafficTarget{
+					{RevisionName: apiutils.Of("status-test-predictor-00001"), Percent: apiutils.Of(int32(100))},
+				},
+			},
+		}
+		deployed, err := _service.UpsertInferenceService(update)
+		require.NoError(t, err)
+		assert.Equal(t, openapi.INFERENCESERVICEACTUALSTATE_DEPLOYED, deployed.GetActualState())
+		assert.False(t, deployed.GetStateDrift())
+
+		retrieved, err := _service.GetInferenceServiceById(*created.Id)
+		require.NoError(t, err)
+		require.NotNil(t, retrieved.DeploymentStatus)
+		assert.Equal(t, "http://status-test.example.com", retrieved.DeploymentStatus.GetUrl())
+		require.Len(t, retrieved.DeploymentStatus.Conditions, 1)
+		assert.Equal(t, "Ready", retrieved.DeploymentStatus.Conditions[0].Type)
+		require.Len(t, retrieved.DeploymentStatus.Traffic, 1)
+		assert.Equal(t, int32(100), retrieved.DeploymentStatus.Traffic[0].GetPercent())
+
+		// Undeploying the inference service drifts until the serving environment catches up
+		retrieved.DesiredState = openapi.INFERENCESERVICESTATE_UNDEPLOYED.Ptr()
+		drifted, err := _service.UpsertInferenceService(retrieved)
+		require.NoError(t, err)
+		assert.True(t, drifted.GetStateDrift())
+		assert.Equal(t, "http://status-test.example.com", drifted.DeploymentStatus.GetUrl())
+
+		drifted.ActualState = openapi.INFERENCESERVICEACTUALSTATE_UNDEPLOYED.Ptr()
+		undeployed, err := _service.UpsertInferenceService(drifted)
+		require.NoError(t, err)
+		assert.False(t, undeployed.GetStateDrift())
+
+		filtered, err := _service.GetInferenceServices(api.ListOptions{
+			FilterQuery: apiutils.Of("stateDrift = true"),
+		}, createdEnv.Id, nil)
+		require.NoError(t, err)
+		assert.Empty(t, filtered.Items)
+	})
+
+	t.Run("traffic split", func(t *testing.T) {
+		createdModel, err := _service.UpsertRegisteredModel(&openapi.RegisteredModel{
+			Name: "split-test-registered-model",
+		})
+		require.NoError(t, err)
+
+		otherModel, err := _service.UpsertRegisteredModel(&openapi.RegisteredModel{
+			Name: "split-test-other-registered-model",
+		})
+		require.NoError(t, err)
+
+		stable, err := _service.UpsertModelVersion(&openapi.ModelVersion{Name: "v1"}, createdModel.Id)
+		require.NoError(t, err)
+		canary, err := _service.UpsertModelVersion(&openapi.ModelVersion{Name: "v2"}, createdModel.Id)
+		require.NoError(t, err)
+		third, err := _service.UpsertModelVersion(&openapi.ModelVersion{Name: "v3"}, createdModel.Id)
+		require.NoError(t, err)
+		other, err := _service.UpsertModelVersion(&openapi.ModelVersion{Name: "v1"}, otherModel.Id)
+		require.NoError(t, err)
+
+		createdEnv, err := _service.UpsertServingEnvironment(&openapi.ServingEnvironment{
+			Name: "split-test-serving-env",
+		})
+		require.NoError(t, err)
+
+		created, err := _service.UpsertInferenceService(&openapi.InferenceService{
+			Name:                 apiutils.Of("split-test-inference-service"),
+			ServingEnvironmentId: *createdEnv.Id,
+			RegisteredModelId:    *createdModel.Id,
+			ModelVersionId:       stable.Id,
+			TrafficSplit: []openapi.InferenceServiceTrafficSplit{
+				{ModelVersionId: *stable.Id, Percent: 90},
+				{ModelVersionId: *canary.Id, Percent: 10},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []openapi.InferenceServiceTrafficSplit{
+			{ModelVersionId: *stable.Id, Percent: 90},
+			{ModelVersionId: *canary.Id, Percent: 10},
+		}, created.TrafficSplit)
+
+		invalid := map[string][]openapi.InferenceServiceTrafficSplit{
+			"not adding up to 100": {
+				{ModelVersionId: *stable.Id, Percent: 90},
+				{ModelVersionId: *canary.Id, Percent: 20},
+			},
+			"zero percent": {
+				{ModelVersionId: *stable.Id, Percent: 100},
+				{ModelVersionId: *canary.Id, Percent: 0},
+			},
+			"duplicate model version": {
+				{ModelVersionId: *stable.Id, Percent: 50},
+				{ModelVersionId: *stable.Id, Percent: 50},
+			},
+			"unknown model version": {
+				{ModelVersionId: *stable.Id, Percent: 50},
+				{ModelVersionId: "99999", Percent: 50},
+			},
+			"model version of another registered model": {
+				{ModelVersionId: *stable.Id, Percent: 50},
+				{ModelVersionId: *other.Id, Percent: 50},
+			},
+			"more than two model versions": {
+				{ModelVersionId: *stable.Id, Percent: 80},
+				{ModelVersionId: *canary.Id, Percent: 10},
+				{ModelVersionId: *third.Id, Percent: 10},
+			},
+		}
+		for name, trafficSplit := range invalid {
+			_, err := _service.UpsertInferenceService(&openapi.InferenceService{
+				Id:                   created.Id,
+				ServingEnvironmentId: *createdEnv.Id,
+				RegisteredModelId:    *createdModel.Id,
+				TrafficSplit:         trafficSplit,
+			})
+			assert.ErrorIs(t, err, api.ErrBadRequest, name)
+		}
+
+		// New inference services are checked too
+		_, err = _service.UpsertInferenceService(&openapi.InferenceService{
+			Name:                 apiutils.Of("split-test-other-inference-service"),
+			ServingEnvironmentId: *createdEnv.Id,
+			RegisteredModelId:    *otherModel.Id,
+			TrafficSplit: []openapi.InferenceServiceTrafficSplit{
+				{ModelVersionId: *other.Id, Percent: 50},
+				{ModelVersionId: *canary.Id, Percent: 50},
+			},
+		})
+		assert.ErrorIs(t, err, api.ErrBadRequest)
+
+		// An update without a traffic split keeps the existing one
+		updated, err := _service.UpsertInferenceService(&openapi.InferenceService{
+			Id:                   created.Id,
+			ServingEnvironmentId: *createdEnv.Id,
+			RegisteredModelId:    *createdModel.Id,
+			Runtime:              apiutils.Of("split-test-runtime"),
+		})
+		require.NoError(t, err)
+		assert.Len(t, updated.TrafficSplit, 2)
+
+		// An empty traffic split clears it
+		updated, err = _service.UpsertInferenceService(&openapi.InferenceService{
+			Id:                   created.Id,
+			ServingEnvironmentId: *createdEnv.Id,
+			RegisteredModelId:    *createdModel.Id,
+			TrafficSplit:         []openapi.InferenceServiceTrafficSplit{},
+		})
+		require.NoError(t, err)
+		assert.Nil(t, updated.TrafficSplit)
+
+		retrieved, err := _service.GetInferenceServiceById(*created.Id)
+		require.NoError(t, err)
+		assert.Nil(t, retrieved.TrafficSplit)
+		assert.Equal(t, "split-test-runtime", retrieved.GetRuntime())
+	})
+
+	t.Run("allowed runtimes", func(t *testing.T) {
+		createdModel, err := _service.UpsertRegisteredModel(&openapi.RegisteredModel{
+			Name: "runtime-test-registered-model",
+		})
+		require.NoError(t, err)
+
+		createdEnv, err := _service.UpsertServingEnvironment(&openapi.ServingEnvironment{
+			Name:            "runtime-test-serving-env",
+			AllowedRuntimes: []string{"kserve-ovms", "vllm-runtime"},
+		})
+		require.NoError(t, err)
+
+		_, err = _service.UpsertInferenceService(&openapi.InferenceService{
+			Name:                 apiutils.Of("runtime-test-not-allowed"),
+			ServingEnvironmentId: *createdEnv.Id,
+			RegisteredModelId:    *createdModel.Id,
+			Runtime:              apiutils.Of("kserve-tritonserver"),
+		})
+		assert.ErrorIs(t, err, api.ErrBadRequest)
+
+		_, err = _service.UpsertInferenceService(&openapi.InferenceService{
+			Name:                 apiutils.Of("runtime-test-no-runtime"),
+			ServingEnvironmentId: *createdEnv.Id,
+			RegisteredModelId:    *createdModel.Id,
+		})
+		require.NoError(t, err, "an auto-selected runtime should be allowed")
+
+		created, err := _service.UpsertInferenceService(&openapi.InferenceService{
+			Name:                 apiutils.Of("runtime-test-allowed"),
+			ServingEnvironmentId: *createdEnv.Id,
+			RegisteredModelId:    *createdModel.Id,
+			Runtime:              apiutils.Of("vllm-runtime"),
+		})
+		require.NoError(t, err)
+
+		_, err = _service.UpsertInferenceService(&openapi.InferenceService{
+			Id:                   created.Id,
+			ServingEnvironmentId: *createdEnv.Id,
+			RegisteredModelId:    *createdModel.Id,
+			Runtime:              apiutils.Of("kserve-tritonserver"),
+		})
+		assert.ErrorIs(t, err, api.ErrBadRequest)
+
+		// Inference services can still be updated once their runtime isn't allowed anymore
+		_, err = _service.UpsertServingEnvironment(&openapi.ServingEnvironment{
+			Id:              createdEnv.Id,
+			Name:            createdEnv.Name,
+			AllowedRuntimes: []string{"kserve-ovms"},
+		})
+		require.NoError(t, err)
+
+		updated, err := _service.UpsertInferenceService(&openapi.InferenceService{
+			Id:                   created.Id,
+			ServingEnvironmentId: *createdEnv.Id,
+			RegisteredModelId:    *createdModel.Id,
+			DesiredState:         openapi.INFERENCESERVICESTATE_UNDEPLOYED.Ptr(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "vllm-runtime", updated.GetRuntime())
+
+		// An empty list allows any runtime again
+		_, err = _service.UpsertServingEnvironment(&openapi.ServingEnvironment{
+			Id:              createdEnv.Id,
+			Name:            createdEnv.Name,
+			AllowedRuntimes: []string{},
+		})
+		require.NoError(t, err)
+
+		_, err = _service.UpsertInferenceService(&openapi.InferenceService{
+			Id:                   created.Id,
+			ServingEnvironmentId: *createdEnv.Id,
+			RegisteredModelId:    *createdModel.Id,
+			Runtime:              apiutils.Of("kserve-tritonserver"),
+		})
+		require.NoError(t, err)
+	})
 }
 
 func TestGetInferenceServiceById(t *testing.T) {
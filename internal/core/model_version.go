@@ -37,6 +37,8 @@ func (b *ModelRegistryService) UpsertModelVersion(modelVersion *openapi.ModelVer
 		}
 
 		modelVersion = &withNotEditable
+	} else {
+		b.checkCatalogDeprecation(modelVersion)
 	}
 
 	if registeredModelId != nil {
@@ -1,11 +1,15 @@
 package core_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"slices"
 	"strings"
 	"testing"
 
+	"github.com/kubeflow/hub/internal/core"
 	"github.com/kubeflow/hub/internal/platform/apiutils"
 	"github.com/kubeflow/hub/pkg/api"
 	"github.com/kubeflow/hub/pkg/openapi"
@@ -426,6 +430,73 @@ func TestUpsertModelVersion(t *testing.T) {
 	})
 }
 
+func TestUpsertModelVersionCatalogDeprecation(t *testing.T) {
+	_service, cleanup := SetupModelRegistryService(t)
+	defer cleanup()
+
+	catalogServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.EscapedPath() {
+		case "/api/model_catalog/v1alpha1/sources/rhoai/models/granite-3.0-8b-instruct":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"name":       "granite-3.0-8b-instruct",
+				"deprecated": true,
+				"replacedBy": map[string]string{"sourceId": "rhoai", "name": "granite-3.1-8b-instruct"},
+			})
+		case "/api/model_catalog/v1alpha1/sources/rhoai/models/granite-3.1-8b-instruct":
+			_ = json.NewEncoder(w).Encode(map[string]any{"name": "granite-3.1-8b-instruct"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer catalogServer.Close()
+
+	catalog, err := core.NewCatalogClient(catalogServer.URL)
+	require.NoError(t, err)
+	_service.SetCatalog(catalog)
+	defer _service.SetCatalog(nil)
+
+	registeredModel, err := _service.UpsertRegisteredModel(&openapi.RegisteredModel{Name: "catalog-deprecation-model"})
+	require.NoError(t, err)
+
+	fromCatalog := func(name string, modelName string) *openapi.ModelVersion {
+		return &openapi.ModelVersion{
+			Name: name,
+			CustomProperties: map[string]openapi.MetadataValue{
+				"catalog_source_id":  {MetadataStringValue: &openapi.MetadataStringValue{StringValue: "rhoai", MetadataType: "MetadataStringValue"}},
+				"catalog_model_name": {MetadataStringValue: &openapi.MetadataStringValue{StringValue: modelName, MetadataType: "MetadataStringValue"}},
+			},
+		}
+	}
+
+	t.Run("deprecated catalog model is flagged", func(t *testing.T) {
+		result, err := _service.UpsertModelVersion(fromCatalog("v1", "granite-3.0-8b-instruct"), registeredModel.Id)
+
+		require.NoError(t, err)
+		props := result.GetCustomProperties()
+		require.Contains(t, props, "catalog_model_deprecated")
+		assert.True(t, props["catalog_model_deprecated"].MetadataBoolValue.BoolValue)
+		assert.Equal(t, "rhoai:granite-3.1-8b-instruct", props["catalog_model_replaced_by"].MetadataStringValue.StringValue)
+		assert.Equal(t, "rhoai", props["catalog_source_id"].MetadataStringValue.StringValue)
+		assert.Equal(t, `model version "v1" is created from deprecated catalog model rhoai/granite-3.0-8b-instruct, use rhoai/granite-3.1-8b-instruct instead`, core.CatalogDeprecationWarning(result))
+	})
+
+	t.Run("current catalog model is not flagged", func(t *testing.T) {
+		result, err := _service.UpsertModelVersion(fromCatalog("v2", "granite-3.1-8b-instruct"), registeredModel.Id)
+
+		require.NoError(t, err)
+		assert.NotContains(t, result.GetCustomProperties(), "catalog_model_deprecated")
+		assert.Empty(t, core.CatalogDeprecationWarning(result))
+	})
+
+	t.Run("missing catalog model does not block the version", func(t *testing.T) {
+		result, err := _service.UpsertModelVersion(fromCatalog("v3", "missing"), registeredModel.Id)
+
+		require.NoError(t, err)
+		assert.NotContains(t, result.GetCustomProperties(), "catalog_model_deprecated")
+	})
+}
+
 func TestGetModelVersionById(t *testing.T) {
 	_service, cleanup := SetupModelRegistryService(t)
 	defer cleanup()
@@ -2,6 +2,7 @@ package core
 
 import (
 	"github.com/kubeflow/hub/internal/db/models"
+	"github.com/kubeflow/hub/internal/licensepolicy"
 	"github.com/kubeflow/hub/internal/mapper"
 	"github.com/kubeflow/hub/pkg/api"
 )
@@ -18,6 +19,7 @@ type ModelRegistryService struct {
 	servingEnvironmentRepository models.ServingEnvironmentRepository
 	inferenceServiceRepository   models.InferenceServiceRepository
 	serveModelRepository         models.ServeModelRepository
+	deploymentEventRepository    models.DeploymentEventRepository
 	experimentRepository         models.ExperimentRepository
 	experimentRunRepository      models.ExperimentRunRepository
 	dataSetRepository            models.DataSetRepository
@@ -26,6 +28,8 @@ type ModelRegistryService struct {
 	metricHistoryRepository      models.MetricHistoryRepository
 	mapper                       mapper.EmbedMDMapper
 	typesMap                     map[string]int32
+	licensePolicy                *licensepolicy.Policy
+	catalog                      CatalogModelGetter
 }
 
 func NewModelRegistryService(
@@ -37,6 +41,7 @@ func NewModelRegistryService(
 	servingEnvironmentRepository models.ServingEnvironmentRepository,
 	inferenceServiceRepository models.InferenceServiceRepository,
 	serveModelRepository models.ServeModelRepository,
+	deploymentEventRepository models.DeploymentEventRepository,
 	experimentRepository models.ExperimentRepository,
 	experimentRunRepository models.ExperimentRunRepository,
 	dataSetRepository models.DataSetRepository,
@@ -53,6 +58,7 @@ func NewModelRegistryService(
 		servingEnvironmentRepository: servingEnvironmentRepository,
 		inferenceServiceRepository:   inferenceServiceRepository,
 		serveModelRepository:         serveModelRepository,
+		deploymentEventRepository:    deploymentEventRepository,
 		experimentRepository:         experimentRepository,
 		experimentRunRepository:      experimentRunRepository,
 		dataSetRepository:            dataSetRepository,
@@ -63,3 +69,9 @@ func NewModelRegistryService(
 		typesMap:                     typesMap,
 	}
 }
+
+// SetLicensePolicy sets the license policy checked when registered models
+// are created. A nil policy disables the check.
+func (b *ModelRegistryService) SetLicensePolicy(policy *licensepolicy.Policy) {
+	b.licensePolicy = policy
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/kubeflow/hub/internal/platform/apiutils"
 	"github.com/kubeflow/hub/internal/converter"
 	"github.com/kubeflow/hub/internal/db/models"
+	"github.com/kubeflow/hub/internal/licensepolicy"
 	"github.com/kubeflow/hub/pkg/api"
 	"github.com/kubeflow/hub/pkg/openapi"
 	"gorm.io/gorm"
@@ -35,6 +36,8 @@ func (b *ModelRegistryService) UpsertRegisteredModel(registeredModel *openapi.Re
 		}
 
 		registeredModel = &withNotEditable
+	} else if err := b.checkLicensePolicy(registeredModel); err != nil {
+		return nil, err
 	}
 
 	model, err := b.mapper.MapFromRegisteredModel(registeredModel)
@@ -54,6 +57,37 @@ func (b *ModelRegistryService) UpsertRegisteredModel(registeredModel *openapi.Re
 	return b.mapper.MapToRegisteredModel(savedModel)
 }
 
+// checkLicensePolicy evaluates the license of a new registered model. Models
+// with a denied license are rejected, otherwise the outcome is recorded in the
+// license_policy and license_classifications custom properties.
+func (b *ModelRegistryService) checkLicensePolicy(registeredModel *openapi.RegisteredModel) error {
+	if b.licensePolicy == nil || registeredModel.GetLicense() == "" {
+		return nil
+	}
+
+	// Models imported from the catalog have human-readable license names,
+	// policies usually use SPDX IDs.
+	license := registeredModel.GetLicense()
+	result := b.licensePolicy.Evaluate(license, licensepolicy.LicenseIDFromHumanReadable(license))
+	if result.Status == licensepolicy.StatusDenied {
+		return fmt.Errorf("license %q is not allowed by the license policy: %w", license, api.ErrBadRequest)
+	}
+
+	customProperties := make(map[string]openapi.MetadataValue, len(registeredModel.GetCustomProperties())+2)
+	for name, value := range registeredModel.GetCustomProperties() {
+		customProperties[name] = value
+	}
+	customProperties[licensepolicy.StatusProperty] = openapi.MetadataStringValueAsMetadataValue(
+		openapi.NewMetadataStringValue(string(result.Status), "MetadataStringValue"))
+	if len(result.Classifications) > 0 {
+		customProperties[licensepolicy.ClassificationsProperty] = openapi.MetadataStringValueAsMetadataValue(
+			openapi.NewMetadataStringValue(licensepolicy.EncodeClassifications(result.Classifications), "MetadataStringValue"))
+	}
+	registeredModel.CustomProperties = customProperties
+
+	return nil
+}
+
 func (b *ModelRegistryService) GetRegisteredModelById(id string) (*openapi.RegisteredModel, error) {
 	convertedId, err := apiutils.ValidateIDAsInt32(id, "registered model")
 	if err != nil {
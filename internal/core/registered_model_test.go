@@ -5,6 +5,7 @@ import (
 	"slices"
 	"testing"
 
+	"github.com/kubeflow/hub/internal/licensepolicy"
 	"github.com/kubeflow/hub/internal/platform/apiutils"
 	"github.com/kubeflow/hub/pkg/api"
 	"github.com/kubeflow/hub/pkg/openapi"
@@ -322,6 +323,77 @@ func TestUpsertRegisteredModel(t *testing.T) {
 	})
 }
 
+func TestUpsertRegisteredModelLicensePolicy(t *testing.T) {
+	_service, cleanup := SetupModelRegistryService(t)
+	defer cleanup()
+
+	_service.SetLicensePolicy(&licensepolicy.Policy{
+		Allow:  []string{"apache-2.0"},
+		Review: []string{"llama3.1"},
+		Classifications: map[string]licensepolicy.Classification{
+			"non-commercial": {Licenses: []string{"cc-by-nc-4.0"}, Status: licensepolicy.StatusDenied},
+			"community":      {Licenses: []string{"llama3.1"}},
+		},
+		Deny: []string{"gemma"},
+	})
+	defer _service.SetLicensePolicy(nil)
+
+	t.Run("allowed license is recorded", func(t *testing.T) {
+		result, err := _service.UpsertRegisteredModel(&openapi.RegisteredModel{
+			Name:    "license-allowed-model",
+			License: apiutils.Of("Apache 2.0"),
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, result.CustomProperties)
+		assert.Equal(t, "allowed", result.GetCustomProperties()["license_policy"].MetadataStringValue.StringValue)
+	})
+
+	t.Run("license needing review is flagged", func(t *testing.T) {
+		result, err := _service.UpsertRegisteredModel(&openapi.RegisteredModel{
+			Name:    "license-review-model",
+			License: apiutils.Of("llama3.1"),
+			CustomProperties: map[string]openapi.MetadataValue{
+				"team": {MetadataStringValue: &openapi.MetadataStringValue{StringValue: "nlp", MetadataType: "MetadataStringValue"}},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "review", result.GetCustomProperties()["license_policy"].MetadataStringValue.StringValue)
+		assert.Equal(t, `["community"]`, result.GetCustomProperties()["license_classifications"].MetadataStringValue.StringValue)
+		assert.Equal(t, "nlp", result.GetCustomProperties()["team"].MetadataStringValue.StringValue)
+	})
+
+	t.Run("denied license is rejected", func(t *testing.T) {
+		_, err := _service.UpsertRegisteredModel(&openapi.RegisteredModel{
+			Name:    "license-denied-model",
+			License: apiutils.Of("CC-BY-NC-4.0"),
+		})
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, api.ErrBadRequest)
+	})
+
+	t.Run("human-readable denied license is rejected", func(t *testing.T) {
+		_, err := _service.UpsertRegisteredModel(&openapi.RegisteredModel{
+			Name:    "license-denied-name-model",
+			License: apiutils.Of("Gemma License"),
+		})
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, api.ErrBadRequest)
+	})
+
+	t.Run("model without a license is not checked", func(t *testing.T) {
+		result, err := _service.UpsertRegisteredModel(&openapi.RegisteredModel{
+			Name: "license-missing-model",
+		})
+
+		require.NoError(t, err)
+		assert.NotContains(t, result.GetCustomProperties(), "license_policy")
+	})
+}
+
 func TestGetRegisteredModelById(t *testing.T) {
 	_service, cleanup := SetupModelRegistryService(t)
 	defer cleanup()
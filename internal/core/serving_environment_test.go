@@ -63,6 +63,34 @@ func TestUpsertServingEnvironment(t *testing.T) {
 		assert.Equal(t, "updated-ext-456", *updated.ExternalId)
 	})
 
+	t.Run("create and update with metadata", func(t *testing.T) {
+		created, err := _service.UpsertServingEnvironment(&openapi.ServingEnvironment{
+			Name:            "metadata-test-serving-env",
+			ClusterId:       apiutils.Of("cluster-1"),
+			Tier:            openapi.SERVINGENVIRONMENTTIER_STAGING.Ptr(),
+			AllowedRuntimes: []string{"kserve-ovms"},
+			Quotas:          map[string]string{"requests.nvidia.com/gpu": "4"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "cluster-1", created.GetClusterId())
+		assert.Equal(t, openapi.SERVINGENVIRONMENTTIER_STAGING, created.GetTier())
+		assert.Equal(t, []string{"kserve-ovms"}, created.AllowedRuntimes)
+		assert.Equal(t, map[string]string{"requests.nvidia.com/gpu": "4"}, created.Quotas)
+
+		// Fields missing from the update are kept, empty ones are cleared
+		updated, err := _service.UpsertServingEnvironment(&openapi.ServingEnvironment{
+			Id:     created.Id,
+			Name:   created.Name,
+			Tier:   openapi.SERVINGENVIRONMENTTIER_PRODUCTION.Ptr(),
+			Quotas: map[string]string{},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "cluster-1", updated.GetClusterId())
+		assert.Equal(t, openapi.SERVINGENVIRONMENTTIER_PRODUCTION, updated.GetTier())
+		assert.Equal(t, []string{"kserve-ovms"}, updated.AllowedRuntimes)
+		assert.Nil(t, updated.Quotas)
+	})
+
 	t.Run("create with custom properties", func(t *testing.T) {
 		customProps := map[string]openapi.MetadataValue{
 			"cpu_limit": {
@@ -64,7 +64,7 @@ var RestEntityPropertyMap = map[RestEntityType]map[string]bool{
 		"createTimeSinceEpoch": true, "lastUpdateTimeSinceEpoch": true,
 		// InferenceService-specific properties
 		"registeredModelId": true, "modelVersionId": true, "servingEnvironmentId": true,
-		"runtime": true, "desiredState": true,
+		"runtime": true, "desiredState": true, "actualState": true, "stateDrift": true,
 		// No experiment-specific properties allowed
 	},
 
@@ -0,0 +1,23 @@
+package models
+
+type DeploymentEventListOptions struct {
+	Pagination
+	InferenceServiceID *int32
+	ModelVersionID     *int32
+}
+
+type DeploymentEventAttributes struct {
+	CreateTimeSinceEpoch     *int64
+	LastUpdateTimeSinceEpoch *int64
+}
+
+type DeploymentEvent interface {
+	Entity[DeploymentEventAttributes]
+}
+
+type DeploymentEventImpl = BaseEntity[DeploymentEventAttributes]
+
+type DeploymentEventRepository interface {
+	List(listOptions DeploymentEventListOptions) (*ListWrapper[DeploymentEvent], error)
+	Save(deploymentEvent DeploymentEvent, inferenceServiceID *int32) (DeploymentEvent, error)
+}
@@ -82,6 +82,13 @@ func getServeModelTypeID(t *testing.T, db *gorm.DB) int32 {
 	return typeRecord.ID
 }
 
+func getDeploymentEventTypeID(t *testing.T, db *gorm.DB) int32 {
+	var typeRecord schema.Type
+	err := db.Where("name = ?", defaults.DeploymentEventTypeName).First(&typeRecord).Error
+	require.NoError(t, err, "Failed to find DeploymentEvent type")
+	return typeRecord.ID
+}
+
 func getExperimentTypeID(t *testing.T, db *gorm.DB) int32 {
 	var typeRecord schema.Type
 	err := db.Where("name = ?", defaults.ExperimentTypeName).First(&typeRecord).Error
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/kubeflow/hub/internal/db/models"
+	"github.com/kubeflow/hub/internal/platform/db/schema"
+	"github.com/kubeflow/hub/internal/platform/db/utils"
+	"gorm.io/gorm"
+)
+
+var ErrDeploymentEventNotFound = errors.New("deployment event by id not found")
+
+// eventTypeInput is the MLMD Event.Type of the artifacts consumed by an
+// execution.
+const eventTypeInput int32 = 3
+
+type DeploymentEventRepositoryImpl struct {
+	*GenericRepository[models.DeploymentEvent, schema.Execution, schema.ExecutionProperty, *models.DeploymentEventListOptions]
+	db *gorm.DB
+}
+
+func NewDeploymentEventRepository(db *gorm.DB, typeID int32) models.DeploymentEventRepository {
+	config := GenericRepositoryConfig[models.DeploymentEvent, schema.Execution, schema.ExecutionProperty, *models.DeploymentEventListOptions]{
+		DB:                  db,
+		TypeID:              typeID,
+		EntityToSchema:      mapDeploymentEventToExecution,
+		SchemaToEntity:      mapDataLayerToDeploymentEvent,
+		EntityToProperties:  mapDeploymentEventToExecutionProperties,
+		NotFoundError:       ErrDeploymentEventNotFound,
+		EntityName:          "deployment event",
+		PropertyFieldName:   "execution_id",
+		ApplyListFilters:    applyDeploymentEventListFilters,
+		IsNewEntity:         func(entity models.DeploymentEvent) bool { return entity.GetID() == nil },
+		HasCustomProperties: func(entity models.DeploymentEvent) bool { return entity.GetCustomProperties() != nil },
+	}
+
+	return &DeploymentEventRepositoryImpl{
+		GenericRepository: NewGenericRepository(config),
+		db:                db,
+	}
+}
+
+// Save saves a deployment event associated with its inference service, and
+// records input events linking it to the artifacts of its model version.
+func (r *DeploymentEventRepositoryImpl) Save(deploymentEvent models.DeploymentEvent, inferenceServiceID *int32) (models.DeploymentEvent, error) {
+	saved, err := r.GenericRepository.Save(deploymentEvent, inferenceServiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	modelVersionID := deploymentEventModelVersionID(saved)
+	if modelVersionID == nil {
+		return saved, nil
+	}
+
+	var artifactIDs []int32
+	if err := r.db.Model(&schema.Attribution{}).Where("context_id = ?", *modelVersionID).Pluck("artifact_id", &artifactIDs).Error; err != nil {
+		return nil, fmt.Errorf("error getting model version artifacts: %w", err)
+	}
+
+	if len(artifactIDs) == 0 {
+		return saved, nil
+	}
+
+	events := make([]schema.Event, 0, len(artifactIDs))
+	for _, artifactID := range artifactIDs {
+		events = append(events, schema.Event{
+			ArtifactID:             artifactID,
+			ExecutionID:            *saved.GetID(),
+			Type:                   eventTypeInput,
+			MillisecondsSinceEpoch: saved.GetAttributes().CreateTimeSinceEpoch,
+		})
+	}
+
+	if err := r.db.Create(&events).Error; err != nil {
+		return nil, fmt.Errorf("error creating deployment event artifact events: %w", err)
+	}
+
+	return saved, nil
+}
+
+func (r *DeploymentEventRepositoryImpl) List(listOptions models.DeploymentEventListOptions) (*models.ListWrapper[models.DeploymentEvent], error) {
+	return r.GenericRepository.List(&listOptions)
+}
+
+func applyDeploymentEventListFilters(query *gorm.DB, listOptions *models.DeploymentEventListOptions) *gorm.DB {
+	if listOptions.InferenceServiceID != nil {
+		// Proper GORM JOIN: Use helper that respects naming strategy
+		query = query.Joins(utils.BuildAssociationJoin(query)).
+			Where(utils.GetColumnRef(query, &schema.Association{}, "context_id")+" = ?", listOptions.InferenceServiceID)
+	}
+
+	if listOptions.ModelVersionID != nil {
+		// Use a unique alias to avoid conflicts with other property joins
+		mvPropsTable := utils.GetTableName(query, &schema.ExecutionProperty{}) + " AS mv_props"
+		executionTable := utils.GetTableName(query, &schema.Execution{})
+		query = query.Joins(fmt.Sprintf("JOIN %s ON mv_props.execution_id = %s.id", mvPropsTable, executionTable)).
+			Where("mv_props.name = ? AND mv_props.is_custom_property = ? AND mv_props.int_value = ?",
+				"model_version_id", false, listOptions.ModelVersionID)
+	}
+
+	return query
+}
+
+// deploymentEventModelVersionID returns the model_version_id property of a
+// deployment event, if any.
+func deploymentEventModelVersionID(deploymentEvent models.DeploymentEvent) *int32 {
+	if deploymentEvent.GetProperties() == nil {
+		return nil
+	}
+
+	for _, prop := range *deploymentEvent.GetProperties() {
+		if prop.Name == "model_version_id" {
+			return prop.IntValue
+		}
+	}
+
+	return nil
+}
+
+func mapDeploymentEventToExecution(deploymentEvent models.DeploymentEvent) schema.Execution {
+	attrs := deploymentEvent.GetAttributes()
+	execution := schema.Execution{
+		TypeID: *deploymentEvent.GetTypeID(),
+	}
+
+	// Only set ID if it's not nil (for existing entities)
+	if deploymentEvent.GetID() != nil {
+		execution.ID = *deploymentEvent.GetID()
+	}
+
+	if attrs != nil {
+		if attrs.CreateTimeSinceEpoch != nil {
+			execution.CreateTimeSinceEpoch = *attrs.CreateTimeSinceEpoch
+		}
+		if attrs.LastUpdateTimeSinceEpoch != nil {
+			execution.LastUpdateTimeSinceEpoch = *attrs.LastUpdateTimeSinceEpoch
+		}
+	}
+
+	return execution
+}
+
+func mapDeploymentEventToExecutionProperties(deploymentEvent models.DeploymentEvent, executionID int32) []schema.ExecutionProperty {
+	var properties []schema.ExecutionProperty
+
+	if deploymentEvent.GetProperties() != nil {
+		for _, prop := range *deploymentEvent.GetProperties() {
+			properties = append(properties, MapPropertiesToExecutionProperty(prop, executionID, false))
+		}
+	}
+
+	if deploymentEvent.GetCustomProperties() != nil {
+		for _, prop := range *deploymentEvent.GetCustomProperties() {
+			properties = append(properties, MapPropertiesToExecutionProperty(prop, executionID, true))
+		}
+	}
+
+	return properties
+}
+
+func mapDataLayerToDeploymentEvent(deploymentEvent schema.Execution, properties []schema.ExecutionProperty) models.DeploymentEvent {
+	deploymentEventModel := &models.BaseEntity[models.DeploymentEventAttributes]{
+		ID:     &deploymentEvent.ID,
+		TypeID: &deploymentEvent.TypeID,
+		Attributes: &models.DeploymentEventAttributes{
+			CreateTimeSinceEpoch:     &deploymentEvent.CreateTimeSinceEpoch,
+			LastUpdateTimeSinceEpoch: &deploymentEvent.LastUpdateTimeSinceEpoch,
+		},
+	}
+
+	modelProperties := []models.Properties{}
+	customProperties := []models.Properties{}
+
+	for _, prop := range properties {
+		mappedProperty := MapExecutionPropertyToProperties(prop)
+
+		if prop.IsCustomProperty {
+			customProperties = append(customProperties, mappedProperty)
+		} else {
+			modelProperties = append(modelProperties, mappedProperty)
+		}
+	}
+
+	// Always set Properties and CustomProperties, even if empty
+	deploymentEventModel.Properties = &modelProperties
+	deploymentEventModel.CustomProperties = &customProperties
+
+	return deploymentEventModel
+}
@@ -0,0 +1,163 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/kubeflow/hub/internal/db/models"
+	"github.com/kubeflow/hub/internal/db/service"
+	"github.com/kubeflow/hub/internal/platform/apiutils"
+	"github.com/kubeflow/hub/internal/platform/db/schema"
+	"github.com/kubeflow/hub/internal/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentEventRepository(t *testing.T) {
+	sharedDB, cleanup := testutils.SetupMySQLWithMigrations(t, service.DatastoreSpec())
+	defer cleanup()
+
+	typeID := getDeploymentEventTypeID(t, sharedDB)
+	repo := service.NewDeploymentEventRepository(sharedDB, typeID)
+
+	registeredModelRepo := service.NewRegisteredModelRepository(sharedDB, getRegisteredModelTypeID(t, sharedDB))
+	modelVersionRepo := service.NewModelVersionRepository(sharedDB, getModelVersionTypeID(t, sharedDB))
+	modelArtifactTypeID := getModelArtifactTypeID(t, sharedDB)
+	modelArtifactRepo := service.NewModelArtifactRepository(sharedDB, modelArtifactTypeID)
+	servingEnvironmentRepo := service.NewServingEnvironmentRepository(sharedDB, getServingEnvironmentTypeID(t, sharedDB))
+	inferenceServiceRepo := service.NewInferenceServiceRepository(sharedDB, getInferenceServiceTypeID(t, sharedDB))
+
+	savedRegisteredModel, err := registeredModelRepo.Save(&models.RegisteredModelImpl{
+		TypeID: apiutils.Of(getRegisteredModelTypeID(t, sharedDB)),
+		Attributes: &models.RegisteredModelAttributes{
+			Name: apiutils.Of("test-registered-model-for-events"),
+		},
+	})
+	require.NoError(t, err)
+
+	saveModelVersion := func(name string) models.ModelVersion {
+		saved, err := modelVersionRepo.Save(&models.ModelVersionImpl{
+			TypeID: apiutils.Of(getModelVersionTypeID(t, sharedDB)),
+			Attributes: &models.ModelVersionAttributes{
+				Name: apiutils.Of(name),
+			},
+			Properties: &[]models.Properties{
+				{
+					Name:     "registered_model_id",
+					IntValue: savedRegisteredModel.GetID(),
+				},
+			},
+		})
+		require.NoError(t, err)
+		return saved
+	}
+
+	version1 := saveModelVersion("test-model-version-1-for-events")
+	version2 := saveModelVersion("test-model-version-2-for-events")
+
+	savedModelArtifact, err := modelArtifactRepo.Save(&models.ModelArtifactImpl{
+		TypeID: apiutils.Of(modelArtifactTypeID),
+		Attributes: &models.ModelArtifactAttributes{
+			Name: apiutils.Of("test-model-artifact-for-events"),
+			URI:  apiutils.Of("s3://bucket/model"),
+		},
+	}, version1.GetID())
+	require.NoError(t, err)
+
+	savedServingEnv, err := servingEnvironmentRepo.Save(&models.ServingEnvironmentImpl{
+		TypeID: apiutils.Of(getServingEnvironmentTypeID(t, sharedDB)),
+		Attributes: &models.ServingEnvironmentAttributes{
+			Name: apiutils.Of("test-serving-env-for-events"),
+		},
+	})
+	require.NoError(t, err)
+
+	saveInferenceService := func(name string) models.InferenceService {
+		saved, err := inferenceServiceRepo.Save(&models.InferenceServiceImpl{
+			TypeID: apiutils.Of(getInferenceServiceTypeID(t, sharedDB)),
+			Attributes: &models.InferenceServiceAttributes{
+				Name: apiutils.Of(name),
+			},
+			Properties: &[]models.Properties{
+				{
+					Name:     "serving_environment_id",
+					IntValue: savedServingEnv.GetID(),
+				},
+				{
+					Name:     "registered_model_id",
+					IntValue: savedRegisteredModel.GetID(),
+				},
+			},
+		})
+		require.NoError(t, err)
+		return saved
+	}
+
+	inferenceService1 := saveInferenceService("test-inference-service-1-for-events")
+	inferenceService2 := saveInferenceService("test-inference-service-2-for-events")
+
+	saveDeploymentEvent := func(inferenceService models.InferenceService, modelVersion models.ModelVersion, eventType string) models.DeploymentEvent {
+		saved, err := repo.Save(&models.DeploymentEventImpl{
+			TypeID:     apiutils.Of(typeID),
+			Attributes: &models.DeploymentEventAttributes{},
+			Properties: &[]models.Properties{
+				models.NewStringProperty("event_type", eventType, false),
+				models.NewIntProperty("inference_service_id", *inferenceService.GetID(), false),
+				models.NewIntProperty("model_version_id", *modelVersion.GetID(), false),
+				models.NewIntProperty("registered_model_id", *savedRegisteredModel.GetID(), false),
+				models.NewIntProperty("serving_environment_id", *savedServingEnv.GetID(), false),
+			},
+		}, inferenceService.GetID())
+		require.NoError(t, err)
+		return saved
+	}
+
+	t.Run("TestSave", func(t *testing.T) {
+		saved := saveDeploymentEvent(inferenceService1, version1, "DEPLOYED")
+		require.NotNil(t, saved.GetID())
+		assert.Equal(t, typeID, *saved.GetTypeID())
+		assert.NotNil(t, saved.GetAttributes().CreateTimeSinceEpoch)
+
+		// The model version artifacts are recorded as inputs of the deployment event
+		var events []schema.Event
+		require.NoError(t, sharedDB.Where("execution_id = ?", *saved.GetID()).Find(&events).Error)
+		require.Len(t, events, 1)
+		assert.Equal(t, *savedModelArtifact.GetID(), events[0].ArtifactID)
+		assert.Equal(t, int32(3), events[0].Type)
+
+		// A model version without artifacts records no event
+		saved = saveDeploymentEvent(inferenceService1, version2, "DEPLOYED")
+		require.NoError(t, sharedDB.Where("execution_id = ?", *saved.GetID()).Find(&events).Error)
+		assert.Empty(t, events)
+	})
+
+	t.Run("TestList", func(t *testing.T) {
+		saveDeploymentEvent(inferenceService2, version1, "DEPLOYED")
+		saveDeploymentEvent(inferenceService2, version1, "UNDEPLOYED")
+
+		result, err := repo.List(models.DeploymentEventListOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, 4, result.Size)
+
+		result, err = repo.List(models.DeploymentEventListOptions{
+			InferenceServiceID: inferenceService2.GetID(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Size)
+		for _, item := range result.Items {
+			assert.Equal(t, typeID, *item.GetTypeID())
+		}
+
+		result, err = repo.List(models.DeploymentEventListOptions{
+			ModelVersionID: version1.GetID(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3, result.Size)
+
+		result, err = repo.List(models.DeploymentEventListOptions{
+			InferenceServiceID: inferenceService1.GetID(),
+			ModelVersionID:     version2.GetID(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Size)
+	})
+}
@@ -13,7 +13,10 @@ func DatastoreSpec() *datastore.Spec {
 			AddString("model_format_version").
 			AddString("service_account_name").
 			AddString("storage_key").
-			AddString("storage_path"),
+			AddString("storage_path").
+			AddString("digest").
+			AddString("file_digests").
+			AddString("signatures"),
 		).
 		AddArtifact(defaults.DocArtifactTypeName, datastore.NewSpecType(NewDocArtifactRepository).
 			AddString("description"),
@@ -65,15 +68,23 @@ func DatastoreSpec() *datastore.Spec {
 			AddString("version"),
 		).
 		AddContext(defaults.ServingEnvironmentTypeName, datastore.NewSpecType(NewServingEnvironmentRepository).
-			AddString("description"),
+			AddString("allowed_runtimes").
+			AddString("cluster_id").
+			AddString("description").
+			AddString("quotas").
+			AddString("tier"),
 		).
 		AddContext(defaults.InferenceServiceTypeName, datastore.NewSpecType(NewInferenceServiceRepository).
+			AddString("actual_state").
+			AddString("deployment_status").
 			AddString("description").
 			AddString("desired_state").
 			AddInt("model_version_id").
 			AddInt("registered_model_id").
 			AddString("runtime").
-			AddInt("serving_environment_id"),
+			AddInt("serving_environment_id").
+			AddBoolean("state_drift").
+			AddString("traffic_split"),
 		).
 		AddContext(defaults.ExperimentTypeName, datastore.NewSpecType(NewExperimentRepository).
 			AddString("description").
@@ -93,5 +104,14 @@ func DatastoreSpec() *datastore.Spec {
 			AddString("description").
 			AddInt("model_version_id"),
 		).
+		AddExecution(defaults.DeploymentEventTypeName, datastore.NewSpecType(NewDeploymentEventRepository).
+			AddString("actor").
+			AddString("event_type").
+			AddInt("inference_service_id").
+			AddInt("model_version_id").
+			AddInt("registered_model_id").
+			AddString("runtime").
+			AddInt("serving_environment_id"),
+		).
 		AddOther(NewArtifactRepository)
 }
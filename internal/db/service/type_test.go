@@ -40,6 +40,7 @@ func TestTypeRepository(t *testing.T) {
 			defaults.ServingEnvironmentTypeName,
 			defaults.InferenceServiceTypeName,
 			defaults.ServeModelTypeName,
+			defaults.DeploymentEventTypeName,
 			defaults.ModelArtifactTypeName,
 			defaults.DocArtifactTypeName,
 			defaults.ExperimentTypeName,
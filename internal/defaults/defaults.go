@@ -9,6 +9,7 @@ const (
 	ServingEnvironmentTypeName = "kf.ServingEnvironment"
 	InferenceServiceTypeName   = "kf.InferenceService"
 	ServeModelTypeName         = "kf.ServeModel"
+	DeploymentEventTypeName    = "kf.DeploymentEvent"
 	ExperimentTypeName         = "kf.Experiment"
 	ExperimentRunTypeName      = "kf.ExperimentRun"
 	DataSetTypeName            = "kf.DataSet"
@@ -1,4 +1,4 @@
-package basecatalog
+package licensepolicy
 
 // File generated by gen_license_names.sh - DO NOT EDIT
 
@@ -1,11 +1,12 @@
-package basecatalog
+package licensepolicy
 
 import (
 	"strings"
+	"sync"
 )
 
 // Generate file for spdxToHumanReadableMap
-//go:generate ../../../../scripts/gen_license_names.sh
+//go:generate ../../scripts/gen_license_names.sh
 
 var spdxOverrides = map[string]string{
 	"apache-2.0":                "Apache 2.0",
@@ -50,3 +51,31 @@ func TransformLicenseToHumanReadable(license string) string {
 	// Fallback to the name we were given
 	return license
 }
+
+// licenseIDsByName maps lower-cased human-readable license names back to
+// their identifiers. Overrides take precedence over SPDX names, and the
+// lowest SPDX ID wins when several share a name.
+var licenseIDsByName = sync.OnceValue(func() map[string]string {
+	ids := make(map[string]string, len(spdxToHumanReadableMap)+len(spdxOverrides))
+	for id, name := range spdxToHumanReadableMap {
+		name = strings.ToLower(name)
+		if existing, exists := ids[name]; !exists || id < existing {
+			ids[name] = id
+		}
+	}
+	for id, name := range spdxOverrides {
+		ids[strings.ToLower(name)] = id
+	}
+	return ids
+})
+
+// LicenseIDFromHumanReadable is the reverse of TransformLicenseToHumanReadable.
+// It returns the license identifier for a human-readable name, or the input
+// when the name isn't known.
+func LicenseIDFromHumanReadable(license string) string {
+	license = strings.TrimSpace(license)
+	if id, exists := licenseIDsByName()[strings.ToLower(license)]; exists {
+		return id
+	}
+	return license
+}
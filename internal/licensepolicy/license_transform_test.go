@@ -1,4 +1,4 @@
-package basecatalog
+package licensepolicy
 
 import (
 	"testing"
@@ -179,3 +179,26 @@ func TestTransformLicenseToHumanReadable(t *testing.T) {
 		})
 	}
 }
+
+func TestLicenseIDFromHumanReadable(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: "Apache 2.0", expected: "apache-2.0"},
+		{input: "llama 3.1 community license", expected: "llama3.1"},
+		{input: "GNU Affero General Public License v3.0", expected: "agpl-3.0"},
+		{input: "mit", expected: "mit"},
+		{input: "Custom License", expected: "Custom License"},
+		{input: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := LicenseIDFromHumanReadable(tt.input)
+			if result != tt.expected {
+				t.Errorf("LicenseIDFromHumanReadable(%q) = %q, expected %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
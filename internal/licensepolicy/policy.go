@@ -0,0 +1,218 @@
+// Package licensepolicy evaluates model licenses against an organization's
+// license policy. It's shared by the model catalog, which records the outcome
+// on every catalog model, and the model registry, which checks the license of
+// new registered models.
+package licensepolicy
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Status is the outcome of evaluating a license against a policy.
+type Status string
+
+const (
+	StatusAllowed Status = "allowed"
+	StatusDenied  Status = "denied"
+	StatusReview  Status = "review"
+	// StatusUnknown is used for models without a license and for licenses
+	// the policy doesn't mention when it has no default.
+	StatusUnknown Status = "unknown"
+)
+
+// Policy lists the licenses that are approved, rejected or need a review.
+// Licenses are matched case-insensitively and spaces are treated like dashes,
+// so either SPDX IDs ("apache-2.0") or license names ("Apache 2.0") can be
+// used.
+//
+// Example:
+//
+//	allow: [apache-2.0, mit]
+//	deny: [cc-by-nc-4.0]
+//	review: [llama3.1]
+//	classifications:
+//	  copyleft:
+//	    licenses: [gpl-2.0, gpl-3.0, agpl-3.0]
+//	    status: review
+//	  non-commercial:
+//	    licenses: [cc-by-nc-4.0, cc-by-nc-sa-4.0]
+//	    status: denied
+//	default: review
+type Policy struct {
+	Allow  []string `yaml:"allow,omitempty" json:"allow,omitempty"`
+	Deny   []string `yaml:"deny,omitempty" json:"deny,omitempty"`
+	Review []string `yaml:"review,omitempty" json:"review,omitempty"`
+
+	// Classifications group licenses under names such as "copyleft" or
+	// "non-commercial". A classification's status applies to its licenses
+	// unless they're listed in allow, deny or review.
+	Classifications map[string]Classification `yaml:"classifications,omitempty" json:"classifications,omitempty"`
+
+	// Default is the status of licenses that aren't mentioned anywhere in
+	// the policy. It's "unknown" when unset.
+	Default Status `yaml:"default,omitempty" json:"default,omitempty"`
+}
+
+// Classification is a named group of licenses.
+type Classification struct {
+	Licenses []string `yaml:"licenses" json:"licenses"`
+	Status   Status   `yaml:"status,omitempty" json:"status,omitempty"`
+}
+
+// Result is the outcome of evaluating a license.
+type Result struct {
+	Status Status
+	// Classifications are the names of the classifications the license
+	// belongs to, sorted.
+	Classifications []string
+}
+
+// Properties recording the outcome of an evaluation on catalog models and
+// registered models.
+const (
+	StatusProperty          = "license_policy"
+	ClassificationsProperty = "license_classifications"
+)
+
+// EncodeClassifications encodes classifications as the JSON array stored in
+// ClassificationsProperty. No classifications are stored as an empty array.
+func EncodeClassifications(classifications []string) string {
+	if classifications == nil {
+		classifications = []string{}
+	}
+	// Marshaling a slice of strings can't fail.
+	encoded, _ := json.Marshal(classifications)
+	return string(encoded)
+}
+
+// DecodeClassifications decodes the value of ClassificationsProperty.
+func DecodeClassifications(value string) ([]string, error) {
+	var classifications []string
+	if err := json.Unmarshal([]byte(value), &classifications); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", ClassificationsProperty, err)
+	}
+	return classifications, nil
+}
+
+// Read reads and validates a license policy file.
+func Read(path string) (*Policy, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &Policy{}
+	if err := yaml.UnmarshalStrict(bytes, policy); err != nil {
+		return nil, err
+	}
+
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid license policy in %s: %w", path, err)
+	}
+	return policy, nil
+}
+
+// Validate checks the policy for unsupported statuses.
+func (p *Policy) Validate() error {
+	if p == nil {
+		return nil
+	}
+	if !validStatus(p.Default) {
+		return fmt.Errorf("unsupported default status %q", p.Default)
+	}
+	for name, classification := range p.Classifications {
+		if name == "" {
+			return fmt.Errorf("license classification missing name")
+		}
+		if !validStatus(classification.Status) {
+			return fmt.Errorf("unsupported status %q for license classification %q", classification.Status, name)
+		}
+	}
+	return nil
+}
+
+func validStatus(status Status) bool {
+	switch status {
+	case "", StatusAllowed, StatusDenied, StatusReview, StatusUnknown:
+		return true
+	}
+	return false
+}
+
+// Evaluate returns the policy outcome for a license. Additional identifiers
+// for the same license, such as its SPDX ID when license is a display name,
+// can be passed as aliases. A nil policy allows nothing and denies nothing:
+// every license is unknown.
+func (p *Policy) Evaluate(license string, aliases ...string) Result {
+	keys := make([]string, 0, len(aliases)+1)
+	for _, id := range append([]string{license}, aliases...) {
+		if key := normalize(id); key != "" && !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	if p == nil || len(keys) == 0 {
+		return Result{Status: StatusUnknown}
+	}
+
+	result := Result{}
+	classStatus := Status("")
+	for name, classification := range p.Classifications {
+		if !matches(classification.Licenses, keys) {
+			continue
+		}
+		result.Classifications = append(result.Classifications, name)
+		classStatus = stricter(classStatus, classification.Status)
+	}
+	slices.Sort(result.Classifications)
+
+	switch {
+	case matches(p.Deny, keys):
+		result.Status = StatusDenied
+	case matches(p.Review, keys):
+		result.Status = StatusReview
+	case matches(p.Allow, keys):
+		result.Status = StatusAllowed
+	case classStatus != "":
+		result.Status = classStatus
+	case p.Default != "":
+		result.Status = p.Default
+	default:
+		result.Status = StatusUnknown
+	}
+	return result
+}
+
+// statusRank orders statuses from the least to the most restrictive.
+var statusRank = map[Status]int{
+	"":            0,
+	StatusAllowed: 1,
+	StatusUnknown: 2,
+	StatusReview:  3,
+	StatusDenied:  4,
+}
+
+func stricter(a, b Status) Status {
+	if statusRank[b] > statusRank[a] {
+		return b
+	}
+	return a
+}
+
+func matches(licenses []string, keys []string) bool {
+	for _, license := range licenses {
+		if slices.Contains(keys, normalize(license)) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalize(license string) string {
+	return strings.Join(strings.Fields(strings.ToLower(license)), "-")
+}
@@ -0,0 +1,187 @@
+package licensepolicy
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func testPolicy() *Policy {
+	return &Policy{
+		Allow:  []string{"apache-2.0", "mit"},
+		Deny:   []string{"cc-by-nc-4.0"},
+		Review: []string{"llama3.1"},
+		Classifications: map[string]Classification{
+			"copyleft": {
+				Licenses: []string{"gpl-3.0", "lgpl-3.0"},
+				Status:   StatusReview,
+			},
+			"non-commercial": {
+				Licenses: []string{"cc-by-nc-4.0", "cc-by-nc-sa-4.0"},
+				Status:   StatusDenied,
+			},
+			"weak-copyleft": {
+				Licenses: []string{"lgpl-3.0"},
+			},
+		},
+	}
+}
+
+func TestPolicyEvaluate(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  *Policy
+		license string
+		aliases []string
+		want    Result
+	}{
+		{
+			name:    "allowed SPDX ID",
+			policy:  testPolicy(),
+			license: "apache-2.0",
+			want:    Result{Status: StatusAllowed},
+		},
+		{
+			name:    "license name matches SPDX ID",
+			policy:  testPolicy(),
+			license: "Apache 2.0",
+			aliases: []string{"apache-2.0"},
+			want:    Result{Status: StatusAllowed},
+		},
+		{
+			name:    "case and spaces are ignored",
+			policy:  testPolicy(),
+			license: " MIT ",
+			want:    Result{Status: StatusAllowed},
+		},
+		{
+			name:    "explicit review",
+			policy:  testPolicy(),
+			license: "Llama 3.1 Community License",
+			aliases: []string{"llama3.1"},
+			want:    Result{Status: StatusReview},
+		},
+		{
+			name:    "deny list wins over classification",
+			policy:  testPolicy(),
+			license: "cc-by-nc-4.0",
+			want:    Result{Status: StatusDenied, Classifications: []string{"non-commercial"}},
+		},
+		{
+			name:    "classification status",
+			policy:  testPolicy(),
+			license: "cc-by-nc-sa-4.0",
+			want:    Result{Status: StatusDenied, Classifications: []string{"non-commercial"}},
+		},
+		{
+			name:    "strictest classification status wins",
+			policy:  testPolicy(),
+			license: "LGPL 3.0",
+			want:    Result{Status: StatusReview, Classifications: []string{"copyleft", "weak-copyleft"}},
+		},
+		{
+			name:    "unlisted license without default",
+			policy:  testPolicy(),
+			license: "openrail",
+			want:    Result{Status: StatusUnknown},
+		},
+		{
+			name: "unlisted license with default",
+			policy: &Policy{
+				Allow:   []string{"mit"},
+				Default: StatusReview,
+			},
+			license: "openrail",
+			want:    Result{Status: StatusReview},
+		},
+		{
+			name:    "empty license",
+			policy:  &Policy{Default: StatusDenied},
+			license: "",
+			want:    Result{Status: StatusUnknown},
+		},
+		{
+			name:    "nil policy",
+			license: "mit",
+			want:    Result{Status: StatusUnknown},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.policy.Evaluate(tt.license, tt.aliases...)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Evaluate(%q, %v) = %+v, want %+v", tt.license, tt.aliases, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolicyValidate(t *testing.T) {
+	if err := testPolicy().Validate(); err != nil {
+		t.Errorf("Validate() unexpected error: %v", err)
+	}
+	if err := (&Policy{Default: "maybe"}).Validate(); err == nil {
+		t.Error("Validate() expected an error for an unsupported default status")
+	}
+	invalid := &Policy{Classifications: map[string]Classification{"copyleft": {Status: "blocked"}}}
+	if err := invalid.Validate(); err == nil {
+		t.Error("Validate() expected an error for an unsupported classification status")
+	}
+}
+
+func TestRead(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	content := `allow: [apache-2.0]
+classifications:
+  non-commercial:
+    licenses: [cc-by-nc-4.0]
+    status: denied
+default: review
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	policy, err := Read(path)
+	if err != nil {
+		t.Fatalf("Read() unexpected error: %v", err)
+	}
+	if got := policy.Evaluate("cc-by-nc-4.0").Status; got != StatusDenied {
+		t.Errorf("Evaluate(cc-by-nc-4.0).Status = %q, want %q", got, StatusDenied)
+	}
+	if got := policy.Evaluate("mit").Status; got != StatusReview {
+		t.Errorf("Evaluate(mit).Status = %q, want %q", got, StatusReview)
+	}
+
+	if err := os.WriteFile(path, []byte("allowed: [mit]\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Read(path); err == nil {
+		t.Error("Read() expected an error for an unknown field")
+	}
+}
+
+func TestClassificationsEncoding(t *testing.T) {
+	if got := EncodeClassifications(nil); got != "[]" {
+		t.Errorf("EncodeClassifications(nil) = %q, want %q", got, "[]")
+	}
+
+	encoded := EncodeClassifications([]string{"copyleft", "weak-copyleft"})
+	if encoded != `["copyleft","weak-copyleft"]` {
+		t.Errorf("EncodeClassifications() = %q", encoded)
+	}
+
+	decoded, err := DecodeClassifications(encoded)
+	if err != nil {
+		t.Fatalf("DecodeClassifications() unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, []string{"copyleft", "weak-copyleft"}) {
+		t.Errorf("DecodeClassifications() = %v", decoded)
+	}
+
+	if _, err := DecodeClassifications("copyleft,weak-copyleft"); err == nil {
+		t.Error("DecodeClassifications() expected an error for a comma-separated list")
+	}
+}
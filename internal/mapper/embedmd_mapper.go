@@ -198,6 +198,20 @@ func (e *EmbedMDMapper) MapToServeModel(serveModel models.ServeModel) (*openapi.
 	})
 }
 
+func (e *EmbedMDMapper) MapToDeploymentEvent(deploymentEvent models.DeploymentEvent) (*openapi.DeploymentEvent, error) {
+	if deploymentEvent == nil {
+		return nil, fmt.Errorf("deployment event is nil")
+	}
+
+	return e.embedMDConverter.ConvertDeploymentEvent(&models.DeploymentEventImpl{
+		ID:               deploymentEvent.GetID(),
+		TypeID:           deploymentEvent.GetTypeID(),
+		Attributes:       deploymentEvent.GetAttributes(),
+		Properties:       deploymentEvent.GetProperties(),
+		CustomProperties: deploymentEvent.GetCustomProperties(),
+	})
+}
+
 func (e *EmbedMDMapper) MapToExperiment(experiment models.Experiment) (*openapi.Experiment, error) {
 	if experiment == nil {
 		return nil, fmt.Errorf("experiment is nil")
@@ -74,6 +74,8 @@ var contextPropertyMap = EntityPropertyMap{
 	"experimentId":         {Location: PropertyTable, ValueType: IntValueType, Column: "experiment_id"},
 	"runtime":              {Location: PropertyTable, ValueType: StringValueType, Column: "runtime"},
 	"desiredState":         {Location: PropertyTable, ValueType: StringValueType, Column: "desired_state"},
+	"actualState":          {Location: PropertyTable, ValueType: StringValueType, Column: "actual_state"},
+	"stateDrift":           {Location: PropertyTable, ValueType: BoolValueType, Column: "state_drift"},
 	"state":                {Location: PropertyTable, ValueType: StringValueType, Column: "state"},
 	"owner":                {Location: PropertyTable, ValueType: StringValueType, Column: "owner"},
 	"author":               {Location: PropertyTable, ValueType: StringValueType, Column: "author"},
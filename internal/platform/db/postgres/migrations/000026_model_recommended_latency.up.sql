@@ -0,0 +1,15 @@
+-- Precomputed recommended latency of each catalog model per performance
+-- profile. An empty use_case holds the latency across all use cases.
+CREATE TABLE IF NOT EXISTS model_recommended_latency (
+    context_id INTEGER NOT NULL REFERENCES "Context" (id) ON DELETE CASCADE,
+    latency_property VARCHAR(255) NOT NULL,
+    target_rps INTEGER NOT NULL,
+    use_case VARCHAR(255) NOT NULL DEFAULT '',
+    hardware_type VARCHAR(255) NOT NULL DEFAULT '',
+    latency DOUBLE PRECISION NOT NULL,
+    PRIMARY KEY (context_id, latency_property, target_rps, use_case, hardware_type)
+);
+
+-- Index for sorting models by their recommended latency for a profile
+CREATE INDEX IF NOT EXISTS idx_model_recommended_latency_profile
+    ON model_recommended_latency (latency_property, target_rps, use_case, hardware_type, latency, context_id);
@@ -9,6 +9,8 @@ var (
 	ErrBadRequest = errors.New("bad request")
 	ErrNotFound   = errors.New("not found")
 	ErrConflict   = errors.New("conflict")
+
+	ErrDigestMismatch = errors.New("digest mismatch")
 )
 
 func ErrToStatus(err error) int {
@@ -19,7 +21,7 @@ func ErrToStatus(err error) int {
 		return http.StatusNotFound
 	}
 
-	if errors.Is(err, ErrConflict) {
+	if errors.Is(err, ErrConflict) || errors.Is(err, ErrDigestMismatch) {
 		return http.StatusConflict
 	}
 
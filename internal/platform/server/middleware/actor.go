@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// UserIDHeader carries the identity of the user making a request, set by the
+// Kubeflow authentication proxy, or by the Model Registry UI for the user it
+// authenticated. The server doesn't authenticate users itself, so the header is
+// only trusted from those two callers. The istio option of the manifests denies
+// requests that carry it from anywhere else.
+const UserIDHeader = "kubeflow-userid"
+
+type actorKey struct{}
+
+// ActorMiddleware stores the user of each request, from its UserIDHeader
+// header, in the request context. The user is only recorded, e.g. in the
+// deployment history, and never used to authorize the request.
+func ActorMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if actor := strings.TrimSpace(r.Header.Get(UserIDHeader)); actor != "" {
+			r = r.WithContext(context.WithValue(r.Context(), actorKey{}, actor))
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// ActorFromContext returns the user stored by ActorMiddleware, or an empty
+// string if the request didn't identify one.
+func ActorFromContext(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+	return actor
+}
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActorMiddleware(t *testing.T) {
+	tests := []struct {
+		name   string
+		userID string
+		want   string
+	}{
+		{name: "with user", userID: "user@example.com", want: "user@example.com"},
+		{name: "with padded user", userID: "  user@example.com ", want: "user@example.com"},
+		{name: "without user", userID: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = ActorFromContext(r.Context())
+			})
+
+			req := httptest.NewRequest(http.MethodPatch, "/", nil)
+			if tt.userID != "" {
+				req.Header.Set(UserIDHeader, tt.userID)
+			}
+
+			ActorMiddleware(next).ServeHTTP(httptest.NewRecorder(), req)
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/golang/glog"
+)
+
+// BearerTokenMiddleware rejects requests for which requiresToken returns true
+// unless they carry an "Authorization: Bearer <token>" header matching token.
+// When token is empty, every such request is rejected so that protected
+// endpoints are never left open by accident.
+func BearerTokenMiddleware(token string, requiresToken func(r *http.Request) bool, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !requiresToken(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if token == "" {
+			returnUnauthorizedError(w, "This endpoint is disabled because no API token is configured")
+			return
+		}
+
+		scheme, provided, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+		if !ok || !strings.EqualFold(scheme, "Bearer") ||
+			subtle.ConstantTimeCompare([]byte(strings.TrimSpace(provided)), []byte(token)) != 1 {
+			returnUnauthorizedError(w, "Missing or invalid bearer token")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// IsWriteRequest reports whether r uses a method that can modify state.
+func IsWriteRequest(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return true
+}
+
+// returnUnauthorizedError sends a standardized 401 Unauthorized response
+func returnUnauthorizedError(w http.ResponseWriter, message string) {
+	errorResponse := struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}{
+		Code:    "Unauthorized",
+		Message: message,
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.Header().Set("WWW-Authenticate", "Bearer")
+	w.WriteHeader(http.StatusUnauthorized)
+	if err := json.NewEncoder(w).Encode(errorResponse); err != nil {
+		glog.Errorf("Error encoding JSON error response: %v", err)
+	}
+}
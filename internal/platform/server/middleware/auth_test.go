@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBearerTokenMiddleware(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+
+	tests := []struct {
+		name          string
+		token         string
+		method        string
+		authorization string
+		want          int
+	}{
+		{name: "read without token", token: "secret", method: http.MethodGet, want: http.StatusTeapot},
+		{name: "write without header", token: "secret", method: http.MethodPost, want: http.StatusUnauthorized},
+		{name: "write with wrong token", token: "secret", method: http.MethodDelete, authorization: "Bearer nope", want: http.StatusUnauthorized},
+		{name: "write with wrong scheme", token: "secret", method: http.MethodPatch, authorization: "Basic secret", want: http.StatusUnauthorized},
+		{name: "write with token", token: "secret", method: http.MethodPatch, authorization: "Bearer secret", want: http.StatusTeapot},
+		{name: "lowercase scheme", token: "secret", method: http.MethodPost, authorization: "bearer secret", want: http.StatusTeapot},
+		{name: "no token configured", token: "", method: http.MethodPost, authorization: "Bearer ", want: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			rr := httptest.NewRecorder()
+
+			BearerTokenMiddleware(tt.token, IsWriteRequest, next).ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.want, rr.Code)
+			if tt.want == http.StatusUnauthorized {
+				assert.Equal(t, "Bearer", rr.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+)
+
+type warningKey struct{}
+
+// WarningMiddleware lets the handlers of each request add warnings to its
+// response with AddWarning.
+func WarningMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), warningKey{}, w.Header())))
+	})
+}
+
+// AddWarning adds a "Warning: 299 - text" header, a persistent warning, to
+// the response of the request of ctx. It must be called before the response
+// is written, and does nothing if the request didn't go through
+// WarningMiddleware.
+func AddWarning(ctx context.Context, text string) {
+	if header, ok := ctx.Value(warningKey{}).(http.Header); ok {
+		header.Add("Warning", "299 - "+strconv.Quote(text))
+	}
+}
@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWarningMiddleware(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		AddWarning(r.Context(), `catalog model "a" is deprecated`)
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	rec := httptest.NewRecorder()
+	WarningMiddleware(next).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", nil))
+
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, []string{`299 - "catalog model \"a\" is deprecated"`}, rec.Header().Values("Warning"))
+
+	// Without the middleware, warnings are dropped.
+	AddWarning(context.Background(), "ignored")
+}
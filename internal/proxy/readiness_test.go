@@ -92,6 +92,7 @@ func setupModelRegistryService(sharedDB *gorm.DB) api.ModelRegistryApi {
 	servingEnvironmentRepo := service.NewServingEnvironmentRepository(sharedDB, typesMap[defaults.ServingEnvironmentTypeName])
 	inferenceServiceRepo := service.NewInferenceServiceRepository(sharedDB, typesMap[defaults.InferenceServiceTypeName])
 	serveModelRepo := service.NewServeModelRepository(sharedDB, typesMap[defaults.ServeModelTypeName])
+	deploymentEventRepo := service.NewDeploymentEventRepository(sharedDB, typesMap[defaults.DeploymentEventTypeName])
 	experimentRepo := service.NewExperimentRepository(sharedDB, typesMap[defaults.ExperimentTypeName])
 	experimentRunRepo := service.NewExperimentRunRepository(sharedDB, typesMap[defaults.ExperimentRunTypeName])
 	dataSetRepo := service.NewDataSetRepository(sharedDB, typesMap[defaults.DataSetTypeName])
@@ -109,6 +110,7 @@ func setupModelRegistryService(sharedDB *gorm.DB) api.ModelRegistryApi {
 		servingEnvironmentRepo,
 		inferenceServiceRepo,
 		serveModelRepo,
+		deploymentEventRepo,
 		experimentRepo,
 		experimentRunRepo,
 		dataSetRepo,
@@ -12,6 +12,8 @@ func WrapWithValidation(routers ...openapi.Router) http.Handler {
 	// Create the auto-generated router
 	baseRouter := openapi.NewRouter(routers...)
 
-	// Wrap it with our custom validation middleware
-	return platformmw.ValidationMiddleware(baseRouter)
+	// Wrap it with our custom validation middleware, record the user of each request
+	// for the deployment history of inference services, and let handlers add warnings
+	// to their responses
+	return platformmw.ValidationMiddleware(platformmw.ActorMiddleware(platformmw.WarningMiddleware(baseRouter)))
 }
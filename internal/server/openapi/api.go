@@ -47,6 +47,7 @@ type ModelRegistryServiceAPIRouter interface {
 	CreateInferenceService(http.ResponseWriter, *http.Request)
 	GetInferenceService(http.ResponseWriter, *http.Request)
 	UpdateInferenceService(http.ResponseWriter, *http.Request)
+	GetInferenceServiceDeploymentEvents(http.ResponseWriter, *http.Request)
 	GetInferenceServiceModel(http.ResponseWriter, *http.Request)
 	GetInferenceServiceServes(http.ResponseWriter, *http.Request)
 	CreateInferenceServiceServe(http.ResponseWriter, *http.Request)
@@ -63,6 +64,7 @@ type ModelRegistryServiceAPIRouter interface {
 	UpdateModelVersion(http.ResponseWriter, *http.Request)
 	GetModelVersionArtifacts(http.ResponseWriter, *http.Request)
 	UpsertModelVersionArtifact(http.ResponseWriter, *http.Request)
+	GetModelVersionDeploymentEvents(http.ResponseWriter, *http.Request)
 	FindRegisteredModel(http.ResponseWriter, *http.Request)
 	GetRegisteredModels(http.ResponseWriter, *http.Request)
 	CreateRegisteredModel(http.ResponseWriter, *http.Request)
@@ -110,6 +112,7 @@ type ModelRegistryServiceAPIServicer interface {
 	CreateInferenceService(context.Context, model.InferenceServiceCreate) (ImplResponse, error)
 	GetInferenceService(context.Context, string) (ImplResponse, error)
 	UpdateInferenceService(context.Context, string, model.InferenceServiceUpdate) (ImplResponse, error)
+	GetInferenceServiceDeploymentEvents(context.Context, string, string, model.OrderByField, model.SortOrder, string) (ImplResponse, error)
 	GetInferenceServiceModel(context.Context, string) (ImplResponse, error)
 	GetInferenceServiceServes(context.Context, string, string, string, string, string, model.OrderByField, model.SortOrder, string) (ImplResponse, error)
 	CreateInferenceServiceServe(context.Context, string, model.ServeModelCreate) (ImplResponse, error)
@@ -126,6 +129,7 @@ type ModelRegistryServiceAPIServicer interface {
 	UpdateModelVersion(context.Context, string, model.ModelVersionUpdate) (ImplResponse, error)
 	GetModelVersionArtifacts(context.Context, string, string, string, string, model.ArtifactTypeQueryParam, string, model.OrderByField, model.SortOrder, string) (ImplResponse, error)
 	UpsertModelVersionArtifact(context.Context, string, model.Artifact) (ImplResponse, error)
+	GetModelVersionDeploymentEvents(context.Context, string, string, model.OrderByField, model.SortOrder, string) (ImplResponse, error)
 	FindRegisteredModel(context.Context, string, string) (ImplResponse, error)
 	GetRegisteredModels(context.Context, string, string, model.OrderByField, model.SortOrder, string) (ImplResponse, error)
 	CreateRegisteredModel(context.Context, model.RegisteredModelCreate) (ImplResponse, error)
@@ -209,6 +209,12 @@ func (c *ModelRegistryServiceAPIController) Routes() Routes {
 			"/api/model_registry/v1alpha3/inference_services/{inferenceserviceId}",
 			c.UpdateInferenceService,
 		},
+		"GetInferenceServiceDeploymentEvents": Route{
+			"GetInferenceServiceDeploymentEvents",
+			strings.ToUpper("Get"),
+			"/api/model_registry/v1alpha3/inference_services/{inferenceserviceId}/deployment_events",
+			c.GetInferenceServiceDeploymentEvents,
+		},
 		"GetInferenceServiceModel": Route{
 			"GetInferenceServiceModel",
 			strings.ToUpper("Get"),
@@ -305,6 +311,12 @@ func (c *ModelRegistryServiceAPIController) Routes() Routes {
 			"/api/model_registry/v1alpha3/model_versions/{modelversionId}/artifacts",
 			c.UpsertModelVersionArtifact,
 		},
+		"GetModelVersionDeploymentEvents": Route{
+			"GetModelVersionDeploymentEvents",
+			strings.ToUpper("Get"),
+			"/api/model_registry/v1alpha3/model_versions/{modelversionId}/deployment_events",
+			c.GetModelVersionDeploymentEvents,
+		},
 		"FindRegisteredModel": Route{
 			"FindRegisteredModel",
 			strings.ToUpper("Get"),
@@ -551,6 +563,12 @@ func (c *ModelRegistryServiceAPIController) OrderedRoutes() []Route {
 			"/api/model_registry/v1alpha3/inference_services/{inferenceserviceId}",
 			c.UpdateInferenceService,
 		},
+		Route{
+			"GetInferenceServiceDeploymentEvents",
+			strings.ToUpper("Get"),
+			"/api/model_registry/v1alpha3/inference_services/{inferenceserviceId}/deployment_events",
+			c.GetInferenceServiceDeploymentEvents,
+		},
 		Route{
 			"GetInferenceServiceModel",
 			strings.ToUpper("Get"),
@@ -647,6 +665,12 @@ func (c *ModelRegistryServiceAPIController) OrderedRoutes() []Route {
 			"/api/model_registry/v1alpha3/model_versions/{modelversionId}/artifacts",
 			c.UpsertModelVersionArtifact,
 		},
+		Route{
+			"GetModelVersionDeploymentEvents",
+			strings.ToUpper("Get"),
+			"/api/model_registry/v1alpha3/model_versions/{modelversionId}/deployment_events",
+			c.GetModelVersionDeploymentEvents,
+		},
 		Route{
 			"FindRegisteredModel",
 			strings.ToUpper("Get"),
@@ -1748,6 +1772,56 @@ func (c *ModelRegistryServiceAPIController) UpdateInferenceService(w http.Respon
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// GetInferenceServiceDeploymentEvents - List All InferenceService's DeploymentEvents
+func (c *ModelRegistryServiceAPIController) GetInferenceServiceDeploymentEvents(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	inferenceserviceIdParam := chi.URLParam(r, "inferenceserviceId")
+	if inferenceserviceIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"inferenceserviceId"}, nil)
+		return
+	}
+	var pageSizeParam string
+	if query.Has("pageSize") {
+		param := query.Get("pageSize")
+
+		pageSizeParam = param
+	} else {
+	}
+	var orderByParam model.OrderByField
+	if query.Has("orderBy") {
+		param := model.OrderByField(query.Get("orderBy"))
+
+		orderByParam = param
+	} else {
+	}
+	var sortOrderParam model.SortOrder
+	if query.Has("sortOrder") {
+		param := model.SortOrder(query.Get("sortOrder"))
+
+		sortOrderParam = param
+	} else {
+	}
+	var nextPageTokenParam string
+	if query.Has("nextPageToken") {
+		param := query.Get("nextPageToken")
+
+		nextPageTokenParam = param
+	} else {
+	}
+	result, err := c.service.GetInferenceServiceDeploymentEvents(r.Context(), inferenceserviceIdParam, pageSizeParam, orderByParam, sortOrderParam, nextPageTokenParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // GetInferenceServiceModel - Get InferenceService's RegisteredModel
 func (c *ModelRegistryServiceAPIController) GetInferenceServiceModel(w http.ResponseWriter, r *http.Request) {
 	inferenceserviceIdParam := chi.URLParam(r, "inferenceserviceId")
@@ -2327,6 +2401,56 @@ func (c *ModelRegistryServiceAPIController) UpsertModelVersionArtifact(w http.Re
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
+// GetModelVersionDeploymentEvents - List All ModelVersion's DeploymentEvents
+func (c *ModelRegistryServiceAPIController) GetModelVersionDeploymentEvents(w http.ResponseWriter, r *http.Request) {
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	modelversionIdParam := chi.URLParam(r, "modelversionId")
+	if modelversionIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"modelversionId"}, nil)
+		return
+	}
+	var pageSizeParam string
+	if query.Has("pageSize") {
+		param := query.Get("pageSize")
+
+		pageSizeParam = param
+	} else {
+	}
+	var orderByParam model.OrderByField
+	if query.Has("orderBy") {
+		param := model.OrderByField(query.Get("orderBy"))
+
+		orderByParam = param
+	} else {
+	}
+	var sortOrderParam model.SortOrder
+	if query.Has("sortOrder") {
+		param := model.SortOrder(query.Get("sortOrder"))
+
+		sortOrderParam = param
+	} else {
+	}
+	var nextPageTokenParam string
+	if query.Has("nextPageToken") {
+		param := query.Get("nextPageToken")
+
+		nextPageTokenParam = param
+	} else {
+	}
+	result, err := c.service.GetModelVersionDeploymentEvents(r.Context(), modelversionIdParam, pageSizeParam, orderByParam, sortOrderParam, nextPageTokenParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // FindRegisteredModel - Get a RegisteredModel that matches search parameters.
 func (c *ModelRegistryServiceAPIController) FindRegisteredModel(w http.ResponseWriter, r *http.Request) {
 	query, err := parseQuery(r.URL.RawQuery)
@@ -17,8 +17,10 @@ import (
 
 	"github.com/kubeflow/hub/internal/converter"
 	"github.com/kubeflow/hub/internal/converter/generated"
+	"github.com/kubeflow/hub/internal/core"
 	"github.com/kubeflow/hub/internal/platform/apiutils"
 	"github.com/kubeflow/hub/internal/platform/db/scopes"
+	platformmw "github.com/kubeflow/hub/internal/platform/server/middleware"
 	"github.com/kubeflow/hub/pkg/api"
 	model "github.com/kubeflow/hub/pkg/openapi"
 )
@@ -55,7 +57,7 @@ func (s *ModelRegistryServiceAPIService) CreateInferenceService(ctx context.Cont
 		return ErrorResponse(http.StatusBadRequest, err), err
 	}
 
-	result, err := s.coreApi.UpsertInferenceService(entity)
+	result, err := s.coreApi.UpsertInferenceServiceWithActor(entity, platformmw.ActorFromContext(ctx))
 	if err != nil {
 		return ErrorResponse(api.ErrToStatus(err), err), err
 	}
@@ -115,6 +117,9 @@ func (s *ModelRegistryServiceAPIService) CreateModelVersion(ctx context.Context,
 	if err != nil {
 		return ErrorResponse(api.ErrToStatus(err), err), err
 	}
+	if warning := core.CatalogDeprecationWarning(result); warning != "" {
+		platformmw.AddWarning(ctx, warning)
+	}
 	return Response(http.StatusCreated, result), nil
 }
 
@@ -154,6 +159,9 @@ func (s *ModelRegistryServiceAPIService) CreateRegisteredModelVersion(ctx contex
 	if err != nil {
 		return ErrorResponse(api.ErrToStatus(err), err), err
 	}
+	if warning := core.CatalogDeprecationWarning(result); warning != "" {
+		platformmw.AddWarning(ctx, warning)
+	}
 	return Response(http.StatusCreated, result), nil
 }
 
@@ -248,6 +256,19 @@ func (s *ModelRegistryServiceAPIService) GetInferenceService(ctx context.Context
 	return Response(http.StatusOK, result), nil
 }
 
+// GetInferenceServiceDeploymentEvents - List All InferenceService&#39;s DeploymentEvents
+func (s *ModelRegistryServiceAPIService) GetInferenceServiceDeploymentEvents(ctx context.Context, inferenceserviceId string, pageSize string, orderBy model.OrderByField, sortOrder model.SortOrder, nextPageToken string) (ImplResponse, error) {
+	listOpts, err := s.buildListOption("", pageSize, orderBy, sortOrder, nextPageToken)
+	if err != nil {
+		return ErrorResponse(api.ErrToStatus(err), err), err
+	}
+	result, err := s.coreApi.GetDeploymentEvents(listOpts, nil, apiutils.StrPtr(inferenceserviceId))
+	if err != nil {
+		return ErrorResponse(api.ErrToStatus(err), err), err
+	}
+	return Response(http.StatusOK, result), nil
+}
+
 // GetInferenceServiceModel - Get InferenceService&#39;s RegisteredModel
 func (s *ModelRegistryServiceAPIService) GetInferenceServiceModel(ctx context.Context, inferenceserviceId string) (ImplResponse, error) {
 	result, err := s.coreApi.GetRegisteredModelByInferenceService(inferenceserviceId)
@@ -345,6 +366,19 @@ func (s *ModelRegistryServiceAPIService) GetModelVersion(ctx context.Context, mo
 	return Response(http.StatusOK, result), nil
 }
 
+// GetModelVersionDeploymentEvents - List All ModelVersion&#39;s DeploymentEvents
+func (s *ModelRegistryServiceAPIService) GetModelVersionDeploymentEvents(ctx context.Context, modelversionId string, pageSize string, orderBy model.OrderByField, sortOrder model.SortOrder, nextPageToken string) (ImplResponse, error) {
+	listOpts, err := s.buildListOption("", pageSize, orderBy, sortOrder, nextPageToken)
+	if err != nil {
+		return ErrorResponse(api.ErrToStatus(err), err), err
+	}
+	result, err := s.coreApi.GetDeploymentEvents(listOpts, apiutils.StrPtr(modelversionId), nil)
+	if err != nil {
+		return ErrorResponse(api.ErrToStatus(err), err), err
+	}
+	return Response(http.StatusOK, result), nil
+}
+
 // GetModelVersionArtifacts - List All ModelVersion&#39;s artifacts
 func (s *ModelRegistryServiceAPIService) GetModelVersionArtifacts(ctx context.Context, modelversionId string,
 	filterQuery string, name string, externalID string, artifactType model.ArtifactTypeQueryParam, pageSize string,
@@ -484,7 +518,7 @@ func (s *ModelRegistryServiceAPIService) UpdateInferenceService(ctx context.Cont
 	if err != nil {
 		return ErrorResponse(http.StatusBadRequest, err), err
 	}
-	result, err := s.coreApi.UpsertInferenceService(&update)
+	result, err := s.coreApi.UpsertInferenceServiceWithActor(&update, platformmw.ActorFromContext(ctx))
 	if err != nil {
 		return ErrorResponse(api.ErrToStatus(err), err), err
 	}
@@ -1,9 +1,16 @@
 package openapi
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	platformmw "github.com/kubeflow/hub/internal/platform/server/middleware"
+	"github.com/kubeflow/hub/pkg/api"
+	model "github.com/kubeflow/hub/pkg/openapi"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestBuildCombinedFilterQuery(t *testing.T) {
@@ -86,3 +93,58 @@ func TestBuildCombinedFilterQuery(t *testing.T) {
 		})
 	}
 }
+
+// upsertModelVersionApi only upserts model versions, returning them with the
+// custom properties set by the core service.
+type upsertModelVersionApi struct {
+	api.ModelRegistryApi
+	customProperties map[string]model.MetadataValue
+}
+
+func (a upsertModelVersionApi) UpsertModelVersion(modelVersion *model.ModelVersion, _ *string) (*model.ModelVersion, error) {
+	result := *modelVersion
+	result.CustomProperties = a.customProperties
+	return &result, nil
+}
+
+func TestCreateModelVersionDeprecationWarning(t *testing.T) {
+	deprecated := map[string]model.MetadataValue{
+		"catalog_source_id":         {MetadataStringValue: model.NewMetadataStringValue("rhoai", "MetadataStringValue")},
+		"catalog_model_name":        {MetadataStringValue: model.NewMetadataStringValue("granite-3.0-8b-instruct", "MetadataStringValue")},
+		"catalog_model_deprecated":  {MetadataBoolValue: model.NewMetadataBoolValue(true, "MetadataBoolValue")},
+		"catalog_model_replaced_by": {MetadataStringValue: model.NewMetadataStringValue("rhoai:granite-3.1-8b-instruct", "MetadataStringValue")},
+	}
+
+	testCases := []struct {
+		name             string
+		customProperties map[string]model.MetadataValue
+		expected         []string
+	}{
+		{
+			name:             "deprecated catalog model",
+			customProperties: deprecated,
+			expected:         []string{`299 - "model version \"v1\" is created from deprecated catalog model rhoai/granite-3.0-8b-instruct, use rhoai/granite-3.1-8b-instruct instead"`},
+		},
+		{
+			name: "current catalog model",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := NewModelRegistryServiceAPIService(upsertModelVersionApi{customProperties: tc.customProperties})
+
+			handler := platformmw.WarningMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				result, err := service.CreateRegisteredModelVersion(r.Context(), "1", model.ModelVersion{Name: "v1"})
+				require.NoError(t, err)
+				_ = EncodeJSONResponse(result.Body, &result.Code, w)
+			}))
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/", nil))
+
+			assert.Equal(t, http.StatusCreated, rec.Code)
+			assert.Equal(t, tc.expected, rec.Header().Values("Warning"))
+		})
+	}
+}
@@ -31,6 +31,57 @@ func AssertArtifactCreateRequired(obj model.ArtifactCreate) error {
 	return nil
 }
 
+// AssertArtifactDigestAlgorithmConstraints checks if the values respects the defined constraints
+func AssertArtifactDigestAlgorithmConstraints(obj model.ArtifactDigestAlgorithm) error {
+	return nil
+}
+
+// AssertArtifactDigestAlgorithmRequired checks if the required fields are not zero-ed
+func AssertArtifactDigestAlgorithmRequired(obj model.ArtifactDigestAlgorithm) error {
+	return nil
+}
+
+// AssertArtifactDigestConstraints checks if the values respects the defined constraints
+func AssertArtifactDigestConstraints(obj model.ArtifactDigest) error {
+	return nil
+}
+
+// AssertArtifactDigestRequired checks if the required fields are not zero-ed
+func AssertArtifactDigestRequired(obj model.ArtifactDigest) error {
+	elements := map[string]interface{}{
+		"algorithm": obj.Algorithm,
+		"value":     obj.Value,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertArtifactFileDigestConstraints checks if the values respects the defined constraints
+func AssertArtifactFileDigestConstraints(obj model.ArtifactFileDigest) error {
+	return nil
+}
+
+// AssertArtifactFileDigestRequired checks if the required fields are not zero-ed
+func AssertArtifactFileDigestRequired(obj model.ArtifactFileDigest) error {
+	elements := map[string]interface{}{
+		"path":      obj.Path,
+		"algorithm": obj.Algorithm,
+		"value":     obj.Value,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
 // AssertArtifactListConstraints checks if the values respects the defined constraints
 func AssertArtifactListConstraints(obj model.ArtifactList) error {
 	for _, el := range obj.Items {
@@ -68,6 +119,26 @@ func AssertArtifactRequired(obj model.Artifact) error {
 	return nil
 }
 
+// AssertArtifactSignatureConstraints checks if the values respects the defined constraints
+func AssertArtifactSignatureConstraints(obj model.ArtifactSignature) error {
+	return nil
+}
+
+// AssertArtifactSignatureRequired checks if the required fields are not zero-ed
+func AssertArtifactSignatureRequired(obj model.ArtifactSignature) error {
+	elements := map[string]interface{}{
+		"type": obj.Type,
+		"uri":  obj.Uri,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
 // AssertArtifactStateConstraints checks if the values respects the defined constraints
 func AssertArtifactStateConstraints(obj model.ArtifactState) error {
 	return nil
@@ -209,6 +280,71 @@ func AssertDataSetUpdateRequired(obj model.DataSetUpdate) error {
 	return nil
 }
 
+// AssertDeploymentEventConstraints checks if the values respects the defined constraints
+func AssertDeploymentEventConstraints(obj model.DeploymentEvent) error {
+	return nil
+}
+
+// AssertDeploymentEventListConstraints checks if the values respects the defined constraints
+func AssertDeploymentEventListConstraints(obj model.DeploymentEventList) error {
+	for _, el := range obj.Items {
+		if err := AssertDeploymentEventConstraints(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertDeploymentEventListRequired checks if the required fields are not zero-ed
+func AssertDeploymentEventListRequired(obj model.DeploymentEventList) error {
+	elements := map[string]interface{}{
+		"nextPageToken": obj.NextPageToken,
+		"pageSize":      obj.PageSize,
+		"size":          obj.Size,
+		"items":         obj.Items,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	for _, el := range obj.Items {
+		if err := AssertDeploymentEventRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertDeploymentEventRequired checks if the required fields are not zero-ed
+func AssertDeploymentEventRequired(obj model.DeploymentEvent) error {
+	elements := map[string]interface{}{
+		"type":                 obj.Type,
+		"inferenceServiceId":   obj.InferenceServiceId,
+		"modelVersionId":       obj.ModelVersionId,
+		"registeredModelId":    obj.RegisteredModelId,
+		"servingEnvironmentId": obj.ServingEnvironmentId,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertDeploymentEventTypeConstraints checks if the values respects the defined constraints
+func AssertDeploymentEventTypeConstraints(obj model.DeploymentEventType) error {
+	return nil
+}
+
+// AssertDeploymentEventTypeRequired checks if the required fields are not zero-ed
+func AssertDeploymentEventTypeRequired(obj model.DeploymentEventType) error {
+	return nil
+}
+
 // AssertDocArtifactConstraints checks if the values respects the defined constraints
 func AssertDocArtifactConstraints(obj model.DocArtifact) error {
 	return nil
@@ -459,13 +595,63 @@ func AssertExperimentUpdateRequired(obj model.ExperimentUpdate) error {
 	return nil
 }
 
+// AssertInferenceServiceActualStateConstraints checks if the values respects the defined constraints
+func AssertInferenceServiceActualStateConstraints(obj model.InferenceServiceActualState) error {
+	return nil
+}
+
+// AssertInferenceServiceActualStateRequired checks if the required fields are not zero-ed
+func AssertInferenceServiceActualStateRequired(obj model.InferenceServiceActualState) error {
+	return nil
+}
+
+// AssertInferenceServiceConditionConstraints checks if the values respects the defined constraints
+func AssertInferenceServiceConditionConstraints(obj model.InferenceServiceCondition) error {
+	return nil
+}
+
+// AssertInferenceServiceConditionRequired checks if the required fields are not zero-ed
+func AssertInferenceServiceConditionRequired(obj model.InferenceServiceCondition) error {
+	elements := map[string]interface{}{
+		"type":   obj.Type,
+		"status": obj.Status,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
 // AssertInferenceServiceConstraints checks if the values respects the defined constraints
 func AssertInferenceServiceConstraints(obj model.InferenceService) error {
+	if obj.DeploymentStatus != nil {
+		if err := AssertInferenceServiceDeploymentStatusConstraints(*obj.DeploymentStatus); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.TrafficSplit {
+		if err := AssertInferenceServiceTrafficSplitConstraints(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 // AssertInferenceServiceCreateConstraints checks if the values respects the defined constraints
 func AssertInferenceServiceCreateConstraints(obj model.InferenceServiceCreate) error {
+	if obj.DeploymentStatus != nil {
+		if err := AssertInferenceServiceDeploymentStatusConstraints(*obj.DeploymentStatus); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.TrafficSplit {
+		if err := AssertInferenceServiceTrafficSplitConstraints(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -481,6 +667,46 @@ func AssertInferenceServiceCreateRequired(obj model.InferenceServiceCreate) erro
 		}
 	}
 
+	if obj.DeploymentStatus != nil {
+		if err := AssertInferenceServiceDeploymentStatusRequired(*obj.DeploymentStatus); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.TrafficSplit {
+		if err := AssertInferenceServiceTrafficSplitRequired(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertInferenceServiceDeploymentStatusConstraints checks if the values respects the defined constraints
+func AssertInferenceServiceDeploymentStatusConstraints(obj model.InferenceServiceDeploymentStatus) error {
+	for _, el := range obj.Conditions {
+		if err := AssertInferenceServiceConditionConstraints(el); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.Traffic {
+		if err := AssertInferenceServiceTrafficTargetConstraints(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AssertInferenceServiceDeploymentStatusRequired checks if the required fields are not zero-ed
+func AssertInferenceServiceDeploymentStatusRequired(obj model.InferenceServiceDeploymentStatus) error {
+	for _, el := range obj.Conditions {
+		if err := AssertInferenceServiceConditionRequired(el); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.Traffic {
+		if err := AssertInferenceServiceTrafficTargetRequired(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -528,6 +754,16 @@ func AssertInferenceServiceRequired(obj model.InferenceService) error {
 		}
 	}
 
+	if obj.DeploymentStatus != nil {
+		if err := AssertInferenceServiceDeploymentStatusRequired(*obj.DeploymentStatus); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.TrafficSplit {
+		if err := AssertInferenceServiceTrafficSplitRequired(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -541,13 +777,63 @@ func AssertInferenceServiceStateRequired(obj model.InferenceServiceState) error
 	return nil
 }
 
+// AssertInferenceServiceTrafficSplitConstraints checks if the values respects the defined constraints
+func AssertInferenceServiceTrafficSplitConstraints(obj model.InferenceServiceTrafficSplit) error {
+	return nil
+}
+
+// AssertInferenceServiceTrafficSplitRequired checks if the required fields are not zero-ed
+func AssertInferenceServiceTrafficSplitRequired(obj model.InferenceServiceTrafficSplit) error {
+	elements := map[string]interface{}{
+		"modelVersionId": obj.ModelVersionId,
+		"percent":        obj.Percent,
+	}
+	for name, el := range elements {
+		if isZero := IsZeroValue(el); isZero {
+			return &RequiredError{Field: name}
+		}
+	}
+
+	return nil
+}
+
+// AssertInferenceServiceTrafficTargetConstraints checks if the values respects the defined constraints
+func AssertInferenceServiceTrafficTargetConstraints(obj model.InferenceServiceTrafficTarget) error {
+	return nil
+}
+
+// AssertInferenceServiceTrafficTargetRequired checks if the required fields are not zero-ed
+func AssertInferenceServiceTrafficTargetRequired(obj model.InferenceServiceTrafficTarget) error {
+	return nil
+}
+
 // AssertInferenceServiceUpdateConstraints checks if the values respects the defined constraints
 func AssertInferenceServiceUpdateConstraints(obj model.InferenceServiceUpdate) error {
+	if obj.DeploymentStatus != nil {
+		if err := AssertInferenceServiceDeploymentStatusConstraints(*obj.DeploymentStatus); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.TrafficSplit {
+		if err := AssertInferenceServiceTrafficSplitConstraints(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 // AssertInferenceServiceUpdateRequired checks if the required fields are not zero-ed
 func AssertInferenceServiceUpdateRequired(obj model.InferenceServiceUpdate) error {
+	if obj.DeploymentStatus != nil {
+		if err := AssertInferenceServiceDeploymentStatusRequired(*obj.DeploymentStatus); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.TrafficSplit {
+		if err := AssertInferenceServiceTrafficSplitRequired(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -763,16 +1049,61 @@ func AssertMetricUpdateRequired(obj model.MetricUpdate) error {
 
 // AssertModelArtifactConstraints checks if the values respects the defined constraints
 func AssertModelArtifactConstraints(obj model.ModelArtifact) error {
+	if obj.Digest != nil {
+		if err := AssertArtifactDigestConstraints(*obj.Digest); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.FileDigests {
+		if err := AssertArtifactFileDigestConstraints(el); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.Signatures {
+		if err := AssertArtifactSignatureConstraints(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 // AssertModelArtifactCreateConstraints checks if the values respects the defined constraints
 func AssertModelArtifactCreateConstraints(obj model.ModelArtifactCreate) error {
+	if obj.Digest != nil {
+		if err := AssertArtifactDigestConstraints(*obj.Digest); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.FileDigests {
+		if err := AssertArtifactFileDigestConstraints(el); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.Signatures {
+		if err := AssertArtifactSignatureConstraints(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 // AssertModelArtifactCreateRequired checks if the required fields are not zero-ed
 func AssertModelArtifactCreateRequired(obj model.ModelArtifactCreate) error {
+	if obj.Digest != nil {
+		if err := AssertArtifactDigestRequired(*obj.Digest); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.FileDigests {
+		if err := AssertArtifactFileDigestRequired(el); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.Signatures {
+		if err := AssertArtifactSignatureRequired(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -810,16 +1141,61 @@ func AssertModelArtifactListRequired(obj model.ModelArtifactList) error {
 
 // AssertModelArtifactRequired checks if the required fields are not zero-ed
 func AssertModelArtifactRequired(obj model.ModelArtifact) error {
+	if obj.Digest != nil {
+		if err := AssertArtifactDigestRequired(*obj.Digest); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.FileDigests {
+		if err := AssertArtifactFileDigestRequired(el); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.Signatures {
+		if err := AssertArtifactSignatureRequired(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 // AssertModelArtifactUpdateConstraints checks if the values respects the defined constraints
 func AssertModelArtifactUpdateConstraints(obj model.ModelArtifactUpdate) error {
+	if obj.Digest != nil {
+		if err := AssertArtifactDigestConstraints(*obj.Digest); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.FileDigests {
+		if err := AssertArtifactFileDigestConstraints(el); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.Signatures {
+		if err := AssertArtifactSignatureConstraints(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 // AssertModelArtifactUpdateRequired checks if the required fields are not zero-ed
 func AssertModelArtifactUpdateRequired(obj model.ModelArtifactUpdate) error {
+	if obj.Digest != nil {
+		if err := AssertArtifactDigestRequired(*obj.Digest); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.FileDigests {
+		if err := AssertArtifactFileDigestRequired(el); err != nil {
+			return err
+		}
+	}
+	for _, el := range obj.Signatures {
+		if err := AssertArtifactSignatureRequired(el); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -1205,6 +1581,16 @@ func AssertServingEnvironmentRequired(obj model.ServingEnvironment) error {
 	return nil
 }
 
+// AssertServingEnvironmentTierConstraints checks if the values respects the defined constraints
+func AssertServingEnvironmentTierConstraints(obj model.ServingEnvironmentTier) error {
+	return nil
+}
+
+// AssertServingEnvironmentTierRequired checks if the required fields are not zero-ed
+func AssertServingEnvironmentTierRequired(obj model.ServingEnvironmentTier) error {
+	return nil
+}
+
 // AssertServingEnvironmentUpdateConstraints checks if the values respects the defined constraints
 func AssertServingEnvironmentUpdateConstraints(obj model.ServingEnvironmentUpdate) error {
 	return nil
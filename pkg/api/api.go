@@ -112,6 +112,10 @@ type ModelRegistryApi interface {
 	// to the newly created InferenceService.
 	UpsertInferenceService(inferenceService *openapi.InferenceService) (*openapi.InferenceService, error)
 
+	// UpsertInferenceServiceWithActor create or update an inference service like UpsertInferenceService,
+	// recording actor as the user that made the change in the deployment history of the InferenceService.
+	UpsertInferenceServiceWithActor(inferenceService *openapi.InferenceService, actor string) (*openapi.InferenceService, error)
+
 	// GetInferenceServiceById retrieve InferenceService by id
 	GetInferenceServiceById(id string) (*openapi.InferenceService, error)
 
@@ -137,6 +141,13 @@ type ModelRegistryApi interface {
 	// if inferenceServiceId is provided, return all ServeModel instances belonging to a specific InferenceService
 	GetServeModels(listOptions ListOptions, inferenceServiceId *string) (*openapi.ServeModelList, error)
 
+	// DEPLOYMENT EVENT
+
+	// GetDeploymentEvents get the deployment history of InferenceServices properly ordered and sized based on listOptions param.
+	// if modelVersionId is provided, return all DeploymentEvent instances of InferenceServices deploying a specific ModelVersion
+	// if inferenceServiceId is provided, return all DeploymentEvent instances of a specific InferenceService
+	GetDeploymentEvents(listOptions ListOptions, modelVersionId *string, inferenceServiceId *string) (*openapi.DeploymentEventList, error)
+
 	// EXPERIMENT
 	// UpsertExperiment create or update an experiment, the behavior follows the same
 	// approach used by MLMD gRPC api. If Id is provided update the entity otherwise create a new one.
@@ -9,6 +9,8 @@ var (
 	ErrBadRequest = platformerrors.ErrBadRequest
 	ErrNotFound   = platformerrors.ErrNotFound
 	ErrConflict   = platformerrors.ErrConflict
+
+	ErrDigestMismatch = platformerrors.ErrDigestMismatch
 )
 
 var ErrToStatus = platformerrors.ErrToStatus
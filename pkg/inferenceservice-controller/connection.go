@@ -0,0 +1,231 @@
+package inferenceservicecontroller
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/kubeflow/hub/pkg/openapi"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ConnectionProfileAnnotation names the Secret holding the credentials
+	// to connect to a model registry, in the namespace of the registry. It's
+	// only read from the model registry Services of the registries namespace,
+	// never from the KServe InferenceServices, which any user can label.
+	ConnectionProfileAnnotation = "modelregistry.kubeflow.org/connection-profile"
+
+	// ConnectionProfileTokenKey is the key of the bearer token in a
+	// connection profile Secret.
+	ConnectionProfileTokenKey = "token"
+	// ConnectionProfileCAKey is the key of the PEM bundle of the CAs to trust
+	// in a connection profile Secret.
+	ConnectionProfileCAKey = "ca.crt"
+	// ConnectionProfileCertKey is the key of the PEM client certificate in a
+	// connection profile Secret, used for mTLS with ConnectionProfileKeyKey.
+	ConnectionProfileCertKey = "tls.crt"
+	// ConnectionProfileKeyKey is the key of the PEM client private key in a
+	// connection profile Secret.
+	ConnectionProfileKeyKey = "tls.key"
+)
+
+// connectionProfile identifies how to connect to a model registry.
+type connectionProfile struct {
+	url string
+	// secret is the Secret holding the credentials, if the registry doesn't
+	// use the default ones.
+	secret types.NamespacedName
+}
+
+// newConnectionProfile returns the profile connecting to the model registry
+// at url, with the credentials in the Secret secretName of its namespace, or
+// the default credentials if secretName is empty.
+func newConnectionProfile(url, namespace, secretName string) connectionProfile {
+	profile := connectionProfile{url: url}
+
+	if secretName != "" {
+		profile.secret = types.NamespacedName{Namespace: namespace, Name: secretName}
+	}
+
+	return profile
+}
+
+// connections keeps a model registry API client for every connection
+// profile. Each of them has its own http.Client, so that the credentials of
+// a registry are never used for another one, and is recreated when the
+// Secret of its profile changes.
+type connections struct {
+	client        client.Client
+	skipTLSVerify bool
+	bearerToken   string
+	// httpClient replaces the http.Client of every profile if set, keeping
+	// its token only.
+	httpClient *http.Client
+
+	mu    sync.Mutex
+	cache map[connectionProfile]*connection
+}
+
+// connection is an API client to a model registry.
+type connection struct {
+	api *openapi.APIClient
+	// secretVersion is the resource version of the Secret the connection
+	// was built from.
+	secretVersion string
+	transport     *http.Transport
+}
+
+func newConnections(client client.Client, skipTLSVerify bool, bearerToken string) *connections {
+	return &connections{
+		client:        client,
+		skipTLSVerify: skipTLSVerify,
+		bearerToken:   bearerToken,
+		cache:         map[connectionProfile]*connection{},
+	}
+}
+
+// overrideHTTPClient makes every connection use httpClient.
+func (c *connections) overrideHTTPClient(httpClient *http.Client) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.httpClient = httpClient
+	c.closeAll()
+}
+
+// get returns the API client of a connection profile.
+func (c *connections) get(ctx context.Context, profile connectionProfile) (*openapi.APIClient, error) {
+	var secret *corev1.Secret
+
+	if profile.secret.Name != "" {
+		secret = &corev1.Secret{}
+
+		if err := c.client.Get(ctx, profile.secret, secret); err != nil {
+			return nil, fmt.Errorf("unable to get the connection profile Secret %s: %w", profile.secret, err)
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if conn, ok := c.cache[profile]; ok && (secret == nil || conn.secretVersion == secret.ResourceVersion) {
+		return conn.api, nil
+	}
+
+	conn, err := c.newConnection(profile, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	if previous, ok := c.cache[profile]; ok {
+		previous.close()
+	}
+
+	c.cache[profile] = conn
+
+	return conn.api, nil
+}
+
+func (c *connections) newConnection(profile connectionProfile, secret *corev1.Secret) (*connection, error) {
+	conn := &connection{}
+	token := c.bearerToken
+	tlsConfig := &tls.Config{InsecureSkipVerify: c.skipTLSVerify}
+
+	if secret != nil {
+		conn.secretVersion = secret.ResourceVersion
+		// A profile without a token, e.g. one that only sets a CA, keeps the
+		// default token of the controller.
+		if profileToken := strings.TrimSpace(string(secret.Data[ConnectionProfileTokenKey])); profileToken != "" {
+			token = profileToken
+		}
+
+		if ca, ok := secret.Data[ConnectionProfileCAKey]; ok {
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(ca) {
+				return nil, fmt.Errorf("no valid certificate in %s of the connection profile Secret %s", ConnectionProfileCAKey, profile.secret)
+			}
+
+			tlsConfig.RootCAs = pool
+			tlsConfig.InsecureSkipVerify = false
+		}
+
+		cert, hasCert := secret.Data[ConnectionProfileCertKey]
+		key, hasKey := secret.Data[ConnectionProfileKeyKey]
+
+		if hasCert || hasKey {
+			certificate, err := tls.X509KeyPair(cert, key)
+			if err != nil {
+				return nil, fmt.Errorf("invalid client certificate in the connection profile Secret %s: %w", profile.secret, err)
+			}
+
+			tlsConfig.Certificates = []tls.Certificate{certificate}
+		}
+	}
+
+	var base http.RoundTripper
+
+	if c.httpClient != nil {
+		base = c.httpClient.Transport
+	} else {
+		conn.transport = http.DefaultTransport.(*http.Transport).Clone()
+		conn.transport.TLSClientConfig = tlsConfig
+		base = conn.transport
+	}
+
+	httpClient := &http.Client{Transport: base}
+
+	if token != "" {
+		httpClient.Transport = &bearerTokenTransport{token: token, base: base}
+	}
+
+	conn.api = openapi.NewAPIClient(&openapi.Configuration{
+		HTTPClient: httpClient,
+		Servers: openapi.ServerConfigurations{
+			{
+				URL: profile.url,
+			},
+		},
+	})
+
+	return conn, nil
+}
+
+func (c *connections) closeAll() {
+	for profile, conn := range c.cache {
+		conn.close()
+		delete(c.cache, profile)
+	}
+}
+
+// close releases the idle connections of conn, in use ones are closed once
+// done.
+func (conn *connection) close() {
+	if conn.transport != nil {
+		conn.transport.CloseIdleConnections()
+	}
+}
+
+// bearerTokenTransport authenticates the requests with a bearer token.
+type bearerTokenTransport struct {
+	token string
+	base  http.RoundTripper
+}
+
+func (t *bearerTokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+t.token)
+
+	return base.RoundTrip(req)
+}
@@ -2,7 +2,6 @@ package inferenceservicecontroller
 
 import (
 	"context"
-	"crypto/tls"
 	"fmt"
 	"net/http"
 
@@ -19,9 +18,8 @@ import (
 
 type InferenceServiceController struct {
 	client                        client.Client
-	httpClient                    *http.Client
+	connections                   *connections
 	log                           logr.Logger
-	bearerToken                   string
 	inferenceServiceIDLabel       string
 	registeredModelIDLabel        string
 	modelVersionIDLabel           string
@@ -48,19 +46,10 @@ func NewInferenceServiceController(
 	serviceURLAnnotation,
 	defaultMRNamespace string,
 ) *InferenceServiceController {
-	httpClient := http.DefaultClient
-
-	if skipTLSVerify {
-		httpClient.Transport = &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-		}
-	}
-
 	return &InferenceServiceController{
 		client:                        client,
-		httpClient:                    httpClient,
+		connections:                   newConnections(client, skipTLSVerify, bearerToken),
 		log:                           log,
-		bearerToken:                   bearerToken,
 		inferenceServiceIDLabel:       isIDLabel,
 		registeredModelIDLabel:        regModelIDLabel,
 		modelVersionIDLabel:           modelVerIDLabel,
@@ -74,7 +63,7 @@ func NewInferenceServiceController(
 }
 
 func (r *InferenceServiceController) OverrideHTTPClient(client *http.Client) {
-	r.httpClient = client
+	r.connections.overrideHTTPClient(client)
 }
 
 // Reconcile performs the reconciliation of the model registry based on Kubeflow InferenceService CRs
@@ -83,10 +72,6 @@ func (r *InferenceServiceController) Reconcile(ctx context.Context, req ctrl.Req
 	mrIs := &openapi.InferenceService{}
 	mrApiCtx := context.Background()
 
-	if r.bearerToken != "" {
-		mrApiCtx = context.WithValue(context.Background(), openapi.ContextAccessToken, r.bearerToken)
-	}
-
 	// Initialize logger format
 	log := r.log.WithValues("InferenceService", req.Name, "namespace", req.Namespace)
 
@@ -168,20 +153,15 @@ func (r *InferenceServiceController) Reconcile(ctx context.Context, req ctrl.Req
 			return ctrl.Result{}, fmt.Errorf("unable to find InferenceService with id %s in model registry: %w", mrIsvcId, err)
 		}
 
-		mrCurrentIvcUrl := mrIs.CustomProperties["url"].MetadataStringValue.GetStringValue()
-
-		urlAreDiff := r.checkURLDiff(isvc, mrCurrentIvcUrl)
-		if urlAreDiff {
-			err := r.updateMRInferenceService(
-				mrApiCtx,
-				log,
-				mrApi,
-				isvc,
-				mrIs,
-			)
-			if err != nil {
-				return ctrl.Result{}, err
-			}
+		err := r.updateMRInferenceService(
+			mrApiCtx,
+			log,
+			mrApi,
+			isvc,
+			mrIs,
+		)
+		if err != nil {
+			return ctrl.Result{}, err
 		}
 
 	} else if okRegisteredModelId {
@@ -253,42 +233,41 @@ func (r *InferenceServiceController) checkURLDiff(isvc *kservev1beta1.InferenceS
 	return mrIsvcUrl != isvc.Status.URL.String()
 }
 
+// initModelRegistryService returns the API client of the model registry
+// named name in namespace, or at url if set.
+//
+// The url, name and namespace come from the ISVC, so they are not trusted
+// with credentials: a registry at url gets the default credentials only, and
+// the connection profile is only read from the registry Service, when it's
+// in the registries namespace where the profile Secrets live.
 func (r *InferenceServiceController) initModelRegistryService(ctx context.Context, log logr.Logger, name, namespace, url string) (*openapi.APIClient, error) {
-	var err error
-
 	log1 := log.WithValues("mr-namespace", namespace, "mr-name", name)
 
-	if url == "" {
-		log1.Info("Retrieving url from deployed model registry service")
-
-		url, err = r.getMRUrlFromService(ctx, name, namespace)
-		if err != nil {
-			log1.Error(err, "Unable to fetch the Model Registry service")
-			return nil, err
-		}
-	}
-
-	cfg := &openapi.Configuration{
-		HTTPClient: r.httpClient,
-		Servers: openapi.ServerConfigurations{
-			{
-				URL: url,
-			},
-		},
+	if url != "" {
+		return r.connections.get(ctx, newConnectionProfile(url, namespace, ""))
 	}
 
-	client := openapi.NewAPIClient(cfg)
+	log1.Info("Retrieving url from deployed model registry service")
 
-	return client, nil
-}
-
-func (r *InferenceServiceController) getMRUrlFromService(ctx context.Context, name, namespace string) (string, error) {
 	svc, err := r.getMRService(ctx, name, namespace)
 	if err != nil {
-		return "", fmt.Errorf("unable to find the Model Registry service: %w", err)
+		err = fmt.Errorf("unable to find the Model Registry service: %w", err)
+		log1.Error(err, "Unable to fetch the Model Registry service")
+		return nil, err
 	}
 
-	return r.buildURLFromService(svc)
+	url, err = r.buildURLFromService(svc)
+	if err != nil {
+		log1.Error(err, "Unable to fetch the Model Registry service")
+		return nil, err
+	}
+
+	profile := svc.Annotations[ConnectionProfileAnnotation]
+	if profile != "" && svc.Namespace != r.defaultModelRegistryNamespace {
+		return nil, fmt.Errorf("connection profiles are only supported for model registries in the namespace %s, found %s on the Model Registry service %s/%s", r.defaultModelRegistryNamespace, ConnectionProfileAnnotation, svc.Namespace, svc.Name)
+	}
+
+	return r.connections.get(ctx, newConnectionProfile(url, svc.Namespace, profile))
 }
 
 func (r *InferenceServiceController) getMRService(ctx context.Context, name, namespace string) (*corev1.Service, error) {
@@ -321,9 +300,15 @@ func (r *InferenceServiceController) getMRService(ctx context.Context, name, nam
 }
 
 func (r *InferenceServiceController) buildURLFromService(svc *corev1.Service) (string, error) {
+	return buildURLFromService(svc, r.serviceURLAnnotation)
+}
+
+// buildURLFromService returns the url of the REST API of a Model Registry
+// service, using the external address set in serviceURLAnnotation if any.
+func buildURLFromService(svc *corev1.Service, serviceURLAnnotation string) (string, error) {
 	var restApiPort *int32
 
-	if url, ok := svc.Annotations[r.serviceURLAnnotation]; ok {
+	if url, ok := svc.Annotations[serviceURLAnnotation]; ok {
 		return fmt.Sprintf("https://%s", url), nil
 	}
 
@@ -364,6 +349,8 @@ func (r *InferenceServiceController) createMRInferenceService(
 
 		isCreate := openapi.InferenceServiceCreate{
 			DesiredState:         openapi.INFERENCESERVICESTATE_DEPLOYED.Ptr(),
+			ActualState:          actualState(isvc).Ptr(),
+			DeploymentStatus:     deploymentStatus(isvc, r.modelVersionIDLabel, nil),
 			ModelVersionId:       modelVersionIdPtr,
 			Name:                 &isName,
 			RegisteredModelId:    registeredModelId,
@@ -385,6 +372,8 @@ func (r *InferenceServiceController) createMRInferenceService(
 	return is, err
 }
 
+// updateMRInferenceService records the live status of the ISVC on the model
+// registry InferenceService, if it changed since the last update.
 func (r *InferenceServiceController) updateMRInferenceService(
 	ctx context.Context,
 	log logr.Logger,
@@ -392,25 +381,45 @@ func (r *InferenceServiceController) updateMRInferenceService(
 	isvc *kservev1beta1.InferenceService,
 	mrIsvc *openapi.InferenceService,
 ) error {
-	log.Info("Updating model registry InferenceService..")
+	isUpdate := openapi.InferenceServiceUpdate{}
+	changed := false
+
+	if r.checkURLDiff(isvc, mrIsvc.CustomProperties["url"].MetadataStringValue.GetStringValue()) {
+		url := ""
 
-	url := ""
+		if isvc.Status.URL != nil {
+			url = isvc.Status.URL.String()
+		}
 
-	if isvc.Status.URL != nil {
-		url = isvc.Status.URL.String()
+		if mrIsvc.CustomProperties == nil {
+			mrIsvc.CustomProperties = map[string]openapi.MetadataValue{}
+		}
+
+		mrIsvc.CustomProperties["url"] = openapi.MetadataValue{
+			MetadataStringValue: openapi.NewMetadataStringValue(url, "MetadataStringValue"),
+		}
+
+		isUpdate.CustomProperties = mrIsvc.CustomProperties
+		changed = true
 	}
 
-	if mrIsvc.CustomProperties == nil {
-		mrIsvc.CustomProperties = map[string]openapi.MetadataValue{}
+	if state := actualState(isvc); mrIsvc.ActualState == nil || *mrIsvc.ActualState != state {
+		isUpdate.ActualState = state.Ptr()
+		changed = true
 	}
 
-	mrIsvc.CustomProperties["url"] = openapi.MetadataValue{
-		MetadataStringValue: openapi.NewMetadataStringValue(url, "MetadataStringValue"),
+	if status := deploymentStatus(isvc, r.modelVersionIDLabel, mrIsvc.DeploymentStatus); !deploymentStatusEqual(status, mrIsvc.DeploymentStatus) {
+		isUpdate.DeploymentStatus = status
+		changed = true
 	}
 
-	_, _, err := mr.ModelRegistryServiceAPI.UpdateInferenceService(ctx, *mrIsvc.Id).InferenceServiceUpdate(openapi.InferenceServiceUpdate{
-		CustomProperties: mrIsvc.CustomProperties,
-	}).Execute()
+	if !changed {
+		return nil
+	}
+
+	log.Info("Updating model registry InferenceService..")
+
+	_, _, err := mr.ModelRegistryServiceAPI.UpdateInferenceService(ctx, *mrIsvc.Id).InferenceServiceUpdate(isUpdate).Execute()
 
 	return err
 }
@@ -431,7 +440,7 @@ func (r *InferenceServiceController) getOrCreateServingEnvironment(ctx context.C
 	return servingEnvironment, nil
 }
 
-// onDeletion mark model registry inference service to UNDEPLOYED desired state
+// onDeletion mark model registry inference service to UNDEPLOYED desired and actual state
 func (r *InferenceServiceController) onDeletion(ctx context.Context, mr *openapi.APIClient, log logr.Logger, is *openapi.InferenceService) (err error) {
 	log.Info("Running onDeletion logic")
 	if is.DesiredState != nil && *is.DesiredState != openapi.INFERENCESERVICESTATE_UNDEPLOYED {
@@ -439,6 +448,7 @@ func (r *InferenceServiceController) onDeletion(ctx context.Context, mr *openapi
 
 		_, _, err = mr.ModelRegistryServiceAPI.UpdateInferenceService(ctx, *is.Id).InferenceServiceUpdate(openapi.InferenceServiceUpdate{
 			DesiredState: openapi.INFERENCESERVICESTATE_UNDEPLOYED.Ptr(),
+			ActualState:  openapi.INFERENCESERVICEACTUALSTATE_UNDEPLOYED.Ptr(),
 		}).Execute()
 	}
 	return err
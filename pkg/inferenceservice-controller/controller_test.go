@@ -10,10 +10,14 @@ import (
 	"github.com/kubeflow/hub/pkg/openapi"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
 	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	knservingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -649,4 +653,164 @@ var _ = Describe("InferenceService Controller", func() {
 			}, 20*time.Second, 1*time.Second).Should(Succeed())
 		})
 	})
+
+	When("An InferenceService reports its deployment status", func() {
+		It("Should record the actual state, conditions and traffic split in the model registry InferenceService", func() {
+			const InferenceServiceMissingNamePath = "./testdata/inferenceservices/inference-service-missing-name.yaml"
+			const ModelRegistrySVCPath = "./testdata/deploy/model-registry-svc.yaml"
+			const namespace = "status-reconcile"
+			const mrUrl = "http://model-registry.svc.cluster.local:8080"
+
+			ns := &corev1.Namespace{}
+
+			ns.SetName(namespace)
+
+			if err := cli.Create(ctx, ns); err != nil && !errors.IsAlreadyExists(err) {
+				Fail(err.Error())
+			}
+
+			mrSvc := &corev1.Service{}
+			Expect(ConvertFileToStructuredResource(ModelRegistrySVCPath, mrSvc)).To(Succeed())
+
+			mrSvc.SetNamespace(namespace)
+
+			if err := cli.Create(ctx, mrSvc); err != nil && !errors.IsAlreadyExists(err) {
+				Fail(err.Error())
+			}
+
+			inferenceService := &kservev1beta1.InferenceService{}
+			Expect(ConvertFileToStructuredResource(InferenceServiceMissingNamePath, inferenceService)).To(Succeed())
+
+			inferenceService.SetNamespace(namespace)
+
+			inferenceService.Labels[namespaceLabel] = namespace
+			inferenceService.Labels[modelVersionIDLabel] = "2"
+
+			if err := cli.Create(ctx, inferenceService); err != nil && !errors.IsAlreadyExists(err) {
+				Fail(err.Error())
+			}
+
+			getRestIsvc := func() *openapi.InferenceService {
+				resp, err := mrMockServer.Client().Get(mrUrl + "/api/model_registry/v1alpha3/inference_services/1")
+				Expect(err).To(BeNil())
+
+				//nolint:errcheck
+				defer resp.Body.Close()
+
+				body, err := io.ReadAll(resp.Body)
+				Expect(err).To(BeNil())
+
+				restIsvc := &openapi.InferenceService{}
+				Expect(json.Unmarshal(body, restIsvc)).To(Succeed())
+
+				return restIsvc
+			}
+
+			Eventually(func() error {
+				isvc := &kservev1beta1.InferenceService{}
+				err := cli.Get(ctx, types.NamespacedName{
+					Name:      inferenceService.Name,
+					Namespace: inferenceService.Namespace,
+				}, isvc)
+				if err != nil {
+					return err
+				}
+
+				if isvc.Labels[inferenceServiceIDLabel] != "1" {
+					return fmt.Errorf("Label for InferenceServiceID is not set, got %s", isvc.Labels[inferenceServiceIDLabel])
+				}
+
+				return nil
+			}, 10*time.Second, 1*time.Second).Should(Succeed())
+
+			Expect(getRestIsvc().GetActualState()).To(Equal(openapi.INFERENCESERVICEACTUALSTATE_DEPLOYING))
+
+			err := cli.Get(ctx, types.NamespacedName{Name: inferenceService.Name, Namespace: inferenceService.Namespace}, inferenceService)
+			Expect(err).To(BeNil())
+
+			readySince := apis.VolatileTime{Inner: metav1.NewTime(time.UnixMilli(1700000000000))}
+			inferenceService.Status.Conditions = duckv1.Conditions{
+				{Type: apis.ConditionReady, Status: corev1.ConditionTrue, LastTransitionTime: readySince},
+				{Type: kservev1beta1.PredictorReady, Status: corev1.ConditionTrue, LastTransitionTime: readySince},
+			}
+			inferenceService.Status.Components = map[kservev1beta1.ComponentType]kservev1beta1.ComponentStatusSpec{
+				kservev1beta1.PredictorComponent: {
+					LatestCreatedRevision: "example-onnx-mnist-predictor-00001",
+					Traffic: []knservingv1.TrafficTarget{
+						{RevisionName: "example-onnx-mnist-predictor-00001", LatestRevision: ptr.To(true), Percent: ptr.To(int64(100))},
+					},
+				},
+			}
+
+			if err := cli.Status().Update(ctx, inferenceService); err != nil {
+				Fail(err.Error())
+			}
+
+			Eventually(func() error {
+				restIsvc := getRestIsvc()
+
+				if restIsvc.GetActualState() != openapi.INFERENCESERVICEACTUALSTATE_DEPLOYED {
+					return fmt.Errorf("InferenceService actual state is not DEPLOYED, got %s", restIsvc.GetActualState())
+				}
+
+				status := restIsvc.GetDeploymentStatus()
+				if len(status.Conditions) != 2 {
+					return fmt.Errorf("InferenceService conditions are not set, got %d", len(status.Conditions))
+				}
+
+				if status.GetLastTransitionTimeSinceEpoch() != "1700000000000" {
+					return fmt.Errorf("InferenceService last transition time is not set correctly, got %s", status.GetLastTransitionTimeSinceEpoch())
+				}
+
+				if len(status.Traffic) != 1 || status.Traffic[0].GetModelVersionId() != "2" || status.Traffic[0].GetPercent() != 100 {
+					return fmt.Errorf("InferenceService traffic is not set correctly, got %+v", status.Traffic)
+				}
+
+				return nil
+			}, 20*time.Second, 1*time.Second).Should(Succeed())
+
+			// Roll out a new model version as a canary
+			err = cli.Get(ctx, types.NamespacedName{Name: inferenceService.Name, Namespace: inferenceService.Namespace}, inferenceService)
+			Expect(err).To(BeNil())
+
+			inferenceService.Labels[modelVersionIDLabel] = "3"
+
+			Expect(cli.Update(ctx, inferenceService)).To(Succeed())
+
+			inferenceService.Status.Conditions = duckv1.Conditions{
+				{Type: apis.ConditionReady, Status: corev1.ConditionTrue, LastTransitionTime: readySince},
+			}
+			inferenceService.Status.Components = map[kservev1beta1.ComponentType]kservev1beta1.ComponentStatusSpec{
+				kservev1beta1.PredictorComponent: {
+					LatestCreatedRevision: "example-onnx-mnist-predictor-00002",
+					Traffic: []knservingv1.TrafficTarget{
+						{RevisionName: "example-onnx-mnist-predictor-00002", Tag: "latest", LatestRevision: ptr.To(true), Percent: ptr.To(int64(10))},
+						{RevisionName: "example-onnx-mnist-predictor-00001", Tag: "prev", LatestRevision: ptr.To(false), Percent: ptr.To(int64(90))},
+					},
+				},
+			}
+
+			if err := cli.Status().Update(ctx, inferenceService); err != nil {
+				Fail(err.Error())
+			}
+
+			Eventually(func() error {
+				traffic := getRestIsvc().GetDeploymentStatus().Traffic
+
+				if len(traffic) != 2 {
+					return fmt.Errorf("InferenceService traffic is not split, got %+v", traffic)
+				}
+
+				if traffic[0].GetModelVersionId() != "3" || traffic[0].GetPercent() != 10 || traffic[0].GetTag() != "latest" {
+					return fmt.Errorf("InferenceService canary traffic is not set correctly, got %+v", traffic[0])
+				}
+
+				if traffic[1].GetModelVersionId() != "2" || traffic[1].GetPercent() != 90 {
+					return fmt.Errorf("InferenceService previous traffic is not set correctly, got %+v", traffic[1])
+				}
+
+				return nil
+			}, 20*time.Second, 1*time.Second).Should(Succeed())
+		})
+	})
 })
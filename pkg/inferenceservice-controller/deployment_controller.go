@@ -0,0 +1,630 @@
+package inferenceservicecontroller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	kservev1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kubeflow/hub/pkg/openapi"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ManagedByLabel is set on the KServe InferenceServices created by the
+	// DeploymentController.
+	ManagedByLabel = "app.kubernetes.io/managed-by"
+	// DeploymentControllerName is the value of ManagedByLabel on the KServe
+	// InferenceServices created by the DeploymentController.
+	DeploymentControllerName = "model-registry-deployment-controller"
+	// StableModelVersionAnnotation is set on the KServe InferenceServices
+	// rolling out a canary, to the id of the ModelVersion served by the rest of
+	// the traffic.
+	StableModelVersionAnnotation = "modelregistry.kubeflow.org/stable-model-version-id"
+)
+
+// UndeployPolicy defines what happens to a KServe InferenceService when its
+// model registry InferenceService is UNDEPLOYED.
+type UndeployPolicy string
+
+const (
+	// UndeployPolicyDelete deletes the KServe InferenceService.
+	UndeployPolicyDelete UndeployPolicy = "delete"
+	// UndeployPolicyScaleToZero keeps the KServe InferenceService, stopping it
+	// with the serving.kserve.io/stop annotation.
+	UndeployPolicyScaleToZero UndeployPolicy = "scale-to-zero"
+)
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// DeploymentController deploys the model registry InferenceServices to
+// KServe: for every InferenceService with desired state DEPLOYED it creates,
+// and keeps up to date, a KServe InferenceService serving the model artifact
+// of its ModelVersion, in the namespace named after its ServingEnvironment.
+// The traffic split of an InferenceService is rolled out as a KServe canary.
+//
+// Model registries are polled, as they don't notify changes.
+type DeploymentController struct {
+	client                      client.Client
+	connections                 *connections
+	log                         logr.Logger
+	inferenceServiceIDLabel     string
+	registeredModelIDLabel      string
+	modelVersionIDLabel         string
+	modelRegistryNamespaceLabel string
+	modelRegistryNameLabel      string
+	serviceURLAnnotation        string
+	registriesNamespace         string
+	syncPeriod                  time.Duration
+	undeployPolicy              UndeployPolicy
+}
+
+func NewDeploymentController(
+	client client.Client,
+	log logr.Logger,
+	skipTLSVerify bool,
+	bearerToken,
+	isIDLabel,
+	regModelIDLabel,
+	modelVerIDLabel,
+	mrNamespaceLabel,
+	mrNameLabel,
+	serviceURLAnnotation,
+	registriesNamespace string,
+	syncPeriod time.Duration,
+	undeployPolicy UndeployPolicy,
+) *DeploymentController {
+	return &DeploymentController{
+		client:                      client,
+		connections:                 newConnections(client, skipTLSVerify, bearerToken),
+		log:                         log,
+		inferenceServiceIDLabel:     isIDLabel,
+		registeredModelIDLabel:      regModelIDLabel,
+		modelVersionIDLabel:         modelVerIDLabel,
+		modelRegistryNamespaceLabel: mrNamespaceLabel,
+		modelRegistryNameLabel:      mrNameLabel,
+		serviceURLAnnotation:        serviceURLAnnotation,
+		registriesNamespace:         registriesNamespace,
+		syncPeriod:                  syncPeriod,
+		undeployPolicy:              undeployPolicy,
+	}
+}
+
+func (r *DeploymentController) OverrideHTTPClient(client *http.Client) {
+	r.connections.overrideHTTPClient(client)
+}
+
+// SetupWithManager adds the controller to the Manager.
+func (r *DeploymentController) SetupWithManager(mgr ctrl.Manager) error {
+	return mgr.Add(r)
+}
+
+// NeedLeaderElection makes the controller run only on the leader, like the
+// reconcilers.
+func (r *DeploymentController) NeedLeaderElection() bool {
+	return true
+}
+
+// Start syncs the deployments every sync period, until ctx is done.
+func (r *DeploymentController) Start(ctx context.Context) error {
+	r.log.Info("Starting to sync model registry deployments", "period", r.syncPeriod)
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := r.Sync(ctx); err != nil {
+			r.log.Error(err, "Unable to sync model registry deployments")
+		}
+	}, r.syncPeriod)
+
+	return nil
+}
+
+// modelRegistry is a model registry found in the registries namespace.
+type modelRegistry struct {
+	name      string
+	namespace string
+	// host is the host of the registry in the model-registry:// URIs.
+	host string
+	api  *openapi.APIClient
+}
+
+// Sync makes the KServe InferenceServices match the deployments of every
+// model registry in the registries namespace. Failing to sync a single
+// deployment doesn't stop the others, it's retried on the next sync.
+func (r *DeploymentController) Sync(ctx context.Context) error {
+	svcList := &corev1.ServiceList{}
+
+	if err := r.client.List(ctx, svcList, client.InNamespace(r.registriesNamespace), client.MatchingLabels{"component": "model-registry"}); err != nil {
+		return fmt.Errorf("unable to list services in the namespace %s: %w", r.registriesNamespace, err)
+	}
+
+	mrApiCtx := context.Background()
+
+	// The namespaces of the ServingEnvironments of each registry, for the
+	// registries whose ServingEnvironments could be listed.
+	environments := map[types.NamespacedName]map[string]bool{}
+
+	for i := range svcList.Items {
+		svc := &svcList.Items[i]
+		log := r.log.WithValues("mr-namespace", svc.Namespace, "mr-name", svc.Name)
+
+		registry, err := newModelRegistry(ctx, r.connections, svc, r.serviceURLAnnotation)
+		if err != nil {
+			log.Error(err, "Unable to initialize Model Registry service")
+			continue
+		}
+
+		servingEnvironments, err := listServingEnvironments(mrApiCtx, registry.api)
+		if err != nil {
+			log.Error(err, "Unable to list the ServingEnvironments")
+			continue
+		}
+
+		namespaces := map[string]bool{}
+
+		for _, servingEnvironment := range servingEnvironments {
+			namespaces[servingEnvironment.Name] = true
+
+			log := log.WithValues("namespace", servingEnvironment.Name)
+
+			if err := r.syncServingEnvironment(ctx, mrApiCtx, log, registry, &servingEnvironment); err != nil {
+				log.Error(err, "Unable to sync the ServingEnvironment deployments")
+			}
+		}
+
+		environments[types.NamespacedName{Namespace: registry.namespace, Name: registry.name}] = namespaces
+	}
+
+	return r.deleteOrphans(ctx, environments)
+}
+
+// deleteOrphans deletes the KServe InferenceServices managed by the registries
+// in environments whose ServingEnvironment doesn't exist anymore, which
+// syncServingEnvironment never visits. The KServe InferenceServices of the
+// registries that couldn't be reached are kept until the next sync.
+func (r *DeploymentController) deleteOrphans(ctx context.Context, environments map[types.NamespacedName]map[string]bool) error {
+	isvcList := &kservev1beta1.InferenceServiceList{}
+	if err := r.client.List(ctx, isvcList, client.MatchingLabels{ManagedByLabel: DeploymentControllerName}); err != nil {
+		return fmt.Errorf("unable to list the KServe InferenceServices: %w", err)
+	}
+
+	for i := range isvcList.Items {
+		isvc := &isvcList.Items[i]
+
+		registry := types.NamespacedName{
+			Namespace: isvc.Labels[r.modelRegistryNamespaceLabel],
+			Name:      isvc.Labels[r.modelRegistryNameLabel],
+		}
+
+		namespaces, ok := environments[registry]
+		if !ok || namespaces[isvc.Namespace] {
+			continue
+		}
+
+		log := r.log.WithValues("mr-namespace", registry.Namespace, "mr-name", registry.Name, "namespace", isvc.Namespace)
+		log.Info("Deleting KServe InferenceService, its ServingEnvironment doesn't exist anymore", "name", isvc.Name)
+
+		if err := r.client.Delete(ctx, isvc); IgnoreDeletingErrors(err) != nil {
+			log.Error(err, "Unable to delete the KServe InferenceService", "name", isvc.Name)
+		}
+	}
+
+	return nil
+}
+
+// newModelRegistry connects to the model registry of the Service svc.
+func newModelRegistry(ctx context.Context, connections *connections, svc *corev1.Service, serviceURLAnnotation string) (*modelRegistry, error) {
+	mrUrl, err := buildURLFromService(svc, serviceURLAnnotation)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := url.Parse(mrUrl)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Model Registry url %s: %w", mrUrl, err)
+	}
+
+	api, err := connections.get(ctx, newConnectionProfile(mrUrl, svc.Namespace, svc.Annotations[ConnectionProfileAnnotation]))
+	if err != nil {
+		return nil, err
+	}
+
+	return &modelRegistry{
+		name:      svc.Name,
+		namespace: svc.Namespace,
+		host:      parsed.Host,
+		api:       api,
+	}, nil
+}
+
+// syncServingEnvironment syncs the KServe InferenceServices of the namespace
+// named after a ServingEnvironment.
+func (r *DeploymentController) syncServingEnvironment(
+	ctx context.Context,
+	mrApiCtx context.Context,
+	log logr.Logger,
+	registry *modelRegistry,
+	servingEnvironment *openapi.ServingEnvironment,
+) error {
+	namespace := servingEnvironment.Name
+
+	inferenceServices, err := listInferenceServices(mrApiCtx, registry.api, servingEnvironment.GetId())
+	if err != nil {
+		return fmt.Errorf("unable to list the InferenceServices: %w", err)
+	}
+
+	isvcList := &kservev1beta1.InferenceServiceList{}
+	if err := r.client.List(ctx, isvcList, client.InNamespace(namespace)); err != nil {
+		return fmt.Errorf("unable to list the KServe InferenceServices in the namespace %s: %w", namespace, err)
+	}
+
+	managed := map[string]*kservev1beta1.InferenceService{}
+	for i := range isvcList.Items {
+		isvc := &isvcList.Items[i]
+		if r.isManagedBy(isvc, registry) {
+			managed[isvc.Labels[r.inferenceServiceIDLabel]] = isvc
+		}
+	}
+
+	found := map[string]bool{}
+
+	for _, is := range inferenceServices {
+		found[is.GetId()] = true
+
+		if r.isMirrored(&is, isvcList.Items, registry) {
+			// The KServe InferenceService was created by users, the registry
+			// InferenceService mirrors it.
+			continue
+		}
+
+		log := log.WithValues("InferenceService", is.GetName(), "id", is.GetId())
+
+		if err := r.syncInferenceService(ctx, mrApiCtx, log, registry, namespace, &is, managed[is.GetId()]); err != nil {
+			log.Error(err, "Unable to sync the KServe InferenceService")
+		}
+	}
+
+	for id, isvc := range managed {
+		if found[id] {
+			continue
+		}
+
+		log.Info("Deleting KServe InferenceService, the model registry InferenceService doesn't exist anymore", "name", isvc.Name, "id", id)
+
+		if err := r.client.Delete(ctx, isvc); IgnoreDeletingErrors(err) != nil {
+			log.Error(err, "Unable to delete the KServe InferenceService", "name", isvc.Name)
+		}
+	}
+
+	return nil
+}
+
+func (r *DeploymentController) syncInferenceService(
+	ctx context.Context,
+	mrApiCtx context.Context,
+	log logr.Logger,
+	registry *modelRegistry,
+	namespace string,
+	is *openapi.InferenceService,
+	existing *kservev1beta1.InferenceService,
+) error {
+	if is.GetDesiredState() != openapi.INFERENCESERVICESTATE_DEPLOYED {
+		if existing == nil {
+			return nil
+		}
+
+		return r.undeploy(ctx, log, existing)
+	}
+
+	desired, err := r.buildInferenceService(mrApiCtx, registry, namespace, is, existing)
+	if err != nil {
+		return err
+	}
+
+	if existing == nil {
+		log.Info("Creating KServe InferenceService", "name", desired.Name)
+
+		return r.client.Create(ctx, desired)
+	}
+
+	updated := existing.DeepCopy()
+
+	if updated.Labels == nil {
+		updated.Labels = map[string]string{}
+	}
+
+	maps.Copy(updated.Labels, desired.Labels)
+	delete(updated.Annotations, constants.StopAnnotationKey)
+
+	if stable, ok := desired.Annotations[StableModelVersionAnnotation]; ok {
+		if updated.Annotations == nil {
+			updated.Annotations = map[string]string{}
+		}
+
+		updated.Annotations[StableModelVersionAnnotation] = stable
+	} else {
+		delete(updated.Annotations, StableModelVersionAnnotation)
+	}
+
+	updated.Spec.Predictor.Model = desired.Spec.Predictor.Model
+	updated.Spec.Predictor.ServiceAccountName = desired.Spec.Predictor.ServiceAccountName
+	updated.Spec.Predictor.CanaryTrafficPercent = desired.Spec.Predictor.CanaryTrafficPercent
+
+	if equality.Semantic.DeepEqual(existing, updated) {
+		return nil
+	}
+
+	log.Info("Updating KServe InferenceService", "name", existing.Name)
+
+	return r.client.Update(ctx, updated)
+}
+
+func (r *DeploymentController) undeploy(ctx context.Context, log logr.Logger, isvc *kservev1beta1.InferenceService) error {
+	if r.undeployPolicy == UndeployPolicyScaleToZero {
+		if isvc.Annotations[constants.StopAnnotationKey] == "true" {
+			return nil
+		}
+
+		log.Info("Stopping KServe InferenceService", "name", isvc.Name)
+
+		stopped := isvc.DeepCopy()
+
+		if stopped.Annotations == nil {
+			stopped.Annotations = map[string]string{}
+		}
+
+		stopped.Annotations[constants.StopAnnotationKey] = "true"
+
+		return r.client.Update(ctx, stopped)
+	}
+
+	log.Info("Deleting KServe InferenceService", "name", isvc.Name)
+
+	return IgnoreDeletingErrors(r.client.Delete(ctx, isvc))
+}
+
+// buildInferenceService returns the KServe InferenceService serving the
+// latest model artifact of the ModelVersion of is, or of the latest version
+// of its RegisteredModel if it has none. With a traffic split, it serves the
+// next step of its rollout from existing instead.
+func (r *DeploymentController) buildInferenceService(
+	ctx context.Context,
+	registry *modelRegistry,
+	namespace string,
+	is *openapi.InferenceService,
+	existing *kservev1beta1.InferenceService,
+) (*kservev1beta1.InferenceService, error) {
+	mr := registry.api
+
+	registeredModel, _, err := mr.ModelRegistryServiceAPI.GetRegisteredModel(ctx, is.RegisteredModelId).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("unable to find RegisteredModel with id %s in model registry: %w", is.RegisteredModelId, err)
+	}
+
+	modelVersionId := is.ModelVersionId
+
+	var step rollout
+
+	if len(is.TrafficSplit) > 0 {
+		step, err = r.planRollout(is, existing)
+		if err != nil {
+			return nil, err
+		}
+
+		modelVersionId = &step.modelVersionId
+	}
+
+	var modelVersion *openapi.ModelVersion
+
+	if modelVersionId != nil {
+		modelVersion, _, err = mr.ModelRegistryServiceAPI.GetModelVersion(ctx, *modelVersionId).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("unable to find ModelVersion with id %s in model registry: %w", *modelVersionId, err)
+		}
+	} else {
+		versions, _, err := mr.ModelRegistryServiceAPI.GetRegisteredModelVersions(ctx, is.RegisteredModelId).
+			OrderBy(openapi.ORDERBYFIELD_CREATE_TIME).
+			SortOrder(openapi.SORTORDER_DESC).
+			PageSize("1").
+			Execute()
+		if err != nil {
+			return nil, fmt.Errorf("unable to list the versions of RegisteredModel %s: %w", is.RegisteredModelId, err)
+		}
+
+		if len(versions.Items) == 0 {
+			return nil, fmt.Errorf("no versions associated to RegisteredModel %s", is.RegisteredModelId)
+		}
+
+		modelVersion = &versions.Items[0]
+	}
+
+	artifacts, _, err := mr.ModelRegistryServiceAPI.GetModelVersionArtifacts(ctx, modelVersion.GetId()).
+		ArtifactType(openapi.ARTIFACTTYPEQUERYPARAM_MODEL_ARTIFACT).
+		OrderBy(openapi.ORDERBYFIELD_CREATE_TIME).
+		SortOrder(openapi.SORTORDER_DESC).
+		PageSize("1").
+		Execute()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list the artifacts of ModelVersion %s: %w", modelVersion.GetId(), err)
+	}
+
+	if len(artifacts.Items) == 0 || artifacts.Items[0].ModelArtifact == nil {
+		return nil, fmt.Errorf("no model artifact associated to ModelVersion %s", modelVersion.GetId())
+	}
+
+	artifact := artifacts.Items[0].ModelArtifact
+
+	if artifact.GetModelFormatName() == "" {
+		return nil, fmt.Errorf("model artifact %s has no model format name", artifact.GetId())
+	}
+
+	model := &kservev1beta1.ModelSpec{
+		ModelFormat: kservev1beta1.ModelFormat{
+			Name:    artifact.GetModelFormatName(),
+			Version: artifact.ModelFormatVersion,
+		},
+		Runtime: is.Runtime,
+	}
+
+	switch {
+	case artifact.GetStorageKey() != "":
+		model.Storage = &kservev1beta1.ModelStorageSpec{
+			StorageSpec: kservev1beta1.StorageSpec{
+				StorageKey: artifact.StorageKey,
+				Path:       artifact.StoragePath,
+			},
+		}
+	case artifact.GetUri() != "":
+		// Let the model registry storage initializer resolve the artifact.
+		storageUri := fmt.Sprintf("model-registry://%s/%s/%s", registry.host, url.PathEscape(registeredModel.Name), url.PathEscape(modelVersion.Name))
+		model.StorageURI = &storageUri
+	default:
+		return nil, fmt.Errorf("model artifact %s has neither a storage key nor a URI", artifact.GetId())
+	}
+
+	isvc := &kservev1beta1.InferenceService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      inferenceServiceName(registry, is),
+			Namespace: namespace,
+			Labels: map[string]string{
+				ManagedByLabel:                DeploymentControllerName,
+				r.inferenceServiceIDLabel:     is.GetId(),
+				r.registeredModelIDLabel:      is.RegisteredModelId,
+				r.modelVersionIDLabel:         modelVersion.GetId(),
+				r.modelRegistryNameLabel:      registry.name,
+				r.modelRegistryNamespaceLabel: registry.namespace,
+			},
+		},
+		Spec: kservev1beta1.InferenceServiceSpec{
+			Predictor: kservev1beta1.PredictorSpec{
+				Model: model,
+				PodSpec: kservev1beta1.PodSpec{
+					ServiceAccountName: artifact.GetServiceAccountName(),
+				},
+				ComponentExtensionSpec: kservev1beta1.ComponentExtensionSpec{
+					CanaryTrafficPercent: step.canaryTrafficPercent,
+				},
+			},
+		},
+	}
+
+	if step.stableModelVersionId != "" {
+		isvc.Annotations = map[string]string{
+			StableModelVersionAnnotation: step.stableModelVersionId,
+		}
+	}
+
+	return isvc, nil
+}
+
+// isManagedBy reports whether isvc was created by the controller for registry.
+func (r *DeploymentController) isManagedBy(isvc *kservev1beta1.InferenceService, registry *modelRegistry) bool {
+	return isvc.Labels[ManagedByLabel] == DeploymentControllerName &&
+		isvc.Labels[r.modelRegistryNameLabel] == registry.name &&
+		isvc.Labels[r.modelRegistryNamespaceLabel] == registry.namespace
+}
+
+// isMirrored reports whether is mirrors a KServe InferenceService not created
+// by the controller, as done by the InferenceServiceController.
+func (r *DeploymentController) isMirrored(is *openapi.InferenceService, isvcs []kservev1beta1.InferenceService, registry *modelRegistry) bool {
+	for i := range isvcs {
+		isvc := &isvcs[i]
+
+		if isvc.Labels[ManagedByLabel] == DeploymentControllerName {
+			continue
+		}
+
+		// The name is set when the InferenceServiceController creates it,
+		// before the id label is.
+		if is.GetName() == fmt.Sprintf("%s/%s", isvc.Name, isvc.UID) {
+			return true
+		}
+
+		mrName, ok := isvc.Labels[r.modelRegistryNameLabel]
+		if isvc.Labels[r.inferenceServiceIDLabel] == is.GetId() && (!ok || mrName == registry.name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// inferenceServiceName returns the name of the KServe InferenceService of is
+// in registry, derived from its name if that makes a valid one. It ends with
+// a short hash of the registry and the id of is, so that the InferenceServices
+// of other registries, or whose names only differ by invalid characters,
+// don't collide.
+func inferenceServiceName(registry *modelRegistry, is *openapi.InferenceService) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%s", registry.namespace, registry.name, is.GetId())))
+	suffix := hex.EncodeToString(sum[:])[:8]
+
+	name := invalidNameChars.ReplaceAllString(strings.ToLower(is.GetName()), "-")
+	name = strings.Trim(name, "-")
+
+	if maxLen := validation.DNS1035LabelMaxLength - len(suffix) - 1; len(name) > maxLen {
+		name = strings.TrimRight(name[:maxLen], "-")
+	}
+
+	name = fmt.Sprintf("%s-%s", name, suffix)
+
+	if len(validation.IsDNS1035Label(name)) > 0 {
+		return fmt.Sprintf("isvc-%s-%s", is.GetId(), suffix)
+	}
+
+	return name
+}
+
+func listServingEnvironments(ctx context.Context, mr *openapi.APIClient) ([]openapi.ServingEnvironment, error) {
+	var result []openapi.ServingEnvironment
+
+	req := mr.ModelRegistryServiceAPI.GetServingEnvironments(ctx)
+	for {
+		list, _, err := req.Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, list.Items...)
+
+		if list.NextPageToken == "" || len(list.Items) == 0 {
+			return result, nil
+		}
+
+		req = req.NextPageToken(list.NextPageToken)
+	}
+}
+
+func listInferenceServices(ctx context.Context, mr *openapi.APIClient, servingEnvironmentId string) ([]openapi.InferenceService, error) {
+	var result []openapi.InferenceService
+
+	req := mr.ModelRegistryServiceAPI.GetEnvironmentInferenceServices(ctx, servingEnvironmentId)
+	for {
+		list, _, err := req.Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, list.Items...)
+
+		if list.NextPageToken == "" || len(list.Items) == 0 {
+			return result, nil
+		}
+
+		req = req.NextPageToken(list.NextPageToken)
+	}
+}
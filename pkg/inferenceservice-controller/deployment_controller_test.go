@@ -0,0 +1,679 @@
+package inferenceservicecontroller_test
+
+import (
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	kservev1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	inferenceservicecontroller "github.com/kubeflow/hub/pkg/inferenceservice-controller"
+	"github.com/kubeflow/hub/pkg/openapi"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+var _ = Describe("Deployment Controller", func() {
+	const (
+		ModelRegistrySVCPath = "./testdata/deploy/model-registry-svc.yaml"
+		registriesNamespace  = "deploy-registries"
+		namespace            = "deploy"
+		servingEnvironmentId = "100"
+		registeredModelId    = "10"
+		modelVersionId       = "11"
+		storageUri           = "model-registry://model-registry.deploy-registries.svc.cluster.local:8080/iris/v1"
+	)
+
+	newDeploymentController := func(undeployPolicy inferenceservicecontroller.UndeployPolicy) *inferenceservicecontroller.DeploymentController {
+		deploymentController := inferenceservicecontroller.NewDeploymentController(
+			cli,
+			ctrl.Log.WithName("controllers").WithName("ModelRegistry-Deployment-Controller"),
+			skipTLSVerify,
+			accessToken,
+			inferenceServiceIDLabel,
+			registeredModelIDLabel,
+			modelVersionIDLabel,
+			namespaceLabel,
+			nameLabel,
+			serviceURLAnnotation,
+			registriesNamespace,
+			time.Second,
+			undeployPolicy,
+		)
+
+		deploymentController.OverrideHTTPClient(mrMockServer.Client())
+
+		return deploymentController
+	}
+
+	newInferenceService := func(id, name, modelVersionId string) openapi.InferenceService {
+		return openapi.InferenceService{
+			Id:                   &id,
+			Name:                 &name,
+			DesiredState:         openapi.INFERENCESERVICESTATE_DEPLOYED.Ptr(),
+			ModelVersionId:       &modelVersionId,
+			RegisteredModelId:    registeredModelId,
+			Runtime:              openapi.PtrString("kserve-sklearnserver"),
+			ServingEnvironmentId: servingEnvironmentId,
+		}
+	}
+
+	// deployedInferenceService returns the KServe InferenceService deployed
+	// for the model registry InferenceService id.
+	deployedInferenceService := func(id string) (*kservev1beta1.InferenceService, error) {
+		isvcList := &kservev1beta1.InferenceServiceList{}
+		if err := cli.List(ctx, isvcList, client.InNamespace(namespace), client.MatchingLabels{
+			inferenceservicecontroller.ManagedByLabel: inferenceservicecontroller.DeploymentControllerName,
+			inferenceServiceIDLabel:                   id,
+		}); err != nil {
+			return nil, err
+		}
+
+		if len(isvcList.Items) == 0 {
+			return nil, errors.NewNotFound(kservev1beta1.Resource("inferenceservices"), id)
+		}
+
+		return &isvcList.Items[0], nil
+	}
+
+	// syncedInferenceService syncs the deployments, then returns the KServe
+	// InferenceService deployed for the model registry InferenceService id.
+	syncedInferenceService := func(deploymentController *inferenceservicecontroller.DeploymentController, id string) (*kservev1beta1.InferenceService, error) {
+		if err := deploymentController.Sync(ctx); err != nil {
+			return nil, err
+		}
+
+		return deployedInferenceService(id)
+	}
+
+	BeforeEach(func() {
+		for _, name := range []string{registriesNamespace, namespace} {
+			ns := &corev1.Namespace{}
+
+			ns.SetName(name)
+
+			if err := cli.Create(ctx, ns); err != nil && !errors.IsAlreadyExists(err) {
+				Fail(err.Error())
+			}
+		}
+
+		mrSvc := &corev1.Service{}
+		Expect(ConvertFileToStructuredResource(ModelRegistrySVCPath, mrSvc)).To(Succeed())
+
+		mrSvc.SetNamespace(registriesNamespace)
+
+		if err := cli.Create(ctx, mrSvc); err != nil && !errors.IsAlreadyExists(err) {
+			Fail(err.Error())
+		}
+
+		mrMockRegistry.SetServingEnvironment(openapi.ServingEnvironment{
+			Id:   openapi.PtrString(servingEnvironmentId),
+			Name: namespace,
+		})
+
+		mrMockRegistry.SetRegisteredModel(openapi.RegisteredModel{
+			Id:   openapi.PtrString(registeredModelId),
+			Name: "iris",
+		})
+
+		artifact := openapi.NewModelArtifact()
+		artifact.Id = openapi.PtrString("12")
+		artifact.ModelFormatName = openapi.PtrString("sklearn")
+		artifact.ModelFormatVersion = openapi.PtrString("1")
+		artifact.Uri = openapi.PtrString("s3://models/iris")
+
+		mrMockRegistry.SetModelVersion(openapi.ModelVersion{
+			Id:                openapi.PtrString(modelVersionId),
+			Name:              "v1",
+			RegisteredModelId: registeredModelId,
+		}, *artifact)
+	})
+
+	When("A model registry InferenceService is DEPLOYED", func() {
+		It("Should create a KServe InferenceService serving the model version", func() {
+			deploymentController := newDeploymentController(inferenceservicecontroller.UndeployPolicyDelete)
+
+			mrMockRegistry.SetInferenceService(newInferenceService("101", "iris-prod", modelVersionId))
+
+			var isvc *kservev1beta1.InferenceService
+
+			Eventually(func() (err error) {
+				isvc, err = syncedInferenceService(deploymentController, "101")
+				return err
+			}, 10*time.Second, 1*time.Second).Should(Succeed())
+
+			Expect(isvc.Name).To(MatchRegexp(`^iris-prod-[0-9a-f]{8}$`))
+			Expect(isvc.Labels[inferenceServiceIDLabel]).To(Equal("101"))
+			Expect(isvc.Labels[registeredModelIDLabel]).To(Equal(registeredModelId))
+			Expect(isvc.Labels[modelVersionIDLabel]).To(Equal(modelVersionId))
+			Expect(isvc.Labels[nameLabel]).To(Equal("model-registry"))
+			Expect(isvc.Labels[namespaceLabel]).To(Equal(registriesNamespace))
+
+			model := isvc.Spec.Predictor.Model
+			Expect(model).ToNot(BeNil())
+			Expect(model.ModelFormat.Name).To(Equal("sklearn"))
+			Expect(model.ModelFormat.Version).To(Equal(openapi.PtrString("1")))
+			Expect(model.Runtime).To(Equal(openapi.PtrString("kserve-sklearnserver")))
+			Expect(model.StorageURI).To(Equal(openapi.PtrString(storageUri)))
+			Expect(model.Storage).To(BeNil())
+		})
+
+		It("Should use the storage key and path of the model artifact if set", func() {
+			deploymentController := newDeploymentController(inferenceservicecontroller.UndeployPolicyDelete)
+
+			artifact := openapi.NewModelArtifact()
+			artifact.Id = openapi.PtrString("22")
+			artifact.ModelFormatName = openapi.PtrString("onnx")
+			artifact.StorageKey = openapi.PtrString("testkey")
+			artifact.StoragePath = openapi.PtrString("/testpath/test")
+
+			mrMockRegistry.SetModelVersion(openapi.ModelVersion{
+				Id:                openapi.PtrString("21"),
+				Name:              "v2",
+				RegisteredModelId: registeredModelId,
+			}, *artifact)
+
+			mrMockRegistry.SetInferenceService(newInferenceService("201", "Iris Storage Key", "21"))
+
+			var isvc *kservev1beta1.InferenceService
+
+			Eventually(func() (err error) {
+				isvc, err = syncedInferenceService(deploymentController, "201")
+				return err
+			}, 10*time.Second, 1*time.Second).Should(Succeed())
+
+			model := isvc.Spec.Predictor.Model
+			Expect(model).ToNot(BeNil())
+			Expect(model.ModelFormat.Name).To(Equal("onnx"))
+			Expect(model.StorageURI).To(BeNil())
+			Expect(model.Storage).ToNot(BeNil())
+			Expect(model.Storage.StorageKey).To(Equal(openapi.PtrString("testkey")))
+			Expect(model.Storage.Path).To(Equal(openapi.PtrString("/testpath/test")))
+		})
+
+		It("Should deploy the InferenceServices whose names collide, escaping the names in their URIs", func() {
+			deploymentController := newDeploymentController(inferenceservicecontroller.UndeployPolicyDelete)
+
+			mrMockRegistry.SetRegisteredModel(openapi.RegisteredModel{
+				Id:   openapi.PtrString("40"),
+				Name: "Iris Classifier",
+			})
+
+			artifact := openapi.NewModelArtifact()
+			artifact.Id = openapi.PtrString("42")
+			artifact.ModelFormatName = openapi.PtrString("sklearn")
+			artifact.Uri = openapi.PtrString("s3://models/iris-classifier")
+
+			mrMockRegistry.SetModelVersion(openapi.ModelVersion{
+				Id:                openapi.PtrString("41"),
+				Name:              "v1/rc 1",
+				RegisteredModelId: "40",
+			}, *artifact)
+
+			names := map[string]bool{}
+
+			for id, name := range map[string]string{"801": "Iris Collide", "802": "iris-collide"} {
+				is := newInferenceService(id, name, "41")
+				is.RegisteredModelId = "40"
+				mrMockRegistry.SetInferenceService(is)
+			}
+
+			for _, id := range []string{"801", "802"} {
+				var isvc *kservev1beta1.InferenceService
+
+				Eventually(func() (err error) {
+					isvc, err = syncedInferenceService(deploymentController, id)
+					return err
+				}, 10*time.Second, 1*time.Second).Should(Succeed())
+
+				Expect(isvc.Name).To(HavePrefix("iris-collide-"))
+				Expect(isvc.Spec.Predictor.Model.StorageURI).To(Equal(openapi.PtrString("model-registry://model-registry.deploy-registries.svc.cluster.local:8080/Iris%20Classifier/v1%2Frc%201")))
+
+				names[isvc.Name] = true
+			}
+
+			Expect(names).To(HaveLen(2))
+		})
+
+		It("Should not deploy a model registry InferenceService mirroring a KServe InferenceService", func() {
+			deploymentController := newDeploymentController(inferenceservicecontroller.UndeployPolicyDelete)
+
+			mrMockRegistry.SetInferenceService(newInferenceService("501", "user-isvc", modelVersionId))
+
+			userIsvc := &kservev1beta1.InferenceService{}
+			userIsvc.SetName("iris-user")
+			userIsvc.SetNamespace(namespace)
+			userIsvc.SetLabels(map[string]string{
+				inferenceServiceIDLabel: "501",
+				registeredModelIDLabel:  registeredModelId,
+				nameLabel:               "model-registry",
+				namespaceLabel:          registriesNamespace,
+			})
+			userIsvc.Spec.Predictor.Model = &kservev1beta1.ModelSpec{
+				ModelFormat: kservev1beta1.ModelFormat{Name: "sklearn"},
+				PredictorExtensionSpec: kservev1beta1.PredictorExtensionSpec{
+					StorageURI: openapi.PtrString(storageUri),
+				},
+			}
+
+			if err := cli.Create(ctx, userIsvc); err != nil && !errors.IsAlreadyExists(err) {
+				Fail(err.Error())
+			}
+
+			Consistently(func() error {
+				if err := deploymentController.Sync(ctx); err != nil {
+					return err
+				}
+
+				isvcList := &kservev1beta1.InferenceServiceList{}
+				if err := cli.List(ctx, isvcList, client.InNamespace(namespace), client.MatchingLabels{
+					inferenceservicecontroller.ManagedByLabel: inferenceservicecontroller.DeploymentControllerName,
+					inferenceServiceIDLabel:                   "501",
+				}); err != nil {
+					return err
+				}
+
+				if len(isvcList.Items) > 0 {
+					return fmt.Errorf("unexpected KServe InferenceService %s created for a mirrored model registry InferenceService", isvcList.Items[0].Name)
+				}
+
+				return nil
+			}, 5*time.Second, 1*time.Second).Should(Succeed())
+		})
+	})
+
+	When("A model registry InferenceService splits its traffic", func() {
+		It("Should roll out the traffic split as a canary, then promote or roll it back", func() {
+			deploymentController := newDeploymentController(inferenceservicecontroller.UndeployPolicyDelete)
+
+			artifact := openapi.NewModelArtifact()
+			artifact.Id = openapi.PtrString("32")
+			artifact.ModelFormatName = openapi.PtrString("sklearn")
+			artifact.Uri = openapi.PtrString("s3://models/iris-v3")
+
+			mrMockRegistry.SetModelVersion(openapi.ModelVersion{
+				Id:                openapi.PtrString("31"),
+				Name:              "v3",
+				RegisteredModelId: registeredModelId,
+			}, *artifact)
+
+			// expectRollout syncs the deployments until the KServe
+			// InferenceService serves modelVersionId with canaryTrafficPercent,
+			// and stableModelVersionId as the stable ModelVersion.
+			expectRollout := func(modelVersionId string, canaryTrafficPercent *int64, stableModelVersionId string) *kservev1beta1.InferenceService {
+				var isvc *kservev1beta1.InferenceService
+
+				Eventually(func() (err error) {
+					isvc, err = syncedInferenceService(deploymentController, "601")
+					if err != nil {
+						return err
+					}
+
+					if isvc.Labels[modelVersionIDLabel] != modelVersionId {
+						return fmt.Errorf("KServe InferenceService serves ModelVersion %s, expected %s", isvc.Labels[modelVersionIDLabel], modelVersionId)
+					}
+
+					if !ptr.Equal(isvc.Spec.Predictor.CanaryTrafficPercent, canaryTrafficPercent) {
+						return fmt.Errorf("unexpected canaryTrafficPercent %v", ptr.Deref(isvc.Spec.Predictor.CanaryTrafficPercent, -1))
+					}
+
+					if stable := isvc.Annotations[inferenceservicecontroller.StableModelVersionAnnotation]; stable != stableModelVersionId {
+						return fmt.Errorf("KServe InferenceService has stable ModelVersion %q, expected %q", stable, stableModelVersionId)
+					}
+
+					return nil
+				}, 10*time.Second, 1*time.Second).Should(Succeed())
+
+				return isvc
+			}
+
+			is := newInferenceService("601", "iris-canary", modelVersionId)
+			is.TrafficSplit = []openapi.InferenceServiceTrafficSplit{
+				{ModelVersionId: modelVersionId, Percent: 90},
+				{ModelVersionId: "31", Percent: 10},
+			}
+			mrMockRegistry.SetInferenceService(is)
+
+			By("serving the stable ModelVersion until it's ready")
+			expectRollout(modelVersionId, nil, "")
+
+			Eventually(func() error {
+				isvc, err := deployedInferenceService("601")
+				if err != nil {
+					return err
+				}
+
+				isvc.Status.Conditions = duckv1.Conditions{
+					{Type: apis.ConditionReady, Status: corev1.ConditionTrue},
+				}
+
+				return cli.Status().Update(ctx, isvc)
+			}, 10*time.Second, 1*time.Second).Should(Succeed())
+
+			By("deploying the other ModelVersion as a canary")
+			expectRollout("31", ptr.To[int64](10), modelVersionId)
+
+			is.TrafficSplit = []openapi.InferenceServiceTrafficSplit{
+				{ModelVersionId: modelVersionId, Percent: 50},
+				{ModelVersionId: "31", Percent: 50},
+			}
+			mrMockRegistry.SetInferenceService(is)
+
+			By("increasing the traffic of the canary")
+			expectRollout("31", ptr.To[int64](50), modelVersionId)
+
+			is.TrafficSplit = []openapi.InferenceServiceTrafficSplit{
+				{ModelVersionId: modelVersionId, Percent: 100},
+			}
+			mrMockRegistry.SetInferenceService(is)
+
+			By("rolling back the canary")
+			expectRollout("31", ptr.To[int64](0), modelVersionId)
+
+			is.TrafficSplit = []openapi.InferenceServiceTrafficSplit{
+				{ModelVersionId: "31", Percent: 100},
+			}
+			mrMockRegistry.SetInferenceService(is)
+
+			By("promoting the canary")
+			isvc := expectRollout("31", nil, "")
+			Expect(isvc.Spec.Predictor.Model.StorageURI).To(Equal(openapi.PtrString("model-registry://model-registry.deploy-registries.svc.cluster.local:8080/iris/v3")))
+		})
+
+		It("Should not deploy a traffic split between more than two model versions", func() {
+			deploymentController := newDeploymentController(inferenceservicecontroller.UndeployPolicyDelete)
+
+			is := newInferenceService("701", "iris-split", modelVersionId)
+			is.TrafficSplit = []openapi.InferenceServiceTrafficSplit{
+				{ModelVersionId: modelVersionId, Percent: 50},
+				{ModelVersionId: "21", Percent: 25},
+				{ModelVersionId: "31", Percent: 25},
+			}
+			mrMockRegistry.SetInferenceService(is)
+
+			Consistently(func() error {
+				_, err := syncedInferenceService(deploymentController, "701")
+				if err == nil {
+					return fmt.Errorf("unexpected KServe InferenceService deployed")
+				}
+
+				if !errors.IsNotFound(err) {
+					return err
+				}
+
+				return nil
+			}, 3*time.Second, 1*time.Second).Should(Succeed())
+		})
+	})
+
+	When("A model registry Service has a connection profile", func() {
+		It("Should connect with the credentials of its Secret, and refresh them when it changes", func() {
+			deploymentController := newDeploymentController(inferenceservicecontroller.UndeployPolicyDelete)
+
+			secret := &corev1.Secret{}
+			secret.SetName("model-registry-connection")
+			secret.SetNamespace(registriesNamespace)
+			secret.Data = map[string][]byte{
+				inferenceservicecontroller.ConnectionProfileTokenKey: []byte("first-token"),
+			}
+			Expect(cli.Create(ctx, secret)).To(Succeed())
+
+			setConnectionProfile := func(profile string) {
+				Eventually(func() error {
+					mrSvc := &corev1.Service{}
+					if err := cli.Get(ctx, types.NamespacedName{Name: "model-registry", Namespace: registriesNamespace}, mrSvc); err != nil {
+						return err
+					}
+
+					if profile == "" {
+						delete(mrSvc.Annotations, inferenceservicecontroller.ConnectionProfileAnnotation)
+					} else {
+						mrSvc.SetAnnotations(map[string]string{inferenceservicecontroller.ConnectionProfileAnnotation: profile})
+					}
+
+					return cli.Update(ctx, mrSvc)
+				}, 10*time.Second, 1*time.Second).Should(Succeed())
+			}
+
+			expectAuthorization := func(authorization string) {
+				Eventually(func() error {
+					if err := deploymentController.Sync(ctx); err != nil {
+						return err
+					}
+
+					if got := mrMockRegistry.Authorization(); got != authorization {
+						return fmt.Errorf("model registry called with Authorization %q, expected %q", got, authorization)
+					}
+
+					return nil
+				}, 10*time.Second, 1*time.Second).Should(Succeed())
+			}
+
+			setConnectionProfile(secret.Name)
+			expectAuthorization("Bearer first-token")
+
+			By("rotating the token of the Secret")
+			Eventually(func() error {
+				if err := cli.Get(ctx, types.NamespacedName{Name: secret.Name, Namespace: registriesNamespace}, secret); err != nil {
+					return err
+				}
+
+				secret.Data[inferenceservicecontroller.ConnectionProfileTokenKey] = []byte("second-token")
+
+				return cli.Update(ctx, secret)
+			}, 10*time.Second, 1*time.Second).Should(Succeed())
+
+			expectAuthorization("Bearer second-token")
+
+			By("removing the connection profile")
+			setConnectionProfile("")
+			expectAuthorization("")
+
+			Expect(cli.Delete(ctx, secret)).To(Succeed())
+		})
+
+		It("Should keep the default token of the controller when the Secret only has a CA", func() {
+			deploymentController := inferenceservicecontroller.NewDeploymentController(
+				cli,
+				ctrl.Log.WithName("controllers").WithName("ModelRegistry-Deployment-Controller"),
+				skipTLSVerify,
+				"default-token",
+				inferenceServiceIDLabel,
+				registeredModelIDLabel,
+				modelVersionIDLabel,
+				namespaceLabel,
+				nameLabel,
+				serviceURLAnnotation,
+				registriesNamespace,
+				time.Second,
+				inferenceservicecontroller.UndeployPolicyDelete,
+			)
+			deploymentController.OverrideHTTPClient(mrMockServer.Client())
+
+			secret := &corev1.Secret{}
+			secret.SetName("model-registry-ca-only")
+			secret.SetNamespace(registriesNamespace)
+			secret.Data = map[string][]byte{
+				inferenceservicecontroller.ConnectionProfileCAKey: pem.EncodeToMemory(&pem.Block{
+					Type:  "CERTIFICATE",
+					Bytes: mrMockServer.Certificate().Raw,
+				}),
+			}
+			Expect(cli.Create(ctx, secret)).To(Succeed())
+			defer func() {
+				Expect(cli.Delete(ctx, secret)).To(Succeed())
+			}()
+
+			Eventually(func() error {
+				mrSvc := &corev1.Service{}
+				if err := cli.Get(ctx, types.NamespacedName{Name: "model-registry", Namespace: registriesNamespace}, mrSvc); err != nil {
+					return err
+				}
+
+				mrSvc.SetAnnotations(map[string]string{inferenceservicecontroller.ConnectionProfileAnnotation: secret.Name})
+
+				return cli.Update(ctx, mrSvc)
+			}, 10*time.Second, 1*time.Second).Should(Succeed())
+			defer func() {
+				Eventually(func() error {
+					mrSvc := &corev1.Service{}
+					if err := cli.Get(ctx, types.NamespacedName{Name: "model-registry", Namespace: registriesNamespace}, mrSvc); err != nil {
+						return err
+					}
+
+					delete(mrSvc.Annotations, inferenceservicecontroller.ConnectionProfileAnnotation)
+
+					return cli.Update(ctx, mrSvc)
+				}, 10*time.Second, 1*time.Second).Should(Succeed())
+			}()
+
+			Eventually(func() error {
+				if err := deploymentController.Sync(ctx); err != nil {
+					return err
+				}
+
+				if got := mrMockRegistry.Authorization(); got != "Bearer default-token" {
+					return fmt.Errorf("model registry called with Authorization %q, expected the default token", got)
+				}
+
+				return nil
+			}, 10*time.Second, 1*time.Second).Should(Succeed())
+		})
+	})
+
+	When("A model registry InferenceService is UNDEPLOYED", func() {
+		It("Should delete the KServe InferenceService", func() {
+			deploymentController := newDeploymentController(inferenceservicecontroller.UndeployPolicyDelete)
+
+			is := newInferenceService("301", "iris-delete", modelVersionId)
+			mrMockRegistry.SetInferenceService(is)
+
+			Eventually(func() error {
+				_, err := syncedInferenceService(deploymentController, "301")
+				return err
+			}, 10*time.Second, 1*time.Second).Should(Succeed())
+
+			is.DesiredState = openapi.INFERENCESERVICESTATE_UNDEPLOYED.Ptr()
+			mrMockRegistry.SetInferenceService(is)
+
+			Eventually(func() error {
+				_, err := syncedInferenceService(deploymentController, "301")
+				if err == nil {
+					return fmt.Errorf("KServe InferenceService still exists")
+				}
+
+				if !errors.IsNotFound(err) {
+					return err
+				}
+
+				return nil
+			}, 10*time.Second, 1*time.Second).Should(Succeed())
+		})
+
+		It("Should stop the KServe InferenceService with the scale-to-zero policy, and restart it when DEPLOYED again", func() {
+			deploymentController := newDeploymentController(inferenceservicecontroller.UndeployPolicyScaleToZero)
+
+			is := newInferenceService("401", "iris-stop", modelVersionId)
+			mrMockRegistry.SetInferenceService(is)
+
+			Eventually(func() error {
+				_, err := syncedInferenceService(deploymentController, "401")
+				return err
+			}, 10*time.Second, 1*time.Second).Should(Succeed())
+
+			is.DesiredState = openapi.INFERENCESERVICESTATE_UNDEPLOYED.Ptr()
+			mrMockRegistry.SetInferenceService(is)
+
+			Eventually(func() error {
+				isvc, err := syncedInferenceService(deploymentController, "401")
+				if err != nil {
+					return err
+				}
+
+				if isvc.Annotations[constants.StopAnnotationKey] != "true" {
+					return fmt.Errorf("KServe InferenceService is not stopped, got annotations %v", isvc.Annotations)
+				}
+
+				return nil
+			}, 10*time.Second, 1*time.Second).Should(Succeed())
+
+			is.DesiredState = openapi.INFERENCESERVICESTATE_DEPLOYED.Ptr()
+			mrMockRegistry.SetInferenceService(is)
+
+			Eventually(func() error {
+				isvc, err := syncedInferenceService(deploymentController, "401")
+				if err != nil {
+					return err
+				}
+
+				if _, ok := isvc.Annotations[constants.StopAnnotationKey]; ok {
+					return fmt.Errorf("KServe InferenceService is still stopped")
+				}
+
+				return nil
+			}, 10*time.Second, 1*time.Second).Should(Succeed())
+		})
+	})
+
+	When("A ServingEnvironment is deleted", func() {
+		It("Should delete the KServe InferenceService of a ServingEnvironment that doesn't exist anymore", func() {
+			deploymentController := newDeploymentController(inferenceservicecontroller.UndeployPolicyDelete)
+
+			const goneNamespace = "deploy-gone"
+
+			ns := &corev1.Namespace{}
+			ns.SetName(goneNamespace)
+
+			if err := cli.Create(ctx, ns); err != nil && !errors.IsAlreadyExists(err) {
+				Fail(err.Error())
+			}
+
+			orphan := &kservev1beta1.InferenceService{}
+			orphan.SetName("iris-orphan")
+			orphan.SetNamespace(goneNamespace)
+			orphan.SetLabels(map[string]string{
+				inferenceservicecontroller.ManagedByLabel: inferenceservicecontroller.DeploymentControllerName,
+				inferenceServiceIDLabel:                   "351",
+				registeredModelIDLabel:                    registeredModelId,
+				nameLabel:                                 "model-registry",
+				namespaceLabel:                            registriesNamespace,
+			})
+			orphan.Spec.Predictor.Model = &kservev1beta1.ModelSpec{
+				ModelFormat: kservev1beta1.ModelFormat{Name: "sklearn"},
+				PredictorExtensionSpec: kservev1beta1.PredictorExtensionSpec{
+					StorageURI: openapi.PtrString(storageUri),
+				},
+			}
+
+			if err := cli.Create(ctx, orphan); err != nil && !errors.IsAlreadyExists(err) {
+				Fail(err.Error())
+			}
+
+			Eventually(func() error {
+				if err := deploymentController.Sync(ctx); err != nil {
+					return err
+				}
+
+				err := cli.Get(ctx, types.NamespacedName{Namespace: goneNamespace, Name: orphan.Name}, &kservev1beta1.InferenceService{})
+				if err == nil {
+					return fmt.Errorf("KServe InferenceService still exists")
+				}
+
+				if !errors.IsNotFound(err) {
+					return err
+				}
+
+				return nil
+			}, 10*time.Second, 1*time.Second).Should(Succeed())
+		})
+	})
+})
@@ -0,0 +1,162 @@
+package inferenceservicecontroller
+
+import (
+	"cmp"
+	"reflect"
+	"slices"
+	"strconv"
+
+	kservev1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kserve/kserve/pkg/constants"
+	"github.com/kubeflow/hub/pkg/openapi"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+)
+
+// actualState returns the state of an ISVC as observed in the cluster.
+func actualState(isvc *kservev1beta1.InferenceService) openapi.InferenceServiceActualState {
+	if isvc.GetDeletionTimestamp() != nil || isvc.Annotations[constants.StopAnnotationKey] == "true" {
+		return openapi.INFERENCESERVICEACTUALSTATE_UNDEPLOYED
+	}
+
+	ready := isvc.Status.GetCondition(apis.ConditionReady)
+	if ready == nil {
+		return openapi.INFERENCESERVICEACTUALSTATE_DEPLOYING
+	}
+
+	switch {
+	case ready.Status == corev1.ConditionTrue:
+		return openapi.INFERENCESERVICEACTUALSTATE_DEPLOYED
+	case ready.Status == corev1.ConditionFalse && failed(isvc):
+		return openapi.INFERENCESERVICEACTUALSTATE_FAILED
+	default:
+		return openapi.INFERENCESERVICEACTUALSTATE_DEPLOYING
+	}
+}
+
+// failed reports whether KServe gave up on deploying the model of an ISVC,
+// rather than still rolling it out.
+func failed(isvc *kservev1beta1.InferenceService) bool {
+	switch isvc.Status.ModelStatus.TransitionStatus {
+	case kservev1beta1.InvalidSpec, kservev1beta1.BlockedByFailedLoad:
+		return true
+	}
+
+	return isvc.Status.ModelStatus.LastFailureInfo != nil
+}
+
+// deploymentStatus returns the live status of an ISVC, in the form recorded on
+// the model registry InferenceService. A revision keeps the model version
+// recorded for it in previous, while a new latest revision serves the model
+// version the ISVC is labeled with.
+func deploymentStatus(
+	isvc *kservev1beta1.InferenceService,
+	modelVersionIDLabel string,
+	previous *openapi.InferenceServiceDeploymentStatus,
+) *openapi.InferenceServiceDeploymentStatus {
+	status := &openapi.InferenceServiceDeploymentStatus{}
+
+	if isvc.Status.URL != nil {
+		status.Url = openapi.PtrString(isvc.Status.URL.String())
+	}
+
+	for _, cond := range isvc.Status.Conditions {
+		condition := openapi.NewInferenceServiceCondition(string(cond.Type), string(cond.Status))
+		if cond.Reason != "" {
+			condition.Reason = openapi.PtrString(cond.Reason)
+		}
+		if cond.Message != "" {
+			condition.Message = openapi.PtrString(cond.Message)
+		}
+		if !cond.LastTransitionTime.Inner.IsZero() {
+			condition.LastTransitionTimeSinceEpoch = sinceEpoch(cond.LastTransitionTime.Inner.Time.UnixMilli())
+		}
+
+		status.Conditions = append(status.Conditions, *condition)
+
+		if cond.Type == apis.ConditionReady {
+			status.LastTransitionTimeSinceEpoch = condition.LastTransitionTimeSinceEpoch
+		}
+	}
+
+	previousModelVersions := map[string]string{}
+	if previous != nil {
+		for _, target := range previous.Traffic {
+			if target.RevisionName != nil && target.ModelVersionId != nil {
+				previousModelVersions[*target.RevisionName] = *target.ModelVersionId
+			}
+		}
+	}
+
+	modelVersionId := isvc.Labels[modelVersionIDLabel]
+	predictor := isvc.Status.Components[kservev1beta1.PredictorComponent]
+
+	for _, traffic := range predictor.Traffic {
+		target := openapi.InferenceServiceTrafficTarget{}
+		if traffic.RevisionName != "" {
+			target.RevisionName = openapi.PtrString(traffic.RevisionName)
+		}
+		if traffic.Tag != "" {
+			target.Tag = openapi.PtrString(traffic.Tag)
+		}
+		if traffic.Percent != nil {
+			target.Percent = openapi.PtrInt32(int32(*traffic.Percent))
+		}
+		target.LatestRevision = traffic.LatestRevision
+
+		latest := traffic.LatestRevision != nil && *traffic.LatestRevision ||
+			traffic.RevisionName != "" && traffic.RevisionName == predictor.LatestCreatedRevision
+		if id, ok := previousModelVersions[traffic.RevisionName]; ok {
+			target.ModelVersionId = openapi.PtrString(id)
+		} else if latest && modelVersionId != "" {
+			target.ModelVersionId = openapi.PtrString(modelVersionId)
+		}
+
+		status.Traffic = append(status.Traffic, target)
+	}
+
+	// Raw deployments don't split traffic between revisions, all of it goes to
+	// the model the ISVC currently serves.
+	if len(status.Traffic) == 0 && isvc.Status.IsReady() {
+		target := openapi.InferenceServiceTrafficTarget{
+			Percent:        openapi.PtrInt32(100),
+			LatestRevision: openapi.PtrBool(true),
+		}
+		if modelVersionId != "" {
+			target.ModelVersionId = openapi.PtrString(modelVersionId)
+		}
+
+		status.Traffic = append(status.Traffic, target)
+	}
+
+	return status
+}
+
+// deploymentStatusEqual reports whether two deployment statuses carry the
+// same information, regardless of the order of their conditions.
+func deploymentStatusEqual(a, b *openapi.InferenceServiceDeploymentStatus) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	sortConditions := func(conditions []openapi.InferenceServiceCondition) []openapi.InferenceServiceCondition {
+		return slices.SortedFunc(slices.Values(conditions), func(x, y openapi.InferenceServiceCondition) int {
+			return cmp.Compare(x.Type, y.Type)
+		})
+	}
+
+	a2, b2 := *a, *b
+	a2.Conditions, b2.Conditions = sortConditions(a.Conditions), sortConditions(b.Conditions)
+	if len(a2.Conditions) == 0 && len(b2.Conditions) == 0 {
+		a2.Conditions, b2.Conditions = nil, nil
+	}
+	if len(a2.Traffic) == 0 && len(b2.Traffic) == 0 {
+		a2.Traffic, b2.Traffic = nil, nil
+	}
+
+	return reflect.DeepEqual(a2, b2)
+}
+
+func sinceEpoch(millis int64) *string {
+	return openapi.PtrString(strconv.FormatInt(millis, 10))
+}
@@ -0,0 +1,613 @@
+package inferenceservicecontroller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/uuid"
+	kservev1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kubeflow/hub/pkg/openapi"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// DriftReconciledAnnotation is set by the DriftController on the KServe
+	// InferenceServices missing from their model registry, to the time it
+	// fixed them, so that the InferenceServiceController registers them again.
+	DriftReconciledAnnotation = "modelregistry.kubeflow.org/drift-reconciled-at"
+)
+
+// DriftPolicy defines what the DriftController does with the drifts it finds.
+type DriftPolicy string
+
+const (
+	// DriftPolicyReport only reports the drifts, in the logs and metrics.
+	DriftPolicyReport DriftPolicy = "report"
+	// DriftPolicyFix reports the drifts, then fixes them.
+	DriftPolicyFix DriftPolicy = "fix"
+)
+
+// DriftKind is the kind of a drift between a model registry and the cluster.
+type DriftKind string
+
+const (
+	// DriftKindMissingInCluster is a DEPLOYED model registry InferenceService
+	// mirroring a KServe InferenceService that doesn't exist anymore. It's
+	// fixed by marking it UNDEPLOYED.
+	DriftKindMissingInCluster DriftKind = "missing-in-cluster"
+	// DriftKindMissingInRegistry is a KServe InferenceService labelled for a
+	// model registry without InferenceService for it. It's fixed by having the
+	// InferenceServiceController register it again.
+	DriftKindMissingInRegistry DriftKind = "missing-in-registry"
+)
+
+var (
+	driftInferenceServices = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "model_registry_drift_inference_services",
+		Help: "Number of InferenceServices drifting between a model registry and the cluster, found by the last drift reconciliation.",
+	}, []string{"registry", "kind"})
+	driftFixesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "model_registry_drift_fixes_total",
+		Help: "Total number of drifting InferenceServices fixed.",
+	}, []string{"registry", "kind"})
+	driftErrorsTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "model_registry_drift_errors_total",
+		Help: "Total number of errors checking or fixing drifts.",
+	})
+	driftLastReconcileTimestamp = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "model_registry_drift_last_reconcile_timestamp_seconds",
+		Help: "Time of the last drift reconciliation, in seconds since epoch.",
+	})
+)
+
+func init() {
+	metrics.Registry.MustRegister(driftInferenceServices, driftFixesTotal, driftErrorsTotal, driftLastReconcileTimestamp)
+}
+
+// Drift is a model registry InferenceService or a KServe InferenceService
+// missing its counterpart.
+type Drift struct {
+	Kind DriftKind `json:"kind"`
+	// Registry is the model registry, as namespace/name.
+	Registry string `json:"registry"`
+	// Namespace is the namespace of the KServe InferenceService, named after
+	// the ServingEnvironment.
+	Namespace string `json:"namespace"`
+	// Name is the name of the model registry InferenceService if it's
+	// missing in the cluster, of the KServe InferenceService otherwise.
+	Name string `json:"name"`
+	// InferenceServiceId is the id of the model registry InferenceService,
+	// the stale one set on the KServe InferenceService if any.
+	InferenceServiceId string `json:"inferenceServiceId,omitempty"`
+	Fixed              bool   `json:"fixed"`
+}
+
+// DriftReport lists the drifts found by a drift reconciliation.
+type DriftReport struct {
+	DryRun bool `json:"dryRun"`
+	// Registries are the model registries checked, as namespace/name.
+	Registries []string `json:"registries"`
+	Drifts     []Drift  `json:"drifts"`
+	// Errors are the model registries and ServingEnvironments that couldn't
+	// be checked, and the drifts that couldn't be fixed.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// DriftController periodically compares the InferenceServices of every model
+// registry in the registries namespace with the KServe InferenceServices
+// labelled for them, to catch the changes the InferenceServiceController
+// missed, e.g. while it was down or when its finalizer was removed by hand.
+// KServe InferenceServices deployed by the DeploymentController are left to
+// it, as it already deletes the ones without model registry InferenceService.
+type DriftController struct {
+	client                      client.Client
+	apiReader                   client.Reader
+	connections                 *connections
+	log                         logr.Logger
+	inferenceServiceIDLabel     string
+	registeredModelIDLabel      string
+	modelRegistryNamespaceLabel string
+	modelRegistryNameLabel      string
+	modelRegistryURLAnnotation  string
+	serviceURLAnnotation        string
+	registriesNamespace         string
+	syncPeriod                  time.Duration
+	policy                      DriftPolicy
+}
+
+func NewDriftController(
+	client client.Client,
+	log logr.Logger,
+	skipTLSVerify bool,
+	bearerToken,
+	isIDLabel,
+	regModelIDLabel,
+	mrNamespaceLabel,
+	mrNameLabel,
+	mrURLAnnotation,
+	serviceURLAnnotation,
+	registriesNamespace string,
+	syncPeriod time.Duration,
+	policy DriftPolicy,
+) *DriftController {
+	return &DriftController{
+		client:                      client,
+		apiReader:                   client,
+		connections:                 newConnections(client, skipTLSVerify, bearerToken),
+		log:                         log,
+		inferenceServiceIDLabel:     isIDLabel,
+		registeredModelIDLabel:      regModelIDLabel,
+		modelRegistryNamespaceLabel: mrNamespaceLabel,
+		modelRegistryNameLabel:      mrNameLabel,
+		modelRegistryURLAnnotation:  mrURLAnnotation,
+		serviceURLAnnotation:        serviceURLAnnotation,
+		registriesNamespace:         registriesNamespace,
+		syncPeriod:                  syncPeriod,
+		policy:                      policy,
+	}
+}
+
+func (r *DriftController) OverrideHTTPClient(client *http.Client) {
+	r.connections.overrideHTTPClient(client)
+}
+
+// OverrideAPIReader sets the reader checking the drifts found in the cache
+// again, the client by default.
+func (r *DriftController) OverrideAPIReader(reader client.Reader) {
+	r.apiReader = reader
+}
+
+// SetupWithManager adds the controller to the Manager, checking the drifts
+// found in its cache against the API server.
+func (r *DriftController) SetupWithManager(mgr ctrl.Manager) error {
+	r.apiReader = mgr.GetAPIReader()
+
+	return mgr.Add(r)
+}
+
+// NeedLeaderElection makes the controller run only on the leader, like the
+// reconcilers.
+func (r *DriftController) NeedLeaderElection() bool {
+	return true
+}
+
+// Start reconciles the drifts every sync period, until ctx is done.
+func (r *DriftController) Start(ctx context.Context) error {
+	r.log.Info("Starting to reconcile model registry drifts", "period", r.syncPeriod, "policy", r.policy)
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		r.Sync(ctx)
+	}, r.syncPeriod)
+
+	return nil
+}
+
+// Sync finds the drifts, fixing them with the fix policy, and records them in
+// the metrics.
+func (r *DriftController) Sync(ctx context.Context) *DriftReport {
+	report := r.reconcile(ctx, r.policy == DriftPolicyFix)
+
+	counts := map[string]map[DriftKind]int{}
+	for _, registry := range report.Registries {
+		counts[registry] = map[DriftKind]int{DriftKindMissingInCluster: 0, DriftKindMissingInRegistry: 0}
+	}
+
+	for _, drift := range report.Drifts {
+		r.log.Info("Found model registry drift", "kind", drift.Kind, "registry", drift.Registry, "namespace", drift.Namespace, "name", drift.Name, "id", drift.InferenceServiceId, "fixed", drift.Fixed)
+
+		counts[drift.Registry][drift.Kind]++
+
+		if drift.Fixed {
+			driftFixesTotal.WithLabelValues(drift.Registry, string(drift.Kind)).Inc()
+		}
+	}
+
+	for _, msg := range report.Errors {
+		r.log.Info("Unable to reconcile model registry drifts", "error", msg)
+	}
+
+	driftInferenceServices.Reset()
+	for registry, kinds := range counts {
+		for kind, count := range kinds {
+			driftInferenceServices.WithLabelValues(registry, string(kind)).Set(float64(count))
+		}
+	}
+
+	driftErrorsTotal.Add(float64(len(report.Errors)))
+	driftLastReconcileTimestamp.SetToCurrentTime()
+
+	return report
+}
+
+// Report finds the drifts without fixing them.
+func (r *DriftController) Report(ctx context.Context) *DriftReport {
+	return r.reconcile(ctx, false)
+}
+
+// ServeHTTP serves the dry-run report of the drifts, as JSON.
+func (r *DriftController) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(r.Report(req.Context())); err != nil {
+		r.log.Error(err, "Unable to write the drift report")
+	}
+}
+
+func (r *DriftController) reconcile(ctx context.Context, fix bool) *DriftReport {
+	report := &DriftReport{
+		DryRun:     !fix,
+		Registries: []string{},
+		Drifts:     []Drift{},
+	}
+
+	svcList := &corev1.ServiceList{}
+	if err := r.client.List(ctx, svcList, client.InNamespace(r.registriesNamespace), client.MatchingLabels{"component": "model-registry"}); err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("unable to list services in the namespace %s: %v", r.registriesNamespace, err))
+
+		return report
+	}
+
+	mrApiCtx := context.Background()
+
+	for i := range svcList.Items {
+		svc := &svcList.Items[i]
+		name := fmt.Sprintf("%s/%s", svc.Namespace, svc.Name)
+
+		registry, err := newModelRegistry(ctx, r.connections, svc, r.serviceURLAnnotation)
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("%s: unable to initialize Model Registry service: %v", name, err))
+			continue
+		}
+
+		report.Registries = append(report.Registries, name)
+
+		r.reconcileRegistry(ctx, mrApiCtx, registry, name, len(svcList.Items), fix, report)
+	}
+
+	return report
+}
+
+// reconcileRegistry adds the drifts of a model registry to report, fixing
+// them if fix is set.
+//
+// The registry is listed before the KServe InferenceServices, so that a
+// KServe InferenceService registered meanwhile has its model registry
+// InferenceService. As the KServe InferenceServices come from the cache, the
+// drifts found are checked again against the API server before being
+// reported, and the objects created during the last sync period are left to
+// the InferenceServiceController.
+func (r *DriftController) reconcileRegistry(
+	ctx context.Context,
+	mrApiCtx context.Context,
+	registry *modelRegistry,
+	name string,
+	registries int,
+	fix bool,
+	report *DriftReport,
+) {
+	servingEnvironments, err := listServingEnvironments(mrApiCtx, registry.api)
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("%s: unable to list the ServingEnvironments: %v", name, err))
+
+		return
+	}
+
+	// The model registry InferenceServices, by ServingEnvironment id.
+	registered := map[string][]openapi.InferenceService{}
+	for _, servingEnvironment := range servingEnvironments {
+		inferenceServices, err := listInferenceServices(mrApiCtx, registry.api, servingEnvironment.GetId())
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("%s: unable to list the InferenceServices of the ServingEnvironment %s: %v", name, servingEnvironment.Name, err))
+			continue
+		}
+
+		registered[servingEnvironment.GetId()] = inferenceServices
+	}
+
+	isvcList := &kservev1beta1.InferenceServiceList{}
+	if err := r.client.List(ctx, isvcList); err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("%s: unable to list the KServe InferenceServices: %v", name, err))
+
+		return
+	}
+
+	now := time.Now()
+
+	// The KServe InferenceServices linked to the registry, by namespace.
+	linked := map[string][]*kservev1beta1.InferenceService{}
+	for i := range isvcList.Items {
+		isvc := &isvcList.Items[i]
+		if r.isLinked(isvc, registry, registries) {
+			linked[isvc.Namespace] = append(linked[isvc.Namespace], isvc)
+		}
+	}
+
+	for _, servingEnvironment := range servingEnvironments {
+		namespace := servingEnvironment.Name
+
+		inferenceServices, ok := registered[servingEnvironment.GetId()]
+		if !ok {
+			// Its KServe InferenceServices can't be checked either.
+			delete(linked, namespace)
+			continue
+		}
+
+		ids := map[string]bool{}
+		names := map[string]bool{}
+		for _, is := range inferenceServices {
+			ids[is.GetId()] = true
+			names[is.GetName()] = true
+		}
+
+		for _, is := range inferenceServices {
+			if is.GetDesiredState() != openapi.INFERENCESERVICESTATE_DEPLOYED || !isMirroredName(is.GetName()) || r.isRecent(createTime(&is), now) || r.hasKServeInferenceService(&is, linked[namespace]) {
+				continue
+			}
+
+			missing, err := r.stillMissingInCluster(ctx, registry, registries, namespace, &is)
+			if err != nil {
+				report.Errors = append(report.Errors, fmt.Sprintf("%s: unable to check the KServe InferenceService of the InferenceService %s: %v", name, is.GetId(), err))
+				continue
+			}
+			if !missing {
+				continue
+			}
+
+			drift := Drift{
+				Kind:               DriftKindMissingInCluster,
+				Registry:           name,
+				Namespace:          namespace,
+				Name:               is.GetName(),
+				InferenceServiceId: is.GetId(),
+			}
+
+			if fix {
+				if err := undeployMRInferenceService(mrApiCtx, registry.api, &is); err != nil {
+					report.Errors = append(report.Errors, fmt.Sprintf("%s: unable to undeploy the InferenceService %s: %v", name, is.GetId(), err))
+				} else {
+					drift.Fixed = true
+				}
+			}
+
+			report.Drifts = append(report.Drifts, drift)
+		}
+
+		for _, isvc := range linked[namespace] {
+			if ids[isvc.Labels[r.inferenceServiceIDLabel]] || names[fmt.Sprintf("%s/%s", isvc.Name, isvc.UID)] || r.isRecent(isvc.CreationTimestamp.Time, now) {
+				continue
+			}
+
+			r.reportMissingInRegistry(ctx, mrApiCtx, registry, name, registries, isvc, fix, report)
+		}
+
+		delete(linked, namespace)
+	}
+
+	// The KServe InferenceServices of namespaces without ServingEnvironment
+	// are all missing.
+	for _, namespace := range slices.Sorted(maps.Keys(linked)) {
+		for _, isvc := range linked[namespace] {
+			if r.isRecent(isvc.CreationTimestamp.Time, now) {
+				continue
+			}
+
+			r.reportMissingInRegistry(ctx, mrApiCtx, registry, name, registries, isvc, fix, report)
+		}
+	}
+}
+
+// stillMissingInCluster returns whether a model registry InferenceService
+// still has no KServe InferenceService, listing them from the API server
+// rather than the cache.
+func (r *DriftController) stillMissingInCluster(ctx context.Context, registry *modelRegistry, registries int, namespace string, is *openapi.InferenceService) (bool, error) {
+	isvcList := &kservev1beta1.InferenceServiceList{}
+	if err := r.apiReader.List(ctx, isvcList, client.InNamespace(namespace)); err != nil {
+		return false, err
+	}
+
+	isvcs := []*kservev1beta1.InferenceService{}
+	for i := range isvcList.Items {
+		if isvc := &isvcList.Items[i]; r.isLinked(isvc, registry, registries) {
+			isvcs = append(isvcs, isvc)
+		}
+	}
+
+	return !r.hasKServeInferenceService(is, isvcs), nil
+}
+
+// stillMissingInRegistry returns the KServe InferenceService read again from
+// the API server rather than the cache, if it's still missing from the model
+// registry.
+func (r *DriftController) stillMissingInRegistry(ctx context.Context, mrApiCtx context.Context, registry *modelRegistry, registries int, isvc *kservev1beta1.InferenceService) (*kservev1beta1.InferenceService, error) {
+	current := &kservev1beta1.InferenceService{}
+	if err := r.apiReader.Get(ctx, client.ObjectKeyFromObject(isvc), current); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+
+	if !r.isLinked(current, registry, registries) {
+		return nil, nil
+	}
+
+	// It may have been registered since the registry was listed.
+	if id := current.Labels[r.inferenceServiceIDLabel]; id != "" {
+		_, resp, err := registry.api.ModelRegistryServiceAPI.GetInferenceService(mrApiCtx, id).Execute()
+		if err == nil {
+			return nil, nil
+		}
+		if resp == nil || resp.StatusCode != http.StatusNotFound {
+			return nil, err
+		}
+	}
+
+	return current, nil
+}
+
+// reportMissingInRegistry adds the drift of a KServe InferenceService missing
+// from the model registry name to report, if it's still missing, fixing it if
+// fix is set.
+func (r *DriftController) reportMissingInRegistry(ctx context.Context, mrApiCtx context.Context, registry *modelRegistry, name string, registries int, isvc *kservev1beta1.InferenceService, fix bool, report *DriftReport) {
+	current, err := r.stillMissingInRegistry(ctx, mrApiCtx, registry, registries, isvc)
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("%s: unable to check the KServe InferenceService %s/%s: %v", name, isvc.Namespace, isvc.Name, err))
+
+		return
+	}
+	if current == nil {
+		return
+	}
+
+	report.Drifts = append(report.Drifts, r.missingInRegistry(ctx, name, current, fix, report))
+}
+
+// missingInRegistry returns the drift of a KServe InferenceService missing
+// from the model registry name, fixing it if fix is set.
+func (r *DriftController) missingInRegistry(ctx context.Context, name string, isvc *kservev1beta1.InferenceService, fix bool, report *DriftReport) Drift {
+	drift := Drift{
+		Kind:               DriftKindMissingInRegistry,
+		Registry:           name,
+		Namespace:          isvc.Namespace,
+		Name:               isvc.Name,
+		InferenceServiceId: isvc.Labels[r.inferenceServiceIDLabel],
+	}
+
+	if !fix {
+		return drift
+	}
+
+	if _, ok := isvc.Labels[r.registeredModelIDLabel]; !ok {
+		report.Errors = append(report.Errors, fmt.Sprintf("%s: unable to register the KServe InferenceService %s/%s again, missing %s label", name, isvc.Namespace, isvc.Name, r.registeredModelIDLabel))
+
+		return drift
+	}
+
+	// Without the stale id, the InferenceServiceController creates a new
+	// model registry InferenceService, the annotation makes sure it reconciles
+	// the KServe InferenceService.
+	updated := isvc.DeepCopy()
+	delete(updated.Labels, r.inferenceServiceIDLabel)
+
+	if updated.Annotations == nil {
+		updated.Annotations = map[string]string{}
+	}
+
+	updated.Annotations[DriftReconciledAnnotation] = time.Now().UTC().Format(time.RFC3339)
+
+	if err := r.client.Patch(ctx, updated, client.MergeFrom(isvc)); IgnoreDeletingErrors(err) != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("%s: unable to update the KServe InferenceService %s/%s: %v", name, isvc.Namespace, isvc.Name, err))
+
+		return drift
+	}
+
+	drift.Fixed = true
+
+	return drift
+}
+
+// isLinked returns whether a KServe InferenceService is checked for drifts
+// with a model registry: linked to it, not being deleted, and not deployed by
+// the DeploymentController.
+func (r *DriftController) isLinked(isvc *kservev1beta1.InferenceService, registry *modelRegistry, registries int) bool {
+	return isvc.DeletionTimestamp == nil && isvc.Labels[ManagedByLabel] != DeploymentControllerName && r.isLinkedTo(isvc, registry, registries)
+}
+
+// isRecent returns whether an object created at created may not be
+// reconciled by the InferenceServiceController yet, which is given a sync
+// period to do so.
+func (r *DriftController) isRecent(created time.Time, now time.Time) bool {
+	return created.Add(r.syncPeriod).After(now)
+}
+
+// createTime returns the time a model registry InferenceService was created,
+// the zero time if unknown.
+func createTime(is *openapi.InferenceService) time.Time {
+	millis, err := strconv.ParseInt(is.GetCreateTimeSinceEpoch(), 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return time.UnixMilli(millis)
+}
+
+// isLinkedTo returns whether a KServe InferenceService is labelled for a
+// model registry, resolving the registry like the InferenceServiceController
+// does. registries is the number of model registries in the registries
+// namespace.
+func (r *DriftController) isLinkedTo(isvc *kservev1beta1.InferenceService, registry *modelRegistry, registries int) bool {
+	_, okIsvcId := isvc.Labels[r.inferenceServiceIDLabel]
+	_, okRegisteredModelId := isvc.Labels[r.registeredModelIDLabel]
+
+	if !okIsvcId && !okRegisteredModelId {
+		return false
+	}
+
+	if namespace, ok := isvc.Labels[r.modelRegistryNamespaceLabel]; ok && namespace != registry.namespace {
+		return false
+	}
+
+	if name, ok := isvc.Labels[r.modelRegistryNameLabel]; ok {
+		return name == registry.name
+	}
+
+	if mrUrl, ok := isvc.Annotations[r.modelRegistryURLAnnotation]; ok {
+		parsed, err := url.Parse(mrUrl)
+
+		return err == nil && parsed.Host == registry.host
+	}
+
+	// Without name or url, the model registry must be the only one of the
+	// namespace.
+	return registries == 1
+}
+
+// hasKServeInferenceService returns whether one of isvcs is mirrored by is.
+func (r *DriftController) hasKServeInferenceService(is *openapi.InferenceService, isvcs []*kservev1beta1.InferenceService) bool {
+	for _, isvc := range isvcs {
+		if isvc.Labels[r.inferenceServiceIDLabel] == is.GetId() || is.GetName() == fmt.Sprintf("%s/%s", isvc.Name, isvc.UID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isMirroredName returns whether a model registry InferenceService was
+// created by the InferenceServiceController, which names it after the name
+// and UID of the KServe InferenceService it mirrors. The others are deployed
+// by the DeploymentController, which creates their missing KServe
+// InferenceServices.
+func isMirroredName(name string) bool {
+	_, uid, ok := strings.Cut(name, "/")
+
+	return ok && uuid.Validate(uid) == nil
+}
+
+// undeployMRInferenceService marks a model registry InferenceService
+// UNDEPLOYED, both desired and actual state.
+func undeployMRInferenceService(ctx context.Context, mr *openapi.APIClient, is *openapi.InferenceService) error {
+	_, _, err := mr.ModelRegistryServiceAPI.UpdateInferenceService(ctx, is.GetId()).InferenceServiceUpdate(openapi.InferenceServiceUpdate{
+		DesiredState: openapi.INFERENCESERVICESTATE_UNDEPLOYED.Ptr(),
+		ActualState:  openapi.INFERENCESERVICEACTUALSTATE_UNDEPLOYED.Ptr(),
+	}).Execute()
+
+	return err
+}
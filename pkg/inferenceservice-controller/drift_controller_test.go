@@ -0,0 +1,288 @@
+package inferenceservicecontroller_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	kservev1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	inferenceservicecontroller "github.com/kubeflow/hub/pkg/inferenceservice-controller"
+	"github.com/kubeflow/hub/pkg/openapi"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Drift Controller", func() {
+	const (
+		ModelRegistrySVCPath = "./testdata/deploy/model-registry-svc.yaml"
+		registriesNamespace  = "drift-registries"
+		namespace            = "drift"
+		servingEnvironmentId = "300"
+		registeredModelId    = "30"
+	)
+
+	// newDriftController returns a DriftController whose sync period is
+	// syncPeriod, the objects created during it aren't checked for drifts.
+	newDriftController := func(cache client.Client, policy inferenceservicecontroller.DriftPolicy, syncPeriod time.Duration) *inferenceservicecontroller.DriftController {
+		driftController := inferenceservicecontroller.NewDriftController(
+			cache,
+			ctrl.Log.WithName("controllers").WithName("ModelRegistry-Drift-Controller"),
+			skipTLSVerify,
+			accessToken,
+			inferenceServiceIDLabel,
+			registeredModelIDLabel,
+			namespaceLabel,
+			nameLabel,
+			urlAnnotation,
+			serviceURLAnnotation,
+			registriesNamespace,
+			syncPeriod,
+			policy,
+		)
+
+		driftController.OverrideHTTPClient(mrMockServer.Client())
+		driftController.OverrideAPIReader(cli)
+
+		return driftController
+	}
+
+	newInferenceService := func(id, name string) openapi.InferenceService {
+		return openapi.InferenceService{
+			Id:                   &id,
+			Name:                 &name,
+			DesiredState:         openapi.INFERENCESERVICESTATE_DEPLOYED.Ptr(),
+			RegisteredModelId:    registeredModelId,
+			ServingEnvironmentId: servingEnvironmentId,
+		}
+	}
+
+	createKServeInferenceService := func(name, mrIsvcId string) {
+		isvc := &kservev1beta1.InferenceService{}
+		isvc.SetName(name)
+		isvc.SetNamespace(namespace)
+		isvc.SetLabels(map[string]string{
+			inferenceServiceIDLabel: mrIsvcId,
+			registeredModelIDLabel:  registeredModelId,
+			nameLabel:               "model-registry",
+			namespaceLabel:          registriesNamespace,
+		})
+		isvc.Spec.Predictor.Model = &kservev1beta1.ModelSpec{
+			ModelFormat: kservev1beta1.ModelFormat{Name: "sklearn"},
+			PredictorExtensionSpec: kservev1beta1.PredictorExtensionSpec{
+				StorageURI: openapi.PtrString("s3://models/iris"),
+			},
+		}
+
+		if err := cli.Create(ctx, isvc); err != nil && !errors.IsAlreadyExists(err) {
+			Fail(err.Error())
+		}
+	}
+
+	// namespaceDrifts returns the drifts of the namespace of the specs.
+	namespaceDrifts := func(report *inferenceservicecontroller.DriftReport) []inferenceservicecontroller.Drift {
+		drifts := []inferenceservicecontroller.Drift{}
+		for _, drift := range report.Drifts {
+			if drift.Namespace == namespace {
+				drifts = append(drifts, drift)
+			}
+		}
+		return drifts
+	}
+
+	// driftNames returns the names of the drifts of the namespace of the specs.
+	driftNames := func(report *inferenceservicecontroller.DriftReport) []string {
+		names := []string{}
+		for _, drift := range namespaceDrifts(report) {
+			names = append(names, drift.Name)
+		}
+		return names
+	}
+
+	BeforeEach(func() {
+		for _, name := range []string{registriesNamespace, namespace} {
+			ns := &corev1.Namespace{}
+
+			ns.SetName(name)
+
+			if err := cli.Create(ctx, ns); err != nil && !errors.IsAlreadyExists(err) {
+				Fail(err.Error())
+			}
+		}
+
+		mrSvc := &corev1.Service{}
+		Expect(ConvertFileToStructuredResource(ModelRegistrySVCPath, mrSvc)).To(Succeed())
+
+		mrSvc.SetNamespace(registriesNamespace)
+
+		if err := cli.Create(ctx, mrSvc); err != nil && !errors.IsAlreadyExists(err) {
+			Fail(err.Error())
+		}
+
+		mrMockRegistry.SetServingEnvironment(openapi.ServingEnvironment{
+			Id:   openapi.PtrString(servingEnvironmentId),
+			Name: namespace,
+		})
+	})
+
+	When("The registry and the cluster drifted apart", func() {
+		It("Should report the drifts in both directions without fixing them", func() {
+			driftController := newDriftController(cli, inferenceservicecontroller.DriftPolicyReport, 0)
+
+			mrMockRegistry.SetInferenceService(newInferenceService("301", "iris-gone/7b0bd1b4-2b7a-4c4f-9d3e-0d3c0a6f1c01"))
+			mrMockRegistry.SetInferenceService(newInferenceService("302", "iris-kept/7b0bd1b4-2b7a-4c4f-9d3e-0d3c0a6f1c02"))
+			// Deployed by the DeploymentController, not a drift
+			mrMockRegistry.SetInferenceService(newInferenceService("303", "iris-pending"))
+
+			createKServeInferenceService("iris-kept", "302")
+			createKServeInferenceService("iris-unknown", "399")
+
+			report := driftController.Sync(ctx)
+
+			Expect(report.DryRun).To(BeTrue())
+			Expect(report.Registries).To(ContainElement(fmt.Sprintf("%s/model-registry", registriesNamespace)))
+			Expect(namespaceDrifts(report)).To(ConsistOf(
+				inferenceservicecontroller.Drift{
+					Kind:               inferenceservicecontroller.DriftKindMissingInCluster,
+					Registry:           fmt.Sprintf("%s/model-registry", registriesNamespace),
+					Namespace:          namespace,
+					Name:               "iris-gone/7b0bd1b4-2b7a-4c4f-9d3e-0d3c0a6f1c01",
+					InferenceServiceId: "301",
+				},
+				inferenceservicecontroller.Drift{
+					Kind:               inferenceservicecontroller.DriftKindMissingInRegistry,
+					Registry:           fmt.Sprintf("%s/model-registry", registriesNamespace),
+					Namespace:          namespace,
+					Name:               "iris-unknown",
+					InferenceServiceId: "399",
+				},
+			))
+
+			is, ok := mrMockRegistry.InferenceService("301")
+			Expect(ok).To(BeTrue())
+			Expect(is.GetDesiredState()).To(Equal(openapi.INFERENCESERVICESTATE_DEPLOYED))
+		})
+	})
+
+	When("The drift policy is fix", func() {
+		It("Should undeploy the registry InferenceServices and register the KServe InferenceServices again", func() {
+			driftController := newDriftController(cli, inferenceservicecontroller.DriftPolicyFix, 0)
+
+			mrMockRegistry.SetInferenceService(newInferenceService("311", "iris-lost/7b0bd1b4-2b7a-4c4f-9d3e-0d3c0a6f1c11"))
+
+			createKServeInferenceService("iris-stale", "398")
+
+			// The dry-run report doesn't fix anything
+			report := driftController.Report(ctx)
+			Expect(report.DryRun).To(BeTrue())
+
+			is, ok := mrMockRegistry.InferenceService("311")
+			Expect(ok).To(BeTrue())
+			Expect(is.GetDesiredState()).To(Equal(openapi.INFERENCESERVICESTATE_DEPLOYED))
+
+			report = driftController.Sync(ctx)
+			Expect(report.DryRun).To(BeFalse())
+
+			for _, drift := range namespaceDrifts(report) {
+				if drift.Name == "iris-lost/7b0bd1b4-2b7a-4c4f-9d3e-0d3c0a6f1c11" || drift.Name == "iris-stale" {
+					Expect(drift.Fixed).To(BeTrue(), "drift %v not fixed", drift)
+				}
+			}
+
+			is, ok = mrMockRegistry.InferenceService("311")
+			Expect(ok).To(BeTrue())
+			Expect(is.GetDesiredState()).To(Equal(openapi.INFERENCESERVICESTATE_UNDEPLOYED))
+			Expect(is.GetActualState()).To(Equal(openapi.INFERENCESERVICEACTUALSTATE_UNDEPLOYED))
+
+			isvc := &kservev1beta1.InferenceService{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: "iris-stale", Namespace: namespace}, isvc)).To(Succeed())
+			Expect(isvc.Labels[inferenceServiceIDLabel]).ToNot(Equal("398"))
+			Expect(isvc.Annotations).To(HaveKey(inferenceservicecontroller.DriftReconciledAnnotation))
+		})
+	})
+	When("The cache lags behind the registry", func() {
+		It("Should only report the drifts still found in the API server", func() {
+			// The cache doesn't have iris-lagging yet
+			createKServeInferenceService("iris-lagging", "321")
+			mrMockRegistry.SetInferenceService(newInferenceService("321", "iris-lagging/7b0bd1b4-2b7a-4c4f-9d3e-0d3c0a6f1c21"))
+
+			// The InferenceServiceController registers iris-registered between
+			// the listings of the registry and the cache
+			createKServeInferenceService("iris-registered", "323")
+
+			cache := &staleCache{
+				Client: cli,
+				hidden: "iris-lagging",
+				onList: func() {
+					mrMockRegistry.SetInferenceService(newInferenceService("323", "iris-registered/7b0bd1b4-2b7a-4c4f-9d3e-0d3c0a6f1c23"))
+				},
+			}
+			driftController := newDriftController(cache, inferenceservicecontroller.DriftPolicyFix, 0)
+
+			report := driftController.Sync(ctx)
+			Expect(report.Errors).To(BeEmpty())
+			Expect(driftNames(report)).ToNot(ContainElement(HavePrefix("iris-lagging")))
+			Expect(driftNames(report)).ToNot(ContainElement(HavePrefix("iris-registered")))
+
+			is, ok := mrMockRegistry.InferenceService("321")
+			Expect(ok).To(BeTrue())
+			Expect(is.GetDesiredState()).To(Equal(openapi.INFERENCESERVICESTATE_DEPLOYED))
+
+			isvc := &kservev1beta1.InferenceService{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: "iris-registered", Namespace: namespace}, isvc)).To(Succeed())
+			Expect(isvc.Labels[inferenceServiceIDLabel]).To(Equal("323"))
+		})
+
+		It("Should not report the objects created during the last sync period", func() {
+			driftController := newDriftController(cli, inferenceservicecontroller.DriftPolicyFix, time.Hour)
+
+			created := newInferenceService("322", "iris-created/7b0bd1b4-2b7a-4c4f-9d3e-0d3c0a6f1c22")
+			created.CreateTimeSinceEpoch = openapi.PtrString(fmt.Sprint(time.Now().UnixMilli()))
+			mrMockRegistry.SetInferenceService(created)
+
+			createKServeInferenceService("iris-new", "397")
+
+			report := driftController.Sync(ctx)
+			Expect(driftNames(report)).ToNot(ContainElement(HavePrefix("iris-created")))
+			Expect(driftNames(report)).ToNot(ContainElement("iris-new"))
+
+			isvc := &kservev1beta1.InferenceService{}
+			Expect(cli.Get(ctx, types.NamespacedName{Name: "iris-new", Namespace: namespace}, isvc)).To(Succeed())
+			Expect(isvc.Labels[inferenceServiceIDLabel]).To(Equal("397"))
+		})
+	})
+})
+
+// staleCache is a client listing the KServe InferenceServices like a cache
+// lagging behind the API server: without the one named hidden, and after
+// calling onList.
+type staleCache struct {
+	client.Client
+	hidden string
+	onList func()
+}
+
+func (c *staleCache) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	isvcList, ok := list.(*kservev1beta1.InferenceServiceList)
+	if !ok {
+		return c.Client.List(ctx, list, opts...)
+	}
+
+	c.onList()
+
+	if err := c.Client.List(ctx, isvcList, opts...); err != nil {
+		return err
+	}
+
+	isvcList.Items = slices.DeleteFunc(isvcList.Items, func(isvc kservev1beta1.InferenceService) bool {
+		return isvc.Name == c.hidden
+	})
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package inferenceservicecontroller
+
+import (
+	"fmt"
+
+	kservev1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	"github.com/kubeflow/hub/pkg/openapi"
+	"k8s.io/utils/ptr"
+)
+
+// rollout is a step of the rollout of a traffic split to a KServe
+// InferenceService.
+type rollout struct {
+	// modelVersionId is the ModelVersion served by the predictor, which is
+	// the canary when canaryTrafficPercent is set.
+	modelVersionId string
+	// canaryTrafficPercent is the share of the traffic sent to the latest
+	// revision, the rest of it going to the previously rolled out one.
+	canaryTrafficPercent *int64
+	// stableModelVersionId is the ModelVersion served by the previously
+	// rolled out revision while a canary is.
+	stableModelVersionId string
+}
+
+// planRollout returns the next step of the rollout of the traffic split of
+// is, given the KServe InferenceService deployed for it, if any.
+//
+// KServe only splits the traffic between the latest revision and the
+// previously rolled out one, so a split is rolled out in stages: the
+// ModelVersion that's already rolled out, or else the one with the largest
+// share, is served first, then once it's ready the other one is deployed as a
+// canary receiving its share. Giving all the traffic to the canary promotes
+// it, while giving it back to the stable ModelVersion rolls the canary back.
+func (r *DeploymentController) planRollout(is *openapi.InferenceService, existing *kservev1beta1.InferenceService) (rollout, error) {
+	var deployed, stable string
+	canaryActive := false
+
+	if existing != nil {
+		deployed = existing.Labels[r.modelVersionIDLabel]
+		stable, canaryActive = existing.Annotations[StableModelVersionAnnotation]
+		if !canaryActive {
+			stable = deployed
+		}
+	}
+
+	split := is.TrafficSplit
+
+	switch len(split) {
+	case 1:
+		if canaryActive && split[0].ModelVersionId == stable {
+			// Keep the canary deployed, without any traffic.
+			return rollout{
+				modelVersionId:       deployed,
+				canaryTrafficPercent: ptr.To[int64](0),
+				stableModelVersionId: stable,
+			}, nil
+		}
+
+		return rollout{modelVersionId: split[0].ModelVersionId}, nil
+	case 2:
+		for i, entry := range split {
+			if entry.ModelVersionId != stable {
+				continue
+			}
+
+			if !canaryActive && !existing.Status.IsReady() {
+				// Wait for the stable ModelVersion to be rolled out first.
+				return rollout{modelVersionId: stable}, nil
+			}
+
+			canary := split[1-i]
+
+			return rollout{
+				modelVersionId:       canary.ModelVersionId,
+				canaryTrafficPercent: ptr.To(int64(canary.Percent)),
+				stableModelVersionId: stable,
+			}, nil
+		}
+
+		heaviest := split[0]
+		if split[1].Percent > heaviest.Percent {
+			heaviest = split[1]
+		}
+
+		return rollout{modelVersionId: heaviest.ModelVersionId}, nil
+	default:
+		return rollout{}, fmt.Errorf("traffic split between %d ModelVersions, KServe supports at most 2", len(split))
+	}
+}
@@ -0,0 +1,308 @@
+package inferenceservicecontroller
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	kservev1alpha1 "github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	"github.com/kubeflow/hub/pkg/openapi"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// ServingEnvironmentLabel opts a Namespace in to the
+	// ServingEnvironmentController when set to "true".
+	ServingEnvironmentLabel = "modelregistry.kubeflow.org/serving-environment"
+	// EnvironmentTierLabel sets the tier of the ServingEnvironment of a
+	// Namespace: development, staging or production.
+	EnvironmentTierLabel = "modelregistry.kubeflow.org/environment-tier"
+)
+
+// environmentTiers maps the values of EnvironmentTierLabel to the
+// ServingEnvironment tiers.
+var environmentTiers = map[string]openapi.ServingEnvironmentTier{
+	"development": openapi.SERVINGENVIRONMENTTIER_DEVELOPMENT,
+	"staging":     openapi.SERVINGENVIRONMENTTIER_STAGING,
+	"production":  openapi.SERVINGENVIRONMENTTIER_PRODUCTION,
+}
+
+// ServingEnvironmentController syncs the metadata of the ServingEnvironment
+// named after every Namespace labelled with ServingEnvironmentLabel, in every
+// model registry in the registries namespace:
+//   - the cluster ID;
+//   - the tier, from EnvironmentTierLabel;
+//   - the allowed runtimes, the enabled KServe ServingRuntimes of the
+//     Namespace, any runtime is allowed if it has none;
+//   - the quotas, the hard limits of the ResourceQuotas of the Namespace,
+//     the lowest one if several ResourceQuotas limit the same resource.
+//
+// The ServingEnvironments are created if missing, and kept when the Namespace
+// is deleted as their InferenceServices still reference them.
+type ServingEnvironmentController struct {
+	client               client.Client
+	connections          *connections
+	log                  logr.Logger
+	clusterID            string
+	serviceURLAnnotation string
+	registriesNamespace  string
+	syncPeriod           time.Duration
+}
+
+func NewServingEnvironmentController(
+	client client.Client,
+	log logr.Logger,
+	skipTLSVerify bool,
+	bearerToken,
+	clusterID,
+	serviceURLAnnotation,
+	registriesNamespace string,
+	syncPeriod time.Duration,
+) *ServingEnvironmentController {
+	return &ServingEnvironmentController{
+		client:               client,
+		connections:          newConnections(client, skipTLSVerify, bearerToken),
+		log:                  log,
+		clusterID:            clusterID,
+		serviceURLAnnotation: serviceURLAnnotation,
+		registriesNamespace:  registriesNamespace,
+		syncPeriod:           syncPeriod,
+	}
+}
+
+func (r *ServingEnvironmentController) OverrideHTTPClient(client *http.Client) {
+	r.connections.overrideHTTPClient(client)
+}
+
+// Reconcile syncs the ServingEnvironments of a Namespace. Namespaces are
+// requeued every sync period, as model registries don't notify when they are
+// created or when their ServingEnvironments are edited.
+func (r *ServingEnvironmentController) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.log.WithValues("namespace", req.Name)
+
+	ns := &corev1.Namespace{}
+	if err := r.client.Get(ctx, req.NamespacedName, ns); err != nil {
+		if apierrs.IsNotFound(err) {
+			log.V(1).Info("Stop ServingEnvironment reconciliation, Namespace not found.")
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("unable to fetch the Namespace: %w", err)
+	}
+
+	if ns.Labels[ServingEnvironmentLabel] != "true" || ns.DeletionTimestamp != nil {
+		return ctrl.Result{}, nil
+	}
+
+	desired, err := r.buildServingEnvironment(ctx, ns)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	svcList := &corev1.ServiceList{}
+	if err := r.client.List(ctx, svcList, client.InNamespace(r.registriesNamespace), client.MatchingLabels{"component": "model-registry"}); err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to list services in the namespace %s: %w", r.registriesNamespace, err)
+	}
+
+	mrApiCtx := context.Background()
+	failed := false
+
+	for i := range svcList.Items {
+		svc := &svcList.Items[i]
+		log := log.WithValues("mr-namespace", svc.Namespace, "mr-name", svc.Name)
+
+		mrUrl, err := buildURLFromService(svc, r.serviceURLAnnotation)
+		if err != nil {
+			log.Error(err, "Unable to initialize Model Registry service")
+			failed = true
+			continue
+		}
+
+		api, err := r.connections.get(ctx, newConnectionProfile(mrUrl, svc.Namespace, svc.Annotations[ConnectionProfileAnnotation]))
+		if err != nil {
+			log.Error(err, "Unable to initialize Model Registry service")
+			failed = true
+			continue
+		}
+
+		if err := r.syncServingEnvironment(mrApiCtx, log, api, desired); err != nil {
+			log.Error(err, "Unable to sync the ServingEnvironment")
+			failed = true
+		}
+	}
+
+	if failed {
+		return ctrl.Result{}, fmt.Errorf("unable to sync the ServingEnvironment of the namespace %s in every model registry", ns.Name)
+	}
+
+	return ctrl.Result{RequeueAfter: r.syncPeriod}, nil
+}
+
+// buildServingEnvironment returns the ServingEnvironment matching the
+// Namespace ns.
+func (r *ServingEnvironmentController) buildServingEnvironment(ctx context.Context, ns *corev1.Namespace) (*openapi.ServingEnvironmentCreate, error) {
+	desired := &openapi.ServingEnvironmentCreate{
+		Name:            ns.Name,
+		AllowedRuntimes: []string{},
+		Quotas:          map[string]string{},
+	}
+
+	if r.clusterID != "" {
+		desired.ClusterId = &r.clusterID
+	}
+
+	if value, ok := ns.Labels[EnvironmentTierLabel]; ok {
+		tier, ok := environmentTiers[value]
+		if !ok {
+			return nil, fmt.Errorf("invalid %s label %q, must be development, staging or production", EnvironmentTierLabel, value)
+		}
+
+		desired.Tier = &tier
+	}
+
+	runtimeList := &kservev1alpha1.ServingRuntimeList{}
+	if err := r.client.List(ctx, runtimeList, client.InNamespace(ns.Name)); err != nil {
+		return nil, fmt.Errorf("unable to list the ServingRuntimes in the namespace %s: %w", ns.Name, err)
+	}
+
+	for i := range runtimeList.Items {
+		runtime := &runtimeList.Items[i]
+		if !runtime.Spec.IsDisabled() {
+			desired.AllowedRuntimes = append(desired.AllowedRuntimes, runtime.Name)
+		}
+	}
+
+	clusterRuntimeList := &kservev1alpha1.ClusterServingRuntimeList{}
+	if err := r.client.List(ctx, clusterRuntimeList); err != nil {
+		return nil, fmt.Errorf("unable to list the ClusterServingRuntimes: %w", err)
+	}
+
+	for i := range clusterRuntimeList.Items {
+		runtime := &clusterRuntimeList.Items[i]
+		if !runtime.Spec.IsDisabled() {
+			desired.AllowedRuntimes = append(desired.AllowedRuntimes, runtime.Name)
+		}
+	}
+
+	slices.Sort(desired.AllowedRuntimes)
+	desired.AllowedRuntimes = slices.Compact(desired.AllowedRuntimes)
+
+	quotaList := &corev1.ResourceQuotaList{}
+	if err := r.client.List(ctx, quotaList, client.InNamespace(ns.Name)); err != nil {
+		return nil, fmt.Errorf("unable to list the ResourceQuotas in the namespace %s: %w", ns.Name, err)
+	}
+
+	quotas := map[corev1.ResourceName]resource.Quantity{}
+	for _, quota := range quotaList.Items {
+		for name, hard := range quota.Spec.Hard {
+			if current, ok := quotas[name]; !ok || hard.Cmp(current) < 0 {
+				quotas[name] = hard
+			}
+		}
+	}
+
+	for name, quantity := range quotas {
+		desired.Quotas[string(name)] = quantity.String()
+	}
+
+	return desired, nil
+}
+
+// syncServingEnvironment creates the desired ServingEnvironment in a model
+// registry, or updates it if its metadata changed.
+func (r *ServingEnvironmentController) syncServingEnvironment(
+	ctx context.Context,
+	log logr.Logger,
+	mr *openapi.APIClient,
+	desired *openapi.ServingEnvironmentCreate,
+) error {
+	existing, resp, err := mr.ModelRegistryServiceAPI.FindServingEnvironment(ctx).Name(desired.Name).Execute()
+	if err != nil {
+		if resp == nil || resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("unable to find ServingEnvironment %s: %w", desired.Name, err)
+		}
+
+		log.Info("Creating ServingEnvironment")
+
+		if _, _, err := mr.ModelRegistryServiceAPI.CreateServingEnvironment(ctx).ServingEnvironmentCreate(*desired).Execute(); err != nil {
+			return fmt.Errorf("unable to create ServingEnvironment %s: %w", desired.Name, err)
+		}
+
+		return nil
+	}
+
+	// Unset fields are kept by updates, so they are only compared when set.
+	if (desired.ClusterId == nil || existing.GetClusterId() == *desired.ClusterId) &&
+		(desired.Tier == nil || existing.GetTier() == *desired.Tier) &&
+		slices.Equal(existing.AllowedRuntimes, desired.AllowedRuntimes) &&
+		maps.Equal(existing.Quotas, desired.Quotas) {
+		return nil
+	}
+
+	log.Info("Updating ServingEnvironment", "id", existing.GetId())
+
+	update := openapi.ServingEnvironmentUpdate{
+		ClusterId:       desired.ClusterId,
+		Tier:            desired.Tier,
+		AllowedRuntimes: desired.AllowedRuntimes,
+		Quotas:          desired.Quotas,
+	}
+
+	if _, _, err := mr.ModelRegistryServiceAPI.UpdateServingEnvironment(ctx, existing.GetId()).ServingEnvironmentUpdate(update).Execute(); err != nil {
+		return fmt.Errorf("unable to update ServingEnvironment %s: %w", existing.GetId(), err)
+	}
+
+	return nil
+}
+
+// SetupWithManager sets up the controller with the Manager, reconciling the
+// labelled Namespaces when they, their ServingRuntimes or their
+// ResourceQuotas change, and all of them when a ClusterServingRuntime changes.
+func (r *ServingEnvironmentController) SetupWithManager(mgr ctrl.Manager) error {
+	labelled := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return obj.GetLabels()[ServingEnvironmentLabel] == "true"
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.Namespace{}, builder.WithPredicates(labelled)).
+		Watches(&kservev1alpha1.ServingRuntime{}, handler.EnqueueRequestsFromMapFunc(namespaceOf)).
+		Watches(&kservev1alpha1.ClusterServingRuntime{}, handler.EnqueueRequestsFromMapFunc(r.labelledNamespaces)).
+		Watches(&corev1.ResourceQuota{}, handler.EnqueueRequestsFromMapFunc(namespaceOf)).
+		Named("servingenvironment").
+		Complete(r)
+}
+
+// labelledNamespaces maps a cluster scoped object to the requests reconciling
+// every labelled Namespace.
+func (r *ServingEnvironmentController) labelledNamespaces(ctx context.Context, _ client.Object) []reconcile.Request {
+	nsList := &corev1.NamespaceList{}
+	if err := r.client.List(ctx, nsList, client.MatchingLabels{ServingEnvironmentLabel: "true"}); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Unable to list the labelled Namespaces")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(nsList.Items))
+	for _, ns := range nsList.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: ns.Name}})
+	}
+	return requests
+}
+
+// namespaceOf maps a namespaced object to the request reconciling its
+// Namespace.
+func namespaceOf(_ context.Context, obj client.Object) []reconcile.Request {
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: obj.GetNamespace()}}}
+}
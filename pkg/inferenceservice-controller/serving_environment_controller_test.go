@@ -0,0 +1,205 @@
+package inferenceservicecontroller_test
+
+import (
+	"time"
+
+	kservev1alpha1 "github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
+	inferenceservicecontroller "github.com/kubeflow/hub/pkg/inferenceservice-controller"
+	"github.com/kubeflow/hub/pkg/openapi"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("ServingEnvironment Controller", func() {
+	const (
+		ModelRegistrySVCPath = "./testdata/deploy/model-registry-svc.yaml"
+		registriesNamespace  = "senv-registries"
+		clusterID            = "test-cluster"
+	)
+
+	newServingEnvironmentController := func() *inferenceservicecontroller.ServingEnvironmentController {
+		servingEnvironmentController := inferenceservicecontroller.NewServingEnvironmentController(
+			cli,
+			ctrl.Log.WithName("controllers").WithName("ModelRegistry-ServingEnvironment-Controller"),
+			skipTLSVerify,
+			accessToken,
+			clusterID,
+			serviceURLAnnotation,
+			registriesNamespace,
+			time.Minute,
+		)
+
+		servingEnvironmentController.OverrideHTTPClient(mrMockServer.Client())
+
+		return servingEnvironmentController
+	}
+
+	createNamespace := func(name string, labels map[string]string) {
+		ns := &corev1.Namespace{}
+
+		ns.SetName(name)
+		ns.SetLabels(labels)
+
+		if err := cli.Create(ctx, ns); err != nil && !errors.IsAlreadyExists(err) {
+			Fail(err.Error())
+		}
+	}
+
+	createServingRuntime := func(namespace, name string, disabled bool) {
+		runtime := &kservev1alpha1.ServingRuntime{}
+
+		runtime.SetName(name)
+		runtime.SetNamespace(namespace)
+		runtime.Spec.Disabled = &disabled
+		runtime.Spec.Containers = []corev1.Container{{Name: "kserve-container", Image: "kserve/runtime:latest"}}
+
+		Expect(cli.Create(ctx, runtime)).To(Succeed())
+	}
+
+	// createClusterServingRuntime returns a func deleting the ClusterServingRuntime, which would
+	// otherwise be allowed in the Namespaces of the other specs.
+	createClusterServingRuntime := func(name string, disabled bool) func() {
+		runtime := &kservev1alpha1.ClusterServingRuntime{}
+
+		runtime.SetName(name)
+		runtime.Spec.Disabled = &disabled
+		runtime.Spec.Containers = []corev1.Container{{Name: "kserve-container", Image: "kserve/runtime:latest"}}
+
+		Expect(cli.Create(ctx, runtime)).To(Succeed())
+
+		return func() {
+			Expect(cli.Delete(ctx, runtime)).To(Succeed())
+		}
+	}
+
+	createResourceQuota := func(namespace, name string, hard corev1.ResourceList) {
+		quota := &corev1.ResourceQuota{}
+
+		quota.SetName(name)
+		quota.SetNamespace(namespace)
+		quota.Spec.Hard = hard
+
+		Expect(cli.Create(ctx, quota)).To(Succeed())
+	}
+
+	reconcile := func(namespace string) error {
+		_, err := newServingEnvironmentController().Reconcile(ctx, ctrl.Request{
+			NamespacedName: types.NamespacedName{Name: namespace},
+		})
+
+		return err
+	}
+
+	BeforeEach(func() {
+		createNamespace(registriesNamespace, nil)
+
+		mrSvc := &corev1.Service{}
+		Expect(ConvertFileToStructuredResource(ModelRegistrySVCPath, mrSvc)).To(Succeed())
+
+		mrSvc.SetNamespace(registriesNamespace)
+
+		if err := cli.Create(ctx, mrSvc); err != nil && !errors.IsAlreadyExists(err) {
+			Fail(err.Error())
+		}
+	})
+
+	When("A labelled Namespace has no ServingEnvironment", func() {
+		It("Should create it with the metadata of the Namespace", func() {
+			const namespace = "senv-create"
+
+			createNamespace(namespace, map[string]string{
+				inferenceservicecontroller.ServingEnvironmentLabel: "true",
+				inferenceservicecontroller.EnvironmentTierLabel:    "production",
+			})
+			createServingRuntime(namespace, "vllm-runtime", false)
+			createServingRuntime(namespace, "kserve-ovms", false)
+			createServingRuntime(namespace, "kserve-tritonserver", true)
+			defer createClusterServingRuntime("kserve-huggingfaceserver", false)()
+			defer createClusterServingRuntime("vllm-runtime", false)()
+			defer createClusterServingRuntime("kserve-lgbserver", true)()
+			createResourceQuota(namespace, "gpu", corev1.ResourceList{
+				"requests.nvidia.com/gpu": resource.MustParse("4"),
+			})
+			createResourceQuota(namespace, "team", corev1.ResourceList{
+				"requests.nvidia.com/gpu":   resource.MustParse("2"),
+				corev1.ResourceLimitsMemory: resource.MustParse("64Gi"),
+			})
+
+			Expect(reconcile(namespace)).To(Succeed())
+
+			senv, ok := mrMockRegistry.CreatedServingEnvironment(namespace)
+			Expect(ok).To(BeTrue())
+			Expect(senv.GetClusterId()).To(Equal(clusterID))
+			Expect(senv.GetTier()).To(Equal(openapi.SERVINGENVIRONMENTTIER_PRODUCTION))
+			Expect(senv.AllowedRuntimes).To(Equal([]string{"kserve-huggingfaceserver", "kserve-ovms", "vllm-runtime"}))
+			Expect(senv.Quotas).To(Equal(map[string]string{
+				"requests.nvidia.com/gpu": "2",
+				"limits.memory":           "64Gi",
+			}))
+		})
+	})
+
+	When("The ServingEnvironment of a labelled Namespace is out of date", func() {
+		It("Should update its metadata", func() {
+			const namespace = "senv-update"
+
+			mrMockRegistry.SetServingEnvironment(openapi.ServingEnvironment{
+				Id:              openapi.PtrString("300"),
+				Name:            namespace,
+				Description:     openapi.PtrString("kept"),
+				Tier:            openapi.SERVINGENVIRONMENTTIER_DEVELOPMENT.Ptr(),
+				AllowedRuntimes: []string{"removed-runtime"},
+			})
+
+			createNamespace(namespace, map[string]string{
+				inferenceservicecontroller.ServingEnvironmentLabel: "true",
+				inferenceservicecontroller.EnvironmentTierLabel:    "staging",
+			})
+			createServingRuntime(namespace, "kserve-sklearnserver", false)
+
+			Expect(reconcile(namespace)).To(Succeed())
+
+			senv, ok := mrMockRegistry.ServingEnvironment("300")
+			Expect(ok).To(BeTrue())
+			Expect(senv.GetDescription()).To(Equal("kept"))
+			Expect(senv.GetClusterId()).To(Equal(clusterID))
+			Expect(senv.GetTier()).To(Equal(openapi.SERVINGENVIRONMENTTIER_STAGING))
+			Expect(senv.AllowedRuntimes).To(Equal([]string{"kserve-sklearnserver"}))
+		})
+	})
+
+	When("A Namespace isn't labelled", func() {
+		It("Should not create a ServingEnvironment", func() {
+			const namespace = "senv-unlabelled"
+
+			createNamespace(namespace, nil)
+
+			Expect(reconcile(namespace)).To(Succeed())
+
+			_, ok := mrMockRegistry.CreatedServingEnvironment(namespace)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	When("A Namespace has an invalid tier", func() {
+		It("Should fail without creating a ServingEnvironment", func() {
+			const namespace = "senv-invalid-tier"
+
+			createNamespace(namespace, map[string]string{
+				inferenceservicecontroller.ServingEnvironmentLabel: "true",
+				inferenceservicecontroller.EnvironmentTierLabel:    "qa",
+			})
+
+			Expect(reconcile(namespace)).ToNot(Succeed())
+
+			_, ok := mrMockRegistry.CreatedServingEnvironment(namespace)
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
@@ -11,9 +11,11 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
+	kservev1alpha1 "github.com/kserve/kserve/pkg/apis/serving/v1alpha1"
 	kservev1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
 	inferenceservicecontroller "github.com/kubeflow/hub/pkg/inferenceservice-controller"
 	"github.com/kubeflow/hub/pkg/openapi"
@@ -51,14 +53,18 @@ const (
 	kserveVersion           = "v0.12.1"
 	kserveCRDParamUrl       = "https://raw.githubusercontent.com/kserve/kserve/refs/tags/%s/config/crd/serving.kserve.io_inferenceservices.yaml"
 	testCRDLocalPath        = "./testdata/crd"
+
+	kserveServingRuntimeCRDParamUrl        = "https://raw.githubusercontent.com/kserve/kserve/refs/tags/%s/config/crd/serving.kserve.io_servingruntimes.yaml"
+	kserveClusterServingRuntimeCRDParamUrl = "https://raw.githubusercontent.com/kserve/kserve/refs/tags/%s/config/crd/serving.kserve.io_clusterservingruntimes.yaml"
 )
 
 var (
-	cli          client.Client
-	envTest      *envtest.Environment
-	ctx          context.Context
-	cancel       context.CancelFunc
-	mrMockServer *httptest.Server
+	cli            client.Client
+	envTest        *envtest.Environment
+	ctx            context.Context
+	cancel         context.CancelFunc
+	mrMockServer   *httptest.Server
+	mrMockRegistry = newMockRegistry()
 )
 
 func TestAPIs(t *testing.T) {
@@ -90,6 +96,18 @@ var _ = BeforeSuite(func() {
 		Fail(err.Error())
 	}
 
+	runtimeCRDUrl := fmt.Sprintf(kserveServingRuntimeCRDParamUrl, kserveVersion)
+
+	if err := DownloadFile(runtimeCRDUrl, filepath.Join(testCRDLocalPath, "serving.kserve.io_servingruntimes.yaml")); err != nil {
+		Fail(err.Error())
+	}
+
+	clusterRuntimeCRDUrl := fmt.Sprintf(kserveClusterServingRuntimeCRDParamUrl, kserveVersion)
+
+	if err := DownloadFile(clusterRuntimeCRDUrl, filepath.Join(testCRDLocalPath, "serving.kserve.io_clusterservingruntimes.yaml")); err != nil {
+		Fail(err.Error())
+	}
+
 	// Initialize test environment:
 	By("Bootstrapping test environment")
 	envTest = &envtest.Environment{
@@ -174,6 +192,9 @@ var _ = AfterSuite(func() {
 	err = os.Remove(filepath.Join(testCRDLocalPath, "serving.kserve.io_inferenceservices.yaml"))
 	Expect(err).NotTo(HaveOccurred())
 
+	err = os.Remove(filepath.Join(testCRDLocalPath, "serving.kserve.io_servingruntimes.yaml"))
+	Expect(err).NotTo(HaveOccurred())
+
 })
 
 func ModelRegistryDefaultMockServer() *httptest.Server {
@@ -186,7 +207,13 @@ func ModelRegistryDefaultMockServer() *httptest.Server {
 		w.Header().Set("Content-Type", "application/json")
 
 		if r.Method == http.MethodGet {
-			w.WriteHeader(http.StatusNotFound)
+			if r.URL.Query().Get("name") == "" {
+				mrMockRegistry.listServingEnvironments(w)
+
+				return
+			}
+
+			mrMockRegistry.findServingEnvironment(w, r.URL.Query().Get("name"))
 
 			return
 		}
@@ -210,6 +237,7 @@ func ModelRegistryDefaultMockServer() *httptest.Server {
 			senv.Id = &id
 
 			servingEnvironments[id] = senv
+			mrMockRegistry.recordCreatedServingEnvironment(*senv)
 
 			w.WriteHeader(http.StatusCreated)
 
@@ -262,6 +290,9 @@ func ModelRegistryDefaultMockServer() *httptest.Server {
 
 		if r.Method == http.MethodPatch {
 			isvc := &openapi.InferenceService{}
+			if existing, ok := inferenceServices[id]; ok {
+				*isvc = *existing
+			}
 
 			isvcvJson, err := io.ReadAll(r.Body)
 			if err != nil {
@@ -345,12 +376,290 @@ func ModelRegistryDefaultMockServer() *httptest.Server {
 		w.WriteHeader(http.StatusOK)
 	})
 
-	return httptest.NewTLSServer(handler)
+	mrMockRegistry.registerHandlers(handler)
+
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mrMockRegistry.setAuthorization(r.Header.Get("Authorization"))
+		handler.ServeHTTP(w, r)
+	}))
+}
+
+// mockRegistry holds the model registry resources read by the deployment and
+// drift controllers, which the specs set directly.
+type mockRegistry struct {
+	mu                  sync.Mutex
+	servingEnvironments map[string]openapi.ServingEnvironment
+	inferenceServices   map[string]openapi.InferenceService
+	registeredModels    map[string]openapi.RegisteredModel
+	modelVersions       map[string]openapi.ModelVersion
+	// modelArtifacts are indexed by model version id.
+	modelArtifacts map[string]openapi.ModelArtifact
+	// createdServingEnvironments are the ServingEnvironments created through
+	// the API, indexed by name.
+	createdServingEnvironments map[string]openapi.ServingEnvironment
+	// authorization is the Authorization header of the last request.
+	authorization string
+}
+
+func newMockRegistry() *mockRegistry {
+	return &mockRegistry{
+		servingEnvironments: map[string]openapi.ServingEnvironment{},
+		inferenceServices:   map[string]openapi.InferenceService{},
+		registeredModels:    map[string]openapi.RegisteredModel{},
+		modelVersions:       map[string]openapi.ModelVersion{},
+		modelArtifacts:      map[string]openapi.ModelArtifact{},
+
+		createdServingEnvironments: map[string]openapi.ServingEnvironment{},
+	}
+}
+
+func (m *mockRegistry) SetServingEnvironment(senv openapi.ServingEnvironment) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.servingEnvironments[senv.GetId()] = senv
+}
+
+// ServingEnvironment returns the ServingEnvironment with the given id.
+func (m *mockRegistry) ServingEnvironment(id string) (openapi.ServingEnvironment, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	senv, ok := m.servingEnvironments[id]
+	return senv, ok
+}
+
+// CreatedServingEnvironment returns the ServingEnvironment named name
+// created through the API.
+func (m *mockRegistry) CreatedServingEnvironment(name string) (openapi.ServingEnvironment, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	senv, ok := m.createdServingEnvironments[name]
+	return senv, ok
+}
+
+func (m *mockRegistry) recordCreatedServingEnvironment(senv openapi.ServingEnvironment) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.createdServingEnvironments[senv.Name] = senv
+}
+
+func (m *mockRegistry) SetInferenceService(is openapi.InferenceService) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.inferenceServices[is.GetId()] = is
+}
+
+// InferenceService returns the InferenceService with the given id.
+func (m *mockRegistry) InferenceService(id string) (openapi.InferenceService, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	is, ok := m.inferenceServices[id]
+	return is, ok
+}
+
+func (m *mockRegistry) SetRegisteredModel(rm openapi.RegisteredModel) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.registeredModels[rm.GetId()] = rm
+}
+
+func (m *mockRegistry) SetModelVersion(mv openapi.ModelVersion, artifact openapi.ModelArtifact) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.modelVersions[mv.GetId()] = mv
+	m.modelArtifacts[mv.GetId()] = artifact
+}
+
+func (m *mockRegistry) setAuthorization(authorization string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.authorization = authorization
+}
+
+// Authorization returns the Authorization header of the last request.
+func (m *mockRegistry) Authorization() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.authorization
+}
+
+func (m *mockRegistry) listServingEnvironments(w http.ResponseWriter) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	list := openapi.ServingEnvironmentList{Items: []openapi.ServingEnvironment{}}
+	for _, senv := range m.servingEnvironments {
+		list.Items = append(list.Items, senv)
+	}
+	list.Size = int32(len(list.Items))
+
+	writeJSON(w, http.StatusOK, list)
+}
+
+func (m *mockRegistry) findServingEnvironment(w http.ResponseWriter, name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, senv := range m.servingEnvironments {
+		if senv.Name == name {
+			writeJSON(w, http.StatusOK, senv)
+
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNotFound)
+}
+
+func (m *mockRegistry) registerHandlers(handler *http.ServeMux) {
+	const prefix = "/api/model_registry/v1alpha3"
+
+	get := func(pattern string, fn func(id string) (any, bool)) {
+		handler.HandleFunc(prefix+pattern, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+
+				return
+			}
+
+			m.mu.Lock()
+			res, ok := fn(r.PathValue("id"))
+			m.mu.Unlock()
+
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+
+				return
+			}
+
+			writeJSON(w, http.StatusOK, res)
+		})
+	}
+
+	handler.HandleFunc(prefix+"/inference_services/{id}", func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		is, ok := m.inferenceServices[r.PathValue("id")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPatch:
+			update := openapi.InferenceServiceUpdate{}
+			if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+
+				return
+			}
+
+			if update.DesiredState != nil {
+				is.DesiredState = update.DesiredState
+			}
+			if update.ActualState != nil {
+				is.ActualState = update.ActualState
+			}
+
+			m.inferenceServices[is.GetId()] = is
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+
+			return
+		}
+
+		writeJSON(w, http.StatusOK, is)
+	})
+
+	handler.HandleFunc("PATCH "+prefix+"/serving_environments/{id}", func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		senv, ok := m.servingEnvironments[r.PathValue("id")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		update := openapi.ServingEnvironmentUpdate{}
+		if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		// Fields missing from the update are kept, like the model registry
+		// does.
+		if update.ClusterId != nil {
+			senv.ClusterId = update.ClusterId
+		}
+		if update.Tier != nil {
+			senv.Tier = update.Tier
+		}
+		if update.AllowedRuntimes != nil {
+			senv.AllowedRuntimes = update.AllowedRuntimes
+		}
+		if update.Quotas != nil {
+			senv.Quotas = update.Quotas
+		}
+
+		m.servingEnvironments[senv.GetId()] = senv
+
+		writeJSON(w, http.StatusOK, senv)
+	})
+
+	get("/serving_environments/{id}/inference_services", func(id string) (any, bool) {
+		list := openapi.InferenceServiceList{Items: []openapi.InferenceService{}}
+		for _, is := range m.inferenceServices {
+			if is.ServingEnvironmentId == id {
+				list.Items = append(list.Items, is)
+			}
+		}
+		list.Size = int32(len(list.Items))
+		return list, true
+	})
+
+	get("/registered_models/{id}", func(id string) (any, bool) {
+		rm, ok := m.registeredModels[id]
+		return rm, ok
+	})
+
+	get("/model_versions/{id}", func(id string) (any, bool) {
+		mv, ok := m.modelVersions[id]
+		return mv, ok
+	})
+
+	get("/model_versions/{id}/artifacts", func(id string) (any, bool) {
+		list := openapi.ArtifactList{Items: []openapi.Artifact{}}
+		if artifact, ok := m.modelArtifacts[id]; ok {
+			list.Items = append(list.Items, openapi.ModelArtifactAsArtifact(&artifact))
+		}
+		list.Size = int32(len(list.Items))
+		return list, true
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	res, err := json.Marshal(v)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_, _ = w.Write(res)
 }
 
 func RegisterSchemes(s *runtime.Scheme) {
 	utilruntime.Must(clientgoscheme.AddToScheme(s))
 	utilruntime.Must(kservev1beta1.AddToScheme(s))
+	utilruntime.Must(kservev1alpha1.AddToScheme(s))
 	utilruntime.Must(corev1.AddToScheme(s))
 	utilruntime.Must(authv1.AddToScheme(s))
 }
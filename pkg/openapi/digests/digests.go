@@ -0,0 +1,70 @@
+// Package digests verifies the content of model artifacts against the digests recorded on them.
+// It's shared by the model registry server and the clients that download model artifacts, and
+// isn't generated like the rest of the openapi module.
+package digests
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/kubeflow/hub/pkg/openapi"
+)
+
+// Recorded returns whether the digest of the content of the model artifact or of its files is
+// recorded.
+func Recorded(artifact *openapi.ModelArtifact) bool {
+	return artifact.Digest != nil || len(artifact.FileDigests) > 0
+}
+
+// Verify checks a manifest of the content of the model artifact, e.g. computed after downloading
+// it, against its file digests or, without them, against its digest as the content of a single
+// file, whatever its name. The error lists every file that doesn't match; callers wrap it in their
+// own mismatch error.
+func Verify(artifact *openapi.ModelArtifact, manifest []openapi.ArtifactFileDigest) error {
+	recorded := artifact.FileDigests
+	if len(recorded) == 0 && artifact.Digest != nil {
+		if len(manifest) != 1 {
+			return fmt.Errorf("the model artifact is made of a single file but the manifest has %d files", len(manifest))
+		}
+		recorded = []openapi.ArtifactFileDigest{{
+			Path:      manifest[0].Path,
+			Algorithm: artifact.Digest.Algorithm,
+			Value:     artifact.Digest.Value,
+		}}
+	}
+	if len(recorded) == 0 {
+		return errors.New("the model artifact has no digest to verify against")
+	}
+
+	files := make(map[string]openapi.ArtifactFileDigest, len(manifest))
+	for _, file := range manifest {
+		files[file.Path] = file
+	}
+
+	var mismatches []string
+	for _, expected := range recorded {
+		actual, ok := files[expected.Path]
+		delete(files, expected.Path)
+
+		switch {
+		case !ok:
+			mismatches = append(mismatches, fmt.Sprintf("%s is missing", expected.Path))
+		case actual.Algorithm != expected.Algorithm:
+			mismatches = append(mismatches, fmt.Sprintf("%s has a %s digest instead of %s", expected.Path, actual.Algorithm, expected.Algorithm))
+		case !strings.EqualFold(actual.Value, expected.Value):
+			mismatches = append(mismatches, fmt.Sprintf("%s has %s digest %s instead of %s", expected.Path, actual.Algorithm, actual.Value, expected.Value))
+		}
+	}
+	for path := range files {
+		mismatches = append(mismatches, fmt.Sprintf("%s is unexpected", path))
+	}
+
+	if len(mismatches) > 0 {
+		slices.Sort(mismatches)
+		return errors.New(strings.Join(mismatches, ", "))
+	}
+
+	return nil
+}
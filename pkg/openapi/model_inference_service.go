@@ -36,12 +36,18 @@ type InferenceService struct {
 	// ID of the `ModelVersion` to serve. If it's unspecified, then the latest `ModelVersion` by creation order will be served.
 	ModelVersionId *string `json:"modelVersionId,omitempty" validate:"regexp=^[1-9][0-9]{0,8}$"`
 	// Model runtime.
-	Runtime      *string                `json:"runtime,omitempty"`
-	DesiredState *InferenceServiceState `json:"desiredState,omitempty"`
+	Runtime          *string                           `json:"runtime,omitempty"`
+	DesiredState     *InferenceServiceState            `json:"desiredState,omitempty"`
+	ActualState      *InferenceServiceActualState      `json:"actualState,omitempty"`
+	DeploymentStatus *InferenceServiceDeploymentStatus `json:"deploymentStatus,omitempty"`
+	// Weighted set of `ModelVersions` to serve, e.g. to roll out a new version as a canary. It has at most two `ModelVersions` of the inference service's `RegisteredModel`, and the percentages must add up to 100. When set, it takes precedence over `modelVersionId`. Set it to an empty list to stop splitting the traffic.
+	TrafficSplit []InferenceServiceTrafficSplit `json:"trafficSplit,omitempty"`
 	// ID of the `RegisteredModel` to serve.
 	RegisteredModelId string `json:"registeredModelId" validate:"regexp=^[1-9][0-9]{0,8}$"`
 	// ID of the parent `ServingEnvironment` for this `InferenceService` entity.
 	ServingEnvironmentId string `json:"servingEnvironmentId" validate:"regexp=^[1-9][0-9]{0,8}$"`
+	// Output only. Whether the `actualState` reported for the `InferenceService` disagrees with its `desiredState`.
+	StateDrift *bool `json:"stateDrift,omitempty"`
 }
 
 type _InferenceService InferenceService
@@ -389,6 +395,70 @@ func (o *InferenceService) SetDesiredState(v InferenceServiceState) {
 	o.DesiredState = &v
 }
 
+// GetActualState returns the ActualState field value if set, zero value otherwise.
+func (o *InferenceService) GetActualState() InferenceServiceActualState {
+	if o == nil || IsNil(o.ActualState) {
+		var ret InferenceServiceActualState
+		return ret
+	}
+	return *o.ActualState
+}
+
+// GetActualStateOk returns a tuple with the ActualState field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *InferenceService) GetActualStateOk() (*InferenceServiceActualState, bool) {
+	if o == nil || IsNil(o.ActualState) {
+		return nil, false
+	}
+	return o.ActualState, true
+}
+
+// HasActualState returns a boolean if a field has been set.
+func (o *InferenceService) HasActualState() bool {
+	if o != nil && !IsNil(o.ActualState) {
+		return true
+	}
+
+	return false
+}
+
+// SetActualState gets a reference to the given InferenceServiceActualState and assigns it to the ActualState field.
+func (o *InferenceService) SetActualState(v InferenceServiceActualState) {
+	o.ActualState = &v
+}
+
+// GetDeploymentStatus returns the DeploymentStatus field value if set, zero value otherwise.
+func (o *InferenceService) GetDeploymentStatus() InferenceServiceDeploymentStatus {
+	if o == nil || IsNil(o.DeploymentStatus) {
+		var ret InferenceServiceDeploymentStatus
+		return ret
+	}
+	return *o.DeploymentStatus
+}
+
+// GetDeploymentStatusOk returns a tuple with the DeploymentStatus field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *InferenceService) GetDeploymentStatusOk() (*InferenceServiceDeploymentStatus, bool) {
+	if o == nil || IsNil(o.DeploymentStatus) {
+		return nil, false
+	}
+	return o.DeploymentStatus, true
+}
+
+// HasDeploymentStatus returns a boolean if a field has been set.
+func (o *InferenceService) HasDeploymentStatus() bool {
+	if o != nil && !IsNil(o.DeploymentStatus) {
+		return true
+	}
+
+	return false
+}
+
+// SetDeploymentStatus gets a reference to the given InferenceServiceDeploymentStatus and assigns it to the DeploymentStatus field.
+func (o *InferenceService) SetDeploymentStatus(v InferenceServiceDeploymentStatus) {
+	o.DeploymentStatus = &v
+}
+
 // GetRegisteredModelId returns the RegisteredModelId field value
 func (o *InferenceService) GetRegisteredModelId() string {
 	if o == nil {
@@ -437,6 +507,70 @@ func (o *InferenceService) SetServingEnvironmentId(v string) {
 	o.ServingEnvironmentId = v
 }
 
+// GetStateDrift returns the StateDrift field value if set, zero value otherwise.
+func (o *InferenceService) GetStateDrift() bool {
+	if o == nil || IsNil(o.StateDrift) {
+		var ret bool
+		return ret
+	}
+	return *o.StateDrift
+}
+
+// GetStateDriftOk returns a tuple with the StateDrift field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *InferenceService) GetStateDriftOk() (*bool, bool) {
+	if o == nil || IsNil(o.StateDrift) {
+		return nil, false
+	}
+	return o.StateDrift, true
+}
+
+// HasStateDrift returns a boolean if a field has been set.
+func (o *InferenceService) HasStateDrift() bool {
+	if o != nil && !IsNil(o.StateDrift) {
+		return true
+	}
+
+	return false
+}
+
+// SetStateDrift gets a reference to the given bool and assigns it to the StateDrift field.
+func (o *InferenceService) SetStateDrift(v bool) {
+	o.StateDrift = &v
+}
+
+// GetTrafficSplit returns the TrafficSplit field value if set, zero value otherwise.
+func (o *InferenceService) GetTrafficSplit() []InferenceServiceTrafficSplit {
+	if o == nil || IsNil(o.TrafficSplit) {
+		var ret []InferenceServiceTrafficSplit
+		return ret
+	}
+	return o.TrafficSplit
+}
+
+// GetTrafficSplitOk returns a tuple with the TrafficSplit field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *InferenceService) GetTrafficSplitOk() ([]InferenceServiceTrafficSplit, bool) {
+	if o == nil || IsNil(o.TrafficSplit) {
+		return nil, false
+	}
+	return o.TrafficSplit, true
+}
+
+// HasTrafficSplit returns a boolean if a field has been set.
+func (o *InferenceService) HasTrafficSplit() bool {
+	if o != nil && !IsNil(o.TrafficSplit) {
+		return true
+	}
+
+	return false
+}
+
+// SetTrafficSplit gets a reference to the given []InferenceServiceTrafficSplit and assigns it to the TrafficSplit field.
+func (o *InferenceService) SetTrafficSplit(v []InferenceServiceTrafficSplit) {
+	o.TrafficSplit = v
+}
+
 func (o InferenceService) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -477,8 +611,20 @@ func (o InferenceService) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.DesiredState) {
 		toSerialize["desiredState"] = o.DesiredState
 	}
+	if !IsNil(o.ActualState) {
+		toSerialize["actualState"] = o.ActualState
+	}
+	if !IsNil(o.DeploymentStatus) {
+		toSerialize["deploymentStatus"] = o.DeploymentStatus
+	}
+	if !IsNil(o.TrafficSplit) {
+		toSerialize["trafficSplit"] = o.TrafficSplit
+	}
 	toSerialize["registeredModelId"] = o.RegisteredModelId
 	toSerialize["servingEnvironmentId"] = o.ServingEnvironmentId
+	if !IsNil(o.StateDrift) {
+		toSerialize["stateDrift"] = o.StateDrift
+	}
 	return toSerialize, nil
 }
 
@@ -30,8 +30,12 @@ type InferenceServiceCreate struct {
 	// ID of the `ModelVersion` to serve. If it's unspecified, then the latest `ModelVersion` by creation order will be served.
 	ModelVersionId *string `json:"modelVersionId,omitempty" validate:"regexp=^[1-9][0-9]{0,8}$"`
 	// Model runtime.
-	Runtime      *string                `json:"runtime,omitempty"`
-	DesiredState *InferenceServiceState `json:"desiredState,omitempty"`
+	Runtime          *string                           `json:"runtime,omitempty"`
+	DesiredState     *InferenceServiceState            `json:"desiredState,omitempty"`
+	ActualState      *InferenceServiceActualState      `json:"actualState,omitempty"`
+	DeploymentStatus *InferenceServiceDeploymentStatus `json:"deploymentStatus,omitempty"`
+	// Weighted set of `ModelVersions` to serve, e.g. to roll out a new version as a canary. The percentages must add up to 100. When set, it takes precedence over `modelVersionId`. Set it to an empty list to stop splitting the traffic.
+	TrafficSplit []InferenceServiceTrafficSplit `json:"trafficSplit,omitempty"`
 	// ID of the `RegisteredModel` to serve.
 	RegisteredModelId string `json:"registeredModelId" validate:"regexp=^[1-9][0-9]{0,8}$"`
 	// ID of the parent `ServingEnvironment` for this `InferenceService` entity.
@@ -287,6 +291,70 @@ func (o *InferenceServiceCreate) SetDesiredState(v InferenceServiceState) {
 	o.DesiredState = &v
 }
 
+// GetActualState returns the ActualState field value if set, zero value otherwise.
+func (o *InferenceServiceCreate) GetActualState() InferenceServiceActualState {
+	if o == nil || IsNil(o.ActualState) {
+		var ret InferenceServiceActualState
+		return ret
+	}
+	return *o.ActualState
+}
+
+// GetActualStateOk returns a tuple with the ActualState field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *InferenceServiceCreate) GetActualStateOk() (*InferenceServiceActualState, bool) {
+	if o == nil || IsNil(o.ActualState) {
+		return nil, false
+	}
+	return o.ActualState, true
+}
+
+// HasActualState returns a boolean if a field has been set.
+func (o *InferenceServiceCreate) HasActualState() bool {
+	if o != nil && !IsNil(o.ActualState) {
+		return true
+	}
+
+	return false
+}
+
+// SetActualState gets a reference to the given InferenceServiceActualState and assigns it to the ActualState field.
+func (o *InferenceServiceCreate) SetActualState(v InferenceServiceActualState) {
+	o.ActualState = &v
+}
+
+// GetDeploymentStatus returns the DeploymentStatus field value if set, zero value otherwise.
+func (o *InferenceServiceCreate) GetDeploymentStatus() InferenceServiceDeploymentStatus {
+	if o == nil || IsNil(o.DeploymentStatus) {
+		var ret InferenceServiceDeploymentStatus
+		return ret
+	}
+	return *o.DeploymentStatus
+}
+
+// GetDeploymentStatusOk returns a tuple with the DeploymentStatus field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *InferenceServiceCreate) GetDeploymentStatusOk() (*InferenceServiceDeploymentStatus, bool) {
+	if o == nil || IsNil(o.DeploymentStatus) {
+		return nil, false
+	}
+	return o.DeploymentStatus, true
+}
+
+// HasDeploymentStatus returns a boolean if a field has been set.
+func (o *InferenceServiceCreate) HasDeploymentStatus() bool {
+	if o != nil && !IsNil(o.DeploymentStatus) {
+		return true
+	}
+
+	return false
+}
+
+// SetDeploymentStatus gets a reference to the given InferenceServiceDeploymentStatus and assigns it to the DeploymentStatus field.
+func (o *InferenceServiceCreate) SetDeploymentStatus(v InferenceServiceDeploymentStatus) {
+	o.DeploymentStatus = &v
+}
+
 // GetRegisteredModelId returns the RegisteredModelId field value
 func (o *InferenceServiceCreate) GetRegisteredModelId() string {
 	if o == nil {
@@ -335,6 +403,38 @@ func (o *InferenceServiceCreate) SetServingEnvironmentId(v string) {
 	o.ServingEnvironmentId = v
 }
 
+// GetTrafficSplit returns the TrafficSplit field value if set, zero value otherwise.
+func (o *InferenceServiceCreate) GetTrafficSplit() []InferenceServiceTrafficSplit {
+	if o == nil || IsNil(o.TrafficSplit) {
+		var ret []InferenceServiceTrafficSplit
+		return ret
+	}
+	return o.TrafficSplit
+}
+
+// GetTrafficSplitOk returns a tuple with the TrafficSplit field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *InferenceServiceCreate) GetTrafficSplitOk() ([]InferenceServiceTrafficSplit, bool) {
+	if o == nil || IsNil(o.TrafficSplit) {
+		return nil, false
+	}
+	return o.TrafficSplit, true
+}
+
+// HasTrafficSplit returns a boolean if a field has been set.
+func (o *InferenceServiceCreate) HasTrafficSplit() bool {
+	if o != nil && !IsNil(o.TrafficSplit) {
+		return true
+	}
+
+	return false
+}
+
+// SetTrafficSplit gets a reference to the given []InferenceServiceTrafficSplit and assigns it to the TrafficSplit field.
+func (o *InferenceServiceCreate) SetTrafficSplit(v []InferenceServiceTrafficSplit) {
+	o.TrafficSplit = v
+}
+
 func (o InferenceServiceCreate) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -366,6 +466,15 @@ func (o InferenceServiceCreate) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.DesiredState) {
 		toSerialize["desiredState"] = o.DesiredState
 	}
+	if !IsNil(o.ActualState) {
+		toSerialize["actualState"] = o.ActualState
+	}
+	if !IsNil(o.DeploymentStatus) {
+		toSerialize["deploymentStatus"] = o.DeploymentStatus
+	}
+	if !IsNil(o.TrafficSplit) {
+		toSerialize["trafficSplit"] = o.TrafficSplit
+	}
 	toSerialize["registeredModelId"] = o.RegisteredModelId
 	toSerialize["servingEnvironmentId"] = o.ServingEnvironmentId
 	return toSerialize, nil
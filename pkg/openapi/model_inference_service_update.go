@@ -28,8 +28,12 @@ type InferenceServiceUpdate struct {
 	// ID of the `ModelVersion` to serve. If it's unspecified, then the latest `ModelVersion` by creation order will be served.
 	ModelVersionId *string `json:"modelVersionId,omitempty" validate:"regexp=^[1-9][0-9]{0,8}$"`
 	// Model runtime.
-	Runtime      *string                `json:"runtime,omitempty"`
-	DesiredState *InferenceServiceState `json:"desiredState,omitempty"`
+	Runtime          *string                           `json:"runtime,omitempty"`
+	DesiredState     *InferenceServiceState            `json:"desiredState,omitempty"`
+	ActualState      *InferenceServiceActualState      `json:"actualState,omitempty"`
+	DeploymentStatus *InferenceServiceDeploymentStatus `json:"deploymentStatus,omitempty"`
+	// Weighted set of `ModelVersions` to serve, e.g. to roll out a new version as a canary. The percentages must add up to 100. When set, it takes precedence over `modelVersionId`. Set it to an empty list to stop splitting the traffic.
+	TrafficSplit []InferenceServiceTrafficSplit `json:"trafficSplit,omitempty"`
 }
 
 // NewInferenceServiceUpdate instantiates a new InferenceServiceUpdate object
@@ -245,6 +249,102 @@ func (o *InferenceServiceUpdate) SetDesiredState(v InferenceServiceState) {
 	o.DesiredState = &v
 }
 
+// GetActualState returns the ActualState field value if set, zero value otherwise.
+func (o *InferenceServiceUpdate) GetActualState() InferenceServiceActualState {
+	if o == nil || IsNil(o.ActualState) {
+		var ret InferenceServiceActualState
+		return ret
+	}
+	return *o.ActualState
+}
+
+// GetActualStateOk returns a tuple with the ActualState field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *InferenceServiceUpdate) GetActualStateOk() (*InferenceServiceActualState, bool) {
+	if o == nil || IsNil(o.ActualState) {
+		return nil, false
+	}
+	return o.ActualState, true
+}
+
+// HasActualState returns a boolean if a field has been set.
+func (o *InferenceServiceUpdate) HasActualState() bool {
+	if o != nil && !IsNil(o.ActualState) {
+		return true
+	}
+
+	return false
+}
+
+// SetActualState gets a reference to the given InferenceServiceActualState and assigns it to the ActualState field.
+func (o *InferenceServiceUpdate) SetActualState(v InferenceServiceActualState) {
+	o.ActualState = &v
+}
+
+// GetDeploymentStatus returns the DeploymentStatus field value if set, zero value otherwise.
+func (o *InferenceServiceUpdate) GetDeploymentStatus() InferenceServiceDeploymentStatus {
+	if o == nil || IsNil(o.DeploymentStatus) {
+		var ret InferenceServiceDeploymentStatus
+		return ret
+	}
+	return *o.DeploymentStatus
+}
+
+// GetDeploymentStatusOk returns a tuple with the DeploymentStatus field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *InferenceServiceUpdate) GetDeploymentStatusOk() (*InferenceServiceDeploymentStatus, bool) {
+	if o == nil || IsNil(o.DeploymentStatus) {
+		return nil, false
+	}
+	return o.DeploymentStatus, true
+}
+
+// HasDeploymentStatus returns a boolean if a field has been set.
+func (o *InferenceServiceUpdate) HasDeploymentStatus() bool {
+	if o != nil && !IsNil(o.DeploymentStatus) {
+		return true
+	}
+
+	return false
+}
+
+// SetDeploymentStatus gets a reference to the given InferenceServiceDeploymentStatus and assigns it to the DeploymentStatus field.
+func (o *InferenceServiceUpdate) SetDeploymentStatus(v InferenceServiceDeploymentStatus) {
+	o.DeploymentStatus = &v
+}
+
+// GetTrafficSplit returns the TrafficSplit field value if set, zero value otherwise.
+func (o *InferenceServiceUpdate) GetTrafficSplit() []InferenceServiceTrafficSplit {
+	if o == nil || IsNil(o.TrafficSplit) {
+		var ret []InferenceServiceTrafficSplit
+		return ret
+	}
+	return o.TrafficSplit
+}
+
+// GetTrafficSplitOk returns a tuple with the TrafficSplit field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *InferenceServiceUpdate) GetTrafficSplitOk() ([]InferenceServiceTrafficSplit, bool) {
+	if o == nil || IsNil(o.TrafficSplit) {
+		return nil, false
+	}
+	return o.TrafficSplit, true
+}
+
+// HasTrafficSplit returns a boolean if a field has been set.
+func (o *InferenceServiceUpdate) HasTrafficSplit() bool {
+	if o != nil && !IsNil(o.TrafficSplit) {
+		return true
+	}
+
+	return false
+}
+
+// SetTrafficSplit gets a reference to the given []InferenceServiceTrafficSplit and assigns it to the TrafficSplit field.
+func (o *InferenceServiceUpdate) SetTrafficSplit(v []InferenceServiceTrafficSplit) {
+	o.TrafficSplit = v
+}
+
 func (o InferenceServiceUpdate) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -273,6 +373,15 @@ func (o InferenceServiceUpdate) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.DesiredState) {
 		toSerialize["desiredState"] = o.DesiredState
 	}
+	if !IsNil(o.ActualState) {
+		toSerialize["actualState"] = o.ActualState
+	}
+	if !IsNil(o.DeploymentStatus) {
+		toSerialize["deploymentStatus"] = o.DeploymentStatus
+	}
+	if !IsNil(o.TrafficSplit) {
+		toSerialize["trafficSplit"] = o.TrafficSplit
+	}
 	return toSerialize, nil
 }
 
@@ -47,8 +47,13 @@ type ModelArtifactUpdate struct {
 	// A human-readable name for the source model.  E.g. `my-project/1`, `ibm-granite/granite-3.1-8b-base:2.1.2`.
 	ModelSourceName *string `json:"modelSourceName,omitempty"`
 	// The uniform resource identifier of the physical artifact. May be empty if there is no physical artifact.
-	Uri   *string        `json:"uri,omitempty"`
-	State *ArtifactState `json:"state,omitempty"`
+	Uri    *string         `json:"uri,omitempty"`
+	State  *ArtifactState  `json:"state,omitempty"`
+	Digest *ArtifactDigest `json:"digest,omitempty"`
+	// Manifest of the content of the artifact, the digest of each of its files. Set it to an empty list to clear it.
+	FileDigests []ArtifactFileDigest `json:"fileDigests,omitempty"`
+	// References to the signatures and attestations of the content of the artifact. Set it to an empty list to clear them.
+	Signatures []ArtifactSignature `json:"signatures,omitempty"`
 }
 
 // NewModelArtifactUpdate instantiates a new ModelArtifactUpdate object
@@ -588,6 +593,102 @@ func (o *ModelArtifactUpdate) SetState(v ArtifactState) {
 	o.State = &v
 }
 
+// GetDigest returns the Digest field value if set, zero value otherwise.
+func (o *ModelArtifactUpdate) GetDigest() ArtifactDigest {
+	if o == nil || IsNil(o.Digest) {
+		var ret ArtifactDigest
+		return ret
+	}
+	return *o.Digest
+}
+
+// GetDigestOk returns a tuple with the Digest field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ModelArtifactUpdate) GetDigestOk() (*ArtifactDigest, bool) {
+	if o == nil || IsNil(o.Digest) {
+		return nil, false
+	}
+	return o.Digest, true
+}
+
+// HasDigest returns a boolean if a field has been set.
+func (o *ModelArtifactUpdate) HasDigest() bool {
+	if o != nil && !IsNil(o.Digest) {
+		return true
+	}
+
+	return false
+}
+
+// SetDigest gets a reference to the given ArtifactDigest and assigns it to the Digest field.
+func (o *ModelArtifactUpdate) SetDigest(v ArtifactDigest) {
+	o.Digest = &v
+}
+
+// GetFileDigests returns the FileDigests field value if set, zero value otherwise.
+func (o *ModelArtifactUpdate) GetFileDigests() []ArtifactFileDigest {
+	if o == nil || IsNil(o.FileDigests) {
+		var ret []ArtifactFileDigest
+		return ret
+	}
+	return o.FileDigests
+}
+
+// GetFileDigestsOk returns a tuple with the FileDigests field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ModelArtifactUpdate) GetFileDigestsOk() ([]ArtifactFileDigest, bool) {
+	if o == nil || IsNil(o.FileDigests) {
+		return nil, false
+	}
+	return o.FileDigests, true
+}
+
+// HasFileDigests returns a boolean if a field has been set.
+func (o *ModelArtifactUpdate) HasFileDigests() bool {
+	if o != nil && !IsNil(o.FileDigests) {
+		return true
+	}
+
+	return false
+}
+
+// SetFileDigests gets a reference to the given []ArtifactFileDigest and assigns it to the FileDigests field.
+func (o *ModelArtifactUpdate) SetFileDigests(v []ArtifactFileDigest) {
+	o.FileDigests = v
+}
+
+// GetSignatures returns the Signatures field value if set, zero value otherwise.
+func (o *ModelArtifactUpdate) GetSignatures() []ArtifactSignature {
+	if o == nil || IsNil(o.Signatures) {
+		var ret []ArtifactSignature
+		return ret
+	}
+	return o.Signatures
+}
+
+// GetSignaturesOk returns a tuple with the Signatures field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ModelArtifactUpdate) GetSignaturesOk() ([]ArtifactSignature, bool) {
+	if o == nil || IsNil(o.Signatures) {
+		return nil, false
+	}
+	return o.Signatures, true
+}
+
+// HasSignatures returns a boolean if a field has been set.
+func (o *ModelArtifactUpdate) HasSignatures() bool {
+	if o != nil && !IsNil(o.Signatures) {
+		return true
+	}
+
+	return false
+}
+
+// SetSignatures gets a reference to the given []ArtifactSignature and assigns it to the Signatures field.
+func (o *ModelArtifactUpdate) SetSignatures(v []ArtifactSignature) {
+	o.Signatures = v
+}
+
 func (o ModelArtifactUpdate) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -646,6 +747,15 @@ func (o ModelArtifactUpdate) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.State) {
 		toSerialize["state"] = o.State
 	}
+	if !IsNil(o.Digest) {
+		toSerialize["digest"] = o.Digest
+	}
+	if !IsNil(o.FileDigests) {
+		toSerialize["fileDigests"] = o.FileDigests
+	}
+	if !IsNil(o.Signatures) {
+		toSerialize["signatures"] = o.Signatures
+	}
 	return toSerialize, nil
 }
 
@@ -27,6 +27,13 @@ type ServingEnvironmentCreate struct {
 	ExternalId *string `json:"externalId,omitempty"`
 	// The name of the ServingEnvironment.
 	Name string `json:"name"`
+	// ID of the Kubernetes cluster hosting the serving environment.
+	ClusterId *string                 `json:"clusterId,omitempty"`
+	Tier      *ServingEnvironmentTier `json:"tier,omitempty"`
+	// Names of the `ServingRuntimes` and `ClusterServingRuntimes` the `InferenceServices` of the serving environment can use. Any runtime is allowed when it's not set, set it to an empty list to allow any runtime again. `InferenceServices` without a runtime are always allowed, as KServe auto-selects it.
+	AllowedRuntimes []string `json:"allowedRuntimes,omitempty"`
+	// Resource quotas of the serving environment, as Kubernetes quantities by resource name, e.g. `requests.nvidia.com/gpu`. Set it to an empty object to clear it.
+	Quotas map[string]string `json:"quotas,omitempty"`
 }
 
 type _ServingEnvironmentCreate ServingEnvironmentCreate
@@ -169,6 +176,134 @@ func (o *ServingEnvironmentCreate) SetName(v string) {
 	o.Name = v
 }
 
+// GetClusterId returns the ClusterId field value if set, zero value otherwise.
+func (o *ServingEnvironmentCreate) GetClusterId() string {
+	if o == nil || IsNil(o.ClusterId) {
+		var ret string
+		return ret
+	}
+	return *o.ClusterId
+}
+
+// GetClusterIdOk returns a tuple with the ClusterId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServingEnvironmentCreate) GetClusterIdOk() (*string, bool) {
+	if o == nil || IsNil(o.ClusterId) {
+		return nil, false
+	}
+	return o.ClusterId, true
+}
+
+// HasClusterId returns a boolean if a field has been set.
+func (o *ServingEnvironmentCreate) HasClusterId() bool {
+	if o != nil && !IsNil(o.ClusterId) {
+		return true
+	}
+
+	return false
+}
+
+// SetClusterId gets a reference to the given string and assigns it to the ClusterId field.
+func (o *ServingEnvironmentCreate) SetClusterId(v string) {
+	o.ClusterId = &v
+}
+
+// GetTier returns the Tier field value if set, zero value otherwise.
+func (o *ServingEnvironmentCreate) GetTier() ServingEnvironmentTier {
+	if o == nil || IsNil(o.Tier) {
+		var ret ServingEnvironmentTier
+		return ret
+	}
+	return *o.Tier
+}
+
+// GetTierOk returns a tuple with the Tier field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServingEnvironmentCreate) GetTierOk() (*ServingEnvironmentTier, bool) {
+	if o == nil || IsNil(o.Tier) {
+		return nil, false
+	}
+	return o.Tier, true
+}
+
+// HasTier returns a boolean if a field has been set.
+func (o *ServingEnvironmentCreate) HasTier() bool {
+	if o != nil && !IsNil(o.Tier) {
+		return true
+	}
+
+	return false
+}
+
+// SetTier gets a reference to the given ServingEnvironmentTier and assigns it to the Tier field.
+func (o *ServingEnvironmentCreate) SetTier(v ServingEnvironmentTier) {
+	o.Tier = &v
+}
+
+// GetAllowedRuntimes returns the AllowedRuntimes field value if set, zero value otherwise.
+func (o *ServingEnvironmentCreate) GetAllowedRuntimes() []string {
+	if o == nil || IsNil(o.AllowedRuntimes) {
+		var ret []string
+		return ret
+	}
+	return o.AllowedRuntimes
+}
+
+// GetAllowedRuntimesOk returns a tuple with the AllowedRuntimes field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServingEnvironmentCreate) GetAllowedRuntimesOk() ([]string, bool) {
+	if o == nil || IsNil(o.AllowedRuntimes) {
+		return nil, false
+	}
+	return o.AllowedRuntimes, true
+}
+
+// HasAllowedRuntimes returns a boolean if a field has been set.
+func (o *ServingEnvironmentCreate) HasAllowedRuntimes() bool {
+	if o != nil && !IsNil(o.AllowedRuntimes) {
+		return true
+	}
+
+	return false
+}
+
+// SetAllowedRuntimes gets a reference to the given []string and assigns it to the AllowedRuntimes field.
+func (o *ServingEnvironmentCreate) SetAllowedRuntimes(v []string) {
+	o.AllowedRuntimes = v
+}
+
+// GetQuotas returns the Quotas field value if set, zero value otherwise.
+func (o *ServingEnvironmentCreate) GetQuotas() map[string]string {
+	if o == nil || IsNil(o.Quotas) {
+		var ret map[string]string
+		return ret
+	}
+	return o.Quotas
+}
+
+// GetQuotasOk returns a tuple with the Quotas field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServingEnvironmentCreate) GetQuotasOk() (map[string]string, bool) {
+	if o == nil || IsNil(o.Quotas) {
+		return map[string]string{}, false
+	}
+	return o.Quotas, true
+}
+
+// HasQuotas returns a boolean if a field has been set.
+func (o *ServingEnvironmentCreate) HasQuotas() bool {
+	if o != nil && !IsNil(o.Quotas) {
+		return true
+	}
+
+	return false
+}
+
+// SetQuotas gets a reference to the given map[string]string and assigns it to the Quotas field.
+func (o *ServingEnvironmentCreate) SetQuotas(v map[string]string) {
+	o.Quotas = v
+}
+
 func (o ServingEnvironmentCreate) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -189,6 +324,18 @@ func (o ServingEnvironmentCreate) ToMap() (map[string]interface{}, error) {
 		toSerialize["externalId"] = o.ExternalId
 	}
 	toSerialize["name"] = o.Name
+	if !IsNil(o.ClusterId) {
+		toSerialize["clusterId"] = o.ClusterId
+	}
+	if !IsNil(o.Tier) {
+		toSerialize["tier"] = o.Tier
+	}
+	if !IsNil(o.AllowedRuntimes) {
+		toSerialize["allowedRuntimes"] = o.AllowedRuntimes
+	}
+	if !IsNil(o.Quotas) {
+		toSerialize["quotas"] = o.Quotas
+	}
 	return toSerialize, nil
 }
 
@@ -25,6 +25,13 @@ type ServingEnvironmentUpdate struct {
 	Description *string `json:"description,omitempty"`
 	// The external id that come from the clients’ system. This field is optional. If set, it must be unique among all resources within a database instance.
 	ExternalId *string `json:"externalId,omitempty"`
+	// ID of the Kubernetes cluster hosting the serving environment.
+	ClusterId *string                 `json:"clusterId,omitempty"`
+	Tier      *ServingEnvironmentTier `json:"tier,omitempty"`
+	// Names of the `ServingRuntimes` and `ClusterServingRuntimes` the `InferenceServices` of the serving environment can use. Any runtime is allowed when it's not set, set it to an empty list to allow any runtime again. `InferenceServices` without a runtime are always allowed, as KServe auto-selects it.
+	AllowedRuntimes []string `json:"allowedRuntimes,omitempty"`
+	// Resource quotas of the serving environment, as Kubernetes quantities by resource name, e.g. `requests.nvidia.com/gpu`. Set it to an empty object to clear it.
+	Quotas map[string]string `json:"quotas,omitempty"`
 }
 
 // NewServingEnvironmentUpdate instantiates a new ServingEnvironmentUpdate object
@@ -140,6 +147,134 @@ func (o *ServingEnvironmentUpdate) SetExternalId(v string) {
 	o.ExternalId = &v
 }
 
+// GetClusterId returns the ClusterId field value if set, zero value otherwise.
+func (o *ServingEnvironmentUpdate) GetClusterId() string {
+	if o == nil || IsNil(o.ClusterId) {
+		var ret string
+		return ret
+	}
+	return *o.ClusterId
+}
+
+// GetClusterIdOk returns a tuple with the ClusterId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServingEnvironmentUpdate) GetClusterIdOk() (*string, bool) {
+	if o == nil || IsNil(o.ClusterId) {
+		return nil, false
+	}
+	return o.ClusterId, true
+}
+
+// HasClusterId returns a boolean if a field has been set.
+func (o *ServingEnvironmentUpdate) HasClusterId() bool {
+	if o != nil && !IsNil(o.ClusterId) {
+		return true
+	}
+
+	return false
+}
+
+// SetClusterId gets a reference to the given string and assigns it to the ClusterId field.
+func (o *ServingEnvironmentUpdate) SetClusterId(v string) {
+	o.ClusterId = &v
+}
+
+// GetTier returns the Tier field value if set, zero value otherwise.
+func (o *ServingEnvironmentUpdate) GetTier() ServingEnvironmentTier {
+	if o == nil || IsNil(o.Tier) {
+		var ret ServingEnvironmentTier
+		return ret
+	}
+	return *o.Tier
+}
+
+// GetTierOk returns a tuple with the Tier field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServingEnvironmentUpdate) GetTierOk() (*ServingEnvironmentTier, bool) {
+	if o == nil || IsNil(o.Tier) {
+		return nil, false
+	}
+	return o.Tier, true
+}
+
+// HasTier returns a boolean if a field has been set.
+func (o *ServingEnvironmentUpdate) HasTier() bool {
+	if o != nil && !IsNil(o.Tier) {
+		return true
+	}
+
+	return false
+}
+
+// SetTier gets a reference to the given ServingEnvironmentTier and assigns it to the Tier field.
+func (o *ServingEnvironmentUpdate) SetTier(v ServingEnvironmentTier) {
+	o.Tier = &v
+}
+
+// GetAllowedRuntimes returns the AllowedRuntimes field value if set, zero value otherwise.
+func (o *ServingEnvironmentUpdate) GetAllowedRuntimes() []string {
+	if o == nil || IsNil(o.AllowedRuntimes) {
+		var ret []string
+		return ret
+	}
+	return o.AllowedRuntimes
+}
+
+// GetAllowedRuntimesOk returns a tuple with the AllowedRuntimes field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServingEnvironmentUpdate) GetAllowedRuntimesOk() ([]string, bool) {
+	if o == nil || IsNil(o.AllowedRuntimes) {
+		return nil, false
+	}
+	return o.AllowedRuntimes, true
+}
+
+// HasAllowedRuntimes returns a boolean if a field has been set.
+func (o *ServingEnvironmentUpdate) HasAllowedRuntimes() bool {
+	if o != nil && !IsNil(o.AllowedRuntimes) {
+		return true
+	}
+
+	return false
+}
+
+// SetAllowedRuntimes gets a reference to the given []string and assigns it to the AllowedRuntimes field.
+func (o *ServingEnvironmentUpdate) SetAllowedRuntimes(v []string) {
+	o.AllowedRuntimes = v
+}
+
+// GetQuotas returns the Quotas field value if set, zero value otherwise.
+func (o *ServingEnvironmentUpdate) GetQuotas() map[string]string {
+	if o == nil || IsNil(o.Quotas) {
+		var ret map[string]string
+		return ret
+	}
+	return o.Quotas
+}
+
+// GetQuotasOk returns a tuple with the Quotas field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServingEnvironmentUpdate) GetQuotasOk() (map[string]string, bool) {
+	if o == nil || IsNil(o.Quotas) {
+		return map[string]string{}, false
+	}
+	return o.Quotas, true
+}
+
+// HasQuotas returns a boolean if a field has been set.
+func (o *ServingEnvironmentUpdate) HasQuotas() bool {
+	if o != nil && !IsNil(o.Quotas) {
+		return true
+	}
+
+	return false
+}
+
+// SetQuotas gets a reference to the given map[string]string and assigns it to the Quotas field.
+func (o *ServingEnvironmentUpdate) SetQuotas(v map[string]string) {
+	o.Quotas = v
+}
+
 func (o ServingEnvironmentUpdate) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -159,6 +294,18 @@ func (o ServingEnvironmentUpdate) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.ExternalId) {
 		toSerialize["externalId"] = o.ExternalId
 	}
+	if !IsNil(o.ClusterId) {
+		toSerialize["clusterId"] = o.ClusterId
+	}
+	if !IsNil(o.Tier) {
+		toSerialize["tier"] = o.Tier
+	}
+	if !IsNil(o.AllowedRuntimes) {
+		toSerialize["allowedRuntimes"] = o.AllowedRuntimes
+	}
+	if !IsNil(o.Quotas) {
+		toSerialize["quotas"] = o.Quotas
+	}
 	return toSerialize, nil
 }
 
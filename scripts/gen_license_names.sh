@@ -4,7 +4,7 @@ set -e
 
 (
     cat <<EOF
-package basecatalog
+package licensepolicy
 
 // File generated by gen_license_names.sh - DO NOT EDIT
 